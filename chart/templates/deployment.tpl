@@ -91,6 +91,12 @@ spec:
                 secretKeyRef:
                   name: {{ include "logistic.secretName" . }}
                   key: purolator-password
+            - name: HASURA_ACTION_SECRET
+              valueFrom:
+                secretKeyRef:
+                  name: {{ include "logistic.secretName" . }}
+                  key: hasura-action-secret
+                  optional: true
             {{- end }}
       {{- with .Values.nodeSelector }}
       nodeSelector:
@@ -12,4 +12,5 @@ data:
   canadapost-account-id: {{ .Values.secret.canadapostAccountId | b64enc | quote }}
   purolator-username: {{ .Values.secret.purolatorUsername | b64enc | quote }}
   purolator-password: {{ .Values.secret.purolatorPassword | b64enc | quote }}
+  hasura-action-secret: {{ .Values.secret.hasuraActionSecret | b64enc | quote }}
 {{- end }}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tournevent/logistic/internal/config"
+	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of the CLI commands.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// requestFlags are the flags of commands that read a carrier request.
+type requestFlags struct {
+	file   string // Path of a JSON or YAML request, "-" for stdin
+	data   string // Inline JSON or YAML request
+	output string
+}
+
+func (f *requestFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.file, "file", "f", "", `JSON or YAML request file, "-" for stdin`)
+	cmd.Flags().StringVar(&f.data, "data", "", "inline JSON or YAML request")
+	cmd.MarkFlagsMutuallyExclusive("file", "data")
+	cmd.Flags().StringVarP(&f.output, "output", "o", outputTable, "output format: table or json")
+}
+
+// provided reports whether a request was given with --file or --data.
+func (f *requestFlags) provided() bool {
+	return f.file != "" || f.data != ""
+}
+
+// read decodes the request given with --file or --data into v.
+func (f *requestFlags) read(cmd *cobra.Command, v any) error {
+	var data []byte
+	switch {
+	case f.data != "":
+		data = []byte(f.data)
+	case f.file == "-":
+		b, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return fmt.Errorf("reading request: %w", err)
+		}
+		data = b
+	case f.file != "":
+		b, err := os.ReadFile(f.file)
+		if err != nil {
+			return fmt.Errorf("reading request: %w", err)
+		}
+		data = b
+	default:
+		return errors.New("a request is required, use --file or --data")
+	}
+	if err := decodeRequest(data, v); err != nil {
+		return fmt.Errorf("decoding request: %w", err)
+	}
+	return nil
+}
+
+// decodeRequest decodes a JSON or YAML request into v. Keys are the field
+// names of the shipper request types, matched case-insensitively as with
+// encoding/json, e.g. "destination: {postalCode: K1A0B1}". Unknown keys
+// are rejected so that typos do not go unnoticed.
+func decodeRequest(data []byte, v any) error {
+	// YAML is a superset of JSON: the document is converted to JSON and
+	// decoded with encoding/json, so both formats share the JSON forms of
+	// Money, Decimal and time.Time
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return errors.New("empty request")
+	}
+	value, err := jsonValue(doc.Content[0], reflect.TypeOf(v))
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return errors.New("empty request")
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// jsonValue returns the value of a YAML node in the form encoding/json
+// expects for type t, nil where the request has no such field. Scalars of
+// string fields keep their text, so that unquoted phone numbers and postal
+// codes such as 02134 are not read as numbers.
+func jsonValue(n *yaml.Node, t reflect.Type) (any, error) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch n.Kind {
+	case yaml.AliasNode:
+		return jsonValue(n.Alias, t)
+	case yaml.MappingNode:
+		m := make(map[string]any, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i].Value
+			v, err := jsonValue(n.Content[i+1], fieldType(t, key))
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		}
+		return m, nil
+	case yaml.SequenceNode:
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		s := make([]any, len(n.Content))
+		for i, c := range n.Content {
+			v, err := jsonValue(c, elem)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	case yaml.ScalarNode:
+		if t != nil && t.Kind() == reflect.String && n.Tag != "!!null" {
+			return n.Value, nil
+		}
+	}
+	var v any
+	if err := n.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// fieldType returns the type encoding/json decodes key of a map or struct
+// of type t into, or nil if t has no such key.
+func fieldType(t reflect.Type, key string) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem()
+	case reflect.Struct:
+		for _, f := range reflect.VisibleFields(t) {
+			if !f.IsExported() || f.Anonymous {
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if strings.EqualFold(name, key) {
+				return f.Type
+			}
+		}
+	}
+	return nil
+}
+
+// cliRegistry builds the carrier registry of the CLI commands from the
+// same environment as serve. Logs go to stderr and tracing is disabled.
+func cliRegistry() (*config.Config, *shipper.Registry, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	logger, err := telemetry.NewStderrLogger(cfg.LogLevel)
+	if err != nil {
+		return nil, nil, err
+	}
+	registry, err := initShipperRegistry(cfg, logger, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, registry, nil
+}
+
+// validateOutput checks the --output flag before any carrier is called.
+func validateOutput(output string) error {
+	if output != outputTable && output != outputJSON {
+		return fmt.Errorf("unsupported output %q, use table or json", output)
+	}
+	return nil
+}
+
+// printJSON writes v as indented JSON.
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// newTable returns a writer aligning tab-separated columns. It must be
+// flushed.
+func newTable(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+}
+
+// printFields writes name and value pairs as a two-column table, skipping
+// empty values.
+func printFields(w io.Writer, fields [][2]string) error {
+	tw := newTable(w)
+	for _, f := range fields {
+		if f[1] != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", f[0], f[1])
+		}
+	}
+	return tw.Flush()
+}
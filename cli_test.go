@@ -0,0 +1,260 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+const quoteYAML = `
+origin:
+  city: Toronto
+  provinceCode: ON
+  postalCode: M5V 1A1
+  countryCode: CA
+destination:
+  city: Boston
+  provinceCode: MA
+  postalCode: 02134
+  countryCode: US
+  phone: 6175550123
+packages:
+  - weight: 2.5
+    length: 30
+    width: 20
+    height: 10
+`
+
+func TestDecodeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    func(t *testing.T, req *shipper.QuoteRequest)
+		wantErr string
+	}{
+		{
+			name: "yaml",
+			data: quoteYAML,
+			want: func(t *testing.T, req *shipper.QuoteRequest) {
+				assert.Equal(t, "M5V 1A1", req.Origin.PostalCode)
+				assert.Equal(t, "02134", req.Destination.PostalCode)
+				assert.Equal(t, "6175550123", req.Destination.Phone)
+				require.Len(t, req.Packages, 1)
+				assert.Equal(t, 2.5, req.Packages[0].Weight)
+			},
+		},
+		{
+			name: "json",
+			data: `{"shipperId": "shipper-123", "destination": {"postalCode": "02134", "phone": "6175550123"}}`,
+			want: func(t *testing.T, req *shipper.QuoteRequest) {
+				assert.Equal(t, "shipper-123", req.ShipperID)
+				assert.Equal(t, "02134", req.Destination.PostalCode)
+				assert.Equal(t, "6175550123", req.Destination.Phone)
+			},
+		},
+		{
+			name: "keys ignore case",
+			data: "ShipperID: shipper-123\noptions: {carriers: [freightcom], SIGNATUREREQUIRED: true}",
+			want: func(t *testing.T, req *shipper.QuoteRequest) {
+				assert.Equal(t, "shipper-123", req.ShipperID)
+				assert.Equal(t, []string{"freightcom"}, req.Options.Carriers)
+				assert.True(t, req.Options.SignatureRequired)
+			},
+		},
+		{
+			name: "null string",
+			data: "shipperId: null",
+			want: func(t *testing.T, req *shipper.QuoteRequest) {
+				assert.Empty(t, req.ShipperID)
+			},
+		},
+		{
+			name:    "unknown key",
+			data:    "destination: {postcode: 02134}",
+			wantErr: `unknown field "postcode"`,
+		},
+		{
+			name:    "text for number field",
+			data:    "packages: [{weight: heavy}]",
+			wantErr: "cannot unmarshal",
+		},
+		{
+			name:    "empty",
+			data:    "\n",
+			wantErr: "empty request",
+		},
+		{
+			name:    "null",
+			data:    "null",
+			wantErr: "empty request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req shipper.QuoteRequest
+			err := decodeRequest([]byte(tt.data), &req)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.want(t, &req)
+		})
+	}
+}
+
+func TestLabelPath(t *testing.T) {
+	req := shipper.GetLabelRequest{OrderID: "order-123", Format: shipper.LabelPDF}
+
+	tests := []struct {
+		name  string
+		out   string
+		req   shipper.GetLabelRequest
+		label shipper.Label
+		i     int
+		want  string
+	}{
+		{"default", "", req, shipper.Label{}, 0, "order-123.pdf"},
+		{"label format", "", req, shipper.Label{Format: shipper.LabelZPL}, 0, "order-123.zpl"},
+		{"out", "labels/shipment.pdf", req, shipper.Label{}, 0, "labels/shipment.pdf"},
+		{"numbered", "", req, shipper.Label{}, 1, "order-123-2.pdf"},
+		{"numbered out", "labels/shipment.pdf", req, shipper.Label{}, 2, "labels/shipment-3.pdf"},
+		{"order ID with slashes", "", shipper.GetLabelRequest{OrderID: "2025/03/123", Format: shipper.LabelPNG}, shipper.Label{}, 0, "2025_03_123.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, labelPath(tt.out, tt.req, tt.label, tt.i))
+		})
+	}
+}
+
+func TestQuoteRequest_Flags(t *testing.T) {
+	saved := quoteFlags
+	t.Cleanup(func() { quoteFlags = saved })
+
+	quoteFlags.data = quoteYAML + "shipperId: shipper-123\noptions: {carriers: [canadapost]}\n"
+	quoteFlags.shipperID = "shipper-456"
+	quoteFlags.carriers = []string{"freightcom", "purolator"}
+
+	req, err := quoteRequest(&cobra.Command{})
+	require.NoError(t, err)
+	assert.Equal(t, "shipper-456", req.ShipperID)
+	assert.Equal(t, []string{"freightcom", "purolator"}, req.Options.Carriers)
+	assert.Equal(t, "02134", req.Destination.PostalCode)
+
+	quoteFlags.data = "destination: {countryCode: CA, postalCode: 02134}"
+	_, err = quoteRequest(&cobra.Command{})
+	assert.ErrorIs(t, err, shipper.ErrInvalidAddress)
+}
+
+func TestLabelRequest_Flags(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		orderID  string
+		format   string
+		pieceIDs []string
+		want     shipper.GetLabelRequest
+		wantErr  string
+	}{
+		{
+			name:    "flags only",
+			orderID: "order-123",
+			want:    shipper.GetLabelRequest{OrderID: "order-123", Format: shipper.LabelPDF},
+		},
+		{
+			name: "request only",
+			data: "orderId: order-123\nformat: zpl\npieceIds: [1, 2]",
+			want: shipper.GetLabelRequest{OrderID: "order-123", Format: shipper.LabelZPL, PieceIDs: []string{"1", "2"}},
+		},
+		{
+			name:     "flags override request",
+			data:     "orderId: order-123\nformat: zpl\npieceIds: [1, 2]",
+			orderID:  "order-456",
+			format:   "png",
+			pieceIDs: []string{"3"},
+			want:     shipper.GetLabelRequest{OrderID: "order-456", Format: shipper.LabelPNG, PieceIDs: []string{"3"}},
+		},
+		{
+			name:    "missing order ID",
+			format:  "pdf",
+			wantErr: "an order ID is required",
+		},
+		{
+			name:    "unsupported format",
+			orderID: "order-123",
+			format:  "gif",
+			wantErr: `unsupported label format "gif"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := labelGetFlags
+			t.Cleanup(func() { labelGetFlags = saved })
+			labelGetFlags.data = tt.data
+			labelGetFlags.orderID = tt.orderID
+			labelGetFlags.format = tt.format
+			labelGetFlags.pieceIDs = tt.pieceIDs
+
+			req, err := labelRequest(&cobra.Command{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *req)
+		})
+	}
+}
+
+func TestCancelRequest_Flags(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		orderID  string
+		reason   string
+		pieceIDs []string
+		want     shipper.CancelOrderRequest
+		wantErr  string
+	}{
+		{
+			name:    "flags only",
+			orderID: "order-123",
+			reason:  "duplicate",
+			want:    shipper.CancelOrderRequest{OrderID: "order-123", Reason: "duplicate"},
+		},
+		{
+			name:     "flags override request",
+			data:     `{"orderId": "order-123", "reason": "duplicate", "pieceIds": ["1"]}`,
+			orderID:  "order-456",
+			pieceIDs: []string{"2"},
+			want:     shipper.CancelOrderRequest{OrderID: "order-456", Reason: "duplicate", PieceIDs: []string{"2"}},
+		},
+		{
+			name:    "missing order ID",
+			reason:  "duplicate",
+			wantErr: "an order ID is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := orderCancelFlags
+			t.Cleanup(func() { orderCancelFlags = saved })
+			orderCancelFlags.data = tt.data
+			orderCancelFlags.orderID = tt.orderID
+			orderCancelFlags.reason = tt.reason
+			orderCancelFlags.pieceIDs = tt.pieceIDs
+
+			req, err := cancelRequest(&cobra.Command{})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *req)
+		})
+	}
+}
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/tournevent/logistic/internal/config"
+	"github.com/tournevent/logistic/internal/poller"
+	"github.com/tournevent/logistic/internal/store"
 	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/internal/webhook"
 	"github.com/tournevent/logistic/pkg/shipper"
 	"github.com/tournevent/logistic/pkg/shipper/canadapost"
 	"github.com/tournevent/logistic/pkg/shipper/freightcom"
+	"github.com/tournevent/logistic/pkg/shipper/middleware"
 	"github.com/tournevent/logistic/pkg/shipper/purolator"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -21,51 +27,152 @@ func initLogger(level string) (*otelzap.Logger, error) {
 	return telemetry.NewLogger(level)
 }
 
-func initTracer(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+// initTracer sets up tracing. With tracing disabled it returns a nil tracer,
+// which carriers treat as the global (noop) tracer provider.
+func initTracer(ctx context.Context, cfg *config.Config) (trace.Tracer, func(context.Context) error, error) {
 	if !cfg.OTELEnabled {
-		return func(context.Context) error { return nil }, nil
+		return nil, func(context.Context) error { return nil }, nil
 	}
 
-	_, shutdown, err := telemetry.InitTracer(ctx, cfg.OTELEndpoint, cfg.ServiceName, cfg.Version)
-	return shutdown, err
+	return telemetry.InitTracer(ctx, cfg.OTELEndpoint, cfg.ServiceName, cfg.Version)
 }
 
-func initShipperRegistry(cfg *config.Config, logger *otelzap.Logger) *shipper.Registry {
-	registry := shipper.NewRegistry()
+func initQuoteStore(cfg *config.Config) (shipper.QuoteStore, error) {
+	if cfg.QuoteStorePath == "" {
+		return store.NewMemoryQuoteStore(), nil
+	}
+	return store.NewFileQuoteStore(cfg.QuoteStorePath)
+}
+
+func initOrderStore(cfg *config.Config) (shipper.OrderStore, error) {
+	if cfg.OrderStorePath == "" {
+		return store.NewMemoryOrderStore(), nil
+	}
+	return store.NewFileOrderStore(cfg.OrderStorePath)
+}
 
-	// Get tracer for carriers
-	var tracer trace.Tracer
-	// tracer would be initialized from otel.GetTracerProvider().Tracer(cfg.ServiceName)
+func initIdempotencyStore(cfg *config.Config) (shipper.IdempotencyStore, error) {
+	if cfg.IdempotencyStorePath == "" {
+		return store.NewMemoryIdempotencyStore(), nil
+	}
+	return store.NewFileIdempotencyStore(cfg.IdempotencyStorePath)
+}
+
+func initWebhooks(cfg *config.Config, logger *otelzap.Logger) (*webhook.Dispatcher, error) {
+	var webhooks webhook.Store = store.NewMemoryWebhookStore()
+	if cfg.WebhookStorePath != "" {
+		fileStore, err := store.NewFileWebhookStore(cfg.WebhookStorePath)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = fileStore
+	}
+	return webhook.NewDispatcher(webhooks, logger, webhook.Config{
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		BaseDelay:   cfg.WebhookBaseDelay,
+		MaxDelay:    cfg.WebhookMaxDelay,
+		Timeout:     cfg.WebhookTimeout,
+	}), nil
+}
+
+// initPoller returns the tracking poller settings, or nil when polling is
+// disabled.
+func initPoller(cfg *config.Config) *poller.Config {
+	if !cfg.PollerEnabled {
+		return nil
+	}
+	return &poller.Config{
+		Carriers:               cfg.PollerCarriers,
+		Interval:               cfg.PollerInterval,
+		OutForDeliveryInterval: cfg.PollerOutForDeliveryInterval,
+		MaxInterval:            cfg.PollerMaxInterval,
+		ScanInterval:           cfg.PollerScanInterval,
+		Workers:                cfg.PollerWorkers,
+		CarrierConcurrency:     cfg.PollerCarrierConcurrency,
+	}
+}
+
+func initShipperRegistry(cfg *config.Config, logger *otelzap.Logger, tracer trace.Tracer) (*shipper.Registry, error) {
+	registry := shipper.NewRegistry()
+	registry.SetTracer(tracer)
 
 	// Register enabled carriers
 	if cfg.FreightcomEnabled {
+		limiter, err := newRateLimiter("freightcom", cfg.FreightcomRateLimits)
+		if err != nil {
+			return nil, err
+		}
 		fc := freightcom.New(freightcom.Config{
-			APIKey:  cfg.FreightcomAPIKey,
-			BaseURL: cfg.FreightcomBaseURL,
-			UseMock: cfg.FreightcomUseMock,
+			APIKey:        cfg.FreightcomAPIKey,
+			BaseURL:       cfg.FreightcomBaseURL,
+			UseMock:       cfg.FreightcomUseMock,
+			RateLimiter:   limiter,
+			WebhookSecret: cfg.FreightcomWebhookSecret,
 		}, logger, tracer)
-		registry.Register(fc)
+		registry.Register(withResilience(fc, cfg))
 	}
 
 	if cfg.CanadaPostEnabled {
+		limiter, err := newRateLimiter("canadapost", cfg.CanadaPostRateLimits)
+		if err != nil {
+			return nil, err
+		}
 		cp := canadapost.New(canadapost.Config{
-			APIKey:    cfg.CanadaPostAPIKey,
-			AccountID: cfg.CanadaPostAccountID,
-			BaseURL:   cfg.CanadaPostBaseURL,
-			UseMock:   cfg.CanadaPostUseMock,
+			APIKey:          cfg.CanadaPostAPIKey,
+			AccountID:       cfg.CanadaPostAccountID,
+			BaseURL:         cfg.CanadaPostBaseURL,
+			UseMock:         cfg.CanadaPostUseMock,
+			RateLimiter:     limiter,
+			WebhookUsername: cfg.CanadaPostWebhookUsername,
+			WebhookPassword: cfg.CanadaPostWebhookPassword,
 		}, logger, tracer)
-		registry.Register(cp)
+		registry.Register(withResilience(cp, cfg))
 	}
 
 	if cfg.PurolatorEnabled {
+		limiter, err := newRateLimiter("purolator", cfg.PurolatorRateLimits)
+		if err != nil {
+			return nil, err
+		}
 		puro := purolator.New(purolator.Config{
-			Username: cfg.PurolatorUsername,
-			Password: cfg.PurolatorPassword,
-			WSDLURL:  cfg.PurolatorWSDLURL,
-			UseMock:  cfg.PurolatorUseMock,
+			Username:    cfg.PurolatorUsername,
+			Password:    cfg.PurolatorPassword,
+			WSDLURL:     cfg.PurolatorWSDLURL,
+			UseMock:     cfg.PurolatorUseMock,
+			RateLimiter: limiter,
 		}, logger, tracer)
-		registry.Register(puro)
+		registry.Register(withResilience(puro, cfg))
 	}
 
-	return registry
+	return registry, nil
+}
+
+// newRateLimiter builds a carrier's rate limiter from its configured limits.
+// It returns nil, meaning unlimited, when no limits are configured.
+func newRateLimiter(carrier string, spec map[string]string) (*ratelimit.Limiter, error) {
+	if len(spec) == 0 {
+		return nil, nil
+	}
+	limits, err := ratelimit.ParseConfig(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", carrier, err)
+	}
+	return ratelimit.New(carrier, limits, telemetry.NewMetrics()), nil
+}
+
+// withResilience wraps a carrier with retries and a circuit breaker.
+// Retries are outermost so that every attempt is seen by the breaker.
+func withResilience(s shipper.Shipper, cfg *config.Config) shipper.Shipper {
+	metrics := telemetry.NewMetrics()
+	return middleware.Chain(s,
+		middleware.Retry(middleware.RetryConfig{
+			MaxAttempts: cfg.RetryMaxAttempts,
+			BaseDelay:   cfg.RetryBaseDelay,
+			MaxDelay:    cfg.RetryMaxDelay,
+		}, metrics),
+		middleware.CircuitBreaker(middleware.BreakerConfig{
+			FailureThreshold: cfg.BreakerFailureThreshold,
+			OpenTimeout:      cfg.BreakerOpenTimeout,
+		}, metrics),
+	)
 }
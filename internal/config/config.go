@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"go.opentelemetry.io/otel/attribute"
@@ -13,12 +14,59 @@ type Config struct {
 	Port     int    `envconfig:"PORT" default:"80"`
 	LogLevel string `envconfig:"LOG_LEVEL" default:"info"`
 
+	// Hasura
+	HasuraActionSecret string `envconfig:"HASURA_ACTION_SECRET"` // Empty = no verification
+
+	// Storage
+	QuoteStorePath       string `envconfig:"QUOTE_STORE_PATH"`       // Empty = in-memory
+	OrderStorePath       string `envconfig:"ORDER_STORE_PATH"`       // Empty = in-memory
+	IdempotencyStorePath string `envconfig:"IDEMPOTENCY_STORE_PATH"` // Empty = in-memory
+	WebhookStorePath     string `envconfig:"WEBHOOK_STORE_PATH"`     // Empty = in-memory
+
+	// Webhook delivery
+	WebhookMaxAttempts int           `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookBaseDelay   time.Duration `envconfig:"WEBHOOK_BASE_DELAY" default:"30s"`
+	WebhookMaxDelay    time.Duration `envconfig:"WEBHOOK_MAX_DELAY" default:"1h"`
+	WebhookTimeout     time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+
+	// Tracking poller, for carriers that do not push tracking events
+	PollerEnabled                bool           `envconfig:"POLLER_ENABLED" default:"true"`
+	PollerCarriers               []string       `envconfig:"POLLER_CARRIERS" default:"purolator"` // Empty = all carriers
+	PollerInterval               time.Duration  `envconfig:"POLLER_INTERVAL" default:"30m"`
+	PollerOutForDeliveryInterval time.Duration  `envconfig:"POLLER_OUT_FOR_DELIVERY_INTERVAL" default:"10m"`
+	PollerMaxInterval            time.Duration  `envconfig:"POLLER_MAX_INTERVAL" default:"6h"`
+	PollerScanInterval           time.Duration  `envconfig:"POLLER_SCAN_INTERVAL" default:"1m"`
+	PollerWorkers                int            `envconfig:"POLLER_WORKERS" default:"8"`
+	PollerCarrierConcurrency     map[string]int `envconfig:"POLLER_CARRIER_CONCURRENCY" default:"default:2"` // Polls at once per carrier, as carrier:count pairs
+
+	// Carrier resilience
+	RetryMaxAttempts        int           `envconfig:"RETRY_MAX_ATTEMPTS" default:"3"`
+	RetryBaseDelay          time.Duration `envconfig:"RETRY_BASE_DELAY" default:"200ms"`
+	RetryMaxDelay           time.Duration `envconfig:"RETRY_MAX_DELAY" default:"2s"`
+	BreakerFailureThreshold int           `envconfig:"BREAKER_FAILURE_THRESHOLD" default:"5"`
+	BreakerOpenTimeout      time.Duration `envconfig:"BREAKER_OPEN_TIMEOUT" default:"30s"`
+
+	// Best value rate ranking, as relative weights of price and transit days
+	RatingPriceWeight float64 `envconfig:"RATING_PRICE_WEIGHT" default:"0.7"`
+	RatingSpeedWeight float64 `envconfig:"RATING_SPEED_WEIGHT" default:"0.3"`
+
 	// Freightcom
 	FreightcomAPIKey  string `envconfig:"FREIGHTCOM_API_KEY"`
 	FreightcomBaseURL string `envconfig:"FREIGHTCOM_BASE_URL" default:"https://api.freightcom.com/v1"`
 	FreightcomEnabled bool   `envconfig:"FREIGHTCOM_ENABLED" default:"true"`
 	FreightcomUseMock bool   `envconfig:"FREIGHTCOM_USE_MOCK" default:"false"`
 
+	// Key of the signatures of tracking pushed to /webhooks/freightcom.
+	// Empty = pushes are rejected.
+	FreightcomWebhookSecret string `envconfig:"FREIGHTCOM_WEBHOOK_SECRET"`
+
+	// Client-side rate limits per carrier, as operation:rate[/burst] pairs in
+	// requests per second, e.g. "default:10/20,poll_rates:2". The default
+	// entry applies to operations without their own limit. Empty = unlimited.
+	FreightcomRateLimits map[string]string `envconfig:"FREIGHTCOM_RATE_LIMITS"`
+	CanadaPostRateLimits map[string]string `envconfig:"CANADAPOST_RATE_LIMITS"`
+	PurolatorRateLimits  map[string]string `envconfig:"PUROLATOR_RATE_LIMITS"`
+
 	// Canada Post
 	CanadaPostAPIKey    string `envconfig:"CANADAPOST_API_KEY"`
 	CanadaPostAccountID string `envconfig:"CANADAPOST_ACCOUNT_ID"`
@@ -26,6 +74,11 @@ type Config struct {
 	CanadaPostEnabled   bool   `envconfig:"CANADAPOST_ENABLED" default:"true"`
 	CanadaPostUseMock   bool   `envconfig:"CANADAPOST_USE_MOCK" default:"false"`
 
+	// Basic auth credentials of tracking pushed to /webhooks/canadapost.
+	// Empty = pushes are rejected.
+	CanadaPostWebhookUsername string `envconfig:"CANADAPOST_WEBHOOK_USERNAME"`
+	CanadaPostWebhookPassword string `envconfig:"CANADAPOST_WEBHOOK_PASSWORD"`
+
 	// Purolator
 	PurolatorUsername string `envconfig:"PUROLATOR_USERNAME"`
 	PurolatorPassword string `envconfig:"PUROLATOR_PASSWORD"`
@@ -14,6 +14,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/tournevent/logistic/pkg/shipper"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -61,6 +62,14 @@ type ComplexityRoot struct {
 		ProvinceCode  func(childComplexity int) int
 	}
 
+	CancelPickupResponse struct {
+		Errors   func(childComplexity int) int
+		Metadata func(childComplexity int) int
+		PickupID func(childComplexity int) int
+		Status   func(childComplexity int) int
+		Success  func(childComplexity int) int
+	}
+
 	CancelResponse struct {
 		ConfirmationNumber func(childComplexity int) int
 		Errors             func(childComplexity int) int
@@ -71,6 +80,31 @@ type ComplexityRoot struct {
 		Success            func(childComplexity int) int
 	}
 
+	CarrierCapabilities struct {
+		Carrier           func(childComplexity int) int
+		Insurance         func(childComplexity int) int
+		International     func(childComplexity int) int
+		LabelFormats      func(childComplexity int) int
+		Manifests         func(childComplexity int) int
+		MaxWeight         func(childComplexity int) int
+		MultiPiece        func(childComplexity int) int
+		Pickups           func(childComplexity int) int
+		Returns           func(childComplexity int) int
+		SaturdayDelivery  func(childComplexity int) int
+		SignatureRequired func(childComplexity int) int
+	}
+
+	CarrierQuoteResult struct {
+		Carrier     func(childComplexity int) int
+		CarrierCode func(childComplexity int) int
+		ErrorCode   func(childComplexity int) int
+		LatencyMs   func(childComplexity int) int
+		QuoteID     func(childComplexity int) int
+		RateCount   func(childComplexity int) int
+		Retryable   func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
 	Error struct {
 		CarrierCode func(childComplexity int) int
 		Code        func(childComplexity int) int
@@ -94,16 +128,72 @@ type ComplexityRoot struct {
 		Success          func(childComplexity int) int
 	}
 
+	Manifest struct {
+		Document   func(childComplexity int) int
+		ManifestID func(childComplexity int) int
+	}
+
+	ManifestGroup struct {
+		Manifests        func(childComplexity int) int
+		OrderIds         func(childComplexity int) int
+		OriginPostalCode func(childComplexity int) int
+		ShipDate         func(childComplexity int) int
+	}
+
+	ManifestResponse struct {
+		Errors   func(childComplexity int) int
+		Groups   func(childComplexity int) int
+		Metadata func(childComplexity int) int
+		Success  func(childComplexity int) int
+	}
+
 	Money struct {
 		Amount   func(childComplexity int) int
 		Currency func(childComplexity int) int
 	}
 
 	Mutation struct {
-		DelivroCancelOrder func(childComplexity int, input CancelOrderInput) int
-		DelivroCreateOrder func(childComplexity int, input CreateOrderInput) int
-		DelivroGetLabel    func(childComplexity int, input GetLabelInput) int
-		DelivroGetQuote    func(childComplexity int, input GetQuoteInput) int
+		DelivroCancelOrder           func(childComplexity int, input CancelOrderInput) int
+		DelivroCancelPickup          func(childComplexity int, input CancelPickupInput) int
+		DelivroCreateOrder           func(childComplexity int, input CreateOrderInput) int
+		DelivroCreateReturn          func(childComplexity int, input CreateReturnInput) int
+		DelivroCreateWebhook         func(childComplexity int, input CreateWebhookInput) int
+		DelivroDeleteWebhook         func(childComplexity int, input DeleteWebhookInput) int
+		DelivroGetLabel              func(childComplexity int, input GetLabelInput) int
+		DelivroGetQuote              func(childComplexity int, input GetQuoteInput) int
+		DelivroManifest              func(childComplexity int, input ManifestInput) int
+		DelivroPickupAvailability    func(childComplexity int, input PickupAvailabilityInput) int
+		DelivroReplayWebhookDelivery func(childComplexity int, input ReplayWebhookDeliveryInput) int
+		DelivroSchedulePickup        func(childComplexity int, input SchedulePickupInput) int
+		DelivroTrackShipment         func(childComplexity int, input TrackShipmentInput) int
+	}
+
+	Order struct {
+		Carrier           func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		EstimatedDelivery func(childComplexity int) int
+		LabelURL          func(childComplexity int) int
+		OrderID           func(childComplexity int) int
+		Pieces            func(childComplexity int) int
+		PoNumber          func(childComplexity int) int
+		RecipientAddress  func(childComplexity int) int
+		Reference         func(childComplexity int) int
+		ReturnOf          func(childComplexity int) int
+		SenderAddress     func(childComplexity int) int
+		ServiceName       func(childComplexity int) int
+		ShipperID         func(childComplexity int) int
+		Status            func(childComplexity int) int
+		StatusHistory     func(childComplexity int) int
+		TotalCharged      func(childComplexity int) int
+		TrackingNumber    func(childComplexity int) int
+		TrackingURL       func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+	}
+
+	OrderConnection struct {
+		HasMore    func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+		Orders     func(childComplexity int) int
 	}
 
 	OrderResponse struct {
@@ -113,6 +203,8 @@ type ComplexityRoot struct {
 		LabelURL          func(childComplexity int) int
 		Metadata          func(childComplexity int) int
 		OrderID           func(childComplexity int) int
+		Pieces            func(childComplexity int) int
+		PreviouslyCreated func(childComplexity int) int
 		ServiceName       func(childComplexity int) int
 		Status            func(childComplexity int) int
 		Success           func(childComplexity int) int
@@ -135,32 +227,72 @@ type ComplexityRoot struct {
 		Width         func(childComplexity int) int
 	}
 
-	Query struct {
-		Carriers     func(childComplexity int) int
-		Health       func(childComplexity int) int
-		ServiceTypes func(childComplexity int) int
+	PickupAvailabilityResponse struct {
+		Available func(childComplexity int) int
+		BookBy    func(childComplexity int) int
+		Errors    func(childComplexity int) int
+		Metadata  func(childComplexity int) int
+		Success   func(childComplexity int) int
+		Windows   func(childComplexity int) int
 	}
 
-	QuoteResponse struct {
+	PickupResponse struct {
+		Carrier  func(childComplexity int) int
 		Errors   func(childComplexity int) int
 		Metadata func(childComplexity int) int
-		QuoteID  func(childComplexity int) int
-		Rates    func(childComplexity int) int
+		PickupID func(childComplexity int) int
+		Status   func(childComplexity int) int
 		Success  func(childComplexity int) int
+		Window   func(childComplexity int) int
+	}
+
+	PickupWindow struct {
+		CloseAt func(childComplexity int) int
+		ReadyAt func(childComplexity int) int
+	}
+
+	Piece struct {
+		LabelURL       func(childComplexity int) int
+		PieceID        func(childComplexity int) int
+		TrackingNumber func(childComplexity int) int
+	}
+
+	Query struct {
+		CarrierCapabilities      func(childComplexity int, carrier *Carrier) int
+		Carriers                 func(childComplexity int) int
+		DelivroOrder             func(childComplexity int, shipperID string, orderID string) int
+		DelivroOrderByReference  func(childComplexity int, shipperID string, reference string) int
+		DelivroOrders            func(childComplexity int, shipperID string, first *int, after *string) int
+		DelivroWebhookDeliveries func(childComplexity int, shipperID string, state *WebhookDeliveryState, first *int) int
+		DelivroWebhooks          func(childComplexity int, shipperID string) int
+		Health                   func(childComplexity int) int
+		ServiceTypes             func(childComplexity int) int
+	}
+
+	QuoteResponse struct {
+		CarrierResults func(childComplexity int) int
+		Errors         func(childComplexity int) int
+		Metadata       func(childComplexity int) int
+		QuoteID        func(childComplexity int) int
+		Rates          func(childComplexity int) int
+		Success        func(childComplexity int) int
 	}
 
 	RateOption struct {
 		BaseRate          func(childComplexity int) int
+		BillableWeight    func(childComplexity int) int
 		Carrier           func(childComplexity int) int
 		EstimatedDelivery func(childComplexity int) int
 		ExpiresAt         func(childComplexity int) int
 		FuelSurcharge     func(childComplexity int) int
 		Guaranteed        func(childComplexity int) int
 		RateID            func(childComplexity int) int
+		Score             func(childComplexity int) int
 		ServiceCode       func(childComplexity int) int
 		ServiceName       func(childComplexity int) int
 		ServiceType       func(childComplexity int) int
 		SignatureRequired func(childComplexity int) int
+		Tags              func(childComplexity int) int
 		Taxes             func(childComplexity int) int
 		TotalPrice        func(childComplexity int) int
 		TransitDays       func(childComplexity int) int
@@ -172,6 +304,89 @@ type ComplexityRoot struct {
 		ProcessedAt  func(childComplexity int) int
 		RequestID    func(childComplexity int) int
 	}
+
+	ReturnResponse struct {
+		AdditionalLabels func(childComplexity int) int
+		Carrier          func(childComplexity int) int
+		Errors           func(childComplexity int) int
+		Label            func(childComplexity int) int
+		Metadata         func(childComplexity int) int
+		OrderID          func(childComplexity int) int
+		OriginalOrderID  func(childComplexity int) int
+		Status           func(childComplexity int) int
+		Success          func(childComplexity int) int
+		TotalCharged     func(childComplexity int) int
+		TrackingNumber   func(childComplexity int) int
+	}
+
+	StatusChange struct {
+		Source    func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+	}
+
+	TrackingEvent struct {
+		CarrierCode func(childComplexity int) int
+		Description func(childComplexity int) int
+		Location    func(childComplexity int) int
+		Status      func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
+	TrackingResponse struct {
+		Errors            func(childComplexity int) int
+		EstimatedDelivery func(childComplexity int) int
+		Events            func(childComplexity int) int
+		Metadata          func(childComplexity int) int
+		OrderID           func(childComplexity int) int
+		Status            func(childComplexity int) int
+		Success           func(childComplexity int) int
+		TrackingNumber    func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		Attempts       func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		DeliveredAt    func(childComplexity int) int
+		EventID        func(childComplexity int) int
+		EventType      func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LastError      func(childComplexity int) int
+		LastStatusCode func(childComplexity int) int
+		NextAttemptAt  func(childComplexity int) int
+		OrderID        func(childComplexity int) int
+		State          func(childComplexity int) int
+		SubscriptionID func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	WebhookDeliveryResponse struct {
+		Delivery func(childComplexity int) int
+		Errors   func(childComplexity int) int
+		Metadata func(childComplexity int) int
+		Success  func(childComplexity int) int
+	}
+
+	WebhookResponse struct {
+		Errors       func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Secret       func(childComplexity int) int
+		Subscription func(childComplexity int) int
+		Success      func(childComplexity int) int
+	}
+
+	WebhookSubscription struct {
+		CreatedAt func(childComplexity int) int
+		Events    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		ShipperID func(childComplexity int) int
+		URL       func(childComplexity int) int
+	}
+
+	Weight struct {
+		Unit  func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
 }
 
 type MutationResolver interface {
@@ -179,11 +394,26 @@ type MutationResolver interface {
 	DelivroCreateOrder(ctx context.Context, input CreateOrderInput) (*OrderResponse, error)
 	DelivroGetLabel(ctx context.Context, input GetLabelInput) (*LabelResponse, error)
 	DelivroCancelOrder(ctx context.Context, input CancelOrderInput) (*CancelResponse, error)
+	DelivroTrackShipment(ctx context.Context, input TrackShipmentInput) (*TrackingResponse, error)
+	DelivroPickupAvailability(ctx context.Context, input PickupAvailabilityInput) (*PickupAvailabilityResponse, error)
+	DelivroSchedulePickup(ctx context.Context, input SchedulePickupInput) (*PickupResponse, error)
+	DelivroCancelPickup(ctx context.Context, input CancelPickupInput) (*CancelPickupResponse, error)
+	DelivroManifest(ctx context.Context, input ManifestInput) (*ManifestResponse, error)
+	DelivroCreateReturn(ctx context.Context, input CreateReturnInput) (*ReturnResponse, error)
+	DelivroCreateWebhook(ctx context.Context, input CreateWebhookInput) (*WebhookResponse, error)
+	DelivroDeleteWebhook(ctx context.Context, input DeleteWebhookInput) (*WebhookResponse, error)
+	DelivroReplayWebhookDelivery(ctx context.Context, input ReplayWebhookDeliveryInput) (*WebhookDeliveryResponse, error)
 }
 type QueryResolver interface {
 	Health(ctx context.Context) (bool, error)
 	Carriers(ctx context.Context) ([]Carrier, error)
 	ServiceTypes(ctx context.Context) ([]ServiceType, error)
+	CarrierCapabilities(ctx context.Context, carrier *Carrier) ([]*CarrierCapabilities, error)
+	DelivroOrder(ctx context.Context, shipperID string, orderID string) (*Order, error)
+	DelivroOrderByReference(ctx context.Context, shipperID string, reference string) (*Order, error)
+	DelivroOrders(ctx context.Context, shipperID string, first *int, after *string) (*OrderConnection, error)
+	DelivroWebhooks(ctx context.Context, shipperID string) ([]*WebhookSubscription, error)
+	DelivroWebhookDeliveries(ctx context.Context, shipperID string, state *WebhookDeliveryState, first *int) ([]*WebhookDelivery, error)
 }
 
 type executableSchema struct {
@@ -278,6 +508,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Address.ProvinceCode(childComplexity), true
 
+	case "CancelPickupResponse.errors":
+		if e.complexity.CancelPickupResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.CancelPickupResponse.Errors(childComplexity), true
+	case "CancelPickupResponse.metadata":
+		if e.complexity.CancelPickupResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.CancelPickupResponse.Metadata(childComplexity), true
+	case "CancelPickupResponse.pickupId":
+		if e.complexity.CancelPickupResponse.PickupID == nil {
+			break
+		}
+
+		return e.complexity.CancelPickupResponse.PickupID(childComplexity), true
+	case "CancelPickupResponse.status":
+		if e.complexity.CancelPickupResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.CancelPickupResponse.Status(childComplexity), true
+	case "CancelPickupResponse.success":
+		if e.complexity.CancelPickupResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.CancelPickupResponse.Success(childComplexity), true
+
 	case "CancelResponse.confirmationNumber":
 		if e.complexity.CancelResponse.ConfirmationNumber == nil {
 			break
@@ -321,6 +582,122 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CancelResponse.Success(childComplexity), true
 
+	case "CarrierCapabilities.carrier":
+		if e.complexity.CarrierCapabilities.Carrier == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.Carrier(childComplexity), true
+	case "CarrierCapabilities.insurance":
+		if e.complexity.CarrierCapabilities.Insurance == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.Insurance(childComplexity), true
+	case "CarrierCapabilities.international":
+		if e.complexity.CarrierCapabilities.International == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.International(childComplexity), true
+	case "CarrierCapabilities.labelFormats":
+		if e.complexity.CarrierCapabilities.LabelFormats == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.LabelFormats(childComplexity), true
+	case "CarrierCapabilities.manifests":
+		if e.complexity.CarrierCapabilities.Manifests == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.Manifests(childComplexity), true
+	case "CarrierCapabilities.maxWeight":
+		if e.complexity.CarrierCapabilities.MaxWeight == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.MaxWeight(childComplexity), true
+	case "CarrierCapabilities.multiPiece":
+		if e.complexity.CarrierCapabilities.MultiPiece == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.MultiPiece(childComplexity), true
+	case "CarrierCapabilities.pickups":
+		if e.complexity.CarrierCapabilities.Pickups == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.Pickups(childComplexity), true
+	case "CarrierCapabilities.returns":
+		if e.complexity.CarrierCapabilities.Returns == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.Returns(childComplexity), true
+	case "CarrierCapabilities.saturdayDelivery":
+		if e.complexity.CarrierCapabilities.SaturdayDelivery == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.SaturdayDelivery(childComplexity), true
+	case "CarrierCapabilities.signatureRequired":
+		if e.complexity.CarrierCapabilities.SignatureRequired == nil {
+			break
+		}
+
+		return e.complexity.CarrierCapabilities.SignatureRequired(childComplexity), true
+
+	case "CarrierQuoteResult.carrier":
+		if e.complexity.CarrierQuoteResult.Carrier == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.Carrier(childComplexity), true
+	case "CarrierQuoteResult.carrierCode":
+		if e.complexity.CarrierQuoteResult.CarrierCode == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.CarrierCode(childComplexity), true
+	case "CarrierQuoteResult.errorCode":
+		if e.complexity.CarrierQuoteResult.ErrorCode == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.ErrorCode(childComplexity), true
+	case "CarrierQuoteResult.latencyMs":
+		if e.complexity.CarrierQuoteResult.LatencyMs == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.LatencyMs(childComplexity), true
+	case "CarrierQuoteResult.quoteId":
+		if e.complexity.CarrierQuoteResult.QuoteID == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.QuoteID(childComplexity), true
+	case "CarrierQuoteResult.rateCount":
+		if e.complexity.CarrierQuoteResult.RateCount == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.RateCount(childComplexity), true
+	case "CarrierQuoteResult.retryable":
+		if e.complexity.CarrierQuoteResult.Retryable == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.Retryable(childComplexity), true
+	case "CarrierQuoteResult.status":
+		if e.complexity.CarrierQuoteResult.Status == nil {
+			break
+		}
+
+		return e.complexity.CarrierQuoteResult.Status(childComplexity), true
+
 	case "Error.carrierCode":
 		if e.complexity.Error.CarrierCode == nil {
 			break
@@ -408,6 +785,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.LabelResponse.Success(childComplexity), true
 
+	case "Manifest.document":
+		if e.complexity.Manifest.Document == nil {
+			break
+		}
+
+		return e.complexity.Manifest.Document(childComplexity), true
+	case "Manifest.manifestId":
+		if e.complexity.Manifest.ManifestID == nil {
+			break
+		}
+
+		return e.complexity.Manifest.ManifestID(childComplexity), true
+
+	case "ManifestGroup.manifests":
+		if e.complexity.ManifestGroup.Manifests == nil {
+			break
+		}
+
+		return e.complexity.ManifestGroup.Manifests(childComplexity), true
+	case "ManifestGroup.orderIds":
+		if e.complexity.ManifestGroup.OrderIds == nil {
+			break
+		}
+
+		return e.complexity.ManifestGroup.OrderIds(childComplexity), true
+	case "ManifestGroup.originPostalCode":
+		if e.complexity.ManifestGroup.OriginPostalCode == nil {
+			break
+		}
+
+		return e.complexity.ManifestGroup.OriginPostalCode(childComplexity), true
+	case "ManifestGroup.shipDate":
+		if e.complexity.ManifestGroup.ShipDate == nil {
+			break
+		}
+
+		return e.complexity.ManifestGroup.ShipDate(childComplexity), true
+
+	case "ManifestResponse.errors":
+		if e.complexity.ManifestResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.ManifestResponse.Errors(childComplexity), true
+	case "ManifestResponse.groups":
+		if e.complexity.ManifestResponse.Groups == nil {
+			break
+		}
+
+		return e.complexity.ManifestResponse.Groups(childComplexity), true
+	case "ManifestResponse.metadata":
+		if e.complexity.ManifestResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.ManifestResponse.Metadata(childComplexity), true
+	case "ManifestResponse.success":
+		if e.complexity.ManifestResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.ManifestResponse.Success(childComplexity), true
+
 	case "Money.amount":
 		if e.complexity.Money.Amount == nil {
 			break
@@ -432,6 +872,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.DelivroCancelOrder(childComplexity, args["input"].(CancelOrderInput)), true
+	case "Mutation.delivro_cancel_pickup":
+		if e.complexity.Mutation.DelivroCancelPickup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_delivro_cancel_pickup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DelivroCancelPickup(childComplexity, args["input"].(CancelPickupInput)), true
 	case "Mutation.delivro_create_order":
 		if e.complexity.Mutation.DelivroCreateOrder == nil {
 			break
@@ -443,6 +894,39 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.DelivroCreateOrder(childComplexity, args["input"].(CreateOrderInput)), true
+	case "Mutation.delivro_create_return":
+		if e.complexity.Mutation.DelivroCreateReturn == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_delivro_create_return_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DelivroCreateReturn(childComplexity, args["input"].(CreateReturnInput)), true
+	case "Mutation.delivro_create_webhook":
+		if e.complexity.Mutation.DelivroCreateWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_delivro_create_webhook_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DelivroCreateWebhook(childComplexity, args["input"].(CreateWebhookInput)), true
+	case "Mutation.delivro_delete_webhook":
+		if e.complexity.Mutation.DelivroDeleteWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_delivro_delete_webhook_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DelivroDeleteWebhook(childComplexity, args["input"].(DeleteWebhookInput)), true
 	case "Mutation.delivro_get_label":
 		if e.complexity.Mutation.DelivroGetLabel == nil {
 			break
@@ -465,63 +949,264 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.DelivroGetQuote(childComplexity, args["input"].(GetQuoteInput)), true
-
-	case "OrderResponse.carrier":
-		if e.complexity.OrderResponse.Carrier == nil {
+	case "Mutation.delivro_manifest":
+		if e.complexity.Mutation.DelivroManifest == nil {
 			break
 		}
 
-		return e.complexity.OrderResponse.Carrier(childComplexity), true
-	case "OrderResponse.errors":
-		if e.complexity.OrderResponse.Errors == nil {
-			break
+		args, err := ec.field_Mutation_delivro_manifest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.OrderResponse.Errors(childComplexity), true
-	case "OrderResponse.estimatedDelivery":
-		if e.complexity.OrderResponse.EstimatedDelivery == nil {
+		return e.complexity.Mutation.DelivroManifest(childComplexity, args["input"].(ManifestInput)), true
+	case "Mutation.delivro_pickup_availability":
+		if e.complexity.Mutation.DelivroPickupAvailability == nil {
 			break
 		}
 
-		return e.complexity.OrderResponse.EstimatedDelivery(childComplexity), true
-	case "OrderResponse.labelUrl":
-		if e.complexity.OrderResponse.LabelURL == nil {
-			break
+		args, err := ec.field_Mutation_delivro_pickup_availability_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.OrderResponse.LabelURL(childComplexity), true
-	case "OrderResponse.metadata":
-		if e.complexity.OrderResponse.Metadata == nil {
+		return e.complexity.Mutation.DelivroPickupAvailability(childComplexity, args["input"].(PickupAvailabilityInput)), true
+	case "Mutation.delivro_replay_webhook_delivery":
+		if e.complexity.Mutation.DelivroReplayWebhookDelivery == nil {
 			break
 		}
 
-		return e.complexity.OrderResponse.Metadata(childComplexity), true
-	case "OrderResponse.orderId":
-		if e.complexity.OrderResponse.OrderID == nil {
-			break
+		args, err := ec.field_Mutation_delivro_replay_webhook_delivery_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.OrderResponse.OrderID(childComplexity), true
-	case "OrderResponse.serviceName":
-		if e.complexity.OrderResponse.ServiceName == nil {
+		return e.complexity.Mutation.DelivroReplayWebhookDelivery(childComplexity, args["input"].(ReplayWebhookDeliveryInput)), true
+	case "Mutation.delivro_schedule_pickup":
+		if e.complexity.Mutation.DelivroSchedulePickup == nil {
 			break
 		}
 
-		return e.complexity.OrderResponse.ServiceName(childComplexity), true
-	case "OrderResponse.status":
-		if e.complexity.OrderResponse.Status == nil {
-			break
+		args, err := ec.field_Mutation_delivro_schedule_pickup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.OrderResponse.Status(childComplexity), true
-	case "OrderResponse.success":
-		if e.complexity.OrderResponse.Success == nil {
+		return e.complexity.Mutation.DelivroSchedulePickup(childComplexity, args["input"].(SchedulePickupInput)), true
+	case "Mutation.delivro_track_shipment":
+		if e.complexity.Mutation.DelivroTrackShipment == nil {
 			break
 		}
 
-		return e.complexity.OrderResponse.Success(childComplexity), true
-	case "OrderResponse.totalCharged":
-		if e.complexity.OrderResponse.TotalCharged == nil {
+		args, err := ec.field_Mutation_delivro_track_shipment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DelivroTrackShipment(childComplexity, args["input"].(TrackShipmentInput)), true
+
+	case "Order.carrier":
+		if e.complexity.Order.Carrier == nil {
+			break
+		}
+
+		return e.complexity.Order.Carrier(childComplexity), true
+	case "Order.createdAt":
+		if e.complexity.Order.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Order.CreatedAt(childComplexity), true
+	case "Order.estimatedDelivery":
+		if e.complexity.Order.EstimatedDelivery == nil {
+			break
+		}
+
+		return e.complexity.Order.EstimatedDelivery(childComplexity), true
+	case "Order.labelUrl":
+		if e.complexity.Order.LabelURL == nil {
+			break
+		}
+
+		return e.complexity.Order.LabelURL(childComplexity), true
+	case "Order.orderId":
+		if e.complexity.Order.OrderID == nil {
+			break
+		}
+
+		return e.complexity.Order.OrderID(childComplexity), true
+	case "Order.pieces":
+		if e.complexity.Order.Pieces == nil {
+			break
+		}
+
+		return e.complexity.Order.Pieces(childComplexity), true
+	case "Order.poNumber":
+		if e.complexity.Order.PoNumber == nil {
+			break
+		}
+
+		return e.complexity.Order.PoNumber(childComplexity), true
+	case "Order.recipientAddress":
+		if e.complexity.Order.RecipientAddress == nil {
+			break
+		}
+
+		return e.complexity.Order.RecipientAddress(childComplexity), true
+	case "Order.reference":
+		if e.complexity.Order.Reference == nil {
+			break
+		}
+
+		return e.complexity.Order.Reference(childComplexity), true
+	case "Order.returnOf":
+		if e.complexity.Order.ReturnOf == nil {
+			break
+		}
+
+		return e.complexity.Order.ReturnOf(childComplexity), true
+	case "Order.senderAddress":
+		if e.complexity.Order.SenderAddress == nil {
+			break
+		}
+
+		return e.complexity.Order.SenderAddress(childComplexity), true
+	case "Order.serviceName":
+		if e.complexity.Order.ServiceName == nil {
+			break
+		}
+
+		return e.complexity.Order.ServiceName(childComplexity), true
+	case "Order.shipperId":
+		if e.complexity.Order.ShipperID == nil {
+			break
+		}
+
+		return e.complexity.Order.ShipperID(childComplexity), true
+	case "Order.status":
+		if e.complexity.Order.Status == nil {
+			break
+		}
+
+		return e.complexity.Order.Status(childComplexity), true
+	case "Order.statusHistory":
+		if e.complexity.Order.StatusHistory == nil {
+			break
+		}
+
+		return e.complexity.Order.StatusHistory(childComplexity), true
+	case "Order.totalCharged":
+		if e.complexity.Order.TotalCharged == nil {
+			break
+		}
+
+		return e.complexity.Order.TotalCharged(childComplexity), true
+	case "Order.trackingNumber":
+		if e.complexity.Order.TrackingNumber == nil {
+			break
+		}
+
+		return e.complexity.Order.TrackingNumber(childComplexity), true
+	case "Order.trackingUrl":
+		if e.complexity.Order.TrackingURL == nil {
+			break
+		}
+
+		return e.complexity.Order.TrackingURL(childComplexity), true
+	case "Order.updatedAt":
+		if e.complexity.Order.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Order.UpdatedAt(childComplexity), true
+
+	case "OrderConnection.hasMore":
+		if e.complexity.OrderConnection.HasMore == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.HasMore(childComplexity), true
+	case "OrderConnection.nextCursor":
+		if e.complexity.OrderConnection.NextCursor == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.NextCursor(childComplexity), true
+	case "OrderConnection.orders":
+		if e.complexity.OrderConnection.Orders == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.Orders(childComplexity), true
+
+	case "OrderResponse.carrier":
+		if e.complexity.OrderResponse.Carrier == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Carrier(childComplexity), true
+	case "OrderResponse.errors":
+		if e.complexity.OrderResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Errors(childComplexity), true
+	case "OrderResponse.estimatedDelivery":
+		if e.complexity.OrderResponse.EstimatedDelivery == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.EstimatedDelivery(childComplexity), true
+	case "OrderResponse.labelUrl":
+		if e.complexity.OrderResponse.LabelURL == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.LabelURL(childComplexity), true
+	case "OrderResponse.metadata":
+		if e.complexity.OrderResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Metadata(childComplexity), true
+	case "OrderResponse.orderId":
+		if e.complexity.OrderResponse.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.OrderID(childComplexity), true
+	case "OrderResponse.pieces":
+		if e.complexity.OrderResponse.Pieces == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Pieces(childComplexity), true
+	case "OrderResponse.previouslyCreated":
+		if e.complexity.OrderResponse.PreviouslyCreated == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.PreviouslyCreated(childComplexity), true
+	case "OrderResponse.serviceName":
+		if e.complexity.OrderResponse.ServiceName == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.ServiceName(childComplexity), true
+	case "OrderResponse.status":
+		if e.complexity.OrderResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Status(childComplexity), true
+	case "OrderResponse.success":
+		if e.complexity.OrderResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.OrderResponse.Success(childComplexity), true
+	case "OrderResponse.totalCharged":
+		if e.complexity.OrderResponse.TotalCharged == nil {
 			break
 		}
 
@@ -606,12 +1291,190 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Package.Width(childComplexity), true
 
+	case "PickupAvailabilityResponse.available":
+		if e.complexity.PickupAvailabilityResponse.Available == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.Available(childComplexity), true
+	case "PickupAvailabilityResponse.bookBy":
+		if e.complexity.PickupAvailabilityResponse.BookBy == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.BookBy(childComplexity), true
+	case "PickupAvailabilityResponse.errors":
+		if e.complexity.PickupAvailabilityResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.Errors(childComplexity), true
+	case "PickupAvailabilityResponse.metadata":
+		if e.complexity.PickupAvailabilityResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.Metadata(childComplexity), true
+	case "PickupAvailabilityResponse.success":
+		if e.complexity.PickupAvailabilityResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.Success(childComplexity), true
+	case "PickupAvailabilityResponse.windows":
+		if e.complexity.PickupAvailabilityResponse.Windows == nil {
+			break
+		}
+
+		return e.complexity.PickupAvailabilityResponse.Windows(childComplexity), true
+
+	case "PickupResponse.carrier":
+		if e.complexity.PickupResponse.Carrier == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Carrier(childComplexity), true
+	case "PickupResponse.errors":
+		if e.complexity.PickupResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Errors(childComplexity), true
+	case "PickupResponse.metadata":
+		if e.complexity.PickupResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Metadata(childComplexity), true
+	case "PickupResponse.pickupId":
+		if e.complexity.PickupResponse.PickupID == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.PickupID(childComplexity), true
+	case "PickupResponse.status":
+		if e.complexity.PickupResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Status(childComplexity), true
+	case "PickupResponse.success":
+		if e.complexity.PickupResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Success(childComplexity), true
+	case "PickupResponse.window":
+		if e.complexity.PickupResponse.Window == nil {
+			break
+		}
+
+		return e.complexity.PickupResponse.Window(childComplexity), true
+
+	case "PickupWindow.closeAt":
+		if e.complexity.PickupWindow.CloseAt == nil {
+			break
+		}
+
+		return e.complexity.PickupWindow.CloseAt(childComplexity), true
+	case "PickupWindow.readyAt":
+		if e.complexity.PickupWindow.ReadyAt == nil {
+			break
+		}
+
+		return e.complexity.PickupWindow.ReadyAt(childComplexity), true
+
+	case "Piece.labelUrl":
+		if e.complexity.Piece.LabelURL == nil {
+			break
+		}
+
+		return e.complexity.Piece.LabelURL(childComplexity), true
+	case "Piece.pieceId":
+		if e.complexity.Piece.PieceID == nil {
+			break
+		}
+
+		return e.complexity.Piece.PieceID(childComplexity), true
+	case "Piece.trackingNumber":
+		if e.complexity.Piece.TrackingNumber == nil {
+			break
+		}
+
+		return e.complexity.Piece.TrackingNumber(childComplexity), true
+
+	case "Query.carrierCapabilities":
+		if e.complexity.Query.CarrierCapabilities == nil {
+			break
+		}
+
+		args, err := ec.field_Query_carrierCapabilities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CarrierCapabilities(childComplexity, args["carrier"].(*Carrier)), true
 	case "Query.carriers":
 		if e.complexity.Query.Carriers == nil {
 			break
 		}
 
 		return e.complexity.Query.Carriers(childComplexity), true
+	case "Query.delivro_order":
+		if e.complexity.Query.DelivroOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Query_delivro_order_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DelivroOrder(childComplexity, args["shipperId"].(string), args["orderId"].(string)), true
+	case "Query.delivro_order_by_reference":
+		if e.complexity.Query.DelivroOrderByReference == nil {
+			break
+		}
+
+		args, err := ec.field_Query_delivro_order_by_reference_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DelivroOrderByReference(childComplexity, args["shipperId"].(string), args["reference"].(string)), true
+	case "Query.delivro_orders":
+		if e.complexity.Query.DelivroOrders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_delivro_orders_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DelivroOrders(childComplexity, args["shipperId"].(string), args["first"].(*int), args["after"].(*string)), true
+	case "Query.delivro_webhook_deliveries":
+		if e.complexity.Query.DelivroWebhookDeliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_delivro_webhook_deliveries_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DelivroWebhookDeliveries(childComplexity, args["shipperId"].(string), args["state"].(*WebhookDeliveryState), args["first"].(*int)), true
+	case "Query.delivro_webhooks":
+		if e.complexity.Query.DelivroWebhooks == nil {
+			break
+		}
+
+		args, err := ec.field_Query_delivro_webhooks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DelivroWebhooks(childComplexity, args["shipperId"].(string)), true
 	case "Query.health":
 		if e.complexity.Query.Health == nil {
 			break
@@ -625,6 +1488,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.ServiceTypes(childComplexity), true
 
+	case "QuoteResponse.carrierResults":
+		if e.complexity.QuoteResponse.CarrierResults == nil {
+			break
+		}
+
+		return e.complexity.QuoteResponse.CarrierResults(childComplexity), true
 	case "QuoteResponse.errors":
 		if e.complexity.QuoteResponse.Errors == nil {
 			break
@@ -662,6 +1531,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.RateOption.BaseRate(childComplexity), true
+	case "RateOption.billableWeight":
+		if e.complexity.RateOption.BillableWeight == nil {
+			break
+		}
+
+		return e.complexity.RateOption.BillableWeight(childComplexity), true
 	case "RateOption.carrier":
 		if e.complexity.RateOption.Carrier == nil {
 			break
@@ -698,6 +1573,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.RateOption.RateID(childComplexity), true
+	case "RateOption.score":
+		if e.complexity.RateOption.Score == nil {
+			break
+		}
+
+		return e.complexity.RateOption.Score(childComplexity), true
 	case "RateOption.serviceCode":
 		if e.complexity.RateOption.ServiceCode == nil {
 			break
@@ -722,6 +1603,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.RateOption.SignatureRequired(childComplexity), true
+	case "RateOption.tags":
+		if e.complexity.RateOption.Tags == nil {
+			break
+		}
+
+		return e.complexity.RateOption.Tags(childComplexity), true
 	case "RateOption.taxes":
 		if e.complexity.RateOption.Taxes == nil {
 			break
@@ -766,22 +1653,380 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ResponseMetadata.RequestID(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "ReturnResponse.additionalLabels":
+		if e.complexity.ReturnResponse.AdditionalLabels == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		return e.complexity.ReturnResponse.AdditionalLabels(childComplexity), true
+	case "ReturnResponse.carrier":
+		if e.complexity.ReturnResponse.Carrier == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Carrier(childComplexity), true
+	case "ReturnResponse.errors":
+		if e.complexity.ReturnResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Errors(childComplexity), true
+	case "ReturnResponse.label":
+		if e.complexity.ReturnResponse.Label == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Label(childComplexity), true
+	case "ReturnResponse.metadata":
+		if e.complexity.ReturnResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Metadata(childComplexity), true
+	case "ReturnResponse.orderId":
+		if e.complexity.ReturnResponse.OrderID == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.OrderID(childComplexity), true
+	case "ReturnResponse.originalOrderId":
+		if e.complexity.ReturnResponse.OriginalOrderID == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.OriginalOrderID(childComplexity), true
+	case "ReturnResponse.status":
+		if e.complexity.ReturnResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Status(childComplexity), true
+	case "ReturnResponse.success":
+		if e.complexity.ReturnResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.Success(childComplexity), true
+	case "ReturnResponse.totalCharged":
+		if e.complexity.ReturnResponse.TotalCharged == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.TotalCharged(childComplexity), true
+	case "ReturnResponse.trackingNumber":
+		if e.complexity.ReturnResponse.TrackingNumber == nil {
+			break
+		}
+
+		return e.complexity.ReturnResponse.TrackingNumber(childComplexity), true
+
+	case "StatusChange.source":
+		if e.complexity.StatusChange.Source == nil {
+			break
+		}
+
+		return e.complexity.StatusChange.Source(childComplexity), true
+	case "StatusChange.status":
+		if e.complexity.StatusChange.Status == nil {
+			break
+		}
+
+		return e.complexity.StatusChange.Status(childComplexity), true
+	case "StatusChange.timestamp":
+		if e.complexity.StatusChange.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.StatusChange.Timestamp(childComplexity), true
+
+	case "TrackingEvent.carrierCode":
+		if e.complexity.TrackingEvent.CarrierCode == nil {
+			break
+		}
+
+		return e.complexity.TrackingEvent.CarrierCode(childComplexity), true
+	case "TrackingEvent.description":
+		if e.complexity.TrackingEvent.Description == nil {
+			break
+		}
+
+		return e.complexity.TrackingEvent.Description(childComplexity), true
+	case "TrackingEvent.location":
+		if e.complexity.TrackingEvent.Location == nil {
+			break
+		}
+
+		return e.complexity.TrackingEvent.Location(childComplexity), true
+	case "TrackingEvent.status":
+		if e.complexity.TrackingEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.TrackingEvent.Status(childComplexity), true
+	case "TrackingEvent.timestamp":
+		if e.complexity.TrackingEvent.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.TrackingEvent.Timestamp(childComplexity), true
+
+	case "TrackingResponse.errors":
+		if e.complexity.TrackingResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.Errors(childComplexity), true
+	case "TrackingResponse.estimatedDelivery":
+		if e.complexity.TrackingResponse.EstimatedDelivery == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.EstimatedDelivery(childComplexity), true
+	case "TrackingResponse.events":
+		if e.complexity.TrackingResponse.Events == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.Events(childComplexity), true
+	case "TrackingResponse.metadata":
+		if e.complexity.TrackingResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.Metadata(childComplexity), true
+	case "TrackingResponse.orderId":
+		if e.complexity.TrackingResponse.OrderID == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.OrderID(childComplexity), true
+	case "TrackingResponse.status":
+		if e.complexity.TrackingResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.Status(childComplexity), true
+	case "TrackingResponse.success":
+		if e.complexity.TrackingResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.Success(childComplexity), true
+	case "TrackingResponse.trackingNumber":
+		if e.complexity.TrackingResponse.TrackingNumber == nil {
+			break
+		}
+
+		return e.complexity.TrackingResponse.TrackingNumber(childComplexity), true
+
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+	case "WebhookDelivery.createdAt":
+		if e.complexity.WebhookDelivery.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.CreatedAt(childComplexity), true
+	case "WebhookDelivery.deliveredAt":
+		if e.complexity.WebhookDelivery.DeliveredAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.DeliveredAt(childComplexity), true
+	case "WebhookDelivery.eventId":
+		if e.complexity.WebhookDelivery.EventID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EventID(childComplexity), true
+	case "WebhookDelivery.eventType":
+		if e.complexity.WebhookDelivery.EventType == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EventType(childComplexity), true
+	case "WebhookDelivery.id":
+		if e.complexity.WebhookDelivery.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ID(childComplexity), true
+	case "WebhookDelivery.lastError":
+		if e.complexity.WebhookDelivery.LastError == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.LastError(childComplexity), true
+	case "WebhookDelivery.lastStatusCode":
+		if e.complexity.WebhookDelivery.LastStatusCode == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.LastStatusCode(childComplexity), true
+	case "WebhookDelivery.nextAttemptAt":
+		if e.complexity.WebhookDelivery.NextAttemptAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.NextAttemptAt(childComplexity), true
+	case "WebhookDelivery.orderId":
+		if e.complexity.WebhookDelivery.OrderID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.OrderID(childComplexity), true
+	case "WebhookDelivery.state":
+		if e.complexity.WebhookDelivery.State == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.State(childComplexity), true
+	case "WebhookDelivery.subscriptionId":
+		if e.complexity.WebhookDelivery.SubscriptionID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.SubscriptionID(childComplexity), true
+	case "WebhookDelivery.updatedAt":
+		if e.complexity.WebhookDelivery.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.UpdatedAt(childComplexity), true
+
+	case "WebhookDeliveryResponse.delivery":
+		if e.complexity.WebhookDeliveryResponse.Delivery == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResponse.Delivery(childComplexity), true
+	case "WebhookDeliveryResponse.errors":
+		if e.complexity.WebhookDeliveryResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResponse.Errors(childComplexity), true
+	case "WebhookDeliveryResponse.metadata":
+		if e.complexity.WebhookDeliveryResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResponse.Metadata(childComplexity), true
+	case "WebhookDeliveryResponse.success":
+		if e.complexity.WebhookDeliveryResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResponse.Success(childComplexity), true
+
+	case "WebhookResponse.errors":
+		if e.complexity.WebhookResponse.Errors == nil {
+			break
+		}
+
+		return e.complexity.WebhookResponse.Errors(childComplexity), true
+	case "WebhookResponse.metadata":
+		if e.complexity.WebhookResponse.Metadata == nil {
+			break
+		}
+
+		return e.complexity.WebhookResponse.Metadata(childComplexity), true
+	case "WebhookResponse.secret":
+		if e.complexity.WebhookResponse.Secret == nil {
+			break
+		}
+
+		return e.complexity.WebhookResponse.Secret(childComplexity), true
+	case "WebhookResponse.subscription":
+		if e.complexity.WebhookResponse.Subscription == nil {
+			break
+		}
+
+		return e.complexity.WebhookResponse.Subscription(childComplexity), true
+	case "WebhookResponse.success":
+		if e.complexity.WebhookResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.WebhookResponse.Success(childComplexity), true
+
+	case "WebhookSubscription.createdAt":
+		if e.complexity.WebhookSubscription.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.CreatedAt(childComplexity), true
+	case "WebhookSubscription.events":
+		if e.complexity.WebhookSubscription.Events == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.Events(childComplexity), true
+	case "WebhookSubscription.id":
+		if e.complexity.WebhookSubscription.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.ID(childComplexity), true
+	case "WebhookSubscription.shipperId":
+		if e.complexity.WebhookSubscription.ShipperID == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.ShipperID(childComplexity), true
+	case "WebhookSubscription.url":
+		if e.complexity.WebhookSubscription.URL == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.URL(childComplexity), true
+
+	case "Weight.unit":
+		if e.complexity.Weight.Unit == nil {
+			break
+		}
+
+		return e.complexity.Weight.Unit(childComplexity), true
+	case "Weight.value":
+		if e.complexity.Weight.Value == nil {
+			break
+		}
+
+		return e.complexity.Weight.Value(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddressInput,
 		ec.unmarshalInputCancelOrderInput,
+		ec.unmarshalInputCancelPickupInput,
 		ec.unmarshalInputContactInput,
 		ec.unmarshalInputCreateOrderInput,
+		ec.unmarshalInputCreateReturnInput,
+		ec.unmarshalInputCreateWebhookInput,
+		ec.unmarshalInputCustomsInput,
+		ec.unmarshalInputCustomsItemInput,
+		ec.unmarshalInputDeleteWebhookInput,
 		ec.unmarshalInputGetLabelInput,
 		ec.unmarshalInputGetQuoteInput,
+		ec.unmarshalInputManifestInput,
 		ec.unmarshalInputPackageInput,
+		ec.unmarshalInputPickupAvailabilityInput,
+		ec.unmarshalInputPickupWindowInput,
+		ec.unmarshalInputRateFilterInput,
+		ec.unmarshalInputReplayWebhookDeliveryInput,
+		ec.unmarshalInputSchedulePickupInput,
 		ec.unmarshalInputShippingOptionsInput,
+		ec.unmarshalInputTrackShipmentInput,
 	)
 	first := true
 
@@ -889,6 +2134,10 @@ var sources = []*ast.Source{
 # ============================================================================
 
 scalar DateTime
+"""
+Exact decimal number written as a string, e.g. "12.50". Exponents, signs
+other than a leading minus and digit separators are rejected.
+"""
 scalar Decimal
 
 # ============================================================================
@@ -968,6 +2217,117 @@ enum LabelFormat {
   ZPL
 }
 
+"""
+Status of a carrier pickup.
+"""
+enum PickupStatus {
+  SCHEDULED
+  CANCELLED
+}
+
+"""
+Reason for export declared to customs.
+"""
+enum ExportReason {
+  SALE
+  GIFT
+  SAMPLE
+  REPAIR
+  RETURN
+}
+
+"""
+Who pays the duties and taxes of an international shipment.
+"""
+enum Incoterm {
+  """
+  Delivered duty unpaid, the recipient pays.
+  """
+  DDU
+  """
+  Delivered duty paid, the shipper pays.
+  """
+  DDP
+}
+
+"""
+Order in which quoted rates are listed.
+"""
+enum RateSort {
+  """
+  Lowest total price first.
+  """
+  CHEAPEST
+  """
+  Fewest transit days first.
+  """
+  FASTEST
+  """
+  Best balance of price and transit days first.
+  """
+  BEST_VALUE
+}
+
+"""
+Outcome of asking one carrier for rates.
+"""
+enum CarrierQuoteStatus {
+  OK
+  ERROR
+  TIMEOUT
+  """
+  The carrier was not asked: it is not configured or cannot quote the
+  request.
+  """
+  SKIPPED
+}
+
+"""
+Marks a notable rate of a quote.
+"""
+enum RateTag {
+  CHEAPEST
+  FASTEST
+  RECOMMENDED
+}
+
+"""
+Order lifecycle event sent to webhook subscriptions.
+"""
+enum WebhookEventType {
+  ORDER_CREATED
+  ORDER_CANCELLED
+  LABEL_READY
+  """
+  The carrier reported a new status.
+  """
+  TRACKING_UPDATED
+  DELIVERED
+  EXCEPTION
+}
+
+"""
+Progress of a webhook delivery.
+"""
+enum WebhookDeliveryState {
+  """
+  Not attempted yet.
+  """
+  PENDING
+  """
+  Acknowledged by the receiver with a 2xx response.
+  """
+  DELIVERED
+  """
+  The last attempt failed and another one is scheduled.
+  """
+  FAILED
+  """
+  Out of attempts. Only delivered again when replayed.
+  """
+  DEAD
+}
+
 # ============================================================================
 # Common Types (Output)
 # ============================================================================
@@ -1016,23 +2376,73 @@ type Money {
 }
 
 """
-Shipping rate option returned from quote.
+Weight with its unit of measurement.
 """
-type RateOption {
-  rateId: ID!
+type Weight {
+  value: Decimal!
+  unit: WeightUnit!
+}
+
+"""
+What a carrier supports, so that clients can hide options it would reject.
+"""
+type CarrierCapabilities {
   carrier: Carrier!
-  serviceCode: String!
-  serviceName: String!
-  serviceType: ServiceType!
-  baseRate: Money!
-  fuelSurcharge: Money
-  taxes: Money!
+  labelFormats: [LabelFormat!]!
+  international: Boolean!
+  multiPiece: Boolean!
+  pickups: Boolean!
+  """
+  Orders must be transmitted with delivro_manifest at the end of the day.
+  """
+  manifests: Boolean!
+  """
+  Prepaid return labels can be created with delivro_create_return.
+  """
+  returns: Boolean!
+  insurance: Boolean!
+  signatureRequired: Boolean!
+  saturdayDelivery: Boolean!
+  """
+  Heaviest package accepted, null if the carrier states no limit.
+  """
+  maxWeight: Weight
+}
+
+"""
+When packages can be collected, in the local time of the pickup address.
+"""
+type PickupWindow {
+  readyAt: DateTime!
+  closeAt: DateTime!
+}
+
+"""
+Shipping rate option returned from quote.
+"""
+type RateOption {
+  rateId: ID!
+  carrier: Carrier!
+  serviceCode: String!
+  serviceName: String!
+  serviceType: ServiceType!
+  baseRate: Money!
+  fuelSurcharge: Money
+  taxes: Money!
   totalPrice: Money!
   transitDays: Int
   estimatedDelivery: DateTime
   expiresAt: DateTime!
   signatureRequired: Boolean
   guaranteed: Boolean
+  """Total package weight the carrier rated, in the carrier's units"""
+  billableWeight: Weight
+  tags: [RateTag!]!
+  """
+  Best value score, from 0 for the best balance of price and transit days
+  to 1.
+  """
+  score: Float!
 }
 
 """
@@ -1045,13 +2455,127 @@ type Label {
   expiresAt: DateTime
 }
 
+"""
+Package of a multi-piece shipment, tracked and labelled on its own.
+"""
+type Piece {
+  pieceId: ID!
+  trackingNumber: String
+  labelUrl: String
+}
+
+"""
+Single tracking event reported by a carrier.
+"""
+type TrackingEvent {
+  timestamp: DateTime!
+  description: String!
+  location: String
+  status: ShipmentStatus!
+  carrierCode: String
+}
+
+"""
+Single entry in an order's status history.
+"""
+type StatusChange {
+  status: ShipmentStatus!
+  timestamp: DateTime!
+  source: String
+}
+
+"""
+Order created through a carrier, as recorded by this service.
+"""
+type Order {
+  orderId: ID!
+  shipperId: ID!
+  carrier: Carrier!
+  reference: String
+  poNumber: String
+  trackingNumber: String
+  trackingUrl: String
+  status: ShipmentStatus!
+  serviceName: String
+  totalCharged: Money
+  estimatedDelivery: DateTime
+  labelUrl: String
+  pieces: [Piece!]
+  senderAddress: Address!
+  recipientAddress: Address!
+  statusHistory: [StatusChange!]!
+  """
+  Order this order returns, null for outbound orders.
+  """
+  returnOf: ID
+  createdAt: DateTime!
+  updatedAt: DateTime!
+}
+
+"""
+Page of orders, newest first.
+"""
+type OrderConnection {
+  orders: [Order!]!
+  nextCursor: String
+  hasMore: Boolean!
+}
+
+"""
+Shipper's subscription to order lifecycle events. Events are POSTed as JSON
+with an X-Delivro-Signature header: "sha256=" followed by the hex
+HMAC-SHA256, keyed with the subscription secret, of the
+X-Delivro-Timestamp header, a dot and the body.
+"""
+type WebhookSubscription {
+  id: ID!
+  shipperId: ID!
+  url: String!
+  """
+  Events sent to the URL. Empty for all events.
+  """
+  events: [WebhookEventType!]!
+  createdAt: DateTime!
+}
+
+"""
+Delivery of an event to a webhook subscription.
+"""
+type WebhookDelivery {
+  id: ID!
+  subscriptionId: ID!
+  eventId: ID!
+  eventType: WebhookEventType!
+  orderId: ID!
+  state: WebhookDeliveryState!
+  attempts: Int!
+  """
+  HTTP status of the last attempt, null if no response was received.
+  """
+  lastStatusCode: Int
+  lastError: String
+  """
+  When the next attempt is scheduled, for pending and failed deliveries.
+  """
+  nextAttemptAt: DateTime
+  deliveredAt: DateTime
+  createdAt: DateTime!
+  updatedAt: DateTime!
+}
+
 """
 Standard error information.
 """
 type Error {
   code: String!
   message: String!
+  """
+  Path of the invalid input field, e.g. "packages[1].weight".
+  """
   field: String
+  """
+  Error code reported by the carrier, if any.
+  """
   carrierCode: String
 }
 
@@ -1126,6 +2650,68 @@ input ShippingOptionsInput {
   shipDate: DateTime
 }
 
+"""
+Narrows the rates of a quote. Service types are filtered with the
+serviceTypes shipping option.
+"""
+input RateFilterInput {
+  guaranteedOnly: Boolean = false
+  """
+  Rates without a transit time are dropped when set.
+  """
+  maxTransitDays: Int
+  """
+  Rates quoted in another currency than maxPriceCurrency are dropped when
+  set.
+  """
+  maxPrice: Decimal
+  maxPriceCurrency: String = "CAD"
+}
+
+"""
+Customs declaration and commercial invoice of an international shipment.
+"""
+input CustomsInput {
+  items: [CustomsItemInput!]!
+  reasonForExport: ExportReason!
+  incoterm: Incoterm = DDU
+  """
+  ISO 4217 currency of the item values. Canada Post accepts CAD only and
+  Purolator CAD or USD.
+  """
+  currency: String = "CAD"
+  invoiceNumber: String
+  importerTaxId: String
+  exporterTaxId: String
+  """
+  B13A or CERS proof of report number, for exports that require one.
+  """
+  exportDeclarationNumber: String
+}
+
+"""
+Line of a customs declaration.
+"""
+input CustomsItemInput {
+  description: String!
+  quantity: Int!
+  """
+  Value of one unit, in the currency of the declaration.
+  """
+  unitValue: Decimal!
+  unitWeight: Decimal!
+  weightUnit: WeightUnit = KG
+  """
+  ISO 3166-1 alpha-2 country where the item was made.
+  """
+  countryOfOrigin: String!
+  """
+  Harmonized System tariff code, 6 to 10 digits.
+  """
+  hsCode: String
+  sku: String
+}
+
 # ============================================================================
 # Mutation Input Types
 # ============================================================================
@@ -1139,6 +2725,17 @@ input GetQuoteInput {
   destination: AddressInput!
   packages: [PackageInput!]!
   options: ShippingOptionsInput
+  """
+  Customs declaration of international quotes. Freightcom and Purolator
+  rate with it.
+  """
+  customs: CustomsInput
+  """
+  Order of the rates. Ties are broken so that the same rates always list in
+  the same order.
+  """
+  sort: RateSort = CHEAPEST
+  filter: RateFilterInput
 }
 
 """
@@ -1156,12 +2753,43 @@ input CreateOrderInput {
   reference: String
   poNumber: String
   instructions: String
+  """
+  Client-generated key identifying this order attempt. Replaying it
+  returns the order first created with the key instead of shipping again,
+  and replaying a key with a different request fails with
+  IDEMPOTENCY_KEY_REUSED. Keys are scoped to the shipper and remembered
+  for 24 hours.
+
+  A key is released when the carrier rejects the order, so that the fixed
+  request can be sent with it again. When the outcome is unknown, e.g.
+  after a timeout, the key stays IDEMPOTENCY_KEY_IN_USE for 5 minutes.
+  Only carriers that deduplicate orders on the key, currently Freightcom,
+  are retried on transient failures; with the others the order may have
+  been created, so look it up by reference before retrying.
+  """
+  idempotencyKey: String
+  """
+  Signature, insurance, Saturday delivery and ship date options. Carrier
+  and service type filters are ignored since the rate is already chosen.
+  """
+  options: ShippingOptionsInput
+  """
+  Book a pickup with the order. Only Freightcom books pickups with orders;
+  use delivro_schedule_pickup for other carriers.
+  """
+  pickup: PickupWindowInput
+  """
+  Customs declaration, required when the sender and recipient are in
+  different countries.
+  """
+  customs: CustomsInput
 }
 
 """
 Input for getting shipping label.
 """
 input GetLabelInput {
+  shipperId: ID!
   orderId: ID!
   format: LabelFormat = PDF
 }
@@ -1170,10 +2798,137 @@ input GetLabelInput {
 Input for cancelling an order.
 """
 input CancelOrderInput {
+  shipperId: ID!
   orderId: ID!
   reason: String
 }
 
+"""
+Pickup window input, with the UTC offset of the pickup address.
+"""
+input PickupWindowInput {
+  readyAt: DateTime!
+  closeAt: DateTime!
+}
+
+"""
+Input for checking pickup availability.
+"""
+input PickupAvailabilityInput {
+  carrier: Carrier!
+  address: AddressInput!
+  """
+  Day of the pickup. Only the date is used.
+  """
+  date: DateTime!
+}
+
+"""
+Input for scheduling a pickup.
+"""
+input SchedulePickupInput {
+  shipperId: ID!
+  carrier: Carrier!
+  address: AddressInput!
+  contact: ContactInput!
+  window: PickupWindowInput!
+  """
+  Orders to collect. Freightcom books pickups per order and requires
+  exactly one.
+  """
+  orderIds: [ID!]
+  packageCount: Int!
+  totalWeight: Decimal!
+  weightUnit: WeightUnit = KG
+  instructions: String
+}
+
+"""
+Input for cancelling a pickup.
+"""
+input CancelPickupInput {
+  carrier: Carrier!
+  pickupId: ID!
+}
+
+"""
+Input for transmitting the day's orders.
+"""
+input ManifestInput {
+  shipperId: ID!
+  carrier: Carrier!
+  """
+  Ship date of the orders to transmit. Defaults to today.
+  """
+  shipDate: DateTime
+}
+
+"""
+Input for creating a return of an order.
+"""
+input CreateReturnInput {
+  shipperId: ID!
+  """
+  Order being returned. The return is sent from its recipient back to its
+  sender with the same service.
+  """
+  orderId: ID!
+  """
+  Return merchandise authorization, printed on the label.
+  """
+  rmaNumber: String
+  """
+  Packages being returned. Defaults to the packages of the order.
+  """
+  packages: [PackageInput!]
+  format: LabelFormat = PDF
+}
+
+"""
+Input for tracking a shipment.
+"""
+input TrackShipmentInput {
+  shipperId: ID!
+  orderId: ID!
+  trackingNumber: String
+  carrier: Carrier
+}
+
+"""
+Input for subscribing to order lifecycle events.
+"""
+input CreateWebhookInput {
+  shipperId: ID!
+  """
+  Absolute http or https URL receiving the events.
+  """
+  url: String!
+  """
+  Key of the delivery signatures. Generated when omitted.
+  """
+  secret: String
+  """
+  Events to receive. Empty for all events.
+  """
+  events: [WebhookEventType!]
+}
+
+"""
+Input for removing a webhook subscription.
+"""
+input DeleteWebhookInput {
+  shipperId: ID!
+  subscriptionId: ID!
+}
+
+"""
+Input for delivering an event again.
+"""
+input ReplayWebhookDeliveryInput {
+  shipperId: ID!
+  deliveryId: ID!
+}
+
 # ============================================================================
 # Response Types
 # ============================================================================
@@ -1182,13 +2937,47 @@ input CancelOrderInput {
 Response for delivro_get_quote mutation.
 """
 type QuoteResponse {
+  """
+  Whether at least one carrier answered, even with no rates.
+  """
   success: Boolean!
+  """
+  Quote ID of the first carrier that answered. Each carrier's own quote ID
+  is in carrierResults.
+  """
   quoteId: ID
   rates: [RateOption!]
   errors: [Error!]
+  carrierResults: [CarrierQuoteResult!]!
   metadata: ResponseMetadata!
 }
 
+"""
+Outcome of quoting with one carrier.
+"""
+type CarrierQuoteResult {
+  carrier: Carrier!
+  quoteId: ID
+  status: CarrierQuoteStatus!
+  latencyMs: Int!
+  """
+  Number of rates the carrier returned, before filtering.
+  """
+  rateCount: Int!
+  """
+  Same as the code of the carrier's entry in errors.
+  """
+  errorCode: String
+  """
+  Error code reported by the carrier, if any.
+  """
+  carrierCode: String
+  """
+  Whether asking again may succeed.
+  """
+  retryable: Boolean!
+}
+
 """
 Response for delivro_create_order mutation.
 """
@@ -1203,6 +2992,12 @@ type OrderResponse {
   totalCharged: Money
   estimatedDelivery: DateTime
   labelUrl: String
+  pieces: [Piece!]
+  """
+  True when the order was created by an earlier request with the same
+  idempotency key and no new shipment was booked.
+  """
+  previouslyCreated: Boolean!
   errors: [Error!]
   metadata: ResponseMetadata!
 }
@@ -1232,31 +3027,192 @@ type CancelResponse {
   metadata: ResponseMetadata!
 }
 
-# ============================================================================
-# Query Root
-# ============================================================================
-
-type Query {
-  """Health check endpoint"""
-  health: Boolean!
-
-  """Get supported carriers"""
-  carriers: [Carrier!]!
-
-  """Get service types"""
-  serviceTypes: [ServiceType!]!
+"""
+Response for delivro_track_shipment mutation.
+"""
+type TrackingResponse {
+  success: Boolean!
+  orderId: ID
+  trackingNumber: String
+  status: ShipmentStatus
+  estimatedDelivery: DateTime
+  events: [TrackingEvent!]
+  errors: [Error!]
+  metadata: ResponseMetadata!
 }
 
-# ============================================================================
-# Mutation Root (Hasura Actions)
-# ============================================================================
-
-type Mutation {
+"""
+Response for delivro_pickup_availability mutation.
+"""
+type PickupAvailabilityResponse {
+  success: Boolean!
+  available: Boolean
   """
-  Get shipping quotes from available carriers.
+  Latest time a pickup on the date can be booked, if the carrier states one.
   """
-  delivro_get_quote(input: GetQuoteInput!): QuoteResponse!
-
+  bookBy: DateTime
+  """
+  Collection times offered, empty if the carrier states none.
+  """
+  windows: [PickupWindow!]
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Response for delivro_schedule_pickup mutation.
+"""
+type PickupResponse {
+  success: Boolean!
+  """
+  Carrier identifier of the pickup, used to cancel it.
+  """
+  pickupId: ID
+  carrier: Carrier
+  status: PickupStatus
+  window: PickupWindow
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Response for delivro_cancel_pickup mutation.
+"""
+type CancelPickupResponse {
+  success: Boolean!
+  pickupId: ID
+  status: PickupStatus
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Carrier record of the orders handed over in one transmit.
+"""
+type Manifest {
+  manifestId: ID!
+  """
+  Printable manifest to hand to the driver.
+  """
+  document: Label!
+}
+
+"""
+Orders transmitted from one origin.
+"""
+type ManifestGroup {
+  originPostalCode: String!
+  shipDate: DateTime!
+  orderIds: [ID!]!
+  manifests: [Manifest!]!
+}
+
+"""
+Response for delivro_manifest mutation.
+"""
+type ManifestResponse {
+  success: Boolean!
+  """
+  One group per origin. Carriers that transmit every open order of the
+  account at once, such as Purolator, return a single group with an empty
+  originPostalCode. Empty when there were no open orders.
+  """
+  groups: [ManifestGroup!]
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Response for delivro_create_return mutation.
+"""
+type ReturnResponse {
+  success: Boolean!
+  """
+  ID of the return order.
+  """
+  orderId: ID
+  """
+  Order being returned.
+  """
+  originalOrderId: ID
+  trackingNumber: String
+  status: ShipmentStatus
+  carrier: Carrier
+  totalCharged: Money
+  label: Label
+  additionalLabels: [Label!]
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Response for delivro_create_webhook and delivro_delete_webhook mutations.
+"""
+type WebhookResponse {
+  success: Boolean!
+  subscription: WebhookSubscription
+  """
+  Key of the delivery signatures, only returned when the subscription is
+  created.
+  """
+  secret: String
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+"""
+Response for delivro_replay_webhook_delivery mutation.
+"""
+type WebhookDeliveryResponse {
+  success: Boolean!
+  delivery: WebhookDelivery
+  errors: [Error!]
+  metadata: ResponseMetadata!
+}
+
+# ============================================================================
+# Query Root
+# ============================================================================
+
+type Query {
+  """Health check endpoint"""
+  health: Boolean!
+
+  """Get supported carriers"""
+  carriers: [Carrier!]!
+
+  """Get service types"""
+  serviceTypes: [ServiceType!]!
+
+  """Get the capabilities of every carrier, or of the given carrier"""
+  carrierCapabilities(carrier: Carrier): [CarrierCapabilities!]!
+
+  """Get an order of a shipper by its carrier order ID"""
+  delivro_order(shipperId: ID!, orderId: ID!): Order
+
+  """Get the most recent order of a shipper with the given reference"""
+  delivro_order_by_reference(shipperId: ID!, reference: String!): Order
+
+  """List a shipper's orders, newest first"""
+  delivro_orders(shipperId: ID!, first: Int = 20, after: String): OrderConnection!
+
+  """List a shipper's webhook subscriptions, oldest first"""
+  delivro_webhooks(shipperId: ID!): [WebhookSubscription!]!
+
+  """List a shipper's webhook deliveries, newest first"""
+  delivro_webhook_deliveries(shipperId: ID!, state: WebhookDeliveryState, first: Int = 20): [WebhookDelivery!]!
+}
+
+# ============================================================================
+# Mutation Root (Hasura Actions)
+# ============================================================================
+
+type Mutation {
+  """
+  Get shipping quotes from available carriers.
+  """
+  delivro_get_quote(input: GetQuoteInput!): QuoteResponse!
+
   """
   Create a shipping order with selected rate.
   """
@@ -1271,6 +3227,53 @@ type Mutation {
   Cancel a shipping order.
   """
   delivro_cancel_order(input: CancelOrderInput!): CancelResponse!
+
+  """
+  Get the normalized tracking history of a shipment.
+  """
+  delivro_track_shipment(input: TrackShipmentInput!): TrackingResponse!
+
+  """
+  Check whether a carrier can collect at an address on a given day.
+  """
+  delivro_pickup_availability(input: PickupAvailabilityInput!): PickupAvailabilityResponse!
+
+  """
+  Book a carrier pickup.
+  """
+  delivro_schedule_pickup(input: SchedulePickupInput!): PickupResponse!
+
+  """
+  Cancel a carrier pickup.
+  """
+  delivro_cancel_pickup(input: CancelPickupInput!): CancelPickupResponse!
+
+  """
+  Transmit a shipper's open orders for the day and return the manifests.
+  Orders are grouped by origin; transmitted orders can no longer be
+  cancelled.
+  """
+  delivro_manifest(input: ManifestInput!): ManifestResponse!
+
+  """
+  Create a prepaid return label for an order, billed to the shipper.
+  """
+  delivro_create_return(input: CreateReturnInput!): ReturnResponse!
+
+  """
+  Subscribe a URL to a shipper's order lifecycle events.
+  """
+  delivro_create_webhook(input: CreateWebhookInput!): WebhookResponse!
+
+  """
+  Remove a webhook subscription.
+  """
+  delivro_delete_webhook(input: DeleteWebhookInput!): WebhookResponse!
+
+  """
+  Deliver an event again, whatever the outcome of its earlier attempts.
+  """
+  delivro_replay_webhook_delivery(input: ReplayWebhookDeliveryInput!): WebhookDeliveryResponse!
 }
 `, BuiltIn: false},
 }
@@ -1291,6 +3294,17 @@ func (ec *executionContext) field_Mutation_delivro_cancel_order_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_delivro_cancel_pickup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCancelPickupInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelPickupInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_delivro_create_order_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1302,6 +3316,39 @@ func (ec *executionContext) field_Mutation_delivro_create_order_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_delivro_create_return_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateReturnInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateReturnInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_delivro_create_webhook_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateWebhookInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateWebhookInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_delivro_delete_webhook_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDeleteWebhookInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDeleteWebhookInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_delivro_get_label_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1324,100 +3371,251 @@ func (ec *executionContext) field_Mutation_delivro_get_quote_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_delivro_manifest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNManifestInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestInput)
 	if err != nil {
 		return nil, err
 	}
-	args["name"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_delivro_pickup_availability_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNPickupAvailabilityInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupAvailabilityInput)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_delivro_replay_webhook_delivery_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNReplayWebhookDeliveryInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐReplayWebhookDeliveryInput)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_delivro_schedule_pickup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSchedulePickupInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐSchedulePickupInput)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_delivro_track_shipment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNTrackShipmentInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackShipmentInput)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
 
-// region    **************************** field.gotpl *****************************
+func (ec *executionContext) field_Query_carrierCapabilities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "carrier", ec.unmarshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier)
+	if err != nil {
+		return nil, err
+	}
+	args["carrier"] = arg0
+	return args, nil
+}
 
-func (ec *executionContext) _Address_name(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query_delivro_order_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "shipperId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["shipperId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "orderId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["orderId"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Address_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_delivro_order_by_reference_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "shipperId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["shipperId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "reference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["reference"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Address_company(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+func (ec *executionContext) field_Query_delivro_orders_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "shipperId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["shipperId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_delivro_webhook_deliveries_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "shipperId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["shipperId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "state", ec.unmarshalOWebhookDeliveryState2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState)
+	if err != nil {
+		return nil, err
+	}
+	args["state"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_delivro_webhooks_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "shipperId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["shipperId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Address_name(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_company(ctx context.Context, field graphql.CollectedField, obj *Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
@@ -1735,12 +3933,12 @@ func (ec *executionContext) fieldContext_Address_isResidential(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _CancelResponse_success(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelPickupResponse_success(ctx context.Context, field graphql.CollectedField, obj *CancelPickupResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CancelResponse_success,
+		ec.fieldContext_CancelPickupResponse_success,
 		func(ctx context.Context) (any, error) {
 			return obj.Success, nil
 		},
@@ -1751,9 +3949,9 @@ func (ec *executionContext) _CancelResponse_success(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_CancelResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelPickupResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
+		Object:     "CancelPickupResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1764,14 +3962,14 @@ func (ec *executionContext) fieldContext_CancelResponse_success(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CancelResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelPickupResponse_pickupId(ctx context.Context, field graphql.CollectedField, obj *CancelPickupResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CancelResponse_orderId,
+		ec.fieldContext_CancelPickupResponse_pickupId,
 		func(ctx context.Context) (any, error) {
-			return obj.OrderID, nil
+			return obj.PickupID, nil
 		},
 		nil,
 		ec.marshalOID2ᚖstring,
@@ -1780,9 +3978,9 @@ func (ec *executionContext) _CancelResponse_orderId(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_CancelResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelPickupResponse_pickupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
+		Object:     "CancelPickupResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1793,105 +3991,41 @@ func (ec *executionContext) fieldContext_CancelResponse_orderId(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CancelResponse_status(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelPickupResponse_status(ctx context.Context, field graphql.CollectedField, obj *CancelPickupResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CancelResponse_status,
+		ec.fieldContext_CancelPickupResponse_status,
 		func(ctx context.Context) (any, error) {
 			return obj.Status, nil
 		},
 		nil,
-		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_CancelResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShipmentStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _CancelResponse_refundAmount(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CancelResponse_refundAmount,
-		func(ctx context.Context) (any, error) {
-			return obj.RefundAmount, nil
-		},
-		nil,
-		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_CancelResponse_refundAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _CancelResponse_confirmationNumber(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CancelResponse_confirmationNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.ConfirmationNumber, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOPickupStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupStatus,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CancelResponse_confirmationNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelPickupResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
+		Object:     "CancelPickupResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type PickupStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CancelResponse_errors(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelPickupResponse_errors(ctx context.Context, field graphql.CollectedField, obj *CancelPickupResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CancelResponse_errors,
+		ec.fieldContext_CancelPickupResponse_errors,
 		func(ctx context.Context) (any, error) {
 			return obj.Errors, nil
 		},
@@ -1902,9 +4036,9 @@ func (ec *executionContext) _CancelResponse_errors(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_CancelResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelPickupResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
+		Object:     "CancelPickupResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1925,12 +4059,12 @@ func (ec *executionContext) fieldContext_CancelResponse_errors(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _CancelResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelPickupResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *CancelPickupResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CancelResponse_metadata,
+		ec.fieldContext_CancelPickupResponse_metadata,
 		func(ctx context.Context) (any, error) {
 			return obj.Metadata, nil
 		},
@@ -1941,9 +4075,9 @@ func (ec *executionContext) _CancelResponse_metadata(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_CancelResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelPickupResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CancelResponse",
+		Object:     "CancelPickupResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1964,837 +4098,713 @@ func (ec *executionContext) fieldContext_CancelResponse_metadata(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_success(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Error_code,
+		ec.fieldContext_CancelResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Error_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_message(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Error_message,
+		ec.fieldContext_CancelResponse_orderId,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.OrderID, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Error_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_field(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_status(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Error_field,
+		ec.fieldContext_CancelResponse_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Field, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Error_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_carrierCode(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_refundAmount(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Error_carrierCode,
+		ec.fieldContext_CancelResponse_refundAmount,
 		func(ctx context.Context) (any, error) {
-			return obj.CarrierCode, nil
+			return obj.RefundAmount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Error_carrierCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_refundAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Label_format(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_confirmationNumber(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Label_format,
+		ec.fieldContext_CancelResponse_confirmationNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.Format, nil
+			return obj.ConfirmationNumber, nil
 		},
 		nil,
-		ec.marshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Label_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_confirmationNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Label",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type LabelFormat does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Label_data(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_errors(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Label_data,
+		ec.fieldContext_CancelResponse_errors,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Errors, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Label_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Label",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Label_url(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
+func (ec *executionContext) _CancelResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *CancelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Label_url,
+		ec.fieldContext_CancelResponse_metadata,
 		func(ctx context.Context) (any, error) {
-			return obj.URL, nil
+			return obj.Metadata, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Label_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CancelResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Label",
+		Object:     "CancelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Label_expiresAt(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_carrier(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Label_expiresAt,
+		ec.fieldContext_CarrierCapabilities_carrier,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			return obj.Carrier, nil
 		},
 		nil,
-		ec.marshalODateTime2ᚖtimeᚐTime,
+		ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Label_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Label",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type Carrier does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_success(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_labelFormats(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_success,
+		ec.fieldContext_CarrierCapabilities_labelFormats,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.LabelFormats, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNLabelFormat2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormatᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_labelFormats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type LabelFormat does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_international(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_orderId,
+		ec.fieldContext_CarrierCapabilities_international,
 		func(ctx context.Context) (any, error) {
-			return obj.OrderID, nil
+			return obj.International, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_international(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_label(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_multiPiece(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_label,
+		ec.fieldContext_CarrierCapabilities_multiPiece,
 		func(ctx context.Context) (any, error) {
-			return obj.Label, nil
+			return obj.MultiPiece, nil
 		},
 		nil,
-		ec.marshalOLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_multiPiece(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "format":
-				return ec.fieldContext_Label_format(ctx, field)
-			case "data":
-				return ec.fieldContext_Label_data(ctx, field)
-			case "url":
-				return ec.fieldContext_Label_url(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_Label_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_additionalLabels(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_pickups(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_additionalLabels,
+		ec.fieldContext_CarrierCapabilities_pickups,
 		func(ctx context.Context) (any, error) {
-			return obj.AdditionalLabels, nil
+			return obj.Pickups, nil
 		},
 		nil,
-		ec.marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelᚄ,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_additionalLabels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_pickups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "format":
-				return ec.fieldContext_Label_format(ctx, field)
-			case "data":
-				return ec.fieldContext_Label_data(ctx, field)
-			case "url":
-				return ec.fieldContext_Label_url(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_Label_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_errors(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_manifests(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_errors,
+		ec.fieldContext_CarrierCapabilities_manifests,
 		func(ctx context.Context) (any, error) {
-			return obj.Errors, nil
+			return obj.Manifests, nil
 		},
 		nil,
-		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_manifests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "message":
-				return ec.fieldContext_Error_message(ctx, field)
-			case "field":
-				return ec.fieldContext_Error_field(ctx, field)
-			case "carrierCode":
-				return ec.fieldContext_Error_carrierCode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LabelResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_returns(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LabelResponse_metadata,
+		ec.fieldContext_CarrierCapabilities_returns,
 		func(ctx context.Context) (any, error) {
-			return obj.Metadata, nil
+			return obj.Returns, nil
 		},
 		nil,
-		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LabelResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_returns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LabelResponse",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "requestId":
-				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
-			case "processedAt":
-				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
-			case "carrier":
-				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
-			case "carrierRefId":
-				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Money_amount(ctx context.Context, field graphql.CollectedField, obj *Money) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_insurance(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Money_amount,
+		ec.fieldContext_CarrierCapabilities_insurance,
 		func(ctx context.Context) (any, error) {
-			return obj.Amount, nil
+			return obj.Insurance, nil
 		},
 		nil,
-		ec.marshalNDecimal2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Money_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_insurance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Money",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Money_currency(ctx context.Context, field graphql.CollectedField, obj *Money) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_signatureRequired(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Money_currency,
+		ec.fieldContext_CarrierCapabilities_signatureRequired,
 		func(ctx context.Context) (any, error) {
-			return obj.Currency, nil
+			return obj.SignatureRequired, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Money_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_signatureRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Money",
+		Object:     "CarrierCapabilities",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delivro_get_quote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_saturdayDelivery(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delivro_get_quote,
+		ec.fieldContext_CarrierCapabilities_saturdayDelivery,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DelivroGetQuote(ctx, fc.Args["input"].(GetQuoteInput))
+			return obj.SaturdayDelivery, nil
 		},
 		nil,
-		ec.marshalNQuoteResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delivro_get_quote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_saturdayDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CarrierCapabilities",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_QuoteResponse_success(ctx, field)
-			case "quoteId":
-				return ec.fieldContext_QuoteResponse_quoteId(ctx, field)
-			case "rates":
-				return ec.fieldContext_QuoteResponse_rates(ctx, field)
-			case "errors":
-				return ec.fieldContext_QuoteResponse_errors(ctx, field)
-			case "metadata":
-				return ec.fieldContext_QuoteResponse_metadata(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type QuoteResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delivro_get_quote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delivro_create_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierCapabilities_maxWeight(ctx context.Context, field graphql.CollectedField, obj *CarrierCapabilities) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delivro_create_order,
+		ec.fieldContext_CarrierCapabilities_maxWeight,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DelivroCreateOrder(ctx, fc.Args["input"].(CreateOrderInput))
+			return obj.MaxWeight, nil
 		},
 		nil,
-		ec.marshalNOrderResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse,
-		true,
+		ec.marshalOWeight2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeight,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delivro_create_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierCapabilities_maxWeight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CarrierCapabilities",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_OrderResponse_success(ctx, field)
-			case "orderId":
-				return ec.fieldContext_OrderResponse_orderId(ctx, field)
-			case "trackingNumber":
-				return ec.fieldContext_OrderResponse_trackingNumber(ctx, field)
-			case "trackingUrl":
-				return ec.fieldContext_OrderResponse_trackingUrl(ctx, field)
-			case "status":
-				return ec.fieldContext_OrderResponse_status(ctx, field)
-			case "carrier":
-				return ec.fieldContext_OrderResponse_carrier(ctx, field)
-			case "serviceName":
-				return ec.fieldContext_OrderResponse_serviceName(ctx, field)
-			case "totalCharged":
-				return ec.fieldContext_OrderResponse_totalCharged(ctx, field)
-			case "estimatedDelivery":
-				return ec.fieldContext_OrderResponse_estimatedDelivery(ctx, field)
-			case "labelUrl":
-				return ec.fieldContext_OrderResponse_labelUrl(ctx, field)
-			case "errors":
-				return ec.fieldContext_OrderResponse_errors(ctx, field)
-			case "metadata":
-				return ec.fieldContext_OrderResponse_metadata(ctx, field)
+			case "value":
+				return ec.fieldContext_Weight_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Weight_unit(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Weight", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delivro_create_order_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delivro_get_label(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_carrier(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delivro_get_label,
+		ec.fieldContext_CarrierQuoteResult_carrier,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DelivroGetLabel(ctx, fc.Args["input"].(GetLabelInput))
+			return obj.Carrier, nil
 		},
 		nil,
-		ec.marshalNLabelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse,
+		ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delivro_get_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_LabelResponse_success(ctx, field)
-			case "orderId":
-				return ec.fieldContext_LabelResponse_orderId(ctx, field)
-			case "label":
-				return ec.fieldContext_LabelResponse_label(ctx, field)
-			case "additionalLabels":
-				return ec.fieldContext_LabelResponse_additionalLabels(ctx, field)
-			case "errors":
-				return ec.fieldContext_LabelResponse_errors(ctx, field)
-			case "metadata":
-				return ec.fieldContext_LabelResponse_metadata(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LabelResponse", field.Name)
+			return nil, errors.New("field of type Carrier does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delivro_get_label_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delivro_cancel_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_quoteId(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delivro_cancel_order,
+		ec.fieldContext_CarrierQuoteResult_quoteId,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DelivroCancelOrder(ctx, fc.Args["input"].(CancelOrderInput))
+			return obj.QuoteID, nil
 		},
 		nil,
-		ec.marshalNCancelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delivro_cancel_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_quoteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_CancelResponse_success(ctx, field)
-			case "orderId":
-				return ec.fieldContext_CancelResponse_orderId(ctx, field)
-			case "status":
-				return ec.fieldContext_CancelResponse_status(ctx, field)
-			case "refundAmount":
-				return ec.fieldContext_CancelResponse_refundAmount(ctx, field)
-			case "confirmationNumber":
-				return ec.fieldContext_CancelResponse_confirmationNumber(ctx, field)
-			case "errors":
-				return ec.fieldContext_CancelResponse_errors(ctx, field)
-			case "metadata":
-				return ec.fieldContext_CancelResponse_metadata(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CancelResponse", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delivro_cancel_order_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_success(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_status(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_success,
+		ec.fieldContext_CarrierQuoteResult_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNCarrierQuoteStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type CarrierQuoteStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_latencyMs(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_orderId,
+		ec.fieldContext_CarrierQuoteResult_latencyMs,
 		func(ctx context.Context) (any, error) {
-			return obj.OrderID, nil
+			return obj.LatencyMs, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_latencyMs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_rateCount(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_trackingNumber,
+		ec.fieldContext_CarrierQuoteResult_rateCount,
 		func(ctx context.Context) (any, error) {
-			return obj.TrackingNumber, nil
+			return obj.RateCount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_rateCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_trackingUrl(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_errorCode(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_trackingUrl,
+		ec.fieldContext_CarrierQuoteResult_errorCode,
 		func(ctx context.Context) (any, error) {
-			return obj.TrackingURL, nil
+			return obj.ErrorCode, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2803,9 +4813,9 @@ func (ec *executionContext) _OrderResponse_trackingUrl(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_trackingUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_errorCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2816,83 +4826,83 @@ func (ec *executionContext) fieldContext_OrderResponse_trackingUrl(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_carrierCode(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_status,
+		ec.fieldContext_CarrierQuoteResult_carrierCode,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.CarrierCode, nil
 		},
 		nil,
-		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_carrierCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShipmentStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_carrier(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CarrierQuoteResult_retryable(ctx context.Context, field graphql.CollectedField, obj *CarrierQuoteResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_carrier,
+		ec.fieldContext_CarrierQuoteResult_retryable,
 		func(ctx context.Context) (any, error) {
-			return obj.Carrier, nil
+			return obj.Retryable, nil
 		},
 		nil,
-		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CarrierQuoteResult_retryable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "CarrierQuoteResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Carrier does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_serviceName(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_serviceName,
+		ec.fieldContext_Error_code,
 		func(ctx context.Context) (any, error) {
-			return obj.ServiceName, nil
+			return obj.Code, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2903,78 +4913,72 @@ func (ec *executionContext) fieldContext_OrderResponse_serviceName(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_totalCharged(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Error_message(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_totalCharged,
+		ec.fieldContext_Error_message,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCharged, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_totalCharged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Error_field(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_estimatedDelivery,
+		ec.fieldContext_Error_field,
 		func(ctx context.Context) (any, error) {
-			return obj.EstimatedDelivery, nil
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalODateTime2ᚖtimeᚐTime,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_labelUrl(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Error_carrierCode(ctx context.Context, field graphql.CollectedField, obj *Error) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_labelUrl,
+		ec.fieldContext_Error_carrierCode,
 		func(ctx context.Context) (any, error) {
-			return obj.LabelURL, nil
+			return obj.CarrierCode, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2983,9 +4987,9 @@ func (ec *executionContext) _OrderResponse_labelUrl(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_labelUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_carrierCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2996,393 +5000,423 @@ func (ec *executionContext) fieldContext_OrderResponse_labelUrl(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_errors(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Label_format(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_errors,
+		ec.fieldContext_Label_format,
 		func(ctx context.Context) (any, error) {
-			return obj.Errors, nil
+			return obj.Format, nil
 		},
 		nil,
-		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		ec.marshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Label_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Label",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "message":
-				return ec.fieldContext_Error_message(ctx, field)
-			case "field":
-				return ec.fieldContext_Error_field(ctx, field)
-			case "carrierCode":
-				return ec.fieldContext_Error_carrierCode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type LabelFormat does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Label_data(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderResponse_metadata,
+		ec.fieldContext_Label_data,
 		func(ctx context.Context) (any, error) {
-			return obj.Metadata, nil
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Label_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderResponse",
+		Object:     "Label",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "requestId":
-				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
-			case "processedAt":
-				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
-			case "carrier":
-				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
-			case "carrierRefId":
-				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_id(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _Label_url(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_id,
+		ec.fieldContext_Label_url,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.URL, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Label_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "Label",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_length(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _Label_expiresAt(ctx context.Context, field graphql.CollectedField, obj *Label) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_length,
+		ec.fieldContext_Label_expiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Length, nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
-		ec.marshalNDecimal2string,
-		true,
+		ec.marshalODateTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_length(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Label_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "Label",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_width(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_success(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_width,
+		ec.fieldContext_LabelResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.Width, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNDecimal2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_height(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_height,
+		ec.fieldContext_LabelResponse_orderId,
 		func(ctx context.Context) (any, error) {
-			return obj.Height, nil
+			return obj.OrderID, nil
 		},
 		nil,
-		ec.marshalNDecimal2string,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_dimensionUnit(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_label(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_dimensionUnit,
+		ec.fieldContext_LabelResponse_label,
 		func(ctx context.Context) (any, error) {
-			return obj.DimensionUnit, nil
+			return obj.Label, nil
 		},
 		nil,
-		ec.marshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit,
-		true,
+		ec.marshalOLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_dimensionUnit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DimensionUnit does not have child fields")
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_Label_format(ctx, field)
+			case "data":
+				return ec.fieldContext_Label_data(ctx, field)
+			case "url":
+				return ec.fieldContext_Label_url(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Label_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_weight(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_additionalLabels(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_weight,
+		ec.fieldContext_LabelResponse_additionalLabels,
 		func(ctx context.Context) (any, error) {
-			return obj.Weight, nil
+			return obj.AdditionalLabels, nil
 		},
 		nil,
-		ec.marshalNDecimal2string,
-		true,
+		ec.marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_additionalLabels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_Label_format(ctx, field)
+			case "data":
+				return ec.fieldContext_Label_data(ctx, field)
+			case "url":
+				return ec.fieldContext_Label_url(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Label_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_weightUnit(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_errors(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_weightUnit,
+		ec.fieldContext_LabelResponse_errors,
 		func(ctx context.Context) (any, error) {
-			return obj.WeightUnit, nil
+			return obj.Errors, nil
 		},
 		nil,
-		ec.marshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit,
-		true,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_weightUnit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type WeightUnit does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_packageType(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _LabelResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *LabelResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_packageType,
+		ec.fieldContext_LabelResponse_metadata,
 		func(ctx context.Context) (any, error) {
-			return obj.PackageType, nil
+			return obj.Metadata, nil
 		},
 		nil,
-		ec.marshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_packageType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LabelResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "LabelResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PackageType does not have child fields")
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_description(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _Manifest_manifestId(ctx context.Context, field graphql.CollectedField, obj *Manifest) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_description,
+		ec.fieldContext_Manifest_manifestId,
 		func(ctx context.Context) (any, error) {
-			return obj.Description, nil
+			return obj.ManifestID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Manifest_manifestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "Manifest",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_declaredValue(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _Manifest_document(ctx context.Context, field graphql.CollectedField, obj *Manifest) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_declaredValue,
+		ec.fieldContext_Manifest_document,
 		func(ctx context.Context) (any, error) {
-			return obj.DeclaredValue, nil
+			return obj.Document, nil
 		},
 		nil,
-		ec.marshalODecimal2ᚖstring,
+		ec.marshalNLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_declaredValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Manifest_document(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "Manifest",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_Label_format(ctx, field)
+			case "data":
+				return ec.fieldContext_Label_data(ctx, field)
+			case "url":
+				return ec.fieldContext_Label_url(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Label_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Package_currency(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestGroup_originPostalCode(ctx context.Context, field graphql.CollectedField, obj *ManifestGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Package_currency,
+		ec.fieldContext_ManifestGroup_originPostalCode,
 		func(ctx context.Context) (any, error) {
-			return obj.Currency, nil
+			return obj.OriginPostalCode, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Package_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestGroup_originPostalCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Package",
+		Object:     "ManifestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3393,207 +5427,105 @@ func (ec *executionContext) fieldContext_Package_currency(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestGroup_shipDate(ctx context.Context, field graphql.CollectedField, obj *ManifestGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_health,
+		ec.fieldContext_ManifestGroup_shipDate,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Health(ctx)
+			return obj.ShipDate, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNDateTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestGroup_shipDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ManifestGroup",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_carriers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestGroup_orderIds(ctx context.Context, field graphql.CollectedField, obj *ManifestGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_carriers,
+		ec.fieldContext_ManifestGroup_orderIds,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Carriers(ctx)
+			return obj.OrderIds, nil
 		},
 		nil,
-		ec.marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ,
+		ec.marshalNID2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_carriers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestGroup_orderIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ManifestGroup",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Carrier does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Query_serviceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_serviceTypes,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().ServiceTypes(ctx)
-		},
-		nil,
-		ec.marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_Query_serviceTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ServiceType does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestGroup_manifests(ctx context.Context, field graphql.CollectedField, obj *ManifestGroup) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_ManifestGroup_manifests,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.Manifests, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNManifest2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestᚄ,
 		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestGroup_manifests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ManifestGroup",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "manifestId":
+				return ec.fieldContext_Manifest_manifestId(ctx, field)
+			case "document":
+				return ec.fieldContext_Manifest_document(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Manifest", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _QuoteResponse_success(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestResponse_success(ctx context.Context, field graphql.CollectedField, obj *ManifestResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_QuoteResponse_success,
+		ec.fieldContext_ManifestResponse_success,
 		func(ctx context.Context) (any, error) {
 			return obj.Success, nil
 		},
@@ -3604,9 +5536,9 @@ func (ec *executionContext) _QuoteResponse_success(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_QuoteResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "QuoteResponse",
+		Object:     "ManifestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3617,100 +5549,51 @@ func (ec *executionContext) fieldContext_QuoteResponse_success(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _QuoteResponse_quoteId(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_QuoteResponse_quoteId,
-		func(ctx context.Context) (any, error) {
-			return obj.QuoteID, nil
-		},
-		nil,
-		ec.marshalOID2ᚖstring,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_QuoteResponse_quoteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "QuoteResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _QuoteResponse_rates(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestResponse_groups(ctx context.Context, field graphql.CollectedField, obj *ManifestResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_QuoteResponse_rates,
+		ec.fieldContext_ManifestResponse_groups,
 		func(ctx context.Context) (any, error) {
-			return obj.Rates, nil
+			return obj.Groups, nil
 		},
 		nil,
-		ec.marshalORateOption2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOptionᚄ,
+		ec.marshalOManifestGroup2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestGroupᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_QuoteResponse_rates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestResponse_groups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "QuoteResponse",
+		Object:     "ManifestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "rateId":
-				return ec.fieldContext_RateOption_rateId(ctx, field)
-			case "carrier":
-				return ec.fieldContext_RateOption_carrier(ctx, field)
-			case "serviceCode":
-				return ec.fieldContext_RateOption_serviceCode(ctx, field)
-			case "serviceName":
-				return ec.fieldContext_RateOption_serviceName(ctx, field)
-			case "serviceType":
-				return ec.fieldContext_RateOption_serviceType(ctx, field)
-			case "baseRate":
-				return ec.fieldContext_RateOption_baseRate(ctx, field)
-			case "fuelSurcharge":
-				return ec.fieldContext_RateOption_fuelSurcharge(ctx, field)
-			case "taxes":
-				return ec.fieldContext_RateOption_taxes(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_RateOption_totalPrice(ctx, field)
-			case "transitDays":
-				return ec.fieldContext_RateOption_transitDays(ctx, field)
-			case "estimatedDelivery":
-				return ec.fieldContext_RateOption_estimatedDelivery(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_RateOption_expiresAt(ctx, field)
-			case "signatureRequired":
-				return ec.fieldContext_RateOption_signatureRequired(ctx, field)
-			case "guaranteed":
-				return ec.fieldContext_RateOption_guaranteed(ctx, field)
+			case "originPostalCode":
+				return ec.fieldContext_ManifestGroup_originPostalCode(ctx, field)
+			case "shipDate":
+				return ec.fieldContext_ManifestGroup_shipDate(ctx, field)
+			case "orderIds":
+				return ec.fieldContext_ManifestGroup_orderIds(ctx, field)
+			case "manifests":
+				return ec.fieldContext_ManifestGroup_manifests(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RateOption", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ManifestGroup", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _QuoteResponse_errors(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestResponse_errors(ctx context.Context, field graphql.CollectedField, obj *ManifestResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_QuoteResponse_errors,
+		ec.fieldContext_ManifestResponse_errors,
 		func(ctx context.Context) (any, error) {
 			return obj.Errors, nil
 		},
@@ -3721,9 +5604,9 @@ func (ec *executionContext) _QuoteResponse_errors(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_QuoteResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "QuoteResponse",
+		Object:     "ManifestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3744,12 +5627,12 @@ func (ec *executionContext) fieldContext_QuoteResponse_errors(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _QuoteResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ManifestResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *ManifestResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_QuoteResponse_metadata,
+		ec.fieldContext_ManifestResponse_metadata,
 		func(ctx context.Context) (any, error) {
 			return obj.Metadata, nil
 		},
@@ -3760,9 +5643,9 @@ func (ec *executionContext) _QuoteResponse_metadata(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_QuoteResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ManifestResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "QuoteResponse",
+		Object:     "ManifestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3783,589 +5666,894 @@ func (ec *executionContext) fieldContext_QuoteResponse_metadata(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_rateId(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Money_amount(ctx context.Context, field graphql.CollectedField, obj *Money) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_rateId,
+		ec.fieldContext_Money_amount,
 		func(ctx context.Context) (any, error) {
-			return obj.RateID, nil
+			return obj.Amount, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_rateId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Money_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Money",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_carrier(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Money_currency(ctx context.Context, field graphql.CollectedField, obj *Money) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_carrier,
+		ec.fieldContext_Money_currency,
 		func(ctx context.Context) (any, error) {
-			return obj.Carrier, nil
+			return obj.Currency, nil
 		},
 		nil,
-		ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Money_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Money",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Carrier does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_serviceCode(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_get_quote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_serviceCode,
+		ec.fieldContext_Mutation_delivro_get_quote,
 		func(ctx context.Context) (any, error) {
-			return obj.ServiceCode, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroGetQuote(ctx, fc.Args["input"].(GetQuoteInput))
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNQuoteResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_serviceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_get_quote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_QuoteResponse_success(ctx, field)
+			case "quoteId":
+				return ec.fieldContext_QuoteResponse_quoteId(ctx, field)
+			case "rates":
+				return ec.fieldContext_QuoteResponse_rates(ctx, field)
+			case "errors":
+				return ec.fieldContext_QuoteResponse_errors(ctx, field)
+			case "carrierResults":
+				return ec.fieldContext_QuoteResponse_carrierResults(ctx, field)
+			case "metadata":
+				return ec.fieldContext_QuoteResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type QuoteResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _RateOption_serviceName(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_RateOption_serviceName,
-		func(ctx context.Context) (any, error) {
-			return obj.ServiceName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_RateOption_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RateOption",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_get_quote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_serviceType(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_create_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_serviceType,
+		ec.fieldContext_Mutation_delivro_create_order,
 		func(ctx context.Context) (any, error) {
-			return obj.ServiceType, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroCreateOrder(ctx, fc.Args["input"].(CreateOrderInput))
 		},
 		nil,
-		ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType,
+		ec.marshalNOrderResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_serviceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_create_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ServiceType does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_OrderResponse_success(ctx, field)
+			case "orderId":
+				return ec.fieldContext_OrderResponse_orderId(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_OrderResponse_trackingNumber(ctx, field)
+			case "trackingUrl":
+				return ec.fieldContext_OrderResponse_trackingUrl(ctx, field)
+			case "status":
+				return ec.fieldContext_OrderResponse_status(ctx, field)
+			case "carrier":
+				return ec.fieldContext_OrderResponse_carrier(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_OrderResponse_serviceName(ctx, field)
+			case "totalCharged":
+				return ec.fieldContext_OrderResponse_totalCharged(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_OrderResponse_estimatedDelivery(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_OrderResponse_labelUrl(ctx, field)
+			case "pieces":
+				return ec.fieldContext_OrderResponse_pieces(ctx, field)
+			case "previouslyCreated":
+				return ec.fieldContext_OrderResponse_previouslyCreated(ctx, field)
+			case "errors":
+				return ec.fieldContext_OrderResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_OrderResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_create_order_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_baseRate(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_get_label(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_baseRate,
+		ec.fieldContext_Mutation_delivro_get_label,
 		func(ctx context.Context) (any, error) {
-			return obj.BaseRate, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroGetLabel(ctx, fc.Args["input"].(GetLabelInput))
 		},
 		nil,
-		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		ec.marshalNLabelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_baseRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_get_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
+			case "success":
+				return ec.fieldContext_LabelResponse_success(ctx, field)
+			case "orderId":
+				return ec.fieldContext_LabelResponse_orderId(ctx, field)
+			case "label":
+				return ec.fieldContext_LabelResponse_label(ctx, field)
+			case "additionalLabels":
+				return ec.fieldContext_LabelResponse_additionalLabels(ctx, field)
+			case "errors":
+				return ec.fieldContext_LabelResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_LabelResponse_metadata(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LabelResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_get_label_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_fuelSurcharge(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_cancel_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_fuelSurcharge,
+		ec.fieldContext_Mutation_delivro_cancel_order,
 		func(ctx context.Context) (any, error) {
-			return obj.FuelSurcharge, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroCancelOrder(ctx, fc.Args["input"].(CancelOrderInput))
 		},
 		nil,
-		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		ec.marshalNCancelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_fuelSurcharge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_cancel_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
+			case "success":
+				return ec.fieldContext_CancelResponse_success(ctx, field)
+			case "orderId":
+				return ec.fieldContext_CancelResponse_orderId(ctx, field)
+			case "status":
+				return ec.fieldContext_CancelResponse_status(ctx, field)
+			case "refundAmount":
+				return ec.fieldContext_CancelResponse_refundAmount(ctx, field)
+			case "confirmationNumber":
+				return ec.fieldContext_CancelResponse_confirmationNumber(ctx, field)
+			case "errors":
+				return ec.fieldContext_CancelResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_CancelResponse_metadata(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CancelResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_cancel_order_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_taxes(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_track_shipment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_taxes,
+		ec.fieldContext_Mutation_delivro_track_shipment,
 		func(ctx context.Context) (any, error) {
-			return obj.Taxes, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroTrackShipment(ctx, fc.Args["input"].(TrackShipmentInput))
 		},
 		nil,
-		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		ec.marshalNTrackingResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_taxes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_track_shipment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
+			case "success":
+				return ec.fieldContext_TrackingResponse_success(ctx, field)
+			case "orderId":
+				return ec.fieldContext_TrackingResponse_orderId(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_TrackingResponse_trackingNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_TrackingResponse_status(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_TrackingResponse_estimatedDelivery(ctx, field)
+			case "events":
+				return ec.fieldContext_TrackingResponse_events(ctx, field)
+			case "errors":
+				return ec.fieldContext_TrackingResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_TrackingResponse_metadata(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TrackingResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_track_shipment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_totalPrice(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_pickup_availability(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_totalPrice,
+		ec.fieldContext_Mutation_delivro_pickup_availability,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalPrice, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroPickupAvailability(ctx, fc.Args["input"].(PickupAvailabilityInput))
 		},
 		nil,
-		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		ec.marshalNPickupAvailabilityResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupAvailabilityResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_pickup_availability(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "amount":
-				return ec.fieldContext_Money_amount(ctx, field)
-			case "currency":
-				return ec.fieldContext_Money_currency(ctx, field)
+			case "success":
+				return ec.fieldContext_PickupAvailabilityResponse_success(ctx, field)
+			case "available":
+				return ec.fieldContext_PickupAvailabilityResponse_available(ctx, field)
+			case "bookBy":
+				return ec.fieldContext_PickupAvailabilityResponse_bookBy(ctx, field)
+			case "windows":
+				return ec.fieldContext_PickupAvailabilityResponse_windows(ctx, field)
+			case "errors":
+				return ec.fieldContext_PickupAvailabilityResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_PickupAvailabilityResponse_metadata(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PickupAvailabilityResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_pickup_availability_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_transitDays(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_schedule_pickup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_transitDays,
+		ec.fieldContext_Mutation_delivro_schedule_pickup,
 		func(ctx context.Context) (any, error) {
-			return obj.TransitDays, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroSchedulePickup(ctx, fc.Args["input"].(SchedulePickupInput))
 		},
 		nil,
-		ec.marshalOInt2ᚖint,
+		ec.marshalNPickupResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_transitDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_schedule_pickup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_PickupResponse_success(ctx, field)
+			case "pickupId":
+				return ec.fieldContext_PickupResponse_pickupId(ctx, field)
+			case "carrier":
+				return ec.fieldContext_PickupResponse_carrier(ctx, field)
+			case "status":
+				return ec.fieldContext_PickupResponse_status(ctx, field)
+			case "window":
+				return ec.fieldContext_PickupResponse_window(ctx, field)
+			case "errors":
+				return ec.fieldContext_PickupResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_PickupResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PickupResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_schedule_pickup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_cancel_pickup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_estimatedDelivery,
+		ec.fieldContext_Mutation_delivro_cancel_pickup,
 		func(ctx context.Context) (any, error) {
-			return obj.EstimatedDelivery, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroCancelPickup(ctx, fc.Args["input"].(CancelPickupInput))
 		},
 		nil,
-		ec.marshalODateTime2ᚖtimeᚐTime,
+		ec.marshalNCancelPickupResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelPickupResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_cancel_pickup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_CancelPickupResponse_success(ctx, field)
+			case "pickupId":
+				return ec.fieldContext_CancelPickupResponse_pickupId(ctx, field)
+			case "status":
+				return ec.fieldContext_CancelPickupResponse_status(ctx, field)
+			case "errors":
+				return ec.fieldContext_CancelPickupResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_CancelPickupResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CancelPickupResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_cancel_pickup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_expiresAt(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_manifest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_expiresAt,
+		ec.fieldContext_Mutation_delivro_manifest,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroManifest(ctx, fc.Args["input"].(ManifestInput))
 		},
 		nil,
-		ec.marshalNDateTime2timeᚐTime,
+		ec.marshalNManifestResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_manifest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ManifestResponse_success(ctx, field)
+			case "groups":
+				return ec.fieldContext_ManifestResponse_groups(ctx, field)
+			case "errors":
+				return ec.fieldContext_ManifestResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_ManifestResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ManifestResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_manifest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_signatureRequired(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_create_return(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_signatureRequired,
+		ec.fieldContext_Mutation_delivro_create_return,
 		func(ctx context.Context) (any, error) {
-			return obj.SignatureRequired, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroCreateReturn(ctx, fc.Args["input"].(CreateReturnInput))
 		},
 		nil,
-		ec.marshalOBoolean2ᚖbool,
+		ec.marshalNReturnResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐReturnResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_signatureRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_create_return(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ReturnResponse_success(ctx, field)
+			case "orderId":
+				return ec.fieldContext_ReturnResponse_orderId(ctx, field)
+			case "originalOrderId":
+				return ec.fieldContext_ReturnResponse_originalOrderId(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_ReturnResponse_trackingNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_ReturnResponse_status(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ReturnResponse_carrier(ctx, field)
+			case "totalCharged":
+				return ec.fieldContext_ReturnResponse_totalCharged(ctx, field)
+			case "label":
+				return ec.fieldContext_ReturnResponse_label(ctx, field)
+			case "additionalLabels":
+				return ec.fieldContext_ReturnResponse_additionalLabels(ctx, field)
+			case "errors":
+				return ec.fieldContext_ReturnResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_ReturnResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReturnResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_create_return_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RateOption_guaranteed(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_create_webhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RateOption_guaranteed,
+		ec.fieldContext_Mutation_delivro_create_webhook,
 		func(ctx context.Context) (any, error) {
-			return obj.Guaranteed, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroCreateWebhook(ctx, fc.Args["input"].(CreateWebhookInput))
 		},
 		nil,
-		ec.marshalOBoolean2ᚖbool,
+		ec.marshalNWebhookResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RateOption_guaranteed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_create_webhook(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RateOption",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_WebhookResponse_success(ctx, field)
+			case "subscription":
+				return ec.fieldContext_WebhookResponse_subscription(ctx, field)
+			case "secret":
+				return ec.fieldContext_WebhookResponse_secret(ctx, field)
+			case "errors":
+				return ec.fieldContext_WebhookResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_WebhookResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_create_webhook_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResponseMetadata_requestId(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_delete_webhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ResponseMetadata_requestId,
+		ec.fieldContext_Mutation_delivro_delete_webhook,
 		func(ctx context.Context) (any, error) {
-			return obj.RequestID, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroDeleteWebhook(ctx, fc.Args["input"].(DeleteWebhookInput))
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNWebhookResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ResponseMetadata_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_delete_webhook(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResponseMetadata",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_WebhookResponse_success(ctx, field)
+			case "subscription":
+				return ec.fieldContext_WebhookResponse_subscription(ctx, field)
+			case "secret":
+				return ec.fieldContext_WebhookResponse_secret(ctx, field)
+			case "errors":
+				return ec.fieldContext_WebhookResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_WebhookResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_delete_webhook_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResponseMetadata_processedAt(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delivro_replay_webhook_delivery(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ResponseMetadata_processedAt,
+		ec.fieldContext_Mutation_delivro_replay_webhook_delivery,
 		func(ctx context.Context) (any, error) {
-			return obj.ProcessedAt, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelivroReplayWebhookDelivery(ctx, fc.Args["input"].(ReplayWebhookDeliveryInput))
 		},
 		nil,
-		ec.marshalNDateTime2timeᚐTime,
+		ec.marshalNWebhookDeliveryResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ResponseMetadata_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delivro_replay_webhook_delivery(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResponseMetadata",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_WebhookDeliveryResponse_success(ctx, field)
+			case "delivery":
+				return ec.fieldContext_WebhookDeliveryResponse_delivery(ctx, field)
+			case "errors":
+				return ec.fieldContext_WebhookDeliveryResponse_errors(ctx, field)
+			case "metadata":
+				return ec.fieldContext_WebhookDeliveryResponse_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDeliveryResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delivro_replay_webhook_delivery_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResponseMetadata_carrier(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_orderId(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ResponseMetadata_carrier,
+		ec.fieldContext_Order_orderId,
 		func(ctx context.Context) (any, error) {
-			return obj.Carrier, nil
+			return obj.OrderID, nil
 		},
 		nil,
-		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ResponseMetadata_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResponseMetadata",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Carrier does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResponseMetadata_carrierRefId(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_shipperId(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ResponseMetadata_carrierRefId,
+		ec.fieldContext_Order_shipperId,
 		func(ctx context.Context) (any, error) {
-			return obj.CarrierRefID, nil
+			return obj.ShipperID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ResponseMetadata_carrierRefId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_shipperId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResponseMetadata",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_carrier(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_name,
+		ec.fieldContext_Order_carrier,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Carrier, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Carrier does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_reference(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_description,
+		ec.fieldContext_Order_reference,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Reference, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -4374,11 +6562,11 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_reference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4387,155 +6575,130 @@ func (ec *executionContext) fieldContext___Directive_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_poNumber(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_isRepeatable,
+		ec.fieldContext_Order_poNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
+			return obj.PoNumber, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_poNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_locations,
+		ec.fieldContext_Order_trackingNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
+			return obj.TrackingNumber, nil
 		},
 		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_trackingUrl(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_args,
+		ec.fieldContext_Order_trackingUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.TrackingURL, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_trackingUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_status(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_Order_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNShipmentStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_serviceName(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_Order_serviceName,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.ServiceName, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -4544,11 +6707,11 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4557,83 +6720,89 @@ func (ec *executionContext) fieldContext___EnumValue_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_totalCharged(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_Order_totalCharged,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.TotalCharged, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_totalCharged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_Order_estimatedDelivery,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.EstimatedDelivery, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODateTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_labelUrl(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_Order_labelUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.LabelURL, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_labelUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4644,319 +6813,354 @@ func (ec *executionContext) fieldContext___Field_name(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_pieces(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_Order_pieces,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Pieces, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOPiece2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPieceᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_pieces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "pieceId":
+				return ec.fieldContext_Piece_pieceId(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_Piece_trackingNumber(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_Piece_labelUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Piece", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_senderAddress(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_Order_senderAddress,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.SenderAddress, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNAddress2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddress,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_senderAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+				return ec.fieldContext_Address_name(ctx, field)
+			case "company":
+				return ec.fieldContext_Address_company(ctx, field)
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "provinceCode":
+				return ec.fieldContext_Address_provinceCode(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_Address_postalCode(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_Address_countryCode(ctx, field)
+			case "phone":
+				return ec.fieldContext_Address_phone(ctx, field)
+			case "email":
+				return ec.fieldContext_Address_email(ctx, field)
+			case "instructions":
+				return ec.fieldContext_Address_instructions(ctx, field)
+			case "isResidential":
+				return ec.fieldContext_Address_isResidential(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_recipientAddress(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_Order_recipientAddress,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.RecipientAddress, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNAddress2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddress,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_recipientAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				return ec.fieldContext_Address_name(ctx, field)
+			case "company":
+				return ec.fieldContext_Address_company(ctx, field)
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "provinceCode":
+				return ec.fieldContext_Address_provinceCode(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_Address_postalCode(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_Address_countryCode(ctx, field)
+			case "phone":
+				return ec.fieldContext_Address_phone(ctx, field)
+			case "email":
+				return ec.fieldContext_Address_email(ctx, field)
+			case "instructions":
+				return ec.fieldContext_Address_instructions(ctx, field)
+			case "isResidential":
+				return ec.fieldContext_Address_isResidential(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_statusHistory(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_Order_statusHistory,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.StatusHistory, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNStatusChange2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐStatusChangeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_statusHistory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_StatusChange_status(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_StatusChange_timestamp(ctx, field)
+			case "source":
+				return ec.fieldContext_StatusChange_source(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StatusChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_returnOf(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_Order_returnOf,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.ReturnOf, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_returnOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_createdAt(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_Order_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNDateTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_updatedAt(ctx context.Context, field graphql.CollectedField, obj *Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_Order_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Order",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderConnection_orders(ctx context.Context, field graphql.CollectedField, obj *OrderConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_OrderConnection_orders,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.Orders, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNOrder2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderConnection_orders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "OrderConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
+			case "orderId":
+				return ec.fieldContext_Order_orderId(ctx, field)
+			case "shipperId":
+				return ec.fieldContext_Order_shipperId(ctx, field)
+			case "carrier":
+				return ec.fieldContext_Order_carrier(ctx, field)
+			case "reference":
+				return ec.fieldContext_Order_reference(ctx, field)
+			case "poNumber":
+				return ec.fieldContext_Order_poNumber(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_Order_trackingNumber(ctx, field)
+			case "trackingUrl":
+				return ec.fieldContext_Order_trackingUrl(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Order_serviceName(ctx, field)
+			case "totalCharged":
+				return ec.fieldContext_Order_totalCharged(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_Order_estimatedDelivery(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_Order_labelUrl(ctx, field)
+			case "pieces":
+				return ec.fieldContext_Order_pieces(ctx, field)
+			case "senderAddress":
+				return ec.fieldContext_Order_senderAddress(ctx, field)
+			case "recipientAddress":
+				return ec.fieldContext_Order_recipientAddress(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Order_statusHistory(ctx, field)
+			case "returnOf":
+				return ec.fieldContext_Order_returnOf(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Order_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Order_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+		},
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderConnection_nextCursor(ctx context.Context, field graphql.CollectedField, obj *OrderConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_OrderConnection_nextCursor,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return obj.NextCursor, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -4965,9 +7169,9 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderConnection_nextCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "OrderConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4978,14 +7182,14 @@ func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderConnection_hasMore(ctx context.Context, field graphql.CollectedField, obj *OrderConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_OrderConnection_hasMore,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.HasMore, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -4994,11 +7198,11 @@ func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderConnection_hasMore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "OrderConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Boolean does not have child fields")
@@ -5007,383 +7211,281 @@ func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_success(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_OrderResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_OrderResponse_orderId,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.OrderID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_OrderResponse_trackingNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			return obj.TrackingNumber, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_trackingUrl(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_OrderResponse_trackingUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return obj.TrackingURL, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_trackingUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_status(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_OrderResponse_status,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_carrier(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_OrderResponse_carrier,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			return obj.Carrier, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Carrier does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_serviceName(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_OrderResponse_serviceName,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			return obj.ServiceName, nil
 		},
 		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_totalCharged(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_OrderResponse_totalCharged,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return obj.TotalCharged, nil
 		},
 		nil,
-		ec.marshalN__TypeKind2string,
-		true,
+		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_totalCharged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_OrderResponse_estimatedDelivery,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return obj.EstimatedDelivery, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODateTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_labelUrl(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_OrderResponse_labelUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.LabelURL, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -5392,11 +7494,11 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_labelUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5405,1005 +7507,9564 @@ func (ec *executionContext) fieldContext___Type_description(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_pieces(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_specifiedByURL,
+		ec.fieldContext_OrderResponse_pieces,
 		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
+			return obj.Pieces, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOPiece2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPieceᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_pieces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "pieceId":
+				return ec.fieldContext_Piece_pieceId(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_Piece_trackingNumber(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_Piece_labelUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Piece", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_previouslyCreated(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_fields,
+		ec.fieldContext_OrderResponse_previouslyCreated,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.PreviouslyCreated, nil
 		},
 		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_previouslyCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_errors(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_interfaces,
+		ec.fieldContext_OrderResponse_errors,
 		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
+			return obj.Errors, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *OrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_possibleTypes,
+		ec.fieldContext_OrderResponse_metadata,
 		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
+			return obj.Metadata, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrderResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "OrderResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Package_id(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_enumValues,
+		ec.fieldContext_Package_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Package_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Package",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Package_length(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_inputFields,
+		ec.fieldContext_Package_length,
 		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
+			return obj.Length, nil
 		},
 		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Package_length(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Package",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Package_width(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_ofType,
+		ec.fieldContext_Package_width,
 		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
+			return obj.Width, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Package_width(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Package",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Package_height(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_isOneOf,
+		ec.fieldContext_Package_height,
 		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
+			return obj.Height, nil
 		},
 		nil,
-		ec.marshalOBoolean2bool,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Package_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Package",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _Package_dimensionUnit(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_dimensionUnit,
+		func(ctx context.Context) (any, error) {
+			return obj.DimensionUnit, nil
+		},
+		nil,
+		ec.marshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit,
+		true,
+		true,
+	)
+}
 
-func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj any) (AddressInput, error) {
-	var it AddressInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_Package_dimensionUnit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DimensionUnit does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	if _, present := asMap["countryCode"]; !present {
-		asMap["countryCode"] = "CA"
-	}
-	if _, present := asMap["isResidential"]; !present {
-		asMap["isResidential"] = false
-	}
+func (ec *executionContext) _Package_weight(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_weight,
+		func(ctx context.Context) (any, error) {
+			return obj.Weight, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"name", "company", "line1", "line2", "city", "provinceCode", "postalCode", "countryCode", "phone", "email", "instructions", "isResidential"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "company":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("company"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Company = data
-		case "line1":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line1"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Line1 = data
-		case "line2":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line2"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Line2 = data
-		case "city":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.City = data
-		case "provinceCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("provinceCode"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ProvinceCode = data
-		case "postalCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("postalCode"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PostalCode = data
-		case "countryCode":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("countryCode"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CountryCode = data
-		case "phone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Phone = data
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Email = data
-		case "instructions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("instructions"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Instructions = data
-		case "isResidential":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isResidential"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.IsResidential = data
-		}
+func (ec *executionContext) fieldContext_Package_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	return it, nil
+func (ec *executionContext) _Package_weightUnit(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_weightUnit,
+		func(ctx context.Context) (any, error) {
+			return obj.WeightUnit, nil
+		},
+		nil,
+		ec.marshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) unmarshalInputCancelOrderInput(ctx context.Context, obj any) (CancelOrderInput, error) {
-	var it CancelOrderInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) fieldContext_Package_weightUnit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WeightUnit does not have child fields")
+		},
 	}
+	return fc, nil
+}
 
-	fieldsInOrder := [...]string{"orderId", "reason"}
-	for _, k := range fieldsInOrder {
+func (ec *executionContext) _Package_packageType(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_packageType,
+		func(ctx context.Context) (any, error) {
+			return obj.PackageType, nil
+		},
+		nil,
+		ec.marshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Package_packageType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PackageType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Package_description(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Package_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Package_declaredValue(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_declaredValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DeclaredValue, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Package_declaredValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Package_currency(ctx context.Context, field graphql.CollectedField, obj *Package) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Package_currency,
+		func(ctx context.Context) (any, error) {
+			return obj.Currency, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Package_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Package",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_success(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_available(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_available,
+		func(ctx context.Context) (any, error) {
+			return obj.Available, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_bookBy(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_bookBy,
+		func(ctx context.Context) (any, error) {
+			return obj.BookBy, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_bookBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_windows(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_windows,
+		func(ctx context.Context) (any, error) {
+			return obj.Windows, nil
+		},
+		nil,
+		ec.marshalOPickupWindow2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_windows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "readyAt":
+				return ec.fieldContext_PickupWindow_readyAt(ctx, field)
+			case "closeAt":
+				return ec.fieldContext_PickupWindow_closeAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PickupWindow", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_errors(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupAvailabilityResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *PickupAvailabilityResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupAvailabilityResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupAvailabilityResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupAvailabilityResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_success(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_pickupId(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_pickupId,
+		func(ctx context.Context) (any, error) {
+			return obj.PickupID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_pickupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_carrier(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_carrier,
+		func(ctx context.Context) (any, error) {
+			return obj.Carrier, nil
+		},
+		nil,
+		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Carrier does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_status(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalOPickupStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PickupStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_window(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_window,
+		func(ctx context.Context) (any, error) {
+			return obj.Window, nil
+		},
+		nil,
+		ec.marshalOPickupWindow2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindow,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_window(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "readyAt":
+				return ec.fieldContext_PickupWindow_readyAt(ctx, field)
+			case "closeAt":
+				return ec.fieldContext_PickupWindow_closeAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PickupWindow", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_errors(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *PickupResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupWindow_readyAt(ctx context.Context, field graphql.CollectedField, obj *PickupWindow) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupWindow_readyAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ReadyAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupWindow_readyAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupWindow",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupWindow_closeAt(ctx context.Context, field graphql.CollectedField, obj *PickupWindow) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupWindow_closeAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CloseAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupWindow_closeAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupWindow",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Piece_pieceId(ctx context.Context, field graphql.CollectedField, obj *Piece) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Piece_pieceId,
+		func(ctx context.Context) (any, error) {
+			return obj.PieceID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Piece_pieceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Piece",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Piece_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *Piece) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Piece_trackingNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.TrackingNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Piece_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Piece",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Piece_labelUrl(ctx context.Context, field graphql.CollectedField, obj *Piece) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Piece_labelUrl,
+		func(ctx context.Context) (any, error) {
+			return obj.LabelURL, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Piece_labelUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Piece",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_health,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Health(ctx)
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_carriers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_carriers,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Carriers(ctx)
+		},
+		nil,
+		ec.marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_carriers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Carrier does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_serviceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_serviceTypes,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().ServiceTypes(ctx)
+		},
+		nil,
+		ec.marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_serviceTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ServiceType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_carrierCapabilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_carrierCapabilities,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CarrierCapabilities(ctx, fc.Args["carrier"].(*Carrier))
+		},
+		nil,
+		ec.marshalNCarrierCapabilities2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierCapabilitiesᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_carrierCapabilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "carrier":
+				return ec.fieldContext_CarrierCapabilities_carrier(ctx, field)
+			case "labelFormats":
+				return ec.fieldContext_CarrierCapabilities_labelFormats(ctx, field)
+			case "international":
+				return ec.fieldContext_CarrierCapabilities_international(ctx, field)
+			case "multiPiece":
+				return ec.fieldContext_CarrierCapabilities_multiPiece(ctx, field)
+			case "pickups":
+				return ec.fieldContext_CarrierCapabilities_pickups(ctx, field)
+			case "manifests":
+				return ec.fieldContext_CarrierCapabilities_manifests(ctx, field)
+			case "returns":
+				return ec.fieldContext_CarrierCapabilities_returns(ctx, field)
+			case "insurance":
+				return ec.fieldContext_CarrierCapabilities_insurance(ctx, field)
+			case "signatureRequired":
+				return ec.fieldContext_CarrierCapabilities_signatureRequired(ctx, field)
+			case "saturdayDelivery":
+				return ec.fieldContext_CarrierCapabilities_saturdayDelivery(ctx, field)
+			case "maxWeight":
+				return ec.fieldContext_CarrierCapabilities_maxWeight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CarrierCapabilities", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_carrierCapabilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_delivro_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_delivro_order,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DelivroOrder(ctx, fc.Args["shipperId"].(string), fc.Args["orderId"].(string))
+		},
+		nil,
+		ec.marshalOOrder2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrder,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_delivro_order(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderId":
+				return ec.fieldContext_Order_orderId(ctx, field)
+			case "shipperId":
+				return ec.fieldContext_Order_shipperId(ctx, field)
+			case "carrier":
+				return ec.fieldContext_Order_carrier(ctx, field)
+			case "reference":
+				return ec.fieldContext_Order_reference(ctx, field)
+			case "poNumber":
+				return ec.fieldContext_Order_poNumber(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_Order_trackingNumber(ctx, field)
+			case "trackingUrl":
+				return ec.fieldContext_Order_trackingUrl(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Order_serviceName(ctx, field)
+			case "totalCharged":
+				return ec.fieldContext_Order_totalCharged(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_Order_estimatedDelivery(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_Order_labelUrl(ctx, field)
+			case "pieces":
+				return ec.fieldContext_Order_pieces(ctx, field)
+			case "senderAddress":
+				return ec.fieldContext_Order_senderAddress(ctx, field)
+			case "recipientAddress":
+				return ec.fieldContext_Order_recipientAddress(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Order_statusHistory(ctx, field)
+			case "returnOf":
+				return ec.fieldContext_Order_returnOf(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Order_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Order_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_delivro_order_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_delivro_order_by_reference(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_delivro_order_by_reference,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DelivroOrderByReference(ctx, fc.Args["shipperId"].(string), fc.Args["reference"].(string))
+		},
+		nil,
+		ec.marshalOOrder2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrder,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_delivro_order_by_reference(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orderId":
+				return ec.fieldContext_Order_orderId(ctx, field)
+			case "shipperId":
+				return ec.fieldContext_Order_shipperId(ctx, field)
+			case "carrier":
+				return ec.fieldContext_Order_carrier(ctx, field)
+			case "reference":
+				return ec.fieldContext_Order_reference(ctx, field)
+			case "poNumber":
+				return ec.fieldContext_Order_poNumber(ctx, field)
+			case "trackingNumber":
+				return ec.fieldContext_Order_trackingNumber(ctx, field)
+			case "trackingUrl":
+				return ec.fieldContext_Order_trackingUrl(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Order_serviceName(ctx, field)
+			case "totalCharged":
+				return ec.fieldContext_Order_totalCharged(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_Order_estimatedDelivery(ctx, field)
+			case "labelUrl":
+				return ec.fieldContext_Order_labelUrl(ctx, field)
+			case "pieces":
+				return ec.fieldContext_Order_pieces(ctx, field)
+			case "senderAddress":
+				return ec.fieldContext_Order_senderAddress(ctx, field)
+			case "recipientAddress":
+				return ec.fieldContext_Order_recipientAddress(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Order_statusHistory(ctx, field)
+			case "returnOf":
+				return ec.fieldContext_Order_returnOf(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Order_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Order_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_delivro_order_by_reference_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_delivro_orders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_delivro_orders,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DelivroOrders(ctx, fc.Args["shipperId"].(string), fc.Args["first"].(*int), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNOrderConnection2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_delivro_orders(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "orders":
+				return ec.fieldContext_OrderConnection_orders(ctx, field)
+			case "nextCursor":
+				return ec.fieldContext_OrderConnection_nextCursor(ctx, field)
+			case "hasMore":
+				return ec.fieldContext_OrderConnection_hasMore(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_delivro_orders_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_delivro_webhooks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_delivro_webhooks,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DelivroWebhooks(ctx, fc.Args["shipperId"].(string))
+		},
+		nil,
+		ec.marshalNWebhookSubscription2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscriptionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_delivro_webhooks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WebhookSubscription_id(ctx, field)
+			case "shipperId":
+				return ec.fieldContext_WebhookSubscription_shipperId(ctx, field)
+			case "url":
+				return ec.fieldContext_WebhookSubscription_url(ctx, field)
+			case "events":
+				return ec.fieldContext_WebhookSubscription_events(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WebhookSubscription_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookSubscription", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_delivro_webhooks_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_delivro_webhook_deliveries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_delivro_webhook_deliveries,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DelivroWebhookDeliveries(ctx, fc.Args["shipperId"].(string), fc.Args["state"].(*WebhookDeliveryState), fc.Args["first"].(*int))
+		},
+		nil,
+		ec.marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_delivro_webhook_deliveries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WebhookDelivery_id(ctx, field)
+			case "subscriptionId":
+				return ec.fieldContext_WebhookDelivery_subscriptionId(ctx, field)
+			case "eventId":
+				return ec.fieldContext_WebhookDelivery_eventId(ctx, field)
+			case "eventType":
+				return ec.fieldContext_WebhookDelivery_eventType(ctx, field)
+			case "orderId":
+				return ec.fieldContext_WebhookDelivery_orderId(ctx, field)
+			case "state":
+				return ec.fieldContext_WebhookDelivery_state(ctx, field)
+			case "attempts":
+				return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+			case "lastStatusCode":
+				return ec.fieldContext_WebhookDelivery_lastStatusCode(ctx, field)
+			case "lastError":
+				return ec.fieldContext_WebhookDelivery_lastError(ctx, field)
+			case "nextAttemptAt":
+				return ec.fieldContext_WebhookDelivery_nextAttemptAt(ctx, field)
+			case "deliveredAt":
+				return ec.fieldContext_WebhookDelivery_deliveredAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WebhookDelivery_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_WebhookDelivery_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDelivery", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_delivro_webhook_deliveries_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_success(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_quoteId(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_quoteId,
+		func(ctx context.Context) (any, error) {
+			return obj.QuoteID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_quoteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_rates(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_rates,
+		func(ctx context.Context) (any, error) {
+			return obj.Rates, nil
+		},
+		nil,
+		ec.marshalORateOption2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOptionᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_rates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "rateId":
+				return ec.fieldContext_RateOption_rateId(ctx, field)
+			case "carrier":
+				return ec.fieldContext_RateOption_carrier(ctx, field)
+			case "serviceCode":
+				return ec.fieldContext_RateOption_serviceCode(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_RateOption_serviceName(ctx, field)
+			case "serviceType":
+				return ec.fieldContext_RateOption_serviceType(ctx, field)
+			case "baseRate":
+				return ec.fieldContext_RateOption_baseRate(ctx, field)
+			case "fuelSurcharge":
+				return ec.fieldContext_RateOption_fuelSurcharge(ctx, field)
+			case "taxes":
+				return ec.fieldContext_RateOption_taxes(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_RateOption_totalPrice(ctx, field)
+			case "transitDays":
+				return ec.fieldContext_RateOption_transitDays(ctx, field)
+			case "estimatedDelivery":
+				return ec.fieldContext_RateOption_estimatedDelivery(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_RateOption_expiresAt(ctx, field)
+			case "signatureRequired":
+				return ec.fieldContext_RateOption_signatureRequired(ctx, field)
+			case "guaranteed":
+				return ec.fieldContext_RateOption_guaranteed(ctx, field)
+			case "billableWeight":
+				return ec.fieldContext_RateOption_billableWeight(ctx, field)
+			case "tags":
+				return ec.fieldContext_RateOption_tags(ctx, field)
+			case "score":
+				return ec.fieldContext_RateOption_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RateOption", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_errors(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_carrierResults(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_carrierResults,
+		func(ctx context.Context) (any, error) {
+			return obj.CarrierResults, nil
+		},
+		nil,
+		ec.marshalNCarrierQuoteResult2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteResultᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_carrierResults(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "carrier":
+				return ec.fieldContext_CarrierQuoteResult_carrier(ctx, field)
+			case "quoteId":
+				return ec.fieldContext_CarrierQuoteResult_quoteId(ctx, field)
+			case "status":
+				return ec.fieldContext_CarrierQuoteResult_status(ctx, field)
+			case "latencyMs":
+				return ec.fieldContext_CarrierQuoteResult_latencyMs(ctx, field)
+			case "rateCount":
+				return ec.fieldContext_CarrierQuoteResult_rateCount(ctx, field)
+			case "errorCode":
+				return ec.fieldContext_CarrierQuoteResult_errorCode(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_CarrierQuoteResult_carrierCode(ctx, field)
+			case "retryable":
+				return ec.fieldContext_CarrierQuoteResult_retryable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CarrierQuoteResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _QuoteResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *QuoteResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_QuoteResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_QuoteResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "QuoteResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_rateId(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_rateId,
+		func(ctx context.Context) (any, error) {
+			return obj.RateID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_rateId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_carrier(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_carrier,
+		func(ctx context.Context) (any, error) {
+			return obj.Carrier, nil
+		},
+		nil,
+		ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Carrier does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_serviceCode(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_serviceCode,
+		func(ctx context.Context) (any, error) {
+			return obj.ServiceCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_serviceCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_serviceName(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_serviceName,
+		func(ctx context.Context) (any, error) {
+			return obj.ServiceName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_serviceType(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_serviceType,
+		func(ctx context.Context) (any, error) {
+			return obj.ServiceType, nil
+		},
+		nil,
+		ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_serviceType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ServiceType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_baseRate(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_baseRate,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseRate, nil
+		},
+		nil,
+		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_baseRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_fuelSurcharge(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_fuelSurcharge,
+		func(ctx context.Context) (any, error) {
+			return obj.FuelSurcharge, nil
+		},
+		nil,
+		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_fuelSurcharge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_taxes(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_taxes,
+		func(ctx context.Context) (any, error) {
+			return obj.Taxes, nil
+		},
+		nil,
+		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_taxes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_totalPrice(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_totalPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPrice, nil
+		},
+		nil,
+		ec.marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_transitDays(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_transitDays,
+		func(ctx context.Context) (any, error) {
+			return obj.TransitDays, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_transitDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_estimatedDelivery,
+		func(ctx context.Context) (any, error) {
+			return obj.EstimatedDelivery, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_expiresAt(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_signatureRequired(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_signatureRequired,
+		func(ctx context.Context) (any, error) {
+			return obj.SignatureRequired, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_signatureRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_guaranteed(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_guaranteed,
+		func(ctx context.Context) (any, error) {
+			return obj.Guaranteed, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_guaranteed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_billableWeight(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_billableWeight,
+		func(ctx context.Context) (any, error) {
+			return obj.BillableWeight, nil
+		},
+		nil,
+		ec.marshalOWeight2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeight,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_billableWeight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "value":
+				return ec.fieldContext_Weight_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Weight_unit(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Weight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_tags(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_tags,
+		func(ctx context.Context) (any, error) {
+			return obj.Tags, nil
+		},
+		nil,
+		ec.marshalNRateTag2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTagᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RateTag does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RateOption_score(ctx context.Context, field graphql.CollectedField, obj *RateOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RateOption_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RateOption_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RateOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResponseMetadata_requestId(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResponseMetadata_requestId,
+		func(ctx context.Context) (any, error) {
+			return obj.RequestID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResponseMetadata_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResponseMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResponseMetadata_processedAt(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResponseMetadata_processedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ProcessedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResponseMetadata_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResponseMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResponseMetadata_carrier(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResponseMetadata_carrier,
+		func(ctx context.Context) (any, error) {
+			return obj.Carrier, nil
+		},
+		nil,
+		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResponseMetadata_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResponseMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Carrier does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResponseMetadata_carrierRefId(ctx context.Context, field graphql.CollectedField, obj *ResponseMetadata) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResponseMetadata_carrierRefId,
+		func(ctx context.Context) (any, error) {
+			return obj.CarrierRefID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResponseMetadata_carrierRefId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResponseMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_success(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_originalOrderId(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_originalOrderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OriginalOrderID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_originalOrderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_trackingNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.TrackingNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_status(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_carrier(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_carrier,
+		func(ctx context.Context) (any, error) {
+			return obj.Carrier, nil
+		},
+		nil,
+		ec.marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_carrier(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Carrier does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_totalCharged(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_totalCharged,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCharged, nil
+		},
+		nil,
+		ec.marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_totalCharged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "amount":
+				return ec.fieldContext_Money_amount(ctx, field)
+			case "currency":
+				return ec.fieldContext_Money_currency(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Money", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_label(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_label,
+		func(ctx context.Context) (any, error) {
+			return obj.Label, nil
+		},
+		nil,
+		ec.marshalOLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_Label_format(ctx, field)
+			case "data":
+				return ec.fieldContext_Label_data(ctx, field)
+			case "url":
+				return ec.fieldContext_Label_url(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Label_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_additionalLabels(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_additionalLabels,
+		func(ctx context.Context) (any, error) {
+			return obj.AdditionalLabels, nil
+		},
+		nil,
+		ec.marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_additionalLabels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_Label_format(ctx, field)
+			case "data":
+				return ec.fieldContext_Label_data(ctx, field)
+			case "url":
+				return ec.fieldContext_Label_url(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Label_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Label", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_errors(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReturnResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *ReturnResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReturnResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReturnResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReturnResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusChange_status(ctx context.Context, field graphql.CollectedField, obj *StatusChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StatusChange_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNShipmentStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StatusChange_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusChange_timestamp(ctx context.Context, field graphql.CollectedField, obj *StatusChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StatusChange_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StatusChange_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusChange_source(ctx context.Context, field graphql.CollectedField, obj *StatusChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StatusChange_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_StatusChange_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *TrackingEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingEvent_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingEvent_description(ctx context.Context, field graphql.CollectedField, obj *TrackingEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingEvent_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingEvent_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingEvent_location(ctx context.Context, field graphql.CollectedField, obj *TrackingEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingEvent_location,
+		func(ctx context.Context) (any, error) {
+			return obj.Location, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingEvent_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingEvent_status(ctx context.Context, field graphql.CollectedField, obj *TrackingEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingEvent_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNShipmentStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingEvent_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingEvent_carrierCode(ctx context.Context, field graphql.CollectedField, obj *TrackingEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingEvent_carrierCode,
+		func(ctx context.Context) (any, error) {
+			return obj.CarrierCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingEvent_carrierCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_success(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_orderId(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_trackingNumber(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_trackingNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.TrackingNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_trackingNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_status(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShipmentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_estimatedDelivery(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_estimatedDelivery,
+		func(ctx context.Context) (any, error) {
+			return obj.EstimatedDelivery, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_estimatedDelivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_events(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_events,
+		func(ctx context.Context) (any, error) {
+			return obj.Events, nil
+		},
+		nil,
+		ec.marshalOTrackingEvent2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingEventᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_events(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_TrackingEvent_timestamp(ctx, field)
+			case "description":
+				return ec.fieldContext_TrackingEvent_description(ctx, field)
+			case "location":
+				return ec.fieldContext_TrackingEvent_location(ctx, field)
+			case "status":
+				return ec.fieldContext_TrackingEvent_status(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_TrackingEvent_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TrackingEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_errors(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrackingResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *TrackingResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrackingResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrackingResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrackingResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_id(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_subscriptionId(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_subscriptionId,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_subscriptionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_eventId(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_eventId,
+		func(ctx context.Context) (any, error) {
+			return obj.EventID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_eventId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_eventType(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_eventType,
+		func(ctx context.Context) (any, error) {
+			return obj.EventType, nil
+		},
+		nil,
+		ec.marshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WebhookEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_orderId(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_state(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNWebhookDeliveryState2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WebhookDeliveryState does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_attempts,
+		func(ctx context.Context) (any, error) {
+			return obj.Attempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_attempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_lastStatusCode(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_lastStatusCode,
+		func(ctx context.Context) (any, error) {
+			return obj.LastStatusCode, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_lastStatusCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_lastError(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_lastError,
+		func(ctx context.Context) (any, error) {
+			return obj.LastError, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_lastError(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_nextAttemptAt(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_nextAttemptAt,
+		func(ctx context.Context) (any, error) {
+			return obj.NextAttemptAt, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_nextAttemptAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_deliveredAt(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_deliveredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.DeliveredAt, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_deliveredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_createdAt(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDelivery_updatedAt(ctx context.Context, field graphql.CollectedField, obj *WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDelivery_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDelivery_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDeliveryResponse_success(ctx context.Context, field graphql.CollectedField, obj *WebhookDeliveryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDeliveryResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDeliveryResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDeliveryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDeliveryResponse_delivery(ctx context.Context, field graphql.CollectedField, obj *WebhookDeliveryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDeliveryResponse_delivery,
+		func(ctx context.Context) (any, error) {
+			return obj.Delivery, nil
+		},
+		nil,
+		ec.marshalOWebhookDelivery2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDelivery,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDeliveryResponse_delivery(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDeliveryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WebhookDelivery_id(ctx, field)
+			case "subscriptionId":
+				return ec.fieldContext_WebhookDelivery_subscriptionId(ctx, field)
+			case "eventId":
+				return ec.fieldContext_WebhookDelivery_eventId(ctx, field)
+			case "eventType":
+				return ec.fieldContext_WebhookDelivery_eventType(ctx, field)
+			case "orderId":
+				return ec.fieldContext_WebhookDelivery_orderId(ctx, field)
+			case "state":
+				return ec.fieldContext_WebhookDelivery_state(ctx, field)
+			case "attempts":
+				return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+			case "lastStatusCode":
+				return ec.fieldContext_WebhookDelivery_lastStatusCode(ctx, field)
+			case "lastError":
+				return ec.fieldContext_WebhookDelivery_lastError(ctx, field)
+			case "nextAttemptAt":
+				return ec.fieldContext_WebhookDelivery_nextAttemptAt(ctx, field)
+			case "deliveredAt":
+				return ec.fieldContext_WebhookDelivery_deliveredAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WebhookDelivery_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_WebhookDelivery_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDelivery", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDeliveryResponse_errors(ctx context.Context, field graphql.CollectedField, obj *WebhookDeliveryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDeliveryResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDeliveryResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDeliveryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookDeliveryResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *WebhookDeliveryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookDeliveryResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookDeliveryResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookDeliveryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookResponse_success(ctx context.Context, field graphql.CollectedField, obj *WebhookResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookResponse_subscription(ctx context.Context, field graphql.CollectedField, obj *WebhookResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookResponse_subscription,
+		func(ctx context.Context) (any, error) {
+			return obj.Subscription, nil
+		},
+		nil,
+		ec.marshalOWebhookSubscription2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscription,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookResponse_subscription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WebhookSubscription_id(ctx, field)
+			case "shipperId":
+				return ec.fieldContext_WebhookSubscription_shipperId(ctx, field)
+			case "url":
+				return ec.fieldContext_WebhookSubscription_url(ctx, field)
+			case "events":
+				return ec.fieldContext_WebhookSubscription_events(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WebhookSubscription_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookSubscription", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookResponse_secret(ctx context.Context, field graphql.CollectedField, obj *WebhookResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookResponse_secret,
+		func(ctx context.Context) (any, error) {
+			return obj.Secret, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookResponse_secret(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookResponse_errors(ctx context.Context, field graphql.CollectedField, obj *WebhookResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookResponse_errors,
+		func(ctx context.Context) (any, error) {
+			return obj.Errors, nil
+		},
+		nil,
+		ec.marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookResponse_errors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "message":
+				return ec.fieldContext_Error_message(ctx, field)
+			case "field":
+				return ec.fieldContext_Error_field(ctx, field)
+			case "carrierCode":
+				return ec.fieldContext_Error_carrierCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookResponse_metadata(ctx context.Context, field graphql.CollectedField, obj *WebhookResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookResponse_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookResponse_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "requestId":
+				return ec.fieldContext_ResponseMetadata_requestId(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_ResponseMetadata_processedAt(ctx, field)
+			case "carrier":
+				return ec.fieldContext_ResponseMetadata_carrier(ctx, field)
+			case "carrierRefId":
+				return ec.fieldContext_ResponseMetadata_carrierRefId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResponseMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookSubscription_id(ctx context.Context, field graphql.CollectedField, obj *WebhookSubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscription_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscription_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookSubscription_shipperId(ctx context.Context, field graphql.CollectedField, obj *WebhookSubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscription_shipperId,
+		func(ctx context.Context) (any, error) {
+			return obj.ShipperID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscription_shipperId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookSubscription_url(ctx context.Context, field graphql.CollectedField, obj *WebhookSubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscription_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscription_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookSubscription_events(ctx context.Context, field graphql.CollectedField, obj *WebhookSubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscription_events,
+		func(ctx context.Context) (any, error) {
+			return obj.Events, nil
+		},
+		nil,
+		ec.marshalNWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscription_events(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WebhookEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebhookSubscription_createdAt(ctx context.Context, field graphql.CollectedField, obj *WebhookSubscription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscription_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscription_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Weight_value(ctx context.Context, field graphql.CollectedField, obj *Weight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Weight_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Weight_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Weight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Weight_unit(ctx context.Context, field graphql.CollectedField, obj *Weight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Weight_unit,
+		func(ctx context.Context) (any, error) {
+			return obj.Unit, nil
+		},
+		nil,
+		ec.marshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Weight_unit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Weight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WeightUnit does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj any) (AddressInput, error) {
+	var it AddressInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["countryCode"]; !present {
+		asMap["countryCode"] = "CA"
+	}
+	if _, present := asMap["isResidential"]; !present {
+		asMap["isResidential"] = false
+	}
+
+	fieldsInOrder := [...]string{"name", "company", "line1", "line2", "city", "provinceCode", "postalCode", "countryCode", "phone", "email", "instructions", "isResidential"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "company":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("company"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Company = data
+		case "line1":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line1"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line1 = data
+		case "line2":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line2"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line2 = data
+		case "city":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.City = data
+		case "provinceCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("provinceCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProvinceCode = data
+		case "postalCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("postalCode"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PostalCode = data
+		case "countryCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("countryCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CountryCode = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "instructions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("instructions"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Instructions = data
+		case "isResidential":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isResidential"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsResidential = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCancelOrderInput(ctx context.Context, obj any) (CancelOrderInput, error) {
+	var it CancelOrderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "orderId", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCancelPickupInput(ctx context.Context, obj any) (CancelPickupInput, error) {
+	var it CancelPickupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"carrier", "pickupId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "carrier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carrier"))
+			data, err := ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carrier = data
+		case "pickupId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pickupId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PickupID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputContactInput(ctx context.Context, obj any) (ContactInput, error) {
+	var it ContactInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "company", "phone", "email", "taxId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "company":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("company"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Company = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "taxId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taxId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TaxID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateOrderInput(ctx context.Context, obj any) (CreateOrderInput, error) {
+	var it CreateOrderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "quoteId", "rateId", "sender", "senderAddress", "recipient", "recipientAddress", "packages", "reference", "poNumber", "instructions", "idempotencyKey", "options", "pickup", "customs"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "quoteId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quoteId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.QuoteID = data
+		case "rateId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rateId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RateID = data
+		case "sender":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sender"))
+			data, err := ec.unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sender = data
+		case "senderAddress":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("senderAddress"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SenderAddress = data
+		case "recipient":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipient"))
+			data, err := ec.unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Recipient = data
+		case "recipientAddress":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipientAddress"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RecipientAddress = data
+		case "packages":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packages"))
+			data, err := ec.unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Packages = data
+		case "reference":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reference"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reference = data
+		case "poNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PoNumber = data
+		case "instructions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("instructions"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Instructions = data
+		case "idempotencyKey":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
+		case "options":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+			data, err := ec.unmarshalOShippingOptionsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShippingOptionsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Options = data
+		case "pickup":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pickup"))
+			data, err := ec.unmarshalOPickupWindowInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pickup = data
+		case "customs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customs"))
+			data, err := ec.unmarshalOCustomsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Customs = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateReturnInput(ctx context.Context, obj any) (CreateReturnInput, error) {
+	var it CreateReturnInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["format"]; !present {
+		asMap["format"] = "PDF"
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "orderId", "rmaNumber", "packages", "format"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "rmaNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rmaNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RmaNumber = data
+		case "packages":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packages"))
+			data, err := ec.unmarshalOPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Packages = data
+		case "format":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			data, err := ec.unmarshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Format = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateWebhookInput(ctx context.Context, obj any) (CreateWebhookInput, error) {
+	var it CreateWebhookInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "url", "secret", "events"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		case "secret":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("secret"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Secret = data
+		case "events":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("events"))
+			data, err := ec.unmarshalOWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Events = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomsInput(ctx context.Context, obj any) (CustomsInput, error) {
+	var it CustomsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["incoterm"]; !present {
+		asMap["incoterm"] = "DDU"
+	}
+	if _, present := asMap["currency"]; !present {
+		asMap["currency"] = "CAD"
+	}
+
+	fieldsInOrder := [...]string{"items", "reasonForExport", "incoterm", "currency", "invoiceNumber", "importerTaxId", "exporterTaxId", "exportDeclarationNumber"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "items":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("items"))
+			data, err := ec.unmarshalNCustomsItemInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsItemInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Items = data
+		case "reasonForExport":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reasonForExport"))
+			data, err := ec.unmarshalNExportReason2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐExportReason(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReasonForExport = data
+		case "incoterm":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("incoterm"))
+			data, err := ec.unmarshalOIncoterm2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐIncoterm(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Incoterm = data
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		case "invoiceNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("invoiceNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InvoiceNumber = data
+		case "importerTaxId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("importerTaxId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImporterTaxID = data
+		case "exporterTaxId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exporterTaxId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExporterTaxID = data
+		case "exportDeclarationNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exportDeclarationNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExportDeclarationNumber = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomsItemInput(ctx context.Context, obj any) (CustomsItemInput, error) {
+	var it CustomsItemInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["weightUnit"]; !present {
+		asMap["weightUnit"] = "KG"
+	}
+
+	fieldsInOrder := [...]string{"description", "quantity", "unitValue", "unitWeight", "weightUnit", "countryOfOrigin", "hsCode", "sku"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		case "unitValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unitValue"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UnitValue = data
+		case "unitWeight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unitWeight"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UnitWeight = data
+		case "weightUnit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weightUnit"))
+			data, err := ec.unmarshalOWeightUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WeightUnit = data
+		case "countryOfOrigin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("countryOfOrigin"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CountryOfOrigin = data
+		case "hsCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hsCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HsCode = data
+		case "sku":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sku"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sku = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteWebhookInput(ctx context.Context, obj any) (DeleteWebhookInput, error) {
+	var it DeleteWebhookInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "subscriptionId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "subscriptionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subscriptionId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubscriptionID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetLabelInput(ctx context.Context, obj any) (GetLabelInput, error) {
+	var it GetLabelInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["format"]; !present {
+		asMap["format"] = "PDF"
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "orderId", "format"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "format":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			data, err := ec.unmarshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Format = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetQuoteInput(ctx context.Context, obj any) (GetQuoteInput, error) {
+	var it GetQuoteInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["sort"]; !present {
+		asMap["sort"] = "CHEAPEST"
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "origin", "destination", "packages", "options", "customs", "sort", "filter"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "origin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("origin"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Origin = data
+		case "destination":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destination"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Destination = data
+		case "packages":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packages"))
+			data, err := ec.unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Packages = data
+		case "options":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+			data, err := ec.unmarshalOShippingOptionsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShippingOptionsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Options = data
+		case "customs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customs"))
+			data, err := ec.unmarshalOCustomsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Customs = data
+		case "sort":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+			data, err := ec.unmarshalORateSort2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateSort(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sort = data
+		case "filter":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORateFilterInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filter = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputManifestInput(ctx context.Context, obj any) (ManifestInput, error) {
+	var it ManifestInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "carrier", "shipDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "carrier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carrier"))
+			data, err := ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carrier = data
+		case "shipDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipDate"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipDate = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPackageInput(ctx context.Context, obj any) (PackageInput, error) {
+	var it PackageInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["dimensionUnit"]; !present {
+		asMap["dimensionUnit"] = "CM"
+	}
+	if _, present := asMap["weightUnit"]; !present {
+		asMap["weightUnit"] = "KG"
+	}
+	if _, present := asMap["packageType"]; !present {
+		asMap["packageType"] = "BOX"
+	}
+	if _, present := asMap["currency"]; !present {
+		asMap["currency"] = "CAD"
+	}
+
+	fieldsInOrder := [...]string{"length", "width", "height", "dimensionUnit", "weight", "weightUnit", "packageType", "description", "declaredValue", "currency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "length":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("length"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Length = data
+		case "width":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("width"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Width = data
+		case "height":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Height = data
+		case "dimensionUnit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dimensionUnit"))
+			data, err := ec.unmarshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DimensionUnit = data
+		case "weight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
+		case "weightUnit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weightUnit"))
+			data, err := ec.unmarshalOWeightUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WeightUnit = data
+		case "packageType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packageType"))
+			data, err := ec.unmarshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PackageType = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "declaredValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("declaredValue"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DeclaredValue = data
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPickupAvailabilityInput(ctx context.Context, obj any) (PickupAvailabilityInput, error) {
+	var it PickupAvailabilityInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"carrier", "address", "date"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "carrier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carrier"))
+			data, err := ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carrier = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Address = data
+		case "date":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
+			data, err := ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Date = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPickupWindowInput(ctx context.Context, obj any) (PickupWindowInput, error) {
+	var it PickupWindowInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"readyAt", "closeAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "readyAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("readyAt"))
+			data, err := ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReadyAt = data
+		case "closeAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("closeAt"))
+			data, err := ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CloseAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRateFilterInput(ctx context.Context, obj any) (RateFilterInput, error) {
+	var it RateFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["guaranteedOnly"]; !present {
+		asMap["guaranteedOnly"] = false
+	}
+	if _, present := asMap["maxPriceCurrency"]; !present {
+		asMap["maxPriceCurrency"] = "CAD"
+	}
+
+	fieldsInOrder := [...]string{"guaranteedOnly", "maxTransitDays", "maxPrice", "maxPriceCurrency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "guaranteedOnly":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("guaranteedOnly"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GuaranteedOnly = data
+		case "maxTransitDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxTransitDays"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxTransitDays = data
+		case "maxPrice":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPrice"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxPrice = data
+		case "maxPriceCurrency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPriceCurrency"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxPriceCurrency = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputReplayWebhookDeliveryInput(ctx context.Context, obj any) (ReplayWebhookDeliveryInput, error) {
+	var it ReplayWebhookDeliveryInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "deliveryId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "deliveryId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deliveryId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DeliveryID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSchedulePickupInput(ctx context.Context, obj any) (SchedulePickupInput, error) {
+	var it SchedulePickupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["weightUnit"]; !present {
+		asMap["weightUnit"] = "KG"
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "carrier", "address", "contact", "window", "orderIds", "packageCount", "totalWeight", "weightUnit", "instructions"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "carrier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carrier"))
+			data, err := ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carrier = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Address = data
+		case "contact":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contact"))
+			data, err := ec.unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Contact = data
+		case "window":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("window"))
+			data, err := ec.unmarshalNPickupWindowInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Window = data
+		case "orderIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderIds"))
+			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderIds = data
+		case "packageCount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packageCount"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PackageCount = data
+		case "totalWeight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalWeight"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TotalWeight = data
+		case "weightUnit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weightUnit"))
+			data, err := ec.unmarshalOWeightUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WeightUnit = data
+		case "instructions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("instructions"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Instructions = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputShippingOptionsInput(ctx context.Context, obj any) (ShippingOptionsInput, error) {
+	var it ShippingOptionsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["signatureRequired"]; !present {
+		asMap["signatureRequired"] = false
+	}
+	if _, present := asMap["insuranceRequired"]; !present {
+		asMap["insuranceRequired"] = false
+	}
+	if _, present := asMap["saturdayDelivery"]; !present {
+		asMap["saturdayDelivery"] = false
+	}
+
+	fieldsInOrder := [...]string{"carriers", "serviceTypes", "signatureRequired", "insuranceRequired", "saturdayDelivery", "shipDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "carriers":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carriers"))
+			data, err := ec.unmarshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carriers = data
+		case "serviceTypes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceTypes"))
+			data, err := ec.unmarshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceTypes = data
+		case "signatureRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("signatureRequired"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SignatureRequired = data
+		case "insuranceRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("insuranceRequired"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InsuranceRequired = data
+		case "saturdayDelivery":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("saturdayDelivery"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SaturdayDelivery = data
+		case "shipDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipDate"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipDate = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTrackShipmentInput(ctx context.Context, obj any) (TrackShipmentInput, error) {
+	var it TrackShipmentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"shipperId", "orderId", "trackingNumber", "carrier"}
+	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
-		switch k {
+		switch k {
+		case "shipperId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShipperID = data
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "trackingNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trackingNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TrackingNumber = data
+		case "carrier":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carrier"))
+			data, err := ec.unmarshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Carrier = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var addressImplementors = []string{"Address"}
+
+func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *Address) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Address")
+		case "name":
+			out.Values[i] = ec._Address_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "company":
+			out.Values[i] = ec._Address_company(ctx, field, obj)
+		case "line1":
+			out.Values[i] = ec._Address_line1(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "line2":
+			out.Values[i] = ec._Address_line2(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._Address_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "provinceCode":
+			out.Values[i] = ec._Address_provinceCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "postalCode":
+			out.Values[i] = ec._Address_postalCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "countryCode":
+			out.Values[i] = ec._Address_countryCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "phone":
+			out.Values[i] = ec._Address_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "email":
+			out.Values[i] = ec._Address_email(ctx, field, obj)
+		case "instructions":
+			out.Values[i] = ec._Address_instructions(ctx, field, obj)
+		case "isResidential":
+			out.Values[i] = ec._Address_isResidential(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cancelPickupResponseImplementors = []string{"CancelPickupResponse"}
+
+func (ec *executionContext) _CancelPickupResponse(ctx context.Context, sel ast.SelectionSet, obj *CancelPickupResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cancelPickupResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CancelPickupResponse")
+		case "success":
+			out.Values[i] = ec._CancelPickupResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pickupId":
+			out.Values[i] = ec._CancelPickupResponse_pickupId(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CancelPickupResponse_status(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._CancelPickupResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._CancelPickupResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cancelResponseImplementors = []string{"CancelResponse"}
+
+func (ec *executionContext) _CancelResponse(ctx context.Context, sel ast.SelectionSet, obj *CancelResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cancelResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CancelResponse")
+		case "success":
+			out.Values[i] = ec._CancelResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderId":
+			out.Values[i] = ec._CancelResponse_orderId(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CancelResponse_status(ctx, field, obj)
+		case "refundAmount":
+			out.Values[i] = ec._CancelResponse_refundAmount(ctx, field, obj)
+		case "confirmationNumber":
+			out.Values[i] = ec._CancelResponse_confirmationNumber(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._CancelResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._CancelResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var carrierCapabilitiesImplementors = []string{"CarrierCapabilities"}
+
+func (ec *executionContext) _CarrierCapabilities(ctx context.Context, sel ast.SelectionSet, obj *CarrierCapabilities) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, carrierCapabilitiesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CarrierCapabilities")
+		case "carrier":
+			out.Values[i] = ec._CarrierCapabilities_carrier(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "labelFormats":
+			out.Values[i] = ec._CarrierCapabilities_labelFormats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "international":
+			out.Values[i] = ec._CarrierCapabilities_international(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "multiPiece":
+			out.Values[i] = ec._CarrierCapabilities_multiPiece(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pickups":
+			out.Values[i] = ec._CarrierCapabilities_pickups(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "manifests":
+			out.Values[i] = ec._CarrierCapabilities_manifests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "returns":
+			out.Values[i] = ec._CarrierCapabilities_returns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "insurance":
+			out.Values[i] = ec._CarrierCapabilities_insurance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "signatureRequired":
+			out.Values[i] = ec._CarrierCapabilities_signatureRequired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "saturdayDelivery":
+			out.Values[i] = ec._CarrierCapabilities_saturdayDelivery(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxWeight":
+			out.Values[i] = ec._CarrierCapabilities_maxWeight(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var carrierQuoteResultImplementors = []string{"CarrierQuoteResult"}
+
+func (ec *executionContext) _CarrierQuoteResult(ctx context.Context, sel ast.SelectionSet, obj *CarrierQuoteResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, carrierQuoteResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CarrierQuoteResult")
+		case "carrier":
+			out.Values[i] = ec._CarrierQuoteResult_carrier(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quoteId":
+			out.Values[i] = ec._CarrierQuoteResult_quoteId(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CarrierQuoteResult_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "latencyMs":
+			out.Values[i] = ec._CarrierQuoteResult_latencyMs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rateCount":
+			out.Values[i] = ec._CarrierQuoteResult_rateCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "errorCode":
+			out.Values[i] = ec._CarrierQuoteResult_errorCode(ctx, field, obj)
+		case "carrierCode":
+			out.Values[i] = ec._CarrierQuoteResult_carrierCode(ctx, field, obj)
+		case "retryable":
+			out.Values[i] = ec._CarrierQuoteResult_retryable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var errorImplementors = []string{"Error"}
+
+func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, obj *Error) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, errorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Error")
+		case "code":
+			out.Values[i] = ec._Error_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._Error_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "field":
+			out.Values[i] = ec._Error_field(ctx, field, obj)
+		case "carrierCode":
+			out.Values[i] = ec._Error_carrierCode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var labelImplementors = []string{"Label"}
+
+func (ec *executionContext) _Label(ctx context.Context, sel ast.SelectionSet, obj *Label) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, labelImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Label")
+		case "format":
+			out.Values[i] = ec._Label_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._Label_data(ctx, field, obj)
+		case "url":
+			out.Values[i] = ec._Label_url(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._Label_expiresAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var labelResponseImplementors = []string{"LabelResponse"}
+
+func (ec *executionContext) _LabelResponse(ctx context.Context, sel ast.SelectionSet, obj *LabelResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, labelResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LabelResponse")
+		case "success":
+			out.Values[i] = ec._LabelResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderId":
+			out.Values[i] = ec._LabelResponse_orderId(ctx, field, obj)
+		case "label":
+			out.Values[i] = ec._LabelResponse_label(ctx, field, obj)
+		case "additionalLabels":
+			out.Values[i] = ec._LabelResponse_additionalLabels(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._LabelResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._LabelResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var manifestImplementors = []string{"Manifest"}
+
+func (ec *executionContext) _Manifest(ctx context.Context, sel ast.SelectionSet, obj *Manifest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manifestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Manifest")
+		case "manifestId":
+			out.Values[i] = ec._Manifest_manifestId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "document":
+			out.Values[i] = ec._Manifest_document(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var manifestGroupImplementors = []string{"ManifestGroup"}
+
+func (ec *executionContext) _ManifestGroup(ctx context.Context, sel ast.SelectionSet, obj *ManifestGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manifestGroupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ManifestGroup")
+		case "originPostalCode":
+			out.Values[i] = ec._ManifestGroup_originPostalCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shipDate":
+			out.Values[i] = ec._ManifestGroup_shipDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderIds":
+			out.Values[i] = ec._ManifestGroup_orderIds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "manifests":
+			out.Values[i] = ec._ManifestGroup_manifests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var manifestResponseImplementors = []string{"ManifestResponse"}
+
+func (ec *executionContext) _ManifestResponse(ctx context.Context, sel ast.SelectionSet, obj *ManifestResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manifestResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ManifestResponse")
+		case "success":
+			out.Values[i] = ec._ManifestResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "groups":
+			out.Values[i] = ec._ManifestResponse_groups(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._ManifestResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._ManifestResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var moneyImplementors = []string{"Money"}
+
+func (ec *executionContext) _Money(ctx context.Context, sel ast.SelectionSet, obj *Money) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moneyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Money")
+		case "amount":
+			out.Values[i] = ec._Money_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._Money_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "delivro_get_quote":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_get_quote(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_create_order":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_create_order(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_get_label":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_get_label(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_cancel_order":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_cancel_order(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_track_shipment":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_track_shipment(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_pickup_availability":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_pickup_availability(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_schedule_pickup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_schedule_pickup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_cancel_pickup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_cancel_pickup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_manifest":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_manifest(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_create_return":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_create_return(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_create_webhook":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_create_webhook(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_delete_webhook":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_delete_webhook(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delivro_replay_webhook_delivery":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delivro_replay_webhook_delivery(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderImplementors = []string{"Order"}
+
+func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, obj *Order) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Order")
+		case "orderId":
+			out.Values[i] = ec._Order_orderId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shipperId":
+			out.Values[i] = ec._Order_shipperId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "carrier":
+			out.Values[i] = ec._Order_carrier(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reference":
+			out.Values[i] = ec._Order_reference(ctx, field, obj)
+		case "poNumber":
+			out.Values[i] = ec._Order_poNumber(ctx, field, obj)
+		case "trackingNumber":
+			out.Values[i] = ec._Order_trackingNumber(ctx, field, obj)
+		case "trackingUrl":
+			out.Values[i] = ec._Order_trackingUrl(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Order_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceName":
+			out.Values[i] = ec._Order_serviceName(ctx, field, obj)
+		case "totalCharged":
+			out.Values[i] = ec._Order_totalCharged(ctx, field, obj)
+		case "estimatedDelivery":
+			out.Values[i] = ec._Order_estimatedDelivery(ctx, field, obj)
+		case "labelUrl":
+			out.Values[i] = ec._Order_labelUrl(ctx, field, obj)
+		case "pieces":
+			out.Values[i] = ec._Order_pieces(ctx, field, obj)
+		case "senderAddress":
+			out.Values[i] = ec._Order_senderAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recipientAddress":
+			out.Values[i] = ec._Order_recipientAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "statusHistory":
+			out.Values[i] = ec._Order_statusHistory(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "returnOf":
+			out.Values[i] = ec._Order_returnOf(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Order_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Order_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderConnectionImplementors = []string{"OrderConnection"}
+
+func (ec *executionContext) _OrderConnection(ctx context.Context, sel ast.SelectionSet, obj *OrderConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderConnection")
+		case "orders":
+			out.Values[i] = ec._OrderConnection_orders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nextCursor":
+			out.Values[i] = ec._OrderConnection_nextCursor(ctx, field, obj)
+		case "hasMore":
+			out.Values[i] = ec._OrderConnection_hasMore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderResponseImplementors = []string{"OrderResponse"}
+
+func (ec *executionContext) _OrderResponse(ctx context.Context, sel ast.SelectionSet, obj *OrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderResponse")
+		case "success":
+			out.Values[i] = ec._OrderResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderId":
+			out.Values[i] = ec._OrderResponse_orderId(ctx, field, obj)
+		case "trackingNumber":
+			out.Values[i] = ec._OrderResponse_trackingNumber(ctx, field, obj)
+		case "trackingUrl":
+			out.Values[i] = ec._OrderResponse_trackingUrl(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._OrderResponse_status(ctx, field, obj)
+		case "carrier":
+			out.Values[i] = ec._OrderResponse_carrier(ctx, field, obj)
+		case "serviceName":
+			out.Values[i] = ec._OrderResponse_serviceName(ctx, field, obj)
+		case "totalCharged":
+			out.Values[i] = ec._OrderResponse_totalCharged(ctx, field, obj)
+		case "estimatedDelivery":
+			out.Values[i] = ec._OrderResponse_estimatedDelivery(ctx, field, obj)
+		case "labelUrl":
+			out.Values[i] = ec._OrderResponse_labelUrl(ctx, field, obj)
+		case "pieces":
+			out.Values[i] = ec._OrderResponse_pieces(ctx, field, obj)
+		case "previouslyCreated":
+			out.Values[i] = ec._OrderResponse_previouslyCreated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "errors":
+			out.Values[i] = ec._OrderResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._OrderResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var packageImplementors = []string{"Package"}
+
+func (ec *executionContext) _Package(ctx context.Context, sel ast.SelectionSet, obj *Package) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, packageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Package")
+		case "id":
+			out.Values[i] = ec._Package_id(ctx, field, obj)
+		case "length":
+			out.Values[i] = ec._Package_length(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "width":
+			out.Values[i] = ec._Package_width(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "height":
+			out.Values[i] = ec._Package_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dimensionUnit":
+			out.Values[i] = ec._Package_dimensionUnit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weight":
+			out.Values[i] = ec._Package_weight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weightUnit":
+			out.Values[i] = ec._Package_weightUnit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "packageType":
+			out.Values[i] = ec._Package_packageType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Package_description(ctx, field, obj)
+		case "declaredValue":
+			out.Values[i] = ec._Package_declaredValue(ctx, field, obj)
+		case "currency":
+			out.Values[i] = ec._Package_currency(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pickupAvailabilityResponseImplementors = []string{"PickupAvailabilityResponse"}
+
+func (ec *executionContext) _PickupAvailabilityResponse(ctx context.Context, sel ast.SelectionSet, obj *PickupAvailabilityResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pickupAvailabilityResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PickupAvailabilityResponse")
+		case "success":
+			out.Values[i] = ec._PickupAvailabilityResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "available":
+			out.Values[i] = ec._PickupAvailabilityResponse_available(ctx, field, obj)
+		case "bookBy":
+			out.Values[i] = ec._PickupAvailabilityResponse_bookBy(ctx, field, obj)
+		case "windows":
+			out.Values[i] = ec._PickupAvailabilityResponse_windows(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._PickupAvailabilityResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._PickupAvailabilityResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pickupResponseImplementors = []string{"PickupResponse"}
+
+func (ec *executionContext) _PickupResponse(ctx context.Context, sel ast.SelectionSet, obj *PickupResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pickupResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PickupResponse")
+		case "success":
+			out.Values[i] = ec._PickupResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pickupId":
+			out.Values[i] = ec._PickupResponse_pickupId(ctx, field, obj)
+		case "carrier":
+			out.Values[i] = ec._PickupResponse_carrier(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._PickupResponse_status(ctx, field, obj)
+		case "window":
+			out.Values[i] = ec._PickupResponse_window(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._PickupResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._PickupResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pickupWindowImplementors = []string{"PickupWindow"}
+
+func (ec *executionContext) _PickupWindow(ctx context.Context, sel ast.SelectionSet, obj *PickupWindow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pickupWindowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PickupWindow")
+		case "readyAt":
+			out.Values[i] = ec._PickupWindow_readyAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "closeAt":
+			out.Values[i] = ec._PickupWindow_closeAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pieceImplementors = []string{"Piece"}
+
+func (ec *executionContext) _Piece(ctx context.Context, sel ast.SelectionSet, obj *Piece) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pieceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Piece")
+		case "pieceId":
+			out.Values[i] = ec._Piece_pieceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trackingNumber":
+			out.Values[i] = ec._Piece_trackingNumber(ctx, field, obj)
+		case "labelUrl":
+			out.Values[i] = ec._Piece_labelUrl(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "health":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_health(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "carriers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_carriers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "serviceTypes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_serviceTypes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "carrierCapabilities":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_carrierCapabilities(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "delivro_order":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_delivro_order(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "delivro_order_by_reference":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_delivro_order_by_reference(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "delivro_orders":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_delivro_orders(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "delivro_webhooks":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_delivro_webhooks(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "delivro_webhook_deliveries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_delivro_webhook_deliveries(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var quoteResponseImplementors = []string{"QuoteResponse"}
+
+func (ec *executionContext) _QuoteResponse(ctx context.Context, sel ast.SelectionSet, obj *QuoteResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, quoteResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("QuoteResponse")
+		case "success":
+			out.Values[i] = ec._QuoteResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quoteId":
+			out.Values[i] = ec._QuoteResponse_quoteId(ctx, field, obj)
+		case "rates":
+			out.Values[i] = ec._QuoteResponse_rates(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._QuoteResponse_errors(ctx, field, obj)
+		case "carrierResults":
+			out.Values[i] = ec._QuoteResponse_carrierResults(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "metadata":
+			out.Values[i] = ec._QuoteResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var rateOptionImplementors = []string{"RateOption"}
+
+func (ec *executionContext) _RateOption(ctx context.Context, sel ast.SelectionSet, obj *RateOption) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rateOptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RateOption")
+		case "rateId":
+			out.Values[i] = ec._RateOption_rateId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "carrier":
+			out.Values[i] = ec._RateOption_carrier(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceCode":
+			out.Values[i] = ec._RateOption_serviceCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceName":
+			out.Values[i] = ec._RateOption_serviceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceType":
+			out.Values[i] = ec._RateOption_serviceType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseRate":
+			out.Values[i] = ec._RateOption_baseRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fuelSurcharge":
+			out.Values[i] = ec._RateOption_fuelSurcharge(ctx, field, obj)
+		case "taxes":
+			out.Values[i] = ec._RateOption_taxes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalPrice":
+			out.Values[i] = ec._RateOption_totalPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "transitDays":
+			out.Values[i] = ec._RateOption_transitDays(ctx, field, obj)
+		case "estimatedDelivery":
+			out.Values[i] = ec._RateOption_estimatedDelivery(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._RateOption_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "signatureRequired":
+			out.Values[i] = ec._RateOption_signatureRequired(ctx, field, obj)
+		case "guaranteed":
+			out.Values[i] = ec._RateOption_guaranteed(ctx, field, obj)
+		case "billableWeight":
+			out.Values[i] = ec._RateOption_billableWeight(ctx, field, obj)
+		case "tags":
+			out.Values[i] = ec._RateOption_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._RateOption_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var responseMetadataImplementors = []string{"ResponseMetadata"}
+
+func (ec *executionContext) _ResponseMetadata(ctx context.Context, sel ast.SelectionSet, obj *ResponseMetadata) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, responseMetadataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResponseMetadata")
+		case "requestId":
+			out.Values[i] = ec._ResponseMetadata_requestId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "processedAt":
+			out.Values[i] = ec._ResponseMetadata_processedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "carrier":
+			out.Values[i] = ec._ResponseMetadata_carrier(ctx, field, obj)
+		case "carrierRefId":
+			out.Values[i] = ec._ResponseMetadata_carrierRefId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var returnResponseImplementors = []string{"ReturnResponse"}
+
+func (ec *executionContext) _ReturnResponse(ctx context.Context, sel ast.SelectionSet, obj *ReturnResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, returnResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReturnResponse")
+		case "success":
+			out.Values[i] = ec._ReturnResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "orderId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ReturnResponse_orderId(ctx, field, obj)
+		case "originalOrderId":
+			out.Values[i] = ec._ReturnResponse_originalOrderId(ctx, field, obj)
+		case "trackingNumber":
+			out.Values[i] = ec._ReturnResponse_trackingNumber(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._ReturnResponse_status(ctx, field, obj)
+		case "carrier":
+			out.Values[i] = ec._ReturnResponse_carrier(ctx, field, obj)
+		case "totalCharged":
+			out.Values[i] = ec._ReturnResponse_totalCharged(ctx, field, obj)
+		case "label":
+			out.Values[i] = ec._ReturnResponse_label(ctx, field, obj)
+		case "additionalLabels":
+			out.Values[i] = ec._ReturnResponse_additionalLabels(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._ReturnResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._ReturnResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.OrderID = data
-		case "reason":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var statusChangeImplementors = []string{"StatusChange"}
+
+func (ec *executionContext) _StatusChange(ctx context.Context, sel ast.SelectionSet, obj *StatusChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, statusChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StatusChange")
+		case "status":
+			out.Values[i] = ec._StatusChange_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Reason = data
+		case "timestamp":
+			out.Values[i] = ec._StatusChange_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._StatusChange_source(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var trackingEventImplementors = []string{"TrackingEvent"}
+
+func (ec *executionContext) _TrackingEvent(ctx context.Context, sel ast.SelectionSet, obj *TrackingEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, trackingEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TrackingEvent")
+		case "timestamp":
+			out.Values[i] = ec._TrackingEvent_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._TrackingEvent_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "location":
+			out.Values[i] = ec._TrackingEvent_location(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._TrackingEvent_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "carrierCode":
+			out.Values[i] = ec._TrackingEvent_carrierCode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputContactInput(ctx context.Context, obj any) (ContactInput, error) {
-	var it ContactInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"name", "company", "phone", "email", "taxId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "company":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("company"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Company = data
-		case "phone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Phone = data
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var trackingResponseImplementors = []string{"TrackingResponse"}
+
+func (ec *executionContext) _TrackingResponse(ctx context.Context, sel ast.SelectionSet, obj *TrackingResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, trackingResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TrackingResponse")
+		case "success":
+			out.Values[i] = ec._TrackingResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Email = data
-		case "taxId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taxId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "orderId":
+			out.Values[i] = ec._TrackingResponse_orderId(ctx, field, obj)
+		case "trackingNumber":
+			out.Values[i] = ec._TrackingResponse_trackingNumber(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._TrackingResponse_status(ctx, field, obj)
+		case "estimatedDelivery":
+			out.Values[i] = ec._TrackingResponse_estimatedDelivery(ctx, field, obj)
+		case "events":
+			out.Values[i] = ec._TrackingResponse_events(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._TrackingResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._TrackingResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.TaxID = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputCreateOrderInput(ctx context.Context, obj any) (CreateOrderInput, error) {
-	var it CreateOrderInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"shipperId", "quoteId", "rateId", "sender", "senderAddress", "recipient", "recipientAddress", "packages", "reference", "poNumber", "instructions"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "shipperId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
+
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *WebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
+		case "id":
+			out.Values[i] = ec._WebhookDelivery_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ShipperID = data
-		case "quoteId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quoteId"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "subscriptionId":
+			out.Values[i] = ec._WebhookDelivery_subscriptionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.QuoteID = data
-		case "rateId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rateId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "eventId":
+			out.Values[i] = ec._WebhookDelivery_eventId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RateID = data
-		case "sender":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sender"))
-			data, err := ec.unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "eventType":
+			out.Values[i] = ec._WebhookDelivery_eventType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Sender = data
-		case "senderAddress":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("senderAddress"))
-			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "orderId":
+			out.Values[i] = ec._WebhookDelivery_orderId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SenderAddress = data
-		case "recipient":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipient"))
-			data, err := ec.unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "state":
+			out.Values[i] = ec._WebhookDelivery_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Recipient = data
-		case "recipientAddress":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recipientAddress"))
-			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RecipientAddress = data
-		case "packages":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packages"))
-			data, err := ec.unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "lastStatusCode":
+			out.Values[i] = ec._WebhookDelivery_lastStatusCode(ctx, field, obj)
+		case "lastError":
+			out.Values[i] = ec._WebhookDelivery_lastError(ctx, field, obj)
+		case "nextAttemptAt":
+			out.Values[i] = ec._WebhookDelivery_nextAttemptAt(ctx, field, obj)
+		case "deliveredAt":
+			out.Values[i] = ec._WebhookDelivery_deliveredAt(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._WebhookDelivery_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Packages = data
-		case "reference":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reference"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "updatedAt":
+			out.Values[i] = ec._WebhookDelivery_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Reference = data
-		case "poNumber":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poNumber"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var webhookDeliveryResponseImplementors = []string{"WebhookDeliveryResponse"}
+
+func (ec *executionContext) _WebhookDeliveryResponse(ctx context.Context, sel ast.SelectionSet, obj *WebhookDeliveryResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDeliveryResponse")
+		case "success":
+			out.Values[i] = ec._WebhookDeliveryResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PoNumber = data
-		case "instructions":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("instructions"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "delivery":
+			out.Values[i] = ec._WebhookDeliveryResponse_delivery(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._WebhookDeliveryResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._WebhookDeliveryResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Instructions = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputGetLabelInput(ctx context.Context, obj any) (GetLabelInput, error) {
-	var it GetLabelInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var webhookResponseImplementors = []string{"WebhookResponse"}
 
-	if _, present := asMap["format"]; !present {
-		asMap["format"] = "PDF"
-	}
+func (ec *executionContext) _WebhookResponse(ctx context.Context, sel ast.SelectionSet, obj *WebhookResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookResponseImplementors)
 
-	fieldsInOrder := [...]string{"orderId", "format"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "orderId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookResponse")
+		case "success":
+			out.Values[i] = ec._WebhookResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.OrderID = data
-		case "format":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
-			data, err := ec.unmarshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx, v)
-			if err != nil {
-				return it, err
+		case "subscription":
+			out.Values[i] = ec._WebhookResponse_subscription(ctx, field, obj)
+		case "secret":
+			out.Values[i] = ec._WebhookResponse_secret(ctx, field, obj)
+		case "errors":
+			out.Values[i] = ec._WebhookResponse_errors(ctx, field, obj)
+		case "metadata":
+			out.Values[i] = ec._WebhookResponse_metadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Format = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGetQuoteInput(ctx context.Context, obj any) (GetQuoteInput, error) {
-	var it GetQuoteInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"shipperId", "origin", "destination", "packages", "options"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "shipperId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipperId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var webhookSubscriptionImplementors = []string{"WebhookSubscription"}
+
+func (ec *executionContext) _WebhookSubscription(ctx context.Context, sel ast.SelectionSet, obj *WebhookSubscription) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookSubscriptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookSubscription")
+		case "id":
+			out.Values[i] = ec._WebhookSubscription_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ShipperID = data
-		case "origin":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("origin"))
-			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "shipperId":
+			out.Values[i] = ec._WebhookSubscription_shipperId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Origin = data
-		case "destination":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destination"))
-			data, err := ec.unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "url":
+			out.Values[i] = ec._WebhookSubscription_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Destination = data
-		case "packages":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packages"))
-			data, err := ec.unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "events":
+			out.Values[i] = ec._WebhookSubscription_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Packages = data
-		case "options":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
-			data, err := ec.unmarshalOShippingOptionsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShippingOptionsInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "createdAt":
+			out.Values[i] = ec._WebhookSubscription_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Options = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputPackageInput(ctx context.Context, obj any) (PackageInput, error) {
-	var it PackageInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	if _, present := asMap["dimensionUnit"]; !present {
-		asMap["dimensionUnit"] = "CM"
-	}
-	if _, present := asMap["weightUnit"]; !present {
-		asMap["weightUnit"] = "KG"
+	return out
+}
+
+var weightImplementors = []string{"Weight"}
+
+func (ec *executionContext) _Weight(ctx context.Context, sel ast.SelectionSet, obj *Weight) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, weightImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Weight")
+		case "value":
+			out.Values[i] = ec._Weight_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unit":
+			out.Values[i] = ec._Weight_unit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if _, present := asMap["packageType"]; !present {
-		asMap["packageType"] = "BOX"
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	if _, present := asMap["currency"]; !present {
-		asMap["currency"] = "CAD"
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"length", "width", "height", "dimensionUnit", "weight", "weightUnit", "packageType", "description", "declaredValue", "currency"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "length":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("length"))
-			data, err := ec.unmarshalNDecimal2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Length = data
-		case "width":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("width"))
-			data, err := ec.unmarshalNDecimal2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Width = data
-		case "height":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
-			data, err := ec.unmarshalNDecimal2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Height = data
-		case "dimensionUnit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dimensionUnit"))
-			data, err := ec.unmarshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.DimensionUnit = data
-		case "weight":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
-			data, err := ec.unmarshalNDecimal2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Weight = data
-		case "weightUnit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weightUnit"))
-			data, err := ec.unmarshalOWeightUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.WeightUnit = data
-		case "packageType":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("packageType"))
-			data, err := ec.unmarshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PackageType = data
 		case "description":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Description = data
-		case "declaredValue":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("declaredValue"))
-			data, err := ec.unmarshalODecimal2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.DeclaredValue = data
-		case "currency":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Currency = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputShippingOptionsInput(ctx context.Context, obj any) (ShippingOptionsInput, error) {
-	var it ShippingOptionsInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	if _, present := asMap["signatureRequired"]; !present {
-		asMap["signatureRequired"] = false
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if _, present := asMap["insuranceRequired"]; !present {
-		asMap["insuranceRequired"] = false
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	if _, present := asMap["saturdayDelivery"]; !present {
-		asMap["saturdayDelivery"] = false
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"carriers", "serviceTypes", "signatureRequired", "insuranceRequired", "saturdayDelivery", "shipDate"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "carriers":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("carriers"))
-			data, err := ec.unmarshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Carriers = data
-		case "serviceTypes":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceTypes"))
-			data, err := ec.unmarshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ServiceTypes = data
-		case "signatureRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("signatureRequired"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SignatureRequired = data
-		case "insuranceRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("insuranceRequired"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.InsuranceRequired = data
-		case "saturdayDelivery":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("saturdayDelivery"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SaturdayDelivery = data
-		case "shipDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipDate"))
-			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ShipDate = data
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var addressImplementors = []string{"Address"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *Address) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Address")
+			out.Values[i] = graphql.MarshalString("__InputValue")
 		case "name":
-			out.Values[i] = ec._Address_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "company":
-			out.Values[i] = ec._Address_company(ctx, field, obj)
-		case "line1":
-			out.Values[i] = ec._Address_line1(ctx, field, obj)
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "line2":
-			out.Values[i] = ec._Address_line2(ctx, field, obj)
-		case "city":
-			out.Values[i] = ec._Address_city(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "provinceCode":
-			out.Values[i] = ec._Address_provinceCode(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "postalCode":
-			out.Values[i] = ec._Address_postalCode(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "countryCode":
-			out.Values[i] = ec._Address_countryCode(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "phone":
-			out.Values[i] = ec._Address_phone(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "email":
-			out.Values[i] = ec._Address_email(ctx, field, obj)
-		case "instructions":
-			out.Values[i] = ec._Address_instructions(ctx, field, obj)
-		case "isResidential":
-			out.Values[i] = ec._Address_isResidential(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6427,37 +17088,42 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var cancelResponseImplementors = []string{"CancelResponse"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _CancelResponse(ctx context.Context, sel ast.SelectionSet, obj *CancelResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cancelResponseImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CancelResponse")
-		case "success":
-			out.Values[i] = ec._CancelResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "orderId":
-			out.Values[i] = ec._CancelResponse_orderId(ctx, field, obj)
-		case "status":
-			out.Values[i] = ec._CancelResponse_status(ctx, field, obj)
-		case "refundAmount":
-			out.Values[i] = ec._CancelResponse_refundAmount(ctx, field, obj)
-		case "confirmationNumber":
-			out.Values[i] = ec._CancelResponse_confirmationNumber(ctx, field, obj)
-		case "errors":
-			out.Values[i] = ec._CancelResponse_errors(ctx, field, obj)
-		case "metadata":
-			out.Values[i] = ec._CancelResponse_metadata(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6478,1105 +17144,1416 @@ func (ec *executionContext) _CancelResponse(ctx context.Context, sel ast.Selecti
 		})
 	}
 
-	return out
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAddress2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddress(ctx context.Context, sel ast.SelectionSet, v *Address) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Address(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx context.Context, v any) (*AddressInput, error) {
+	res, err := ec.unmarshalInputAddressInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCancelOrderInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelOrderInput(ctx context.Context, v any) (CancelOrderInput, error) {
+	res, err := ec.unmarshalInputCancelOrderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCancelPickupInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelPickupInput(ctx context.Context, v any) (CancelPickupInput, error) {
+	res, err := ec.unmarshalInputCancelPickupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCancelPickupResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelPickupResponse(ctx context.Context, sel ast.SelectionSet, v CancelPickupResponse) graphql.Marshaler {
+	return ec._CancelPickupResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCancelPickupResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelPickupResponse(ctx context.Context, sel ast.SelectionSet, v *CancelPickupResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CancelPickupResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCancelResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse(ctx context.Context, sel ast.SelectionSet, v CancelResponse) graphql.Marshaler {
+	return ec._CancelResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCancelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse(ctx context.Context, sel ast.SelectionSet, v *CancelResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CancelResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, v any) (Carrier, error) {
+	var res Carrier
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, sel ast.SelectionSet, v Carrier) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, v any) ([]Carrier, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]Carrier, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, sel ast.SelectionSet, v []Carrier) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCarrierCapabilities2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierCapabilitiesᚄ(ctx context.Context, sel ast.SelectionSet, v []*CarrierCapabilities) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCarrierCapabilities2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierCapabilities(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var errorImplementors = []string{"Error"}
-
-func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, obj *Error) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, errorImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Error")
-		case "code":
-			out.Values[i] = ec._Error_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "message":
-			out.Values[i] = ec._Error_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "field":
-			out.Values[i] = ec._Error_field(ctx, field, obj)
-		case "carrierCode":
-			out.Values[i] = ec._Error_carrierCode(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCarrierCapabilities2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierCapabilities(ctx context.Context, sel ast.SelectionSet, v *CarrierCapabilities) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._CarrierCapabilities(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNCarrierQuoteResult2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*CarrierQuoteResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCarrierQuoteResult2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
+	}
+	wg.Wait()
 
-var labelImplementors = []string{"Label"}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) _Label(ctx context.Context, sel ast.SelectionSet, obj *Label) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, labelImplementors)
+	return ret
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Label")
-		case "format":
-			out.Values[i] = ec._Label_format(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "data":
-			out.Values[i] = ec._Label_data(ctx, field, obj)
-		case "url":
-			out.Values[i] = ec._Label_url(ctx, field, obj)
-		case "expiresAt":
-			out.Values[i] = ec._Label_expiresAt(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCarrierQuoteResult2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteResult(ctx context.Context, sel ast.SelectionSet, v *CarrierQuoteResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._CarrierQuoteResult(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNCarrierQuoteStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteStatus(ctx context.Context, v any) (CarrierQuoteStatus, error) {
+	var res CarrierQuoteStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) marshalNCarrierQuoteStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierQuoteStatus(ctx context.Context, sel ast.SelectionSet, v CarrierQuoteStatus) graphql.Marshaler {
+	return v
+}
 
-	return out
+func (ec *executionContext) unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx context.Context, v any) (*ContactInput, error) {
+	res, err := ec.unmarshalInputContactInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-var labelResponseImplementors = []string{"LabelResponse"}
+func (ec *executionContext) unmarshalNCreateOrderInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateOrderInput(ctx context.Context, v any) (CreateOrderInput, error) {
+	res, err := ec.unmarshalInputCreateOrderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _LabelResponse(ctx context.Context, sel ast.SelectionSet, obj *LabelResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, labelResponseImplementors)
+func (ec *executionContext) unmarshalNCreateReturnInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateReturnInput(ctx context.Context, v any) (CreateReturnInput, error) {
+	res, err := ec.unmarshalInputCreateReturnInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("LabelResponse")
-		case "success":
-			out.Values[i] = ec._LabelResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "orderId":
-			out.Values[i] = ec._LabelResponse_orderId(ctx, field, obj)
-		case "label":
-			out.Values[i] = ec._LabelResponse_label(ctx, field, obj)
-		case "additionalLabels":
-			out.Values[i] = ec._LabelResponse_additionalLabels(ctx, field, obj)
-		case "errors":
-			out.Values[i] = ec._LabelResponse_errors(ctx, field, obj)
-		case "metadata":
-			out.Values[i] = ec._LabelResponse_metadata(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNCreateWebhookInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateWebhookInput(ctx context.Context, v any) (CreateWebhookInput, error) {
+	res, err := ec.unmarshalInputCreateWebhookInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCustomsItemInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsItemInputᚄ(ctx context.Context, v any) ([]*CustomsItemInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*CustomsItemInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCustomsItemInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsItemInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNCustomsItemInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsItemInput(ctx context.Context, v any) (*CustomsItemInput, error) {
+	res, err := ec.unmarshalInputCustomsItemInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx context.Context, v any) (shipper.Decimal, error) {
+	var res shipper.Decimal
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var moneyImplementors = []string{"Money"}
+func (ec *executionContext) marshalNDecimal2githubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx context.Context, sel ast.SelectionSet, v shipper.Decimal) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) _Money(ctx context.Context, sel ast.SelectionSet, obj *Money) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, moneyImplementors)
+func (ec *executionContext) unmarshalNDeleteWebhookInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDeleteWebhookInput(ctx context.Context, v any) (DeleteWebhookInput, error) {
+	res, err := ec.unmarshalInputDeleteWebhookInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Money")
-		case "amount":
-			out.Values[i] = ec._Money_amount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "currency":
-			out.Values[i] = ec._Money_currency(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, v any) (DimensionUnit, error) {
+	var res DimensionUnit
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, sel ast.SelectionSet, v DimensionUnit) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNError2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐError(ctx context.Context, sel ast.SelectionSet, v *Error) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._Error(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNExportReason2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐExportReason(ctx context.Context, v any) (ExportReason, error) {
+	var res ExportReason
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNExportReason2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐExportReason(ctx context.Context, sel ast.SelectionSet, v ExportReason) graphql.Marshaler {
+	return v
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
-var mutationImplementors = []string{"Mutation"}
+func (ec *executionContext) unmarshalNGetLabelInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐGetLabelInput(ctx context.Context, v any) (GetLabelInput, error) {
+	res, err := ec.unmarshalInputGetLabelInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) unmarshalNGetQuoteInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐGetQuoteInput(ctx context.Context, v any) (GetQuoteInput, error) {
+	res, err := ec.unmarshalInputGetQuoteInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "delivro_get_quote":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_delivro_get_quote(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "delivro_create_order":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_delivro_create_order(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "delivro_get_label":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_delivro_get_label(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "delivro_cancel_order":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_delivro_cancel_order(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var orderResponseImplementors = []string{"OrderResponse"}
-
-func (ec *executionContext) _OrderResponse(ctx context.Context, sel ast.SelectionSet, obj *OrderResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderResponseImplementors)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderResponse")
-		case "success":
-			out.Values[i] = ec._OrderResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "orderId":
-			out.Values[i] = ec._OrderResponse_orderId(ctx, field, obj)
-		case "trackingNumber":
-			out.Values[i] = ec._OrderResponse_trackingNumber(ctx, field, obj)
-		case "trackingUrl":
-			out.Values[i] = ec._OrderResponse_trackingUrl(ctx, field, obj)
-		case "status":
-			out.Values[i] = ec._OrderResponse_status(ctx, field, obj)
-		case "carrier":
-			out.Values[i] = ec._OrderResponse_carrier(ctx, field, obj)
-		case "serviceName":
-			out.Values[i] = ec._OrderResponse_serviceName(ctx, field, obj)
-		case "totalCharged":
-			out.Values[i] = ec._OrderResponse_totalCharged(ctx, field, obj)
-		case "estimatedDelivery":
-			out.Values[i] = ec._OrderResponse_estimatedDelivery(ctx, field, obj)
-		case "labelUrl":
-			out.Values[i] = ec._OrderResponse_labelUrl(ctx, field, obj)
-		case "errors":
-			out.Values[i] = ec._OrderResponse_errors(ctx, field, obj)
-		case "metadata":
-			out.Values[i] = ec._OrderResponse_metadata(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return res
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx context.Context, sel ast.SelectionSet, v *Label) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Label(ctx, sel, v)
 }
 
-var packageImplementors = []string{"Package"}
-
-func (ec *executionContext) _Package(ctx context.Context, sel ast.SelectionSet, obj *Package) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, packageImplementors)
+func (ec *executionContext) unmarshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, v any) (LabelFormat, error) {
+	var res LabelFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Package")
-		case "id":
-			out.Values[i] = ec._Package_id(ctx, field, obj)
-		case "length":
-			out.Values[i] = ec._Package_length(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "width":
-			out.Values[i] = ec._Package_width(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "height":
-			out.Values[i] = ec._Package_height(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "dimensionUnit":
-			out.Values[i] = ec._Package_dimensionUnit(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "weight":
-			out.Values[i] = ec._Package_weight(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "weightUnit":
-			out.Values[i] = ec._Package_weightUnit(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "packageType":
-			out.Values[i] = ec._Package_packageType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec._Package_description(ctx, field, obj)
-		case "declaredValue":
-			out.Values[i] = ec._Package_declaredValue(ctx, field, obj)
-		case "currency":
-			out.Values[i] = ec._Package_currency(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, sel ast.SelectionSet, v LabelFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNLabelFormat2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormatᚄ(ctx context.Context, v any) ([]LabelFormat, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]LabelFormat, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res, nil
+}
+
+func (ec *executionContext) marshalNLabelFormat2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormatᚄ(ctx context.Context, sel ast.SelectionSet, v []LabelFormat) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) marshalNLabelResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse(ctx context.Context, sel ast.SelectionSet, v LabelResponse) graphql.Marshaler {
+	return ec._LabelResponse(ctx, sel, &v)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "health":
-			field := field
+func (ec *executionContext) marshalNLabelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse(ctx context.Context, sel ast.SelectionSet, v *LabelResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LabelResponse(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_health(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNManifest2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestᚄ(ctx context.Context, sel ast.SelectionSet, v []*Manifest) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNManifest2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifest(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "carriers":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_carriers(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNManifest2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifest(ctx context.Context, sel ast.SelectionSet, v *Manifest) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Manifest(ctx, sel, v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "serviceTypes":
-			field := field
+func (ec *executionContext) marshalNManifestGroup2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestGroup(ctx context.Context, sel ast.SelectionSet, v *ManifestGroup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ManifestGroup(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_serviceTypes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) unmarshalNManifestInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestInput(ctx context.Context, v any) (ManifestInput, error) {
+	res, err := ec.unmarshalInputManifestInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+func (ec *executionContext) marshalNManifestResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestResponse(ctx context.Context, sel ast.SelectionSet, v ManifestResponse) graphql.Marshaler {
+	return ec._ManifestResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNManifestResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestResponse(ctx context.Context, sel ast.SelectionSet, v *ManifestResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ManifestResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney(ctx context.Context, sel ast.SelectionSet, v *Money) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Money(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOrder2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []*Order) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNOrder2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrder(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNOrder2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrder(ctx context.Context, sel ast.SelectionSet, v *Order) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._Order(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNOrderConnection2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v OrderConnection) graphql.Marshaler {
+	return ec._OrderConnection(ctx, sel, &v)
 }
 
-var quoteResponseImplementors = []string{"QuoteResponse"}
+func (ec *executionContext) marshalNOrderConnection2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v *OrderConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OrderConnection(ctx, sel, v)
+}
 
-func (ec *executionContext) _QuoteResponse(ctx context.Context, sel ast.SelectionSet, obj *QuoteResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, quoteResponseImplementors)
+func (ec *executionContext) marshalNOrderResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse(ctx context.Context, sel ast.SelectionSet, v OrderResponse) graphql.Marshaler {
+	return ec._OrderResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("QuoteResponse")
-		case "success":
-			out.Values[i] = ec._QuoteResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "quoteId":
-			out.Values[i] = ec._QuoteResponse_quoteId(ctx, field, obj)
-		case "rates":
-			out.Values[i] = ec._QuoteResponse_rates(ctx, field, obj)
-		case "errors":
-			out.Values[i] = ec._QuoteResponse_errors(ctx, field, obj)
-		case "metadata":
-			out.Values[i] = ec._QuoteResponse_metadata(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrderResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse(ctx context.Context, sel ast.SelectionSet, v *OrderResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._OrderResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx context.Context, v any) ([]*PackageInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*PackageInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPackageInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	return out
+func (ec *executionContext) unmarshalNPackageInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInput(ctx context.Context, v any) (*PackageInput, error) {
+	res, err := ec.unmarshalInputPackageInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-var rateOptionImplementors = []string{"RateOption"}
+func (ec *executionContext) unmarshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, v any) (PackageType, error) {
+	var res PackageType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _RateOption(ctx context.Context, sel ast.SelectionSet, obj *RateOption) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, rateOptionImplementors)
+func (ec *executionContext) marshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, sel ast.SelectionSet, v PackageType) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RateOption")
-		case "rateId":
-			out.Values[i] = ec._RateOption_rateId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "carrier":
-			out.Values[i] = ec._RateOption_carrier(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "serviceCode":
-			out.Values[i] = ec._RateOption_serviceCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "serviceName":
-			out.Values[i] = ec._RateOption_serviceName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "serviceType":
-			out.Values[i] = ec._RateOption_serviceType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "baseRate":
-			out.Values[i] = ec._RateOption_baseRate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fuelSurcharge":
-			out.Values[i] = ec._RateOption_fuelSurcharge(ctx, field, obj)
-		case "taxes":
-			out.Values[i] = ec._RateOption_taxes(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPrice":
-			out.Values[i] = ec._RateOption_totalPrice(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "transitDays":
-			out.Values[i] = ec._RateOption_transitDays(ctx, field, obj)
-		case "estimatedDelivery":
-			out.Values[i] = ec._RateOption_estimatedDelivery(ctx, field, obj)
-		case "expiresAt":
-			out.Values[i] = ec._RateOption_expiresAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "signatureRequired":
-			out.Values[i] = ec._RateOption_signatureRequired(ctx, field, obj)
-		case "guaranteed":
-			out.Values[i] = ec._RateOption_guaranteed(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNPickupAvailabilityInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupAvailabilityInput(ctx context.Context, v any) (PickupAvailabilityInput, error) {
+	res, err := ec.unmarshalInputPickupAvailabilityInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPickupAvailabilityResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupAvailabilityResponse(ctx context.Context, sel ast.SelectionSet, v PickupAvailabilityResponse) graphql.Marshaler {
+	return ec._PickupAvailabilityResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPickupAvailabilityResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupAvailabilityResponse(ctx context.Context, sel ast.SelectionSet, v *PickupAvailabilityResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._PickupAvailabilityResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNPickupResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupResponse(ctx context.Context, sel ast.SelectionSet, v PickupResponse) graphql.Marshaler {
+	return ec._PickupResponse(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPickupResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupResponse(ctx context.Context, sel ast.SelectionSet, v *PickupResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._PickupResponse(ctx, sel, v)
 }
 
-var responseMetadataImplementors = []string{"ResponseMetadata"}
+func (ec *executionContext) marshalNPickupWindow2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindow(ctx context.Context, sel ast.SelectionSet, v *PickupWindow) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PickupWindow(ctx, sel, v)
+}
 
-func (ec *executionContext) _ResponseMetadata(ctx context.Context, sel ast.SelectionSet, obj *ResponseMetadata) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, responseMetadataImplementors)
+func (ec *executionContext) unmarshalNPickupWindowInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowInput(ctx context.Context, v any) (*PickupWindowInput, error) {
+	res, err := ec.unmarshalInputPickupWindowInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResponseMetadata")
-		case "requestId":
-			out.Values[i] = ec._ResponseMetadata_requestId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "processedAt":
-			out.Values[i] = ec._ResponseMetadata_processedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "carrier":
-			out.Values[i] = ec._ResponseMetadata_carrier(ctx, field, obj)
-		case "carrierRefId":
-			out.Values[i] = ec._ResponseMetadata_carrierRefId(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPiece2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPiece(ctx context.Context, sel ast.SelectionSet, v *Piece) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._Piece(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNQuoteResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse(ctx context.Context, sel ast.SelectionSet, v QuoteResponse) graphql.Marshaler {
+	return ec._QuoteResponse(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNQuoteResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse(ctx context.Context, sel ast.SelectionSet, v *QuoteResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._QuoteResponse(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNRateOption2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOption(ctx context.Context, sel ast.SelectionSet, v *RateOption) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RateOption(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) unmarshalNRateTag2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTag(ctx context.Context, v any) (RateTag, error) {
+	var res RateTag
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNRateTag2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTag(ctx context.Context, sel ast.SelectionSet, v RateTag) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNRateTag2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTagᚄ(ctx context.Context, v any) ([]RateTag, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]RateTag, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRateTag2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTag(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res, nil
+}
+
+func (ec *executionContext) marshalNRateTag2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTagᚄ(ctx context.Context, sel ast.SelectionSet, v []RateTag) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRateTag2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateTag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) unmarshalNReplayWebhookDeliveryInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐReplayWebhookDeliveryInput(ctx context.Context, v any) (ReplayWebhookDeliveryInput, error) {
+	res, err := ec.unmarshalInputReplayWebhookDeliveryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata(ctx context.Context, sel ast.SelectionSet, v *ResponseMetadata) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._ResponseMetadata(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNReturnResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐReturnResponse(ctx context.Context, sel ast.SelectionSet, v ReturnResponse) graphql.Marshaler {
+	return ec._ReturnResponse(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNReturnResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐReturnResponse(ctx context.Context, sel ast.SelectionSet, v *ReturnResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._ReturnResponse(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNSchedulePickupInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐSchedulePickupInput(ctx context.Context, v any) (SchedulePickupInput, error) {
+	res, err := ec.unmarshalInputSchedulePickupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx context.Context, v any) (ServiceType, error) {
+	var res ServiceType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx context.Context, sel ast.SelectionSet, v ServiceType) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, v any) ([]ServiceType, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]ServiceType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []ServiceType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNShipmentStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, v any) (ShipmentStatus, error) {
+	var res ShipmentStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) marshalNShipmentStatus2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, sel ast.SelectionSet, v ShipmentStatus) graphql.Marshaler {
+	return v
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNStatusChange2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐStatusChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*StatusChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStatusChange2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐStatusChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStatusChange2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐStatusChange(ctx context.Context, sel ast.SelectionSet, v *StatusChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._StatusChange(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNTrackShipmentInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackShipmentInput(ctx context.Context, v any) (TrackShipmentInput, error) {
+	res, err := ec.unmarshalInputTrackShipmentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNTrackingEvent2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingEvent(ctx context.Context, sel ast.SelectionSet, v *TrackingEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TrackingEvent(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNTrackingResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingResponse(ctx context.Context, sel ast.SelectionSet, v TrackingResponse) graphql.Marshaler {
+	return ec._TrackingResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNTrackingResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingResponse(ctx context.Context, sel ast.SelectionSet, v *TrackingResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._TrackingResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryᚄ(ctx context.Context, sel ast.SelectionSet, v []*WebhookDelivery) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDelivery(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) marshalNWebhookDelivery2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WebhookDelivery(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNWebhookDeliveryResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryResponse(ctx context.Context, sel ast.SelectionSet, v WebhookDeliveryResponse) graphql.Marshaler {
+	return ec._WebhookDeliveryResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNWebhookDeliveryResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryResponse(ctx context.Context, sel ast.SelectionSet, v *WebhookDeliveryResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._WebhookDeliveryResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNWebhookDeliveryState2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState(ctx context.Context, v any) (WebhookDeliveryState, error) {
+	var res WebhookDeliveryState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) marshalNWebhookDeliveryState2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState(ctx context.Context, sel ast.SelectionSet, v WebhookDeliveryState) graphql.Marshaler {
+	return v
+}
 
-	return out
+func (ec *executionContext) unmarshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx context.Context, v any) (WebhookEventType, error) {
+	var res WebhookEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx context.Context, sel ast.SelectionSet, v WebhookEventType) graphql.Marshaler {
+	return v
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ(ctx context.Context, v any) ([]WebhookEventType, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]WebhookEventType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-func (ec *executionContext) unmarshalNAddressInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐAddressInput(ctx context.Context, v any) (*AddressInput, error) {
-	res, err := ec.unmarshalInputAddressInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []WebhookEventType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNWebhookResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookResponse(ctx context.Context, sel ast.SelectionSet, v WebhookResponse) graphql.Marshaler {
+	return ec._WebhookResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNWebhookResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookResponse(ctx context.Context, sel ast.SelectionSet, v *WebhookResponse) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._WebhookResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCancelOrderInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelOrderInput(ctx context.Context, v any) (CancelOrderInput, error) {
-	res, err := ec.unmarshalInputCancelOrderInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalNWebhookSubscription2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscriptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*WebhookSubscription) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWebhookSubscription2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscription(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNCancelResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse(ctx context.Context, sel ast.SelectionSet, v CancelResponse) graphql.Marshaler {
-	return ec._CancelResponse(ctx, sel, &v)
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNCancelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCancelResponse(ctx context.Context, sel ast.SelectionSet, v *CancelResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookSubscription2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscription(ctx context.Context, sel ast.SelectionSet, v *WebhookSubscription) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CancelResponse(ctx, sel, v)
+	return ec._WebhookSubscription(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, v any) (Carrier, error) {
-	var res Carrier
+func (ec *executionContext) unmarshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx context.Context, v any) (WeightUnit, error) {
+	var res WeightUnit
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, sel ast.SelectionSet, v Carrier) graphql.Marshaler {
+func (ec *executionContext) marshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx context.Context, sel ast.SelectionSet, v WeightUnit) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, v any) ([]Carrier, error) {
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
-	res := make([]Carrier, len(vSlice))
+	res := make([]string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, vSlice[i])
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -7584,7 +18561,63 @@ func (ec *executionContext) unmarshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlog
 	return res, nil
 }
 
-func (ec *executionContext) marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, sel ast.SelectionSet, v []Carrier) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7608,7 +18641,7 @@ func (ec *executionContext) marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogis
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, sel, v[i])
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7628,86 +18661,72 @@ func (ec *executionContext) marshalNCarrier2ᚕgithubᚗcomᚋtourneventᚋlogis
 	return ret
 }
 
-func (ec *executionContext) unmarshalNContactInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐContactInput(ctx context.Context, v any) (*ContactInput, error) {
-	res, err := ec.unmarshalInputContactInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateOrderInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCreateOrderInput(ctx context.Context, v any) (CreateOrderInput, error) {
-	res, err := ec.unmarshalInputCreateOrderInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalTime(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return res
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNDecimal2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNDecimal2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, v any) (DimensionUnit, error) {
-	var res DimensionUnit
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
 
-func (ec *executionContext) marshalNDimensionUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, sel ast.SelectionSet, v DimensionUnit) graphql.Marshaler {
-	return v
+	return ret
 }
 
-func (ec *executionContext) marshalNError2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐError(ctx context.Context, sel ast.SelectionSet, v *Error) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Error(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNGetLabelInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐGetLabelInput(ctx context.Context, v any) (GetLabelInput, error) {
-	res, err := ec.unmarshalInputGetLabelInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNGetQuoteInput2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐGetQuoteInput(ctx context.Context, v any) (GetQuoteInput, error) {
-	res, err := ec.unmarshalInputGetQuoteInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec.___Type(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalID(v)
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -7716,146 +18735,47 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 	return res
 }
 
-func (ec *executionContext) marshalNLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx context.Context, sel ast.SelectionSet, v *Label) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Label(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, v any) (LabelFormat, error) {
-	var res LabelFormat
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNLabelFormat2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, sel ast.SelectionSet, v LabelFormat) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNLabelResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse(ctx context.Context, sel ast.SelectionSet, v LabelResponse) graphql.Marshaler {
-	return ec._LabelResponse(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNLabelResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelResponse(ctx context.Context, sel ast.SelectionSet, v *LabelResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._LabelResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney(ctx context.Context, sel ast.SelectionSet, v *Money) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Money(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNOrderResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse(ctx context.Context, sel ast.SelectionSet, v OrderResponse) graphql.Marshaler {
-	return ec._OrderResponse(ctx, sel, &v)
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(v)
+	return res
 }
 
-func (ec *executionContext) marshalNOrderResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrderResponse(ctx context.Context, sel ast.SelectionSet, v *OrderResponse) graphql.Marshaler {
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._OrderResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx context.Context, v any) ([]*PackageInput, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*PackageInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNPackageInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+		return nil, nil
 	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNPackageInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInput(ctx context.Context, v any) (*PackageInput, error) {
-	res, err := ec.unmarshalInputPackageInput(ctx, v)
+	res, err := graphql.UnmarshalBoolean(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, v any) (PackageType, error) {
-	var res PackageType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPackageType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, sel ast.SelectionSet, v PackageType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNQuoteResponse2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse(ctx context.Context, sel ast.SelectionSet, v QuoteResponse) graphql.Marshaler {
-	return ec._QuoteResponse(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNQuoteResponse2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐQuoteResponse(ctx context.Context, sel ast.SelectionSet, v *QuoteResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._QuoteResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNRateOption2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOption(ctx context.Context, sel ast.SelectionSet, v *RateOption) graphql.Marshaler {
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec._RateOption(ctx, sel, v)
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(*v)
+	return res
 }
 
-func (ec *executionContext) marshalNResponseMetadata2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐResponseMetadata(ctx context.Context, sel ast.SelectionSet, v *ResponseMetadata) graphql.Marshaler {
+func (ec *executionContext) unmarshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, v any) ([]Carrier, error) {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
+		return nil, nil
 	}
-	return ec._ResponseMetadata(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx context.Context, v any) (ServiceType, error) {
-	var res ServiceType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx context.Context, sel ast.SelectionSet, v ServiceType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, v any) ([]ServiceType, error) {
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
-	res := make([]ServiceType, len(vSlice))
+	res := make([]Carrier, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -7863,7 +18783,10 @@ func (ec *executionContext) unmarshalNServiceType2ᚕgithubᚗcomᚋtournevent
 	return res, nil
 }
 
-func (ec *executionContext) marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []ServiceType) graphql.Marshaler {
+func (ec *executionContext) marshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, sel ast.SelectionSet, v []Carrier) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7887,7 +18810,7 @@ func (ec *executionContext) marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋl
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, sel, v[i])
+			ret[i] = ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7907,37 +18830,84 @@ func (ec *executionContext) marshalNServiceType2ᚕgithubᚗcomᚋtourneventᚋl
 	return ret
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, v any) (*Carrier, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(Carrier)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, sel ast.SelectionSet, v *Carrier) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOCustomsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCustomsInput(ctx context.Context, v any) (*CustomsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCustomsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalODateTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalTime(*v)
 	return res
 }
 
-func (ec *executionContext) unmarshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx context.Context, v any) (WeightUnit, error) {
-	var res WeightUnit
+func (ec *executionContext) unmarshalODecimal2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx context.Context, v any) (*shipper.Decimal, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(shipper.Decimal)
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNWeightUnit2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx context.Context, sel ast.SelectionSet, v WeightUnit) graphql.Marshaler {
+func (ec *executionContext) marshalODecimal2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋpkgᚋshipperᚐDecimal(ctx context.Context, sel ast.SelectionSet, v *shipper.Decimal) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	return v
 }
 
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) unmarshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, v any) (*DimensionUnit, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(DimensionUnit)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, sel ast.SelectionSet, v *DimensionUnit) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*Error) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7961,7 +18931,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalNError2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐError(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7981,30 +18951,17 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) unmarshalOID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
 	res := make([]string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -8012,40 +18969,14 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 	return res, nil
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) marshalOID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	ret := make(graphql.Array, len(v))
 	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
 	}
-	wg.Wait()
 
 	for _, e := range ret {
 		if e == graphql.Null {
@@ -8056,19 +18987,62 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) unmarshalOIncoterm2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐIncoterm(ctx context.Context, v any) (*Incoterm, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(Incoterm)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalOIncoterm2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐIncoterm(ctx context.Context, sel ast.SelectionSet, v *Incoterm) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelᚄ(ctx context.Context, sel ast.SelectionSet, v []*Label) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8092,7 +19066,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalNLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8112,11 +19086,33 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) marshalOLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx context.Context, sel ast.SelectionSet, v *Label) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Label(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) unmarshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, v any) (*LabelFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(LabelFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, sel ast.SelectionSet, v *LabelFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOManifestGroup2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*ManifestGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8140,7 +19136,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNManifestGroup2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐManifestGroup(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8160,73 +19156,31 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney(ctx context.Context, sel ast.SelectionSet, v *Money) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalBoolean(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalBoolean(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._Money(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOOrder2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐOrder(ctx context.Context, sel ast.SelectionSet, v *Order) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalBoolean(*v)
-	return res
+	return ec._Order(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, v any) ([]Carrier, error) {
+func (ec *executionContext) unmarshalOPackageInput2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInputᚄ(ctx context.Context, v any) ([]*PackageInput, error) {
 	if v == nil {
 		return nil, nil
 	}
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
-	res := make([]Carrier, len(vSlice))
+	res := make([]*PackageInput, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNPackageInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageInput(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -8234,7 +19188,39 @@ func (ec *executionContext) unmarshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlog
 	return res, nil
 }
 
-func (ec *executionContext) marshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrierᚄ(ctx context.Context, sel ast.SelectionSet, v []Carrier) graphql.Marshaler {
+func (ec *executionContext) unmarshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, v any) (*PackageType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(PackageType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, sel ast.SelectionSet, v *PackageType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOPickupStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupStatus(ctx context.Context, v any) (*PickupStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(PickupStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOPickupStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupStatus(ctx context.Context, sel ast.SelectionSet, v *PickupStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOPickupWindow2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowᚄ(ctx context.Context, sel ast.SelectionSet, v []*PickupWindow) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8261,7 +19247,7 @@ func (ec *executionContext) marshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogis
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCarrier2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx, sel, v[i])
+			ret[i] = ec.marshalNPickupWindow2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindow(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8281,75 +19267,77 @@ func (ec *executionContext) marshalOCarrier2ᚕgithubᚗcomᚋtourneventᚋlogis
 	return ret
 }
 
-func (ec *executionContext) unmarshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, v any) (*Carrier, error) {
-	if v == nil {
-		return nil, nil
-	}
-	var res = new(Carrier)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOCarrier2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐCarrier(ctx context.Context, sel ast.SelectionSet, v *Carrier) graphql.Marshaler {
+func (ec *executionContext) marshalOPickupWindow2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindow(ctx context.Context, sel ast.SelectionSet, v *PickupWindow) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	return ec._PickupWindow(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalODateTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+func (ec *executionContext) unmarshalOPickupWindowInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPickupWindowInput(ctx context.Context, v any) (*PickupWindowInput, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalTime(v)
+	res, err := ec.unmarshalInputPickupWindowInput(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+func (ec *executionContext) marshalOPiece2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPieceᚄ(ctx context.Context, sel ast.SelectionSet, v []*Piece) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalTime(*v)
-	return res
-}
-
-func (ec *executionContext) unmarshalODecimal2ᚖstring(ctx context.Context, v any) (*string, error) {
-	if v == nil {
-		return nil, nil
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	res, err := graphql.UnmarshalString(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPiece2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPiece(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalODecimal2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(*v)
-	return res
-}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, v any) (*DimensionUnit, error) {
-	if v == nil {
-		return nil, nil
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	var res = new(DimensionUnit)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+
+	return ret
 }
 
-func (ec *executionContext) marshalODimensionUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐDimensionUnit(ctx context.Context, sel ast.SelectionSet, v *DimensionUnit) graphql.Marshaler {
+func (ec *executionContext) unmarshalORateFilterInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateFilterInput(ctx context.Context, v any) (*RateFilterInput, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	return v
+	res, err := ec.unmarshalInputRateFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []*Error) graphql.Marshaler {
+func (ec *executionContext) marshalORateOption2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*RateOption) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8376,7 +19364,7 @@ func (ec *executionContext) marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNError2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐError(ctx, sel, v[i])
+			ret[i] = ec.marshalNRateOption2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOption(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8396,43 +19384,41 @@ func (ec *executionContext) marshalOError2ᚕᚖgithubᚗcomᚋtourneventᚋlogi
 	return ret
 }
 
-func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+func (ec *executionContext) unmarshalORateSort2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateSort(ctx context.Context, v any) (*RateSort, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	var res = new(RateSort)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalORateSort2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateSort(ctx context.Context, sel ast.SelectionSet, v *RateSort) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalID(*v)
-	return res
+	return v
 }
 
-func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+func (ec *executionContext) unmarshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, v any) ([]ServiceType, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalInt(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]ServiceType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalInt(*v)
-	return res
+	return res, nil
 }
 
-func (ec *executionContext) marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelᚄ(ctx context.Context, sel ast.SelectionSet, v []*Label) graphql.Marshaler {
+func (ec *executionContext) marshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []ServiceType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8459,7 +19445,7 @@ func (ec *executionContext) marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx, sel, v[i])
+			ret[i] = ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8479,53 +19465,49 @@ func (ec *executionContext) marshalOLabel2ᚕᚖgithubᚗcomᚋtourneventᚋlogi
 	return ret
 }
 
-func (ec *executionContext) marshalOLabel2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabel(ctx context.Context, sel ast.SelectionSet, v *Label) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._Label(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, v any) (*LabelFormat, error) {
+func (ec *executionContext) unmarshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, v any) (*ShipmentStatus, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(LabelFormat)
+	var res = new(ShipmentStatus)
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOLabelFormat2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐLabelFormat(ctx context.Context, sel ast.SelectionSet, v *LabelFormat) graphql.Marshaler {
+func (ec *executionContext) marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, sel ast.SelectionSet, v *ShipmentStatus) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return v
 }
 
-func (ec *executionContext) marshalOMoney2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐMoney(ctx context.Context, sel ast.SelectionSet, v *Money) graphql.Marshaler {
+func (ec *executionContext) unmarshalOShippingOptionsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShippingOptionsInput(ctx context.Context, v any) (*ShippingOptionsInput, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	return ec._Money(ctx, sel, v)
+	res, err := ec.unmarshalInputShippingOptionsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, v any) (*PackageType, error) {
+func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(PackageType)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	res, err := graphql.UnmarshalString(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOPackageType2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐPackageType(ctx context.Context, sel ast.SelectionSet, v *PackageType) graphql.Marshaler {
+func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(*v)
+	return res
 }
 
-func (ec *executionContext) marshalORateOption2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*RateOption) graphql.Marshaler {
+func (ec *executionContext) marshalOTrackingEvent2ᚕᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*TrackingEvent) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8552,7 +19534,7 @@ func (ec *executionContext) marshalORateOption2ᚕᚖgithubᚗcomᚋtournevent
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNRateOption2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐRateOption(ctx, sel, v[i])
+			ret[i] = ec.marshalNTrackingEvent2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐTrackingEvent(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8572,17 +19554,40 @@ func (ec *executionContext) marshalORateOption2ᚕᚖgithubᚗcomᚋtournevent
 	return ret
 }
 
-func (ec *executionContext) unmarshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, v any) ([]ServiceType, error) {
+func (ec *executionContext) marshalOWebhookDelivery2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._WebhookDelivery(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOWebhookDeliveryState2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState(ctx context.Context, v any) (*WebhookDeliveryState, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(WebhookDeliveryState)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOWebhookDeliveryState2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookDeliveryState(ctx context.Context, sel ast.SelectionSet, v *WebhookDeliveryState) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ(ctx context.Context, v any) ([]WebhookEventType, error) {
 	if v == nil {
 		return nil, nil
 	}
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
-	res := make([]ServiceType, len(vSlice))
+	res := make([]WebhookEventType, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -8590,7 +19595,7 @@ func (ec *executionContext) unmarshalOServiceType2ᚕgithubᚗcomᚋtournevent
 	return res, nil
 }
 
-func (ec *executionContext) marshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []ServiceType) graphql.Marshaler {
+func (ec *executionContext) marshalOWebhookEventType2ᚕgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []WebhookEventType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -8617,7 +19622,7 @@ func (ec *executionContext) marshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋl
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNServiceType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐServiceType(ctx, sel, v[i])
+			ret[i] = ec.marshalNWebhookEventType2githubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookEventType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8637,46 +19642,18 @@ func (ec *executionContext) marshalOServiceType2ᚕgithubᚗcomᚋtourneventᚋl
 	return ret
 }
 
-func (ec *executionContext) unmarshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, v any) (*ShipmentStatus, error) {
-	if v == nil {
-		return nil, nil
-	}
-	var res = new(ShipmentStatus)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOShipmentStatus2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShipmentStatus(ctx context.Context, sel ast.SelectionSet, v *ShipmentStatus) graphql.Marshaler {
+func (ec *executionContext) marshalOWebhookSubscription2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWebhookSubscription(ctx context.Context, sel ast.SelectionSet, v *WebhookSubscription) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
-}
-
-func (ec *executionContext) unmarshalOShippingOptionsInput2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐShippingOptionsInput(ctx context.Context, v any) (*ShippingOptionsInput, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := ec.unmarshalInputShippingOptionsInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalString(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._WebhookSubscription(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalOWeight2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeight(ctx context.Context, sel ast.SelectionSet, v *Weight) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(*v)
-	return res
+	return ec._Weight(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalOWeightUnit2ᚖgithubᚗcomᚋtourneventᚋlogisticᚋinternalᚋgraphqlᚋgeneratedᚐWeightUnit(ctx context.Context, v any) (*WeightUnit, error) {
@@ -8,6 +8,8 @@ import (
 	"io"
 	"strconv"
 	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
 )
 
 // Physical address for origin/destination.
@@ -44,8 +46,24 @@ type AddressInput struct {
 
 // Input for cancelling an order.
 type CancelOrderInput struct {
-	OrderID string  `json:"orderId"`
-	Reason  *string `json:"reason,omitempty"`
+	ShipperID string  `json:"shipperId"`
+	OrderID   string  `json:"orderId"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
+// Input for cancelling a pickup.
+type CancelPickupInput struct {
+	Carrier  Carrier `json:"carrier"`
+	PickupID string  `json:"pickupId"`
+}
+
+// Response for delivro_cancel_pickup mutation.
+type CancelPickupResponse struct {
+	Success  bool              `json:"success"`
+	PickupID *string           `json:"pickupId,omitempty"`
+	Status   *PickupStatus     `json:"status,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
 }
 
 // Response for delivro_cancel_order mutation.
@@ -59,6 +77,40 @@ type CancelResponse struct {
 	Metadata           *ResponseMetadata `json:"metadata"`
 }
 
+// What a carrier supports, so that clients can hide options it would reject.
+type CarrierCapabilities struct {
+	Carrier       Carrier       `json:"carrier"`
+	LabelFormats  []LabelFormat `json:"labelFormats"`
+	International bool          `json:"international"`
+	MultiPiece    bool          `json:"multiPiece"`
+	Pickups       bool          `json:"pickups"`
+	// Orders must be transmitted with delivro_manifest at the end of the day.
+	Manifests bool `json:"manifests"`
+	// Prepaid return labels can be created with delivro_create_return.
+	Returns           bool `json:"returns"`
+	Insurance         bool `json:"insurance"`
+	SignatureRequired bool `json:"signatureRequired"`
+	SaturdayDelivery  bool `json:"saturdayDelivery"`
+	// Heaviest package accepted, null if the carrier states no limit.
+	MaxWeight *Weight `json:"maxWeight,omitempty"`
+}
+
+// Outcome of quoting with one carrier.
+type CarrierQuoteResult struct {
+	Carrier   Carrier            `json:"carrier"`
+	QuoteID   *string            `json:"quoteId,omitempty"`
+	Status    CarrierQuoteStatus `json:"status"`
+	LatencyMs int                `json:"latencyMs"`
+	// Number of rates the carrier returned, before filtering.
+	RateCount int `json:"rateCount"`
+	// Same as the code of the carrier's entry in errors.
+	ErrorCode *string `json:"errorCode,omitempty"`
+	// Error code reported by the carrier, if any.
+	CarrierCode *string `json:"carrierCode,omitempty"`
+	// Whether asking again may succeed.
+	Retryable bool `json:"retryable"`
+}
+
 // Contact input for sender/recipient.
 type ContactInput struct {
 	Name    string  `json:"name"`
@@ -81,20 +133,105 @@ type CreateOrderInput struct {
 	Reference        *string         `json:"reference,omitempty"`
 	PoNumber         *string         `json:"poNumber,omitempty"`
 	Instructions     *string         `json:"instructions,omitempty"`
+	// Client-generated key identifying this order attempt. Replaying it
+	// returns the order first created with the key instead of shipping again,
+	// and replaying a key with a different request fails with
+	// IDEMPOTENCY_KEY_REUSED. Keys are scoped to the shipper and remembered
+	// for 24 hours.
+	//
+	// A key is released when the carrier rejects the order, so that the fixed
+	// request can be sent with it again. When the outcome is unknown, e.g.
+	// after a timeout, the key stays IDEMPOTENCY_KEY_IN_USE for 5 minutes.
+	// Only carriers that deduplicate orders on the key, currently Freightcom,
+	// are retried on transient failures; with the others the order may have
+	// been created, so look it up by reference before retrying.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+	// Signature, insurance, Saturday delivery and ship date options. Carrier
+	// and service type filters are ignored since the rate is already chosen.
+	Options *ShippingOptionsInput `json:"options,omitempty"`
+	// Book a pickup with the order. Only Freightcom books pickups with orders;
+	// use delivro_schedule_pickup for other carriers.
+	Pickup *PickupWindowInput `json:"pickup,omitempty"`
+	// Customs declaration, required when the sender and recipient are in
+	// different countries.
+	Customs *CustomsInput `json:"customs,omitempty"`
+}
+
+// Input for creating a return of an order.
+type CreateReturnInput struct {
+	ShipperID string `json:"shipperId"`
+	// Order being returned. The return is sent from its recipient back to its
+	// sender with the same service.
+	OrderID string `json:"orderId"`
+	// Return merchandise authorization, printed on the label.
+	RmaNumber *string `json:"rmaNumber,omitempty"`
+	// Packages being returned. Defaults to the packages of the order.
+	Packages []*PackageInput `json:"packages,omitempty"`
+	Format   *LabelFormat    `json:"format,omitempty"`
+}
+
+// Input for subscribing to order lifecycle events.
+type CreateWebhookInput struct {
+	ShipperID string `json:"shipperId"`
+	// Absolute http or https URL receiving the events.
+	URL string `json:"url"`
+	// Key of the delivery signatures. Generated when omitted.
+	Secret *string `json:"secret,omitempty"`
+	// Events to receive. Empty for all events.
+	Events []WebhookEventType `json:"events,omitempty"`
+}
+
+// Customs declaration and commercial invoice of an international shipment.
+type CustomsInput struct {
+	Items           []*CustomsItemInput `json:"items"`
+	ReasonForExport ExportReason        `json:"reasonForExport"`
+	Incoterm        *Incoterm           `json:"incoterm,omitempty"`
+	// ISO 4217 currency of the item values. Canada Post accepts CAD only and
+	// Purolator CAD or USD.
+	Currency      *string `json:"currency,omitempty"`
+	InvoiceNumber *string `json:"invoiceNumber,omitempty"`
+	ImporterTaxID *string `json:"importerTaxId,omitempty"`
+	ExporterTaxID *string `json:"exporterTaxId,omitempty"`
+	// B13A or CERS proof of report number, for exports that require one.
+	ExportDeclarationNumber *string `json:"exportDeclarationNumber,omitempty"`
+}
+
+// Line of a customs declaration.
+type CustomsItemInput struct {
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	// Value of one unit, in the currency of the declaration.
+	UnitValue  shipper.Decimal `json:"unitValue"`
+	UnitWeight shipper.Decimal `json:"unitWeight"`
+	WeightUnit *WeightUnit     `json:"weightUnit,omitempty"`
+	// ISO 3166-1 alpha-2 country where the item was made.
+	CountryOfOrigin string `json:"countryOfOrigin"`
+	// Harmonized System tariff code, 6 to 10 digits.
+	HsCode *string `json:"hsCode,omitempty"`
+	Sku    *string `json:"sku,omitempty"`
+}
+
+// Input for removing a webhook subscription.
+type DeleteWebhookInput struct {
+	ShipperID      string `json:"shipperId"`
+	SubscriptionID string `json:"subscriptionId"`
 }
 
 // Standard error information.
 type Error struct {
-	Code        string  `json:"code"`
-	Message     string  `json:"message"`
-	Field       *string `json:"field,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Path of the invalid input field, e.g. "packages[1].weight".
+	Field *string `json:"field,omitempty"`
+	// Error code reported by the carrier, if any.
 	CarrierCode *string `json:"carrierCode,omitempty"`
 }
 
 // Input for getting shipping label.
 type GetLabelInput struct {
-	OrderID string       `json:"orderId"`
-	Format  *LabelFormat `json:"format,omitempty"`
+	ShipperID string       `json:"shipperId"`
+	OrderID   string       `json:"orderId"`
+	Format    *LabelFormat `json:"format,omitempty"`
 }
 
 // Input for getting shipping quotes.
@@ -104,6 +241,13 @@ type GetQuoteInput struct {
 	Destination *AddressInput         `json:"destination"`
 	Packages    []*PackageInput       `json:"packages"`
 	Options     *ShippingOptionsInput `json:"options,omitempty"`
+	// Customs declaration of international quotes. Freightcom and Purolator
+	// rate with it.
+	Customs *CustomsInput `json:"customs,omitempty"`
+	// Order of the rates. Ties are broken so that the same rates always list in
+	// the same order.
+	Sort   *RateSort        `json:"sort,omitempty"`
+	Filter *RateFilterInput `json:"filter,omitempty"`
 }
 
 // Shipping label information.
@@ -124,58 +268,178 @@ type LabelResponse struct {
 	Metadata         *ResponseMetadata `json:"metadata"`
 }
 
+// Carrier record of the orders handed over in one transmit.
+type Manifest struct {
+	ManifestID string `json:"manifestId"`
+	// Printable manifest to hand to the driver.
+	Document *Label `json:"document"`
+}
+
+// Orders transmitted from one origin.
+type ManifestGroup struct {
+	OriginPostalCode string      `json:"originPostalCode"`
+	ShipDate         time.Time   `json:"shipDate"`
+	OrderIds         []string    `json:"orderIds"`
+	Manifests        []*Manifest `json:"manifests"`
+}
+
+// Input for transmitting the day's orders.
+type ManifestInput struct {
+	ShipperID string  `json:"shipperId"`
+	Carrier   Carrier `json:"carrier"`
+	// Ship date of the orders to transmit. Defaults to today.
+	ShipDate *time.Time `json:"shipDate,omitempty"`
+}
+
+// Response for delivro_manifest mutation.
+type ManifestResponse struct {
+	Success bool `json:"success"`
+	// One group per origin. Carriers that transmit every open order of the
+	// account at once, such as Purolator, return a single group with an empty
+	// originPostalCode. Empty when there were no open orders.
+	Groups   []*ManifestGroup  `json:"groups,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
+}
+
 // Monetary amount.
 type Money struct {
-	Amount   string `json:"amount"`
-	Currency string `json:"currency"`
+	Amount   shipper.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
 }
 
 type Mutation struct {
 }
 
+// Order created through a carrier, as recorded by this service.
+type Order struct {
+	OrderID           string          `json:"orderId"`
+	ShipperID         string          `json:"shipperId"`
+	Carrier           Carrier         `json:"carrier"`
+	Reference         *string         `json:"reference,omitempty"`
+	PoNumber          *string         `json:"poNumber,omitempty"`
+	TrackingNumber    *string         `json:"trackingNumber,omitempty"`
+	TrackingURL       *string         `json:"trackingUrl,omitempty"`
+	Status            ShipmentStatus  `json:"status"`
+	ServiceName       *string         `json:"serviceName,omitempty"`
+	TotalCharged      *Money          `json:"totalCharged,omitempty"`
+	EstimatedDelivery *time.Time      `json:"estimatedDelivery,omitempty"`
+	LabelURL          *string         `json:"labelUrl,omitempty"`
+	Pieces            []*Piece        `json:"pieces,omitempty"`
+	SenderAddress     *Address        `json:"senderAddress"`
+	RecipientAddress  *Address        `json:"recipientAddress"`
+	StatusHistory     []*StatusChange `json:"statusHistory"`
+	// Order this order returns, null for outbound orders.
+	ReturnOf  *string   `json:"returnOf,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Page of orders, newest first.
+type OrderConnection struct {
+	Orders     []*Order `json:"orders"`
+	NextCursor *string  `json:"nextCursor,omitempty"`
+	HasMore    bool     `json:"hasMore"`
+}
+
 // Response for delivro_create_order mutation.
 type OrderResponse struct {
-	Success           bool              `json:"success"`
-	OrderID           *string           `json:"orderId,omitempty"`
-	TrackingNumber    *string           `json:"trackingNumber,omitempty"`
-	TrackingURL       *string           `json:"trackingUrl,omitempty"`
-	Status            *ShipmentStatus   `json:"status,omitempty"`
-	Carrier           *Carrier          `json:"carrier,omitempty"`
-	ServiceName       *string           `json:"serviceName,omitempty"`
-	TotalCharged      *Money            `json:"totalCharged,omitempty"`
-	EstimatedDelivery *time.Time        `json:"estimatedDelivery,omitempty"`
-	LabelURL          *string           `json:"labelUrl,omitempty"`
+	Success           bool            `json:"success"`
+	OrderID           *string         `json:"orderId,omitempty"`
+	TrackingNumber    *string         `json:"trackingNumber,omitempty"`
+	TrackingURL       *string         `json:"trackingUrl,omitempty"`
+	Status            *ShipmentStatus `json:"status,omitempty"`
+	Carrier           *Carrier        `json:"carrier,omitempty"`
+	ServiceName       *string         `json:"serviceName,omitempty"`
+	TotalCharged      *Money          `json:"totalCharged,omitempty"`
+	EstimatedDelivery *time.Time      `json:"estimatedDelivery,omitempty"`
+	LabelURL          *string         `json:"labelUrl,omitempty"`
+	Pieces            []*Piece        `json:"pieces,omitempty"`
+	// True when the order was created by an earlier request with the same
+	// idempotency key and no new shipment was booked.
+	PreviouslyCreated bool              `json:"previouslyCreated"`
 	Errors            []*Error          `json:"errors,omitempty"`
 	Metadata          *ResponseMetadata `json:"metadata"`
 }
 
 // Package dimensions and weight.
 type Package struct {
-	ID            *string       `json:"id,omitempty"`
-	Length        string        `json:"length"`
-	Width         string        `json:"width"`
-	Height        string        `json:"height"`
-	DimensionUnit DimensionUnit `json:"dimensionUnit"`
-	Weight        string        `json:"weight"`
-	WeightUnit    WeightUnit    `json:"weightUnit"`
-	PackageType   PackageType   `json:"packageType"`
-	Description   *string       `json:"description,omitempty"`
-	DeclaredValue *string       `json:"declaredValue,omitempty"`
-	Currency      *string       `json:"currency,omitempty"`
+	ID            *string          `json:"id,omitempty"`
+	Length        shipper.Decimal  `json:"length"`
+	Width         shipper.Decimal  `json:"width"`
+	Height        shipper.Decimal  `json:"height"`
+	DimensionUnit DimensionUnit    `json:"dimensionUnit"`
+	Weight        shipper.Decimal  `json:"weight"`
+	WeightUnit    WeightUnit       `json:"weightUnit"`
+	PackageType   PackageType      `json:"packageType"`
+	Description   *string          `json:"description,omitempty"`
+	DeclaredValue *shipper.Decimal `json:"declaredValue,omitempty"`
+	Currency      *string          `json:"currency,omitempty"`
 }
 
 // Package input for requests.
 type PackageInput struct {
-	Length        string         `json:"length"`
-	Width         string         `json:"width"`
-	Height        string         `json:"height"`
-	DimensionUnit *DimensionUnit `json:"dimensionUnit,omitempty"`
-	Weight        string         `json:"weight"`
-	WeightUnit    *WeightUnit    `json:"weightUnit,omitempty"`
-	PackageType   *PackageType   `json:"packageType,omitempty"`
-	Description   *string        `json:"description,omitempty"`
-	DeclaredValue *string        `json:"declaredValue,omitempty"`
-	Currency      *string        `json:"currency,omitempty"`
+	Length        shipper.Decimal  `json:"length"`
+	Width         shipper.Decimal  `json:"width"`
+	Height        shipper.Decimal  `json:"height"`
+	DimensionUnit *DimensionUnit   `json:"dimensionUnit,omitempty"`
+	Weight        shipper.Decimal  `json:"weight"`
+	WeightUnit    *WeightUnit      `json:"weightUnit,omitempty"`
+	PackageType   *PackageType     `json:"packageType,omitempty"`
+	Description   *string          `json:"description,omitempty"`
+	DeclaredValue *shipper.Decimal `json:"declaredValue,omitempty"`
+	Currency      *string          `json:"currency,omitempty"`
+}
+
+// Input for checking pickup availability.
+type PickupAvailabilityInput struct {
+	Carrier Carrier       `json:"carrier"`
+	Address *AddressInput `json:"address"`
+	// Day of the pickup. Only the date is used.
+	Date time.Time `json:"date"`
+}
+
+// Response for delivro_pickup_availability mutation.
+type PickupAvailabilityResponse struct {
+	Success   bool  `json:"success"`
+	Available *bool `json:"available,omitempty"`
+	// Latest time a pickup on the date can be booked, if the carrier states one.
+	BookBy *time.Time `json:"bookBy,omitempty"`
+	// Collection times offered, empty if the carrier states none.
+	Windows  []*PickupWindow   `json:"windows,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
+}
+
+// Response for delivro_schedule_pickup mutation.
+type PickupResponse struct {
+	Success bool `json:"success"`
+	// Carrier identifier of the pickup, used to cancel it.
+	PickupID *string           `json:"pickupId,omitempty"`
+	Carrier  *Carrier          `json:"carrier,omitempty"`
+	Status   *PickupStatus     `json:"status,omitempty"`
+	Window   *PickupWindow     `json:"window,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
+}
+
+// When packages can be collected, in the local time of the pickup address.
+type PickupWindow struct {
+	ReadyAt time.Time `json:"readyAt"`
+	CloseAt time.Time `json:"closeAt"`
+}
+
+// Pickup window input, with the UTC offset of the pickup address.
+type PickupWindowInput struct {
+	ReadyAt time.Time `json:"readyAt"`
+	CloseAt time.Time `json:"closeAt"`
+}
+
+// Package of a multi-piece shipment, tracked and labelled on its own.
+type Piece struct {
+	PieceID        string  `json:"pieceId"`
+	TrackingNumber *string `json:"trackingNumber,omitempty"`
+	LabelURL       *string `json:"labelUrl,omitempty"`
 }
 
 type Query struct {
@@ -183,11 +447,27 @@ type Query struct {
 
 // Response for delivro_get_quote mutation.
 type QuoteResponse struct {
-	Success  bool              `json:"success"`
-	QuoteID  *string           `json:"quoteId,omitempty"`
-	Rates    []*RateOption     `json:"rates,omitempty"`
-	Errors   []*Error          `json:"errors,omitempty"`
-	Metadata *ResponseMetadata `json:"metadata"`
+	// Whether at least one carrier answered, even with no rates.
+	Success bool `json:"success"`
+	// Quote ID of the first carrier that answered. Each carrier's own quote ID
+	// is in carrierResults.
+	QuoteID        *string               `json:"quoteId,omitempty"`
+	Rates          []*RateOption         `json:"rates,omitempty"`
+	Errors         []*Error              `json:"errors,omitempty"`
+	CarrierResults []*CarrierQuoteResult `json:"carrierResults"`
+	Metadata       *ResponseMetadata     `json:"metadata"`
+}
+
+// Narrows the rates of a quote. Service types are filtered with the
+// serviceTypes shipping option.
+type RateFilterInput struct {
+	GuaranteedOnly *bool `json:"guaranteedOnly,omitempty"`
+	// Rates without a transit time are dropped when set.
+	MaxTransitDays *int `json:"maxTransitDays,omitempty"`
+	// Rates quoted in another currency than maxPriceCurrency are dropped when
+	// set.
+	MaxPrice         *shipper.Decimal `json:"maxPrice,omitempty"`
+	MaxPriceCurrency *string          `json:"maxPriceCurrency,omitempty"`
 }
 
 // Shipping rate option returned from quote.
@@ -206,6 +486,18 @@ type RateOption struct {
 	ExpiresAt         time.Time   `json:"expiresAt"`
 	SignatureRequired *bool       `json:"signatureRequired,omitempty"`
 	Guaranteed        *bool       `json:"guaranteed,omitempty"`
+	// Total package weight the carrier rated, in the carrier's units
+	BillableWeight *Weight   `json:"billableWeight,omitempty"`
+	Tags           []RateTag `json:"tags"`
+	// Best value score, from 0 for the best balance of price and transit days
+	// to 1.
+	Score float64 `json:"score"`
+}
+
+// Input for delivering an event again.
+type ReplayWebhookDeliveryInput struct {
+	ShipperID  string `json:"shipperId"`
+	DeliveryID string `json:"deliveryId"`
 }
 
 // Response metadata for debugging.
@@ -216,6 +508,39 @@ type ResponseMetadata struct {
 	CarrierRefID *string   `json:"carrierRefId,omitempty"`
 }
 
+// Response for delivro_create_return mutation.
+type ReturnResponse struct {
+	Success bool `json:"success"`
+	// ID of the return order.
+	OrderID *string `json:"orderId,omitempty"`
+	// Order being returned.
+	OriginalOrderID  *string           `json:"originalOrderId,omitempty"`
+	TrackingNumber   *string           `json:"trackingNumber,omitempty"`
+	Status           *ShipmentStatus   `json:"status,omitempty"`
+	Carrier          *Carrier          `json:"carrier,omitempty"`
+	TotalCharged     *Money            `json:"totalCharged,omitempty"`
+	Label            *Label            `json:"label,omitempty"`
+	AdditionalLabels []*Label          `json:"additionalLabels,omitempty"`
+	Errors           []*Error          `json:"errors,omitempty"`
+	Metadata         *ResponseMetadata `json:"metadata"`
+}
+
+// Input for scheduling a pickup.
+type SchedulePickupInput struct {
+	ShipperID string             `json:"shipperId"`
+	Carrier   Carrier            `json:"carrier"`
+	Address   *AddressInput      `json:"address"`
+	Contact   *ContactInput      `json:"contact"`
+	Window    *PickupWindowInput `json:"window"`
+	// Orders to collect. Freightcom books pickups per order and requires
+	// exactly one.
+	OrderIds     []string        `json:"orderIds,omitempty"`
+	PackageCount int             `json:"packageCount"`
+	TotalWeight  shipper.Decimal `json:"totalWeight"`
+	WeightUnit   *WeightUnit     `json:"weightUnit,omitempty"`
+	Instructions *string         `json:"instructions,omitempty"`
+}
+
 // Shipping options/preferences.
 type ShippingOptionsInput struct {
 	Carriers          []Carrier     `json:"carriers,omitempty"`
@@ -226,6 +551,99 @@ type ShippingOptionsInput struct {
 	ShipDate          *time.Time    `json:"shipDate,omitempty"`
 }
 
+// Single entry in an order's status history.
+type StatusChange struct {
+	Status    ShipmentStatus `json:"status"`
+	Timestamp time.Time      `json:"timestamp"`
+	Source    *string        `json:"source,omitempty"`
+}
+
+// Input for tracking a shipment.
+type TrackShipmentInput struct {
+	ShipperID      string   `json:"shipperId"`
+	OrderID        string   `json:"orderId"`
+	TrackingNumber *string  `json:"trackingNumber,omitempty"`
+	Carrier        *Carrier `json:"carrier,omitempty"`
+}
+
+// Single tracking event reported by a carrier.
+type TrackingEvent struct {
+	Timestamp   time.Time      `json:"timestamp"`
+	Description string         `json:"description"`
+	Location    *string        `json:"location,omitempty"`
+	Status      ShipmentStatus `json:"status"`
+	CarrierCode *string        `json:"carrierCode,omitempty"`
+}
+
+// Response for delivro_track_shipment mutation.
+type TrackingResponse struct {
+	Success           bool              `json:"success"`
+	OrderID           *string           `json:"orderId,omitempty"`
+	TrackingNumber    *string           `json:"trackingNumber,omitempty"`
+	Status            *ShipmentStatus   `json:"status,omitempty"`
+	EstimatedDelivery *time.Time        `json:"estimatedDelivery,omitempty"`
+	Events            []*TrackingEvent  `json:"events,omitempty"`
+	Errors            []*Error          `json:"errors,omitempty"`
+	Metadata          *ResponseMetadata `json:"metadata"`
+}
+
+// Delivery of an event to a webhook subscription.
+type WebhookDelivery struct {
+	ID             string               `json:"id"`
+	SubscriptionID string               `json:"subscriptionId"`
+	EventID        string               `json:"eventId"`
+	EventType      WebhookEventType     `json:"eventType"`
+	OrderID        string               `json:"orderId"`
+	State          WebhookDeliveryState `json:"state"`
+	Attempts       int                  `json:"attempts"`
+	// HTTP status of the last attempt, null if no response was received.
+	LastStatusCode *int    `json:"lastStatusCode,omitempty"`
+	LastError      *string `json:"lastError,omitempty"`
+	// When the next attempt is scheduled, for pending and failed deliveries.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	DeliveredAt   *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+// Response for delivro_replay_webhook_delivery mutation.
+type WebhookDeliveryResponse struct {
+	Success  bool              `json:"success"`
+	Delivery *WebhookDelivery  `json:"delivery,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
+}
+
+// Response for delivro_create_webhook and delivro_delete_webhook mutations.
+type WebhookResponse struct {
+	Success      bool                 `json:"success"`
+	Subscription *WebhookSubscription `json:"subscription,omitempty"`
+	// Key of the delivery signatures, only returned when the subscription is
+	// created.
+	Secret   *string           `json:"secret,omitempty"`
+	Errors   []*Error          `json:"errors,omitempty"`
+	Metadata *ResponseMetadata `json:"metadata"`
+}
+
+// Shipper's subscription to order lifecycle events. Events are POSTed as JSON
+// with an X-Delivro-Signature header: "sha256=" followed by the hex
+// HMAC-SHA256, keyed with the subscription secret, of the
+// X-Delivro-Timestamp header, a dot and the body.
+type WebhookSubscription struct {
+	ID        string `json:"id"`
+	ShipperID string `json:"shipperId"`
+	URL       string `json:"url"`
+	// Events sent to the URL. Empty for all events.
+	Events    []WebhookEventType `json:"events"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// Weight with its unit of measurement.
+type Weight struct {
+	Value shipper.Decimal `json:"value"`
+	Unit  WeightUnit      `json:"unit"`
+}
+
 // Supported carrier identifiers.
 type Carrier string
 
@@ -284,6 +702,68 @@ func (e Carrier) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Outcome of asking one carrier for rates.
+type CarrierQuoteStatus string
+
+const (
+	CarrierQuoteStatusOk      CarrierQuoteStatus = "OK"
+	CarrierQuoteStatusError   CarrierQuoteStatus = "ERROR"
+	CarrierQuoteStatusTimeout CarrierQuoteStatus = "TIMEOUT"
+	// The carrier was not asked: it is not configured or cannot quote the
+	// request.
+	CarrierQuoteStatusSkipped CarrierQuoteStatus = "SKIPPED"
+)
+
+var AllCarrierQuoteStatus = []CarrierQuoteStatus{
+	CarrierQuoteStatusOk,
+	CarrierQuoteStatusError,
+	CarrierQuoteStatusTimeout,
+	CarrierQuoteStatusSkipped,
+}
+
+func (e CarrierQuoteStatus) IsValid() bool {
+	switch e {
+	case CarrierQuoteStatusOk, CarrierQuoteStatusError, CarrierQuoteStatusTimeout, CarrierQuoteStatusSkipped:
+		return true
+	}
+	return false
+}
+
+func (e CarrierQuoteStatus) String() string {
+	return string(e)
+}
+
+func (e *CarrierQuoteStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CarrierQuoteStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CarrierQuoteStatus", str)
+	}
+	return nil
+}
+
+func (e CarrierQuoteStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CarrierQuoteStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CarrierQuoteStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Dimension unit of measurement.
 type DimensionUnit string
 
@@ -340,6 +820,126 @@ func (e DimensionUnit) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Reason for export declared to customs.
+type ExportReason string
+
+const (
+	ExportReasonSale   ExportReason = "SALE"
+	ExportReasonGift   ExportReason = "GIFT"
+	ExportReasonSample ExportReason = "SAMPLE"
+	ExportReasonRepair ExportReason = "REPAIR"
+	ExportReasonReturn ExportReason = "RETURN"
+)
+
+var AllExportReason = []ExportReason{
+	ExportReasonSale,
+	ExportReasonGift,
+	ExportReasonSample,
+	ExportReasonRepair,
+	ExportReasonReturn,
+}
+
+func (e ExportReason) IsValid() bool {
+	switch e {
+	case ExportReasonSale, ExportReasonGift, ExportReasonSample, ExportReasonRepair, ExportReasonReturn:
+		return true
+	}
+	return false
+}
+
+func (e ExportReason) String() string {
+	return string(e)
+}
+
+func (e *ExportReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExportReason", str)
+	}
+	return nil
+}
+
+func (e ExportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ExportReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ExportReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Who pays the duties and taxes of an international shipment.
+type Incoterm string
+
+const (
+	// Delivered duty unpaid, the recipient pays.
+	IncotermDdu Incoterm = "DDU"
+	// Delivered duty paid, the shipper pays.
+	IncotermDdp Incoterm = "DDP"
+)
+
+var AllIncoterm = []Incoterm{
+	IncotermDdu,
+	IncotermDdp,
+}
+
+func (e Incoterm) IsValid() bool {
+	switch e {
+	case IncotermDdu, IncotermDdp:
+		return true
+	}
+	return false
+}
+
+func (e Incoterm) String() string {
+	return string(e)
+}
+
+func (e *Incoterm) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Incoterm(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Incoterm", str)
+	}
+	return nil
+}
+
+func (e Incoterm) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Incoterm) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Incoterm) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Label format for shipping labels.
 type LabelFormat string
 
@@ -460,6 +1060,181 @@ func (e PackageType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Status of a carrier pickup.
+type PickupStatus string
+
+const (
+	PickupStatusScheduled PickupStatus = "SCHEDULED"
+	PickupStatusCancelled PickupStatus = "CANCELLED"
+)
+
+var AllPickupStatus = []PickupStatus{
+	PickupStatusScheduled,
+	PickupStatusCancelled,
+}
+
+func (e PickupStatus) IsValid() bool {
+	switch e {
+	case PickupStatusScheduled, PickupStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e PickupStatus) String() string {
+	return string(e)
+}
+
+func (e *PickupStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PickupStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PickupStatus", str)
+	}
+	return nil
+}
+
+func (e PickupStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PickupStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PickupStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Order in which quoted rates are listed.
+type RateSort string
+
+const (
+	// Lowest total price first.
+	RateSortCheapest RateSort = "CHEAPEST"
+	// Fewest transit days first.
+	RateSortFastest RateSort = "FASTEST"
+	// Best balance of price and transit days first.
+	RateSortBestValue RateSort = "BEST_VALUE"
+)
+
+var AllRateSort = []RateSort{
+	RateSortCheapest,
+	RateSortFastest,
+	RateSortBestValue,
+}
+
+func (e RateSort) IsValid() bool {
+	switch e {
+	case RateSortCheapest, RateSortFastest, RateSortBestValue:
+		return true
+	}
+	return false
+}
+
+func (e RateSort) String() string {
+	return string(e)
+}
+
+func (e *RateSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RateSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RateSort", str)
+	}
+	return nil
+}
+
+func (e RateSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RateSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RateSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Marks a notable rate of a quote.
+type RateTag string
+
+const (
+	RateTagCheapest    RateTag = "CHEAPEST"
+	RateTagFastest     RateTag = "FASTEST"
+	RateTagRecommended RateTag = "RECOMMENDED"
+)
+
+var AllRateTag = []RateTag{
+	RateTagCheapest,
+	RateTagFastest,
+	RateTagRecommended,
+}
+
+func (e RateTag) IsValid() bool {
+	switch e {
+	case RateTagCheapest, RateTagFastest, RateTagRecommended:
+		return true
+	}
+	return false
+}
+
+func (e RateTag) String() string {
+	return string(e)
+}
+
+func (e *RateTag) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RateTag(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RateTag", str)
+	}
+	return nil
+}
+
+func (e RateTag) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RateTag) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RateTag) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Service type classification.
 type ServiceType string
 
@@ -596,6 +1371,135 @@ func (e ShipmentStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Progress of a webhook delivery.
+type WebhookDeliveryState string
+
+const (
+	// Not attempted yet.
+	WebhookDeliveryStatePending WebhookDeliveryState = "PENDING"
+	// Acknowledged by the receiver with a 2xx response.
+	WebhookDeliveryStateDelivered WebhookDeliveryState = "DELIVERED"
+	// The last attempt failed and another one is scheduled.
+	WebhookDeliveryStateFailed WebhookDeliveryState = "FAILED"
+	// Out of attempts. Only delivered again when replayed.
+	WebhookDeliveryStateDead WebhookDeliveryState = "DEAD"
+)
+
+var AllWebhookDeliveryState = []WebhookDeliveryState{
+	WebhookDeliveryStatePending,
+	WebhookDeliveryStateDelivered,
+	WebhookDeliveryStateFailed,
+	WebhookDeliveryStateDead,
+}
+
+func (e WebhookDeliveryState) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatePending, WebhookDeliveryStateDelivered, WebhookDeliveryStateFailed, WebhookDeliveryStateDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryState) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryState", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Order lifecycle event sent to webhook subscriptions.
+type WebhookEventType string
+
+const (
+	WebhookEventTypeOrderCreated   WebhookEventType = "ORDER_CREATED"
+	WebhookEventTypeOrderCancelled WebhookEventType = "ORDER_CANCELLED"
+	WebhookEventTypeLabelReady     WebhookEventType = "LABEL_READY"
+	// The carrier reported a new status.
+	WebhookEventTypeTrackingUpdated WebhookEventType = "TRACKING_UPDATED"
+	WebhookEventTypeDelivered       WebhookEventType = "DELIVERED"
+	WebhookEventTypeException       WebhookEventType = "EXCEPTION"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeOrderCreated,
+	WebhookEventTypeOrderCancelled,
+	WebhookEventTypeLabelReady,
+	WebhookEventTypeTrackingUpdated,
+	WebhookEventTypeDelivered,
+	WebhookEventTypeException,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeOrderCreated, WebhookEventTypeOrderCancelled, WebhookEventTypeLabelReady, WebhookEventTypeTrackingUpdated, WebhookEventTypeDelivered, WebhookEventTypeException:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Weight unit of measurement.
 type WeightUnit string
 
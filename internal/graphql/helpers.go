@@ -1,11 +1,16 @@
 package graphql
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tournevent/logistic/internal/graphql/generated"
+	"github.com/tournevent/logistic/internal/manifest"
+	"github.com/tournevent/logistic/internal/webhook"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 )
 
 func addressInputToModel(input *generated.AddressInput) shipper.Address {
@@ -63,14 +68,16 @@ func contactInputToModel(input *generated.ContactInput) shipper.Contact {
 	return contact
 }
 
-func packagesInputToModel(inputs []*generated.PackageInput) []shipper.Package {
+// packagesInputToModel converts package inputs. It fails when a declared
+// value is more precise than its currency allows.
+func packagesInputToModel(inputs []*generated.PackageInput) ([]shipper.Package, error) {
 	packages := make([]shipper.Package, len(inputs))
 	for i, input := range inputs {
 		pkg := shipper.Package{
-			Length: parseDecimal(input.Length),
-			Width:  parseDecimal(input.Width),
-			Height: parseDecimal(input.Height),
-			Weight: parseDecimal(input.Weight),
+			Length: input.Length.Float64(),
+			Width:  input.Width.Float64(),
+			Height: input.Height.Float64(),
+			Weight: input.Weight.Float64(),
 		}
 		if input.DimensionUnit != nil {
 			pkg.DimensionUnit = dimensionUnitToModel(*input.DimensionUnit)
@@ -91,16 +98,77 @@ func packagesInputToModel(inputs []*generated.PackageInput) []shipper.Package {
 			pkg.Description = *input.Description
 		}
 		if input.DeclaredValue != nil {
-			pkg.DeclaredValue = parseDecimal(*input.DeclaredValue)
-		}
-		if input.Currency != nil {
-			pkg.Currency = *input.Currency
-		} else {
-			pkg.Currency = "CAD"
+			currency := shipper.DefaultCurrency
+			if input.Currency != nil {
+				currency = *input.Currency
+			}
+			value, err := shipper.NewMoney(*input.DeclaredValue, currency)
+			if err != nil {
+				return nil, fmt.Errorf("packages[%d].declaredValue: %w", i, err)
+			}
+			pkg.DeclaredValue = value
 		}
 		packages[i] = pkg
 	}
-	return packages
+	return packages, nil
+}
+
+// customsInputToModel converts a customs declaration input, or returns nil
+// without one. It fails when a unit value is more precise than the
+// declaration's currency allows.
+func customsInputToModel(input *generated.CustomsInput) (*shipper.Customs, error) {
+	if input == nil {
+		return nil, nil
+	}
+	customs := &shipper.Customs{
+		Items:           make([]shipper.CustomsItem, len(input.Items)),
+		ReasonForExport: exportReasonToModel(input.ReasonForExport),
+		Incoterm:        shipper.IncotermDDU,
+		Currency:        shipper.DefaultCurrency,
+	}
+	if input.Incoterm != nil {
+		customs.Incoterm = incotermToModel(*input.Incoterm)
+	}
+	if input.Currency != nil {
+		customs.Currency = *input.Currency
+	}
+	if input.InvoiceNumber != nil {
+		customs.InvoiceNumber = *input.InvoiceNumber
+	}
+	if input.ImporterTaxID != nil {
+		customs.ImporterTaxID = *input.ImporterTaxID
+	}
+	if input.ExporterTaxID != nil {
+		customs.ExporterTaxID = *input.ExporterTaxID
+	}
+	if input.ExportDeclarationNumber != nil {
+		customs.ExportDeclarationNumber = *input.ExportDeclarationNumber
+	}
+
+	for i, item := range input.Items {
+		value, err := shipper.NewMoney(item.UnitValue, customs.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("customs.items[%d].unitValue: %w", i, err)
+		}
+		customs.Items[i] = shipper.CustomsItem{
+			Description:     item.Description,
+			Quantity:        item.Quantity,
+			UnitValue:       value,
+			UnitWeight:      item.UnitWeight.Float64(),
+			WeightUnit:      shipper.WeightKG,
+			CountryOfOrigin: item.CountryOfOrigin,
+		}
+		if item.WeightUnit != nil {
+			customs.Items[i].WeightUnit = weightUnitToModel(*item.WeightUnit)
+		}
+		if item.HsCode != nil {
+			customs.Items[i].HSCode = *item.HsCode
+		}
+		if item.Sku != nil {
+			customs.Items[i].SKU = *item.Sku
+		}
+	}
+	return customs, nil
 }
 
 func optionsInputToModel(input *generated.ShippingOptionsInput) shipper.ShippingOptions {
@@ -149,7 +217,163 @@ func rateToGraphQL(rate *shipper.RateOption) *generated.RateOption {
 		ExpiresAt:         rate.ExpiresAt,
 		SignatureRequired: &rate.SignatureRequired,
 		Guaranteed:        &rate.Guaranteed,
+		BillableWeight:    weightToGraphQL(rate.BillableWeight, rate.BillableWeightUnit),
+		Tags:              []generated.RateTag{},
+	}
+}
+
+func rankedRateToGraphQL(rate *rating.Rate) *generated.RateOption {
+	result := rateToGraphQL(&rate.RateOption)
+	for _, t := range rate.Tags {
+		result.Tags = append(result.Tags, rateTagToEnum(t))
+	}
+	result.Score = rate.Score
+	return result
+}
+
+// rankOptionsToModel converts the sort and filter of a quote input. Service
+// types come from the shipping options. It fails when the maximum price is
+// more precise than its currency allows.
+func rankOptionsToModel(input generated.GetQuoteInput, weights rating.Weights) (rating.Options, error) {
+	opts := rating.Options{Sort: rating.SortCheapest, Weights: weights}
+	if input.Sort != nil {
+		opts.Sort = rateSortToModel(*input.Sort)
+	}
+	if input.Options != nil {
+		for _, st := range input.Options.ServiceTypes {
+			opts.Filter.ServiceTypes = append(opts.Filter.ServiceTypes, serviceTypeToModel(st))
+		}
+	}
+
+	f := input.Filter
+	if f == nil {
+		return opts, nil
+	}
+	if f.GuaranteedOnly != nil {
+		opts.Filter.GuaranteedOnly = *f.GuaranteedOnly
+	}
+	if f.MaxTransitDays != nil {
+		opts.Filter.MaxTransitDays = *f.MaxTransitDays
+	}
+	if f.MaxPrice != nil {
+		currency := shipper.DefaultCurrency
+		if f.MaxPriceCurrency != nil {
+			currency = *f.MaxPriceCurrency
+		}
+		maxPrice, err := shipper.NewMoney(*f.MaxPrice, currency)
+		if err != nil {
+			return opts, fmt.Errorf("filter.maxPrice: %w", err)
+		}
+		opts.Filter.MaxPrice = maxPrice
+	}
+	return opts, nil
+}
+
+func carrierQuoteToGraphQL(q *shipper.CarrierQuote) *generated.CarrierQuoteResult {
+	result := &generated.CarrierQuoteResult{
+		Carrier:   carrierNameToEnumValue(q.Carrier),
+		Status:    quoteStatusToEnum(q.Status),
+		LatencyMs: int(q.Latency.Milliseconds()),
+		Retryable: q.Retryable(),
+	}
+	if q.Response != nil {
+		result.QuoteID = &q.Response.QuoteID
+		result.RateCount = len(q.Response.Rates)
+	}
+	if q.Err != nil {
+		code := carrierErrorCode(q.Err, "CARRIER_ERROR")
+		result.ErrorCode = &code
+		if carrierCode := q.ErrorCode(); carrierCode != "" {
+			result.CarrierCode = &carrierCode
+		}
+	}
+	return result
+}
+
+// quoteMetricStatus returns the metric status of a carrier quote, in the
+// vocabulary of the other request metrics.
+func quoteMetricStatus(status shipper.QuoteStatus) string {
+	if status == shipper.QuoteOK {
+		return "success"
+	}
+	return string(status)
+}
+
+func weightToGraphQL(value float64, unit shipper.WeightUnit) *generated.Weight {
+	if value == 0 {
+		return nil
+	}
+	return &generated.Weight{
+		Value: shipper.DecimalFromFloat(value, 3), // Finest carrier precision is a gram
+		Unit:  weightUnitToEnum(unit),
+	}
+}
+
+func capabilitiesToGraphQL(carrier generated.Carrier, caps shipper.Capabilities) *generated.CarrierCapabilities {
+	formats := make([]generated.LabelFormat, len(caps.LabelFormats))
+	for i, f := range caps.LabelFormats {
+		formats[i] = labelFormatToEnum(f)
+	}
+	return &generated.CarrierCapabilities{
+		Carrier:           carrier,
+		LabelFormats:      formats,
+		International:     caps.International,
+		MultiPiece:        caps.MultiPiece,
+		Pickups:           caps.Pickups,
+		Manifests:         caps.Manifests,
+		Returns:           caps.Returns,
+		Insurance:         caps.Insurance,
+		SignatureRequired: caps.SignatureRequired,
+		SaturdayDelivery:  caps.SaturdayDelivery,
+		MaxWeight:         weightToGraphQL(caps.MaxWeight, caps.MaxWeightUnit),
+	}
+}
+
+func manifestResultsToGraphQL(results []manifest.Result) []*generated.ManifestGroup {
+	groups := make([]*generated.ManifestGroup, len(results))
+	for i, r := range results {
+		manifests := make([]*generated.Manifest, len(r.Manifests))
+		for j := range r.Manifests {
+			manifests[j] = &generated.Manifest{
+				ManifestID: r.Manifests[j].ManifestID,
+				Document:   labelToGraphQL(&r.Manifests[j].Document),
+			}
+		}
+		groups[i] = &generated.ManifestGroup{
+			OriginPostalCode: r.Origin.PostalCode,
+			ShipDate:         r.ShipDate,
+			OrderIds:         r.OrderIDs,
+			Manifests:        manifests,
+		}
+	}
+	return groups
+}
+
+func pickupWindowInputToModel(input *generated.PickupWindowInput) shipper.PickupWindow {
+	if input == nil {
+		return shipper.PickupWindow{}
+	}
+	return shipper.PickupWindow{ReadyAt: input.ReadyAt, CloseAt: input.CloseAt}
+}
+
+func pickupWindowToGraphQL(w shipper.PickupWindow) *generated.PickupWindow {
+	return &generated.PickupWindow{ReadyAt: w.ReadyAt, CloseAt: w.CloseAt}
+}
+
+func pickupWindowsToGraphQL(windows []shipper.PickupWindow) []*generated.PickupWindow {
+	result := make([]*generated.PickupWindow, len(windows))
+	for i, w := range windows {
+		result[i] = pickupWindowToGraphQL(w)
+	}
+	return result
+}
+
+func pickupStatusToEnum(s shipper.PickupStatus) *generated.PickupStatus {
+	status := generated.PickupStatusScheduled
+	if s == shipper.PickupCancelled {
+		status = generated.PickupStatusCancelled
 	}
+	return &status
 }
 
 func moneyToGraphQL(m *shipper.Money) *generated.Money {
@@ -157,7 +381,7 @@ func moneyToGraphQL(m *shipper.Money) *generated.Money {
 		return nil
 	}
 	return &generated.Money{
-		Amount:   fmt.Sprintf("%.2f", m.Amount),
+		Amount:   m.Amount(),
 		Currency: m.Currency,
 	}
 }
@@ -175,20 +399,213 @@ func labelToGraphQL(l *shipper.Label) *generated.Label {
 	}
 }
 
+func labelsToGraphQL(labels []shipper.Label) []*generated.Label {
+	if len(labels) == 0 {
+		return nil
+	}
+	result := make([]*generated.Label, len(labels))
+	for i := range labels {
+		result[i] = labelToGraphQL(&labels[i])
+	}
+	return result
+}
+
+func piecesToGraphQL(pieces []shipper.Piece) []*generated.Piece {
+	if len(pieces) == 0 {
+		return nil
+	}
+	result := make([]*generated.Piece, len(pieces))
+	for i, p := range pieces {
+		result[i] = &generated.Piece{
+			PieceID:        p.ID,
+			TrackingNumber: optionalString(p.TrackingNumber),
+			LabelURL:       optionalString(p.LabelURL),
+		}
+	}
+	return result
+}
+
+func trackingEventsToGraphQL(events []shipper.TrackingEvent) []*generated.TrackingEvent {
+	result := make([]*generated.TrackingEvent, len(events))
+	for i, e := range events {
+		result[i] = &generated.TrackingEvent{
+			Timestamp:   e.Timestamp,
+			Description: e.Description,
+			Location:    &e.Location,
+			Status:      *statusToEnum(e.Status),
+			CarrierCode: &e.CarrierCode,
+		}
+	}
+	return result
+}
+
+func addressToGraphQL(addr shipper.Address) *generated.Address {
+	return &generated.Address{
+		Name:          addr.Name,
+		Company:       optionalString(addr.Company),
+		Line1:         addr.Line1,
+		Line2:         optionalString(addr.Line2),
+		City:          addr.City,
+		ProvinceCode:  addr.ProvinceCode,
+		PostalCode:    addr.PostalCode,
+		CountryCode:   addr.CountryCode,
+		Phone:         addr.Phone,
+		Email:         optionalString(addr.Email),
+		Instructions:  optionalString(addr.Instructions),
+		IsResidential: &addr.IsResidential,
+	}
+}
+
+// createOrderResponseToGraphQL converts the response of a created, or
+// replayed, order.
+func createOrderResponseToGraphQL(resp *shipper.CreateOrderResponse, carrierName, requestID string) *generated.OrderResponse {
+	return &generated.OrderResponse{
+		Success:           true,
+		OrderID:           &resp.OrderID,
+		TrackingNumber:    &resp.TrackingNumber,
+		TrackingURL:       &resp.TrackingURL,
+		Status:            statusToEnum(resp.Status),
+		Carrier:           carrierNameToEnum(carrierName),
+		ServiceName:       &resp.ServiceName,
+		TotalCharged:      moneyToGraphQL(&resp.TotalCharged),
+		EstimatedDelivery: resp.EstimatedDelivery,
+		LabelURL:          &resp.LabelURL,
+		Pieces:            piecesToGraphQL(resp.Pieces),
+		PreviouslyCreated: resp.PreviouslyCreated,
+		Metadata:          &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}
+}
+
+func orderToGraphQL(o *shipper.Order) *generated.Order {
+	if o == nil {
+		return nil
+	}
+	history := make([]*generated.StatusChange, len(o.History))
+	for i, h := range o.History {
+		history[i] = &generated.StatusChange{
+			Status:    *statusToEnum(h.Status),
+			Timestamp: h.Timestamp,
+			Source:    optionalString(h.Source),
+		}
+	}
+	return &generated.Order{
+		OrderID:           o.OrderID,
+		ShipperID:         o.ShipperID,
+		Carrier:           carrierNameToEnumValue(o.Carrier),
+		Reference:         optionalString(o.Reference),
+		PoNumber:          optionalString(o.Request.PONumber),
+		TrackingNumber:    optionalString(o.Response.TrackingNumber),
+		TrackingURL:       optionalString(o.Response.TrackingURL),
+		Status:            *statusToEnum(o.Status),
+		ServiceName:       optionalString(o.Response.ServiceName),
+		TotalCharged:      moneyToGraphQL(&o.Response.TotalCharged),
+		EstimatedDelivery: o.Response.EstimatedDelivery,
+		LabelURL:          optionalString(o.Response.LabelURL),
+		Pieces:            piecesToGraphQL(o.Response.Pieces),
+		SenderAddress:     addressToGraphQL(o.Request.SenderAddress),
+		RecipientAddress:  addressToGraphQL(o.Request.RecipientAddress),
+		StatusHistory:     history,
+		ReturnOf:          optionalString(o.ReturnOf),
+		CreatedAt:         o.CreatedAt,
+		UpdatedAt:         o.UpdatedAt,
+	}
+}
+
+func webhookSubscriptionToGraphQL(sub *webhook.Subscription) *generated.WebhookSubscription {
+	events := make([]generated.WebhookEventType, len(sub.Events))
+	for i, t := range sub.Events {
+		events[i] = webhookEventTypeToEnum(t)
+	}
+	return &generated.WebhookSubscription{
+		ID:        sub.ID,
+		ShipperID: sub.ShipperID,
+		URL:       sub.URL,
+		Events:    events,
+		CreatedAt: sub.CreatedAt,
+	}
+}
+
+func webhookDeliveryToGraphQL(d *webhook.Delivery) *generated.WebhookDelivery {
+	result := &generated.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.Event.ID,
+		EventType:      webhookEventTypeToEnum(d.Event.Type),
+		OrderID:        d.Event.OrderID,
+		State:          webhookDeliveryStateToEnum(d.State),
+		Attempts:       d.Attempts,
+		LastError:      optionalString(d.LastError),
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+	if d.LastStatusCode != 0 {
+		result.LastStatusCode = &d.LastStatusCode
+	}
+	if d.State.Due() {
+		result.NextAttemptAt = &d.NextAttemptAt
+	}
+	return result
+}
+
 func errorsToGraphQL(errs []error) []*generated.Error {
 	if len(errs) == 0 {
 		return nil
 	}
-	result := make([]*generated.Error, len(errs))
-	for i, err := range errs {
+	var result []*generated.Error
+	for _, err := range errs {
+		result = append(result, errorToGraphQL(err, "CARRIER_ERROR")...)
+	}
+	return result
+}
+
+// errorToGraphQL converts a failed call into GraphQL errors. Validation
+// failures yield one error per invalid field, and carrier errors keep the
+// carrier's own code.
+func errorToGraphQL(err error, fallback string) []*generated.Error {
+	var carrierCode *string
+	prefix := ""
+	var shipperErr *shipper.ShipperError
+	if errors.As(err, &shipperErr) {
+		carrierCode = &shipperErr.Code
+		prefix = shipperErr.Carrier + ": "
+	}
+
+	fields := shipper.ValidationFields(err)
+	if len(fields) == 0 {
+		return []*generated.Error{{Code: carrierErrorCode(err, fallback), Message: err.Error(), CarrierCode: carrierCode}}
+	}
+	result := make([]*generated.Error, len(fields))
+	for i, f := range fields {
 		result[i] = &generated.Error{
-			Code:    "CARRIER_ERROR",
-			Message: err.Error(),
+			Code:        carrierErrorCode(f, fallback),
+			Message:     prefix + f.Error(),
+			Field:       &f.Field,
+			CarrierCode: carrierCode,
 		}
 	}
 	return result
 }
 
+// carrierErrorCode returns the error code for a failed carrier call, or
+// fallback when the failure has no more specific code.
+func carrierErrorCode(err error, fallback string) string {
+	switch {
+	case errors.Is(err, shipper.ErrUnsupportedOption):
+		return "UNSUPPORTED_OPTION"
+	case errors.Is(err, shipper.ErrInvalidAddress):
+		return "INVALID_ADDRESS"
+	case errors.Is(err, shipper.ErrInvalidPackage):
+		return "INVALID_PACKAGE"
+	case errors.Is(err, shipper.ErrInvalidPickup):
+		return "INVALID_PICKUP"
+	case errors.Is(err, shipper.ErrInvalidCustoms):
+		return "INVALID_CUSTOMS"
+	default:
+		return fallback
+	}
+}
+
 func carrierEnumToName(c generated.Carrier) string {
 	switch c {
 	case generated.CarrierFreightcom:
@@ -231,30 +648,48 @@ func carrierNameToEnumValue(name string) generated.Carrier {
 	}
 }
 
-func carrierFromRateID(rateID string) string {
-	if strings.HasPrefix(rateID, "fc-") {
-		return "freightcom"
-	}
-	if strings.HasPrefix(rateID, "cp-") {
-		return "canadapost"
-	}
-	if strings.HasPrefix(rateID, "puro-") {
-		return "purolator"
+func quoteErrorCode(err error) string {
+	switch {
+	case errors.Is(err, shipper.ErrQuoteNotFound):
+		return "QUOTE_NOT_FOUND"
+	case errors.Is(err, shipper.ErrQuoteExpired):
+		return "QUOTE_EXPIRED"
+	default:
+		return "QUOTE_LOOKUP_FAILED"
 	}
-	return "unknown"
 }
 
-func carrierFromOrderID(orderID string) string {
-	if strings.HasPrefix(orderID, "fc-") {
-		return "freightcom"
+func idempotencyErrorCode(err error) string {
+	switch {
+	case errors.Is(err, shipper.ErrIdempotencyKeyReused):
+		return "IDEMPOTENCY_KEY_REUSED"
+	case errors.Is(err, shipper.ErrIdempotencyKeyInUse):
+		return "IDEMPOTENCY_KEY_IN_USE"
+	case errors.Is(err, shipper.ErrOrderNotFound):
+		return "ORDER_NOT_FOUND"
+	default:
+		return "IDEMPOTENCY_CHECK_FAILED"
 	}
-	if strings.HasPrefix(orderID, "cp-") {
-		return "canadapost"
+}
+
+func webhookErrorCode(err error, fallback string) string {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidSubscription):
+		return "INVALID_INPUT"
+	case errors.Is(err, webhook.ErrSubscriptionNotFound):
+		return "WEBHOOK_NOT_FOUND"
+	case errors.Is(err, webhook.ErrDeliveryNotFound):
+		return "WEBHOOK_DELIVERY_NOT_FOUND"
+	default:
+		return fallback
 	}
-	if strings.HasPrefix(orderID, "puro-") {
-		return "purolator"
+}
+
+func orderErrorCode(err error) string {
+	if errors.Is(err, shipper.ErrOrderNotFound) {
+		return "ORDER_NOT_FOUND"
 	}
-	return "unknown"
+	return "ORDER_LOOKUP_FAILED"
 }
 
 func statusToEnum(s shipper.ShipmentStatus) *generated.ShipmentStatus {
@@ -346,6 +781,140 @@ func weightUnitToModel(wu generated.WeightUnit) shipper.WeightUnit {
 	}
 }
 
+func weightUnitToEnum(wu shipper.WeightUnit) generated.WeightUnit {
+	switch wu {
+	case shipper.WeightLB:
+		return generated.WeightUnitLb
+	default:
+		return generated.WeightUnitKg
+	}
+}
+
+func exportReasonToModel(r generated.ExportReason) shipper.ExportReason {
+	switch r {
+	case generated.ExportReasonSale:
+		return shipper.ExportSale
+	case generated.ExportReasonGift:
+		return shipper.ExportGift
+	case generated.ExportReasonSample:
+		return shipper.ExportSample
+	case generated.ExportReasonRepair:
+		return shipper.ExportRepair
+	case generated.ExportReasonReturn:
+		return shipper.ExportReturn
+	default:
+		return shipper.ExportReason(strings.ToLower(string(r)))
+	}
+}
+
+func incotermToModel(i generated.Incoterm) shipper.Incoterm {
+	switch i {
+	case generated.IncotermDdp:
+		return shipper.IncotermDDP
+	case generated.IncotermDdu:
+		return shipper.IncotermDDU
+	default:
+		return shipper.Incoterm(i)
+	}
+}
+
+func quoteStatusToEnum(s shipper.QuoteStatus) generated.CarrierQuoteStatus {
+	switch s {
+	case shipper.QuoteOK:
+		return generated.CarrierQuoteStatusOk
+	case shipper.QuoteTimeout:
+		return generated.CarrierQuoteStatusTimeout
+	case shipper.QuoteSkipped:
+		return generated.CarrierQuoteStatusSkipped
+	default:
+		return generated.CarrierQuoteStatusError
+	}
+}
+
+func rateSortToModel(s generated.RateSort) rating.Sort {
+	switch s {
+	case generated.RateSortFastest:
+		return rating.SortFastest
+	case generated.RateSortBestValue:
+		return rating.SortBestValue
+	default:
+		return rating.SortCheapest
+	}
+}
+
+func rateTagToEnum(t rating.Tag) generated.RateTag {
+	switch t {
+	case rating.TagCheapest:
+		return generated.RateTagCheapest
+	case rating.TagFastest:
+		return generated.RateTagFastest
+	default:
+		return generated.RateTagRecommended
+	}
+}
+
+func webhookEventTypeToModel(t generated.WebhookEventType) webhook.EventType {
+	switch t {
+	case generated.WebhookEventTypeOrderCreated:
+		return webhook.EventOrderCreated
+	case generated.WebhookEventTypeOrderCancelled:
+		return webhook.EventOrderCancelled
+	case generated.WebhookEventTypeLabelReady:
+		return webhook.EventLabelReady
+	case generated.WebhookEventTypeTrackingUpdated:
+		return webhook.EventTrackingUpdated
+	case generated.WebhookEventTypeDelivered:
+		return webhook.EventDelivered
+	case generated.WebhookEventTypeException:
+		return webhook.EventException
+	default:
+		return webhook.EventType(strings.ToLower(string(t)))
+	}
+}
+
+func webhookEventTypeToEnum(t webhook.EventType) generated.WebhookEventType {
+	switch t {
+	case webhook.EventOrderCreated:
+		return generated.WebhookEventTypeOrderCreated
+	case webhook.EventOrderCancelled:
+		return generated.WebhookEventTypeOrderCancelled
+	case webhook.EventLabelReady:
+		return generated.WebhookEventTypeLabelReady
+	case webhook.EventDelivered:
+		return generated.WebhookEventTypeDelivered
+	case webhook.EventException:
+		return generated.WebhookEventTypeException
+	default:
+		return generated.WebhookEventTypeTrackingUpdated
+	}
+}
+
+func webhookDeliveryStateToModel(s generated.WebhookDeliveryState) webhook.DeliveryState {
+	switch s {
+	case generated.WebhookDeliveryStateDelivered:
+		return webhook.DeliveryDelivered
+	case generated.WebhookDeliveryStateFailed:
+		return webhook.DeliveryFailed
+	case generated.WebhookDeliveryStateDead:
+		return webhook.DeliveryDead
+	default:
+		return webhook.DeliveryPending
+	}
+}
+
+func webhookDeliveryStateToEnum(s webhook.DeliveryState) generated.WebhookDeliveryState {
+	switch s {
+	case webhook.DeliveryDelivered:
+		return generated.WebhookDeliveryStateDelivered
+	case webhook.DeliveryFailed:
+		return generated.WebhookDeliveryStateFailed
+	case webhook.DeliveryDead:
+		return generated.WebhookDeliveryStateDead
+	default:
+		return generated.WebhookDeliveryStatePending
+	}
+}
+
 func packageTypeToModel(pt generated.PackageType) shipper.PackageType {
 	switch pt {
 	case generated.PackageTypeBox:
@@ -389,8 +958,9 @@ func labelFormatToEnum(lf shipper.LabelFormat) generated.LabelFormat {
 	}
 }
 
-func parseDecimal(s string) float64 {
-	var f float64
-	fmt.Sscanf(s, "%f", &f)
-	return f
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
@@ -1,12 +1,16 @@
 package graphql
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tournevent/logistic/internal/graphql/generated"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 )
 
 func TestAddressInputToModel(t *testing.T) {
@@ -99,16 +103,16 @@ func TestPackagesInputToModel(t *testing.T) {
 	weightUnit := generated.WeightUnitLb
 	pkgType := generated.PackageTypeEnvelope
 	description := "Documents"
-	declaredValue := "100.00"
+	declaredValue := decimal(t, "100.00")
 	currency := "USD"
 
 	inputs := []*generated.PackageInput{
 		{
-			Length:        "10",
-			Width:         "8",
-			Height:        "2",
+			Length:        decimal(t, "10"),
+			Width:         decimal(t, "8"),
+			Height:        decimal(t, "2"),
 			DimensionUnit: &dimUnit,
-			Weight:        "1.5",
+			Weight:        decimal(t, "1.5"),
 			WeightUnit:    &weightUnit,
 			PackageType:   &pkgType,
 			Description:   &description,
@@ -117,8 +121,9 @@ func TestPackagesInputToModel(t *testing.T) {
 		},
 	}
 
-	result := packagesInputToModel(inputs)
+	result, err := packagesInputToModel(inputs)
 
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, float64(10), result[0].Length)
 	assert.Equal(t, float64(8), result[0].Width)
@@ -128,27 +133,118 @@ func TestPackagesInputToModel(t *testing.T) {
 	assert.Equal(t, shipper.WeightLB, result[0].WeightUnit)
 	assert.Equal(t, shipper.PackageEnvelope, result[0].PackageType)
 	assert.Equal(t, "Documents", result[0].Description)
-	assert.Equal(t, float64(100), result[0].DeclaredValue)
-	assert.Equal(t, "USD", result[0].Currency)
+	assert.Equal(t, shipper.Money{Minor: 10000, Currency: "USD"}, result[0].DeclaredValue)
 }
 
 func TestPackagesInputToModel_Defaults(t *testing.T) {
+	declaredValue := decimal(t, "25")
 	inputs := []*generated.PackageInput{
 		{
-			Length: "10",
-			Width:  "10",
-			Height: "10",
-			Weight: "5",
+			Length:        decimal(t, "10"),
+			Width:         decimal(t, "10"),
+			Height:        decimal(t, "10"),
+			Weight:        decimal(t, "5"),
+			DeclaredValue: &declaredValue,
 		},
 	}
 
-	result := packagesInputToModel(inputs)
+	result, err := packagesInputToModel(inputs)
 
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, shipper.DimensionCM, result[0].DimensionUnit)
 	assert.Equal(t, shipper.WeightKG, result[0].WeightUnit)
 	assert.Equal(t, shipper.PackageBox, result[0].PackageType)
-	assert.Equal(t, "CAD", result[0].Currency)
+	assert.Equal(t, shipper.Money{Minor: 2500, Currency: "CAD"}, result[0].DeclaredValue)
+}
+
+func TestPackagesInputToModel_DeclaredValueTooPrecise(t *testing.T) {
+	declaredValue := decimal(t, "10.005")
+	inputs := []*generated.PackageInput{
+		{Length: decimal(t, "1"), Width: decimal(t, "1"), Height: decimal(t, "1"), Weight: decimal(t, "1")},
+		{Length: decimal(t, "1"), Width: decimal(t, "1"), Height: decimal(t, "1"), Weight: decimal(t, "1"), DeclaredValue: &declaredValue},
+	}
+
+	_, err := packagesInputToModel(inputs)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+	assert.Contains(t, err.Error(), "packages[1].declaredValue")
+}
+
+func TestCustomsInputToModel(t *testing.T) {
+	incoterm := generated.IncotermDdp
+	currency := "USD"
+	weightUnit := generated.WeightUnitLb
+	hsCode := "6109.10"
+	input := &generated.CustomsInput{
+		Items: []*generated.CustomsItemInput{{
+			Description:     "Cotton t-shirt",
+			Quantity:        3,
+			UnitValue:       decimal(t, "19.99"),
+			UnitWeight:      decimal(t, "0.5"),
+			WeightUnit:      &weightUnit,
+			CountryOfOrigin: "CA",
+			HsCode:          &hsCode,
+		}},
+		ReasonForExport: generated.ExportReasonGift,
+		Incoterm:        &incoterm,
+		Currency:        &currency,
+		ImporterTaxID:   ptr("12-3456789"),
+	}
+
+	result, err := customsInputToModel(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, &shipper.Customs{
+		Items: []shipper.CustomsItem{{
+			Description:     "Cotton t-shirt",
+			Quantity:        3,
+			UnitValue:       shipper.Money{Minor: 1999, Currency: "USD"},
+			UnitWeight:      0.5,
+			WeightUnit:      shipper.WeightLB,
+			CountryOfOrigin: "CA",
+			HSCode:          "6109.10",
+		}},
+		ReasonForExport: shipper.ExportGift,
+		Incoterm:        shipper.IncotermDDP,
+		Currency:        "USD",
+		ImporterTaxID:   "12-3456789",
+	}, result)
+}
+
+func TestCustomsInputToModel_Defaults(t *testing.T) {
+	input := &generated.CustomsInput{
+		Items:           []*generated.CustomsItemInput{{Description: "Book", Quantity: 1, UnitValue: decimal(t, "20"), UnitWeight: decimal(t, "1"), CountryOfOrigin: "CA"}},
+		ReasonForExport: generated.ExportReasonSale,
+	}
+
+	result, err := customsInputToModel(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, shipper.IncotermDDU, result.Incoterm)
+	assert.Equal(t, "CAD", result.Currency)
+	assert.Equal(t, shipper.WeightKG, result.Items[0].WeightUnit)
+	assert.Equal(t, shipper.Money{Minor: 2000, Currency: "CAD"}, result.Items[0].UnitValue)
+}
+
+func TestCustomsInputToModel_Nil(t *testing.T) {
+	result, err := customsInputToModel(nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCustomsInputToModel_UnitValueTooPrecise(t *testing.T) {
+	input := &generated.CustomsInput{
+		Items:           []*generated.CustomsItemInput{{Description: "Book", Quantity: 1, UnitValue: decimal(t, "19.999"), UnitWeight: decimal(t, "1"), CountryOfOrigin: "CA"}},
+		ReasonForExport: generated.ExportReasonSale,
+	}
+
+	_, err := customsInputToModel(input)
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+	assert.Contains(t, err.Error(), "customs.items[0].unitValue")
 }
 
 func TestOptionsInputToModel(t *testing.T) {
@@ -221,42 +317,66 @@ func TestCarrierNameToEnum(t *testing.T) {
 	}
 }
 
-func TestCarrierFromRateID(t *testing.T) {
+func TestQuoteErrorCode(t *testing.T) {
 	tests := []struct {
-		rateID   string
+		err      error
 		expected string
 	}{
-		{"fc-rate-standard-123", "freightcom"},
-		{"cp-DOM.RP-20231215", "canadapost"},
-		{"puro-PurolatorGround-123", "purolator"},
-		{"unknown-rate-123", "unknown"},
+		{fmt.Errorf("%w: rate-123", shipper.ErrQuoteNotFound), "QUOTE_NOT_FOUND"},
+		{fmt.Errorf("%w: rate-123", shipper.ErrQuoteExpired), "QUOTE_EXPIRED"},
+		{errors.New("disk full"), "QUOTE_LOOKUP_FAILED"},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.rateID, func(t *testing.T) {
-			result := carrierFromRateID(tt.rateID)
-			assert.Equal(t, tt.expected, result)
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, quoteErrorCode(tt.err))
 		})
 	}
 }
 
-func TestCarrierFromOrderID(t *testing.T) {
-	tests := []struct {
-		orderID  string
-		expected string
-	}{
-		{"fc-order-123", "freightcom"},
-		{"cp-ship-12345678", "canadapost"},
-		{"puro-ship-12345678", "purolator"},
-		{"unknown-order-123", "unknown"},
-	}
+func TestOrderErrorCode(t *testing.T) {
+	assert.Equal(t, "ORDER_NOT_FOUND", orderErrorCode(fmt.Errorf("%w: 123", shipper.ErrOrderNotFound)))
+	assert.Equal(t, "ORDER_LOOKUP_FAILED", orderErrorCode(errors.New("disk full")))
+}
 
-	for _, tt := range tests {
-		t.Run(tt.orderID, func(t *testing.T) {
-			result := carrierFromOrderID(tt.orderID)
-			assert.Equal(t, tt.expected, result)
-		})
+func TestOrderToGraphQL(t *testing.T) {
+	now := time.Now()
+	order := &shipper.Order{
+		OrderID:   "329012345678901",
+		ShipperID: "shipper-123",
+		Carrier:   "purolator",
+		Reference: "INV-1001",
+		Request: shipper.CreateOrderRequest{
+			RecipientAddress: shipper.Address{Name: "Jane Smith", City: "Vancouver"},
+		},
+		Response: shipper.CreateOrderResponse{
+			TrackingNumber: "329012345678901",
+			TotalCharged:   shipper.Money{Minor: 2550, Currency: "CAD"},
+		},
+		Status: shipper.StatusInTransit,
+		History: []shipper.StatusChange{
+			{Status: shipper.StatusConfirmed, Timestamp: now.Add(-time.Hour), Source: "create_order"},
+			{Status: shipper.StatusInTransit, Timestamp: now, Source: "track"},
+		},
+		CreatedAt: now.Add(-time.Hour),
+		UpdatedAt: now,
 	}
+
+	result := orderToGraphQL(order)
+
+	assert.Equal(t, "329012345678901", result.OrderID)
+	assert.Equal(t, generated.CarrierPurolator, result.Carrier)
+	assert.Equal(t, "INV-1001", *result.Reference)
+	assert.Nil(t, result.PoNumber)
+	assert.Equal(t, generated.ShipmentStatusInTransit, result.Status)
+	assert.Equal(t, "25.50", result.TotalCharged.Amount.String())
+	assert.Equal(t, "Vancouver", result.RecipientAddress.City)
+	require.Len(t, result.StatusHistory, 2)
+	assert.Equal(t, "track", *result.StatusHistory[1].Source)
+}
+
+func TestOrderToGraphQL_Nil(t *testing.T) {
+	assert.Nil(t, orderToGraphQL(nil))
 }
 
 func TestStatusToEnum(t *testing.T) {
@@ -363,10 +483,10 @@ func TestLabelFormatToEnum(t *testing.T) {
 }
 
 func TestMoneyToGraphQL(t *testing.T) {
-	money := &shipper.Money{Amount: 25.99, Currency: "CAD"}
+	money := &shipper.Money{Minor: 2599, Currency: "CAD"}
 	result := moneyToGraphQL(money)
 
-	assert.Equal(t, "25.99", result.Amount)
+	assert.Equal(t, "25.99", result.Amount.String())
 	assert.Equal(t, "CAD", result.Currency)
 }
 
@@ -383,20 +503,22 @@ func TestRateToGraphQL(t *testing.T) {
 	guaranteed := true
 
 	rate := &shipper.RateOption{
-		RateID:            "fc-rate-express-123",
-		Carrier:           "freightcom",
-		ServiceCode:       "EXPRESS",
-		ServiceName:       "Express Shipping",
-		ServiceType:       shipper.ServiceExpress,
-		BaseRate:          shipper.Money{Amount: 20.00, Currency: "CAD"},
-		FuelSurcharge:     shipper.Money{Amount: 2.50, Currency: "CAD"},
-		Taxes:             shipper.Money{Amount: 2.93, Currency: "CAD"},
-		TotalPrice:        shipper.Money{Amount: 25.43, Currency: "CAD"},
-		TransitDays:       transitDays,
-		EstimatedDelivery: &estimatedDelivery,
-		ExpiresAt:         expiresAt,
-		SignatureRequired: signatureRequired,
-		Guaranteed:        guaranteed,
+		RateID:             "fc-rate-express-123",
+		Carrier:            "freightcom",
+		ServiceCode:        "EXPRESS",
+		ServiceName:        "Express Shipping",
+		ServiceType:        shipper.ServiceExpress,
+		BaseRate:           shipper.Money{Minor: 2000, Currency: "CAD"},
+		FuelSurcharge:      shipper.Money{Minor: 250, Currency: "CAD"},
+		Taxes:              shipper.Money{Minor: 293, Currency: "CAD"},
+		TotalPrice:         shipper.Money{Minor: 2543, Currency: "CAD"},
+		TransitDays:        transitDays,
+		EstimatedDelivery:  &estimatedDelivery,
+		ExpiresAt:          expiresAt,
+		SignatureRequired:  signatureRequired,
+		Guaranteed:         guaranteed,
+		BillableWeight:     4.536,
+		BillableWeightUnit: shipper.WeightKG,
 	}
 
 	result := rateToGraphQL(rate)
@@ -406,15 +528,78 @@ func TestRateToGraphQL(t *testing.T) {
 	assert.Equal(t, "EXPRESS", result.ServiceCode)
 	assert.Equal(t, "Express Shipping", result.ServiceName)
 	assert.Equal(t, generated.ServiceTypeExpress, result.ServiceType)
-	assert.Equal(t, "20.00", result.BaseRate.Amount)
-	assert.Equal(t, "2.50", result.FuelSurcharge.Amount)
-	assert.Equal(t, "2.93", result.Taxes.Amount)
-	assert.Equal(t, "25.43", result.TotalPrice.Amount)
+	assert.Equal(t, "20.00", result.BaseRate.Amount.String())
+	assert.Equal(t, "2.50", result.FuelSurcharge.Amount.String())
+	assert.Equal(t, "2.93", result.Taxes.Amount.String())
+	assert.Equal(t, "25.43", result.TotalPrice.Amount.String())
 	assert.Equal(t, &transitDays, result.TransitDays)
 	assert.Equal(t, &estimatedDelivery, result.EstimatedDelivery)
 	assert.Equal(t, expiresAt, result.ExpiresAt)
 	assert.Equal(t, &signatureRequired, result.SignatureRequired)
 	assert.Equal(t, &guaranteed, result.Guaranteed)
+	assert.Equal(t, &generated.Weight{Value: decimal(t, "4.536"), Unit: generated.WeightUnitKg}, result.BillableWeight)
+	assert.Empty(t, result.Tags)
+	assert.NotNil(t, result.Tags)
+}
+
+func TestRankedRateToGraphQL(t *testing.T) {
+	rate := &rating.Rate{
+		RateOption: shipper.RateOption{RateID: "cp-rate-1", Carrier: "canadapost", TotalPrice: shipper.Money{Minor: 1582, Currency: "CAD"}},
+		Score:      0.25,
+		Tags:       []rating.Tag{rating.TagCheapest, rating.TagRecommended},
+	}
+
+	result := rankedRateToGraphQL(rate)
+
+	assert.Equal(t, "cp-rate-1", result.RateID)
+	assert.Equal(t, []generated.RateTag{generated.RateTagCheapest, generated.RateTagRecommended}, result.Tags)
+	assert.Equal(t, 0.25, result.Score)
+}
+
+func TestRankOptionsToModel(t *testing.T) {
+	sort := generated.RateSortBestValue
+	maxPrice := decimal(t, "45.50")
+	input := generated.GetQuoteInput{
+		Sort: &sort,
+		Filter: &generated.RateFilterInput{
+			GuaranteedOnly:   ptr(true),
+			MaxTransitDays:   ptr(3),
+			MaxPrice:         &maxPrice,
+			MaxPriceCurrency: ptr("USD"),
+		},
+		Options: &generated.ShippingOptionsInput{ServiceTypes: []generated.ServiceType{generated.ServiceTypeExpress}},
+	}
+	weights := rating.Weights{Price: 1, Speed: 1}
+
+	opts, err := rankOptionsToModel(input, weights)
+
+	require.NoError(t, err)
+	assert.Equal(t, rating.Options{
+		Sort:    rating.SortBestValue,
+		Weights: weights,
+		Filter: rating.Filter{
+			ServiceTypes:   []shipper.ServiceType{shipper.ServiceExpress},
+			GuaranteedOnly: true,
+			MaxTransitDays: 3,
+			MaxPrice:       shipper.Money{Minor: 4550, Currency: "USD"},
+		},
+	}, opts)
+}
+
+func TestRankOptionsToModel_Defaults(t *testing.T) {
+	opts, err := rankOptionsToModel(generated.GetQuoteInput{}, rating.DefaultWeights)
+
+	require.NoError(t, err)
+	assert.Equal(t, rating.Options{Sort: rating.SortCheapest, Weights: rating.DefaultWeights}, opts)
+}
+
+func TestRankOptionsToModel_MaxPriceTooPrecise(t *testing.T) {
+	maxPrice := decimal(t, "10.005")
+
+	_, err := rankOptionsToModel(generated.GetQuoteInput{Filter: &generated.RateFilterInput{MaxPrice: &maxPrice}}, rating.DefaultWeights)
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+	assert.Contains(t, err.Error(), "filter.maxPrice")
 }
 
 func TestLabelToGraphQL(t *testing.T) {
@@ -451,36 +636,58 @@ func TestErrorsToGraphQL(t *testing.T) {
 	result := errorsToGraphQL(errs)
 
 	assert.Len(t, result, 2)
-	assert.Equal(t, "CARRIER_ERROR", result[0].Code)
+	assert.Equal(t, "INVALID_ADDRESS", result[0].Code)
 	assert.Equal(t, "CARRIER_ERROR", result[1].Code)
 }
 
+func TestErrorsToGraphQL_Validation(t *testing.T) {
+	errs := []error{
+		fmt.Errorf("canadapost: %w", shipper.NewPackageLimitError("canadapost", &shipper.ValidationError{Fields: []*shipper.FieldError{
+			{Field: "packages[1].weight", Message: "must not exceed 30 kg for this carrier", Err: shipper.ErrInvalidPackage},
+		}})),
+		&shipper.ValidationError{Fields: []*shipper.FieldError{
+			{Field: "origin.postalCode", Message: "is required", Err: shipper.ErrInvalidAddress},
+		}},
+	}
+
+	result := errorsToGraphQL(errs)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "INVALID_PACKAGE", result[0].Code)
+	assert.Equal(t, ptr("packages[1].weight"), result[0].Field)
+	assert.Equal(t, ptr("invalid_package"), result[0].CarrierCode)
+	assert.Equal(t, "canadapost: packages[1].weight: must not exceed 30 kg for this carrier", result[0].Message)
+	assert.Equal(t, "INVALID_ADDRESS", result[1].Code)
+	assert.Equal(t, ptr("origin.postalCode"), result[1].Field)
+	assert.Nil(t, result[1].CarrierCode)
+}
+
 func TestErrorsToGraphQL_Empty(t *testing.T) {
 	result := errorsToGraphQL(nil)
 	assert.Nil(t, result)
 }
 
-func TestParseDecimal(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected float64
-	}{
-		{"10", 10.0},
-		{"10.5", 10.5},
-		{"0.99", 0.99},
-		{"100.00", 100.0},
-		{"", 0.0},
-		{"invalid", 0.0},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			result := parseDecimal(tt.input)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
+func decimal(t *testing.T, s string) shipper.Decimal {
+	t.Helper()
+	d, err := shipper.ParseDecimal(s)
+	require.NoError(t, err)
+	return d
 }
 
 func ptr[T any](v T) *T {
 	return &v
 }
+
+func TestErrorsToGraphQL_UnsupportedOption(t *testing.T) {
+	errs := []error{
+		fmt.Errorf("canadapost: %w", shipper.NewUnsupportedOptionError("canadapost", shipper.OptionSaturdayDelivery)),
+		errors.New("purolator: timeout"),
+	}
+
+	result := errorsToGraphQL(errs)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "UNSUPPORTED_OPTION", result[0].Code)
+	assert.Contains(t, result[0].Message, "saturday_delivery is not supported by canadapost")
+	assert.Equal(t, "CARRIER_ERROR", result[1].Code)
+}
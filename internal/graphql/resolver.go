@@ -1,24 +1,253 @@
 package graphql
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/tournevent/logistic/internal/store"
 	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/internal/webhook"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
 )
 
+// maxOrdersPageSize caps the page size accepted by the delivro_orders query.
+const maxOrdersPageSize = 100
+
 // Resolver is the root resolver for the GraphQL schema.
 // It holds dependencies needed by all resolvers.
 type Resolver struct {
-	Registry *shipper.Registry
-	Logger   *otelzap.Logger
-	Metrics  *telemetry.Metrics
+	Registry   *shipper.Registry
+	Logger     *otelzap.Logger
+	Metrics    *telemetry.Metrics
+	QuoteStore shipper.QuoteStore
+	OrderStore shipper.OrderStore
+
+	// Orders created with client-supplied idempotency keys
+	IdempotencyStore shipper.IdempotencyStore
+
+	// Delivers order lifecycle events to subscribed shippers
+	Webhooks *webhook.Dispatcher
+
+	// Balance of price and speed in best value rankings
+	RatingWeights rating.Weights
 }
 
 // NewResolver creates a new resolver with the given dependencies.
+// Quotes, orders, idempotency keys and webhooks are kept in memory unless
+// the stores are replaced.
 func NewResolver(registry *shipper.Registry, logger *otelzap.Logger, metrics *telemetry.Metrics) *Resolver {
 	return &Resolver{
-		Registry: registry,
-		Logger:   logger,
-		Metrics:  metrics,
+		Registry:         registry,
+		Logger:           logger,
+		Metrics:          metrics,
+		QuoteStore:       store.NewMemoryQuoteStore(),
+		OrderStore:       store.NewMemoryOrderStore(),
+		IdempotencyStore: store.NewMemoryIdempotencyStore(),
+		Webhooks:         webhook.NewDispatcher(store.NewMemoryWebhookStore(), logger, webhook.Config{}),
+		RatingWeights:    rating.DefaultWeights,
+	}
+}
+
+// recordStatus appends a status reported by a carrier to the order history
+// and notifies the shipper of the change. Failures are logged rather than
+// returned since the carrier call succeeded.
+func (r *Resolver) recordStatus(ctx context.Context, order *shipper.Order, status shipper.ShipmentStatus, source string) {
+	change := shipper.StatusChange{Status: status, Timestamp: time.Now(), Source: source}
+	if _, err := r.applyStatus(ctx, order, change); err != nil {
+		r.Logger.Error("Failed to update order status",
+			zap.String("order_id", order.OrderID),
+			zap.String("status", string(status)),
+			zap.Error(err),
+		)
+	}
+}
+
+// applyStatus records a status change in the order history and notifies
+// the shipper if the status changed.
+func (r *Resolver) applyStatus(ctx context.Context, order *shipper.Order, change shipper.StatusChange) (bool, error) {
+	changed, err := r.OrderStore.UpdateStatus(ctx, order.OrderID, change)
+	if err != nil || !changed {
+		return false, err
+	}
+
+	updated := *order
+	updated.Status = change.Status
+	for _, t := range webhook.StatusEvents(change.Status) {
+		r.publish(ctx, webhook.OrderEvent(t, &updated))
+	}
+	return true, nil
+}
+
+// RecordTrackingUpdates records tracking events reported by a carrier in
+// the history of their orders, oldest first, and notifies shippers of
+// status changes. source tells how the events were obtained, e.g. "push"
+// or "poll". Events of shipments not booked through Delivro are skipped,
+// as are events no newer than the last carrier event of the order, pushed
+// or polled: carriers report their full history again on every poll or
+// push retry, and a poll must not replay what a push already recorded.
+// Changes stamped with our own clock, such as those of create_order or
+// delivro_track_shipment, are not compared with carrier times. Orders
+// never move back to an earlier status, nor away from delivered or
+// cancelled (see shipper.Order.AcceptsStatus). It returns the number of
+// status changes recorded.
+func (r *Resolver) RecordTrackingUpdates(ctx context.Context, carrierName, source string, updates []shipper.TrackingUpdate) (int, error) {
+	updates = slices.Clone(updates)
+	slices.SortStableFunc(updates, func(a, b shipper.TrackingUpdate) int {
+		return a.Event.Timestamp.Compare(b.Event.Timestamp)
+	})
+
+	recorded := 0
+	for _, update := range updates {
+		order, err := r.trackedOrder(ctx, carrierName, update)
+		if errors.Is(err, shipper.ErrOrderNotFound) {
+			r.Logger.Debug("Skipping tracking event of unknown shipment",
+				zap.String("carrier", carrierName),
+				zap.String("order_id", update.OrderID),
+				zap.String("tracking_number", update.TrackingNumber),
+			)
+			continue
+		}
+		if err != nil {
+			return recorded, err
+		}
+
+		change := shipper.StatusChange{Status: update.Event.Status, Timestamp: update.Event.Timestamp, Source: source, CarrierTime: true}
+		if change.Timestamp.IsZero() {
+			// An event without a time cannot be told from a repeat: only
+			// the order's own status checks apply
+			change.Timestamp = time.Now()
+			change.CarrierTime = false
+		} else if last := lastCarrierChange(order); last != nil && !change.Timestamp.After(last.Timestamp) {
+			continue
+		}
+		changed, err := r.applyStatus(ctx, order, change)
+		if err != nil {
+			return recorded, err
+		}
+		if changed {
+			recorded++
+		}
+	}
+	return recorded, nil
+}
+
+// trackedOrder returns the order of the carrier a tracking update is
+// about, by carrier order ID or else by tracking number.
+func (r *Resolver) trackedOrder(ctx context.Context, carrierName string, update shipper.TrackingUpdate) (*shipper.Order, error) {
+	if update.OrderID == "" {
+		return r.OrderStore.GetOrderByTrackingNumber(ctx, carrierName, update.TrackingNumber)
+	}
+	order, err := r.OrderStore.GetOrder(ctx, update.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Carrier != carrierName {
+		return nil, fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, update.OrderID)
+	}
+	return order, nil
+}
+
+// shipperOrder returns a stored order of a shipper. Orders of other
+// shippers are reported as ErrOrderNotFound, so that their existence is not
+// revealed.
+func (r *Resolver) shipperOrder(ctx context.Context, shipperID, orderID string) (*shipper.Order, error) {
+	order, err := r.OrderStore.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.ShipperID != shipperID {
+		return nil, fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, orderID)
+	}
+	return order, nil
+}
+
+// lastCarrierChange returns the latest entry of the order history stamped
+// with a carrier's event time, or nil if there is none.
+func lastCarrierChange(order *shipper.Order) *shipper.StatusChange {
+	for i := len(order.History) - 1; i >= 0; i-- {
+		if order.History[i].CarrierTime {
+			return &order.History[i]
+		}
+	}
+	return nil
+}
+
+// publish queues the webhook deliveries of an event. Failures are logged
+// rather than returned since the operation it reports succeeded.
+func (r *Resolver) publish(ctx context.Context, event webhook.Event) {
+	if err := r.Webhooks.Publish(ctx, event); err != nil {
+		r.Logger.Error("Failed to publish webhook event",
+			zap.String("event_type", string(event.Type)),
+			zap.String("order_id", event.OrderID),
+			zap.Error(err),
+		)
+	}
+}
+
+// publishCreated notifies the shipper of a new order, and of its label
+// when the carrier returned one.
+func (r *Resolver) publishCreated(ctx context.Context, order *shipper.Order) {
+	r.publish(ctx, webhook.OrderEvent(webhook.EventOrderCreated, order))
+	if order.Response.LabelURL != "" {
+		r.publish(ctx, webhook.OrderEvent(webhook.EventLabelReady, order))
+	}
+}
+
+// claimIdempotencyKey reserves the idempotency key of an order request.
+// For a replay it returns the order first created with the key, and it
+// fails with ErrIdempotencyKeyReused if the key was used with a different
+// request or ErrIdempotencyKeyInUse if that request is still in flight.
+// Requests without a key are never replays.
+func (r *Resolver) claimIdempotencyKey(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.Order, error) {
+	if req.IdempotencyKey == "" {
+		return nil, nil
+	}
+	fingerprint := req.Fingerprint()
+	record, claimed, err := r.IdempotencyStore.Claim(ctx, req.ShipperID, req.IdempotencyKey, fingerprint)
+	if err != nil || claimed {
+		return nil, err
+	}
+	switch {
+	case record.Fingerprint != fingerprint:
+		return nil, fmt.Errorf("%w: %s", shipper.ErrIdempotencyKeyReused, req.IdempotencyKey)
+	case record.Pending():
+		return nil, fmt.Errorf("%w: %s", shipper.ErrIdempotencyKeyInUse, req.IdempotencyKey)
+	}
+	return r.OrderStore.GetOrder(ctx, record.OrderID)
+}
+
+// releaseIdempotencyKey frees the idempotency key of an order the carrier
+// rejected, so that the client can retry with the same key.
+func (r *Resolver) releaseIdempotencyKey(ctx context.Context, req *shipper.CreateOrderRequest) {
+	if req.IdempotencyKey == "" {
+		return
+	}
+	if err := r.IdempotencyStore.Release(ctx, req.ShipperID, req.IdempotencyKey); err != nil {
+		r.Logger.Error("Failed to release idempotency key",
+			zap.String("shipper_id", req.ShipperID),
+			zap.String("idempotency_key", req.IdempotencyKey),
+			zap.Error(err),
+		)
+	}
+}
+
+// pickupScheduler returns the pickup scheduler of a carrier. It fails with
+// ErrCarrierNotFound for unknown carriers and ErrUnsupportedOption for
+// carriers that do not book pickups.
+func (r *Resolver) pickupScheduler(carrierName string) (shipper.PickupScheduler, error) {
+	carrier, err := r.Registry.Get(carrierName)
+	if err != nil {
+		return nil, err
+	}
+	scheduler, ok := shipper.As[shipper.PickupScheduler](carrier)
+	if !ok {
+		return nil, shipper.NewUnsupportedOptionError(carrierName, shipper.OptionPickup)
 	}
+	return scheduler, nil
 }
@@ -2,14 +2,19 @@ package graphql_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tournevent/logistic/internal/graphql"
 	"github.com/tournevent/logistic/internal/graphql/generated"
 	"github.com/tournevent/logistic/internal/telemetry"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/canadapost"
 	"github.com/tournevent/logistic/pkg/shipper/mock"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
 	"go.uber.org/zap"
@@ -28,6 +33,86 @@ func newTestResolver() (*graphql.Resolver, *shipper.Registry) {
 	return resolver, registry
 }
 
+// storeRate records a single rate for shipper-123 and returns its ID.
+func storeRate(t *testing.T, resolver *graphql.Resolver, carrier string, expiresAt time.Time) string {
+	t.Helper()
+
+	rateID := carrier + "-rate-" + uuid.New().String()
+	err := resolver.QuoteStore.SaveQuote(context.Background(), "shipper-123", &shipper.QuoteResponse{
+		QuoteID: carrier + "-quote-123",
+		Rates: []shipper.RateOption{
+			{RateID: rateID, Carrier: carrier, ServiceID: "STANDARD", ExpiresAt: expiresAt},
+		},
+	})
+	require.NoError(t, err)
+	return rateID
+}
+
+// storeOrder records a confirmed order for shipper-123 and returns its ID.
+func storeOrder(t *testing.T, resolver *graphql.Resolver, carrier, reference string) string {
+	t.Helper()
+
+	now := time.Now()
+	orderID := uuid.New().String()
+	err := resolver.OrderStore.SaveOrder(context.Background(), &shipper.Order{
+		OrderID:   orderID,
+		ShipperID: "shipper-123",
+		Carrier:   carrier,
+		Reference: reference,
+		Response:  shipper.CreateOrderResponse{OrderID: orderID, TrackingNumber: "TRK-" + orderID, Status: shipper.StatusConfirmed},
+		Status:    shipper.StatusConfirmed,
+		History:   []shipper.StatusChange{{Status: shipper.StatusConfirmed, Timestamp: now, Source: "create_order"}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	require.NoError(t, err)
+	return orderID
+}
+
+// newPackageInput returns a 10x10x10 cm, 5 kg package.
+func newPackageInput() *generated.PackageInput {
+	return &generated.PackageInput{
+		Length: shipper.DecimalFromFloat(10, 0),
+		Width:  shipper.DecimalFromFloat(10, 0),
+		Height: shipper.DecimalFromFloat(10, 0),
+		Weight: shipper.DecimalFromFloat(5, 0),
+	}
+}
+
+func newCreateOrderInput(rateID string) generated.CreateOrderInput {
+	return generated.CreateOrderInput{
+		ShipperID: "shipper-123",
+		RateID:    rateID,
+		Sender: &generated.ContactInput{
+			Name:  "John Doe",
+			Phone: "416-555-1234",
+		},
+		SenderAddress: &generated.AddressInput{
+			Name:         "John Doe",
+			Line1:        "123 Main St",
+			City:         "Toronto",
+			ProvinceCode: "ON",
+			PostalCode:   "M5V1A1",
+			Phone:        "416-555-1234",
+		},
+		Recipient: &generated.ContactInput{
+			Name:  "Jane Smith",
+			Phone: "604-555-5678",
+		},
+		RecipientAddress: &generated.AddressInput{
+			Name:         "Jane Smith",
+			Line1:        "456 Oak Ave",
+			City:         "Vancouver",
+			ProvinceCode: "BC",
+			PostalCode:   "V6B2W2",
+			Phone:        "604-555-5678",
+		},
+		Packages: []*generated.PackageInput{
+			newPackageInput(),
+		},
+	}
+}
+
 func TestMutation_DelivroGetQuote_Success(t *testing.T) {
 	resolver, _ := newTestResolver()
 	mutation := resolver.Mutation()
@@ -51,7 +136,7 @@ func TestMutation_DelivroGetQuote_Success(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -66,6 +151,160 @@ func TestMutation_DelivroGetQuote_Success(t *testing.T) {
 	assert.NotEmpty(t, resp.Metadata.RequestID)
 }
 
+func TestMutation_DelivroGetQuote_InvalidInput(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	weightless := newPackageInput()
+	weightless.Weight = shipper.DecimalFromFloat(0, 0)
+	country := "XX"
+	input := generated.GetQuoteInput{
+		ShipperID:   "shipper-123",
+		Origin:      &generated.AddressInput{Name: "Sender", Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "", Phone: "416-555-1234"},
+		Destination: &generated.AddressInput{Name: "Receiver", Line1: "456 Oak Ave", City: "Nowhere", PostalCode: "00000", Phone: "604-555-5678", CountryCode: &country},
+		Packages:    []*generated.PackageInput{newPackageInput(), weightless},
+	}
+
+	resp, err := mutation.DelivroGetQuote(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Empty(t, resp.Rates)
+	require.Len(t, resp.Errors, 3)
+	fields := make([]string, len(resp.Errors))
+	for i, e := range resp.Errors {
+		require.NotNil(t, e.Field)
+		fields[i] = *e.Field
+	}
+	assert.Equal(t, []string{"origin.postalCode", "destination.countryCode", "packages[1].weight"}, fields)
+	assert.Equal(t, "INVALID_ADDRESS", resp.Errors[0].Code)
+	assert.Equal(t, "origin.postalCode: is required", resp.Errors[0].Message)
+	assert.Equal(t, "INVALID_ADDRESS", resp.Errors[1].Code)
+	assert.Equal(t, "INVALID_PACKAGE", resp.Errors[2].Code)
+}
+
+func newGetQuoteInput() generated.GetQuoteInput {
+	return generated.GetQuoteInput{
+		ShipperID:   "shipper-123",
+		Origin:      &generated.AddressInput{Name: "Sender", Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", Phone: "416-555-1234"},
+		Destination: &generated.AddressInput{Name: "Receiver", Line1: "456 Oak Ave", City: "Vancouver", ProvinceCode: "BC", PostalCode: "V6B2W2", Phone: "604-555-5678"},
+		Packages:    []*generated.PackageInput{newPackageInput()},
+	}
+}
+
+func TestMutation_DelivroGetQuote_Ranked(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroGetQuote(context.Background(), newGetQuoteInput())
+
+	require.NoError(t, err)
+	require.Len(t, resp.Rates, 6)
+	services := make([]string, len(resp.Rates))
+	for i, rate := range resp.Rates {
+		services[i] = string(rate.Carrier) + " " + rate.ServiceCode
+	}
+	assert.Equal(t, []string{
+		"CANADA_POST STANDARD", "FREIGHTCOM STANDARD", "PUROLATOR STANDARD",
+		"CANADA_POST EXPRESS", "FREIGHTCOM EXPRESS", "PUROLATOR EXPRESS",
+	}, services)
+	assert.Equal(t, []generated.RateTag{generated.RateTagCheapest, generated.RateTagRecommended}, resp.Rates[0].Tags)
+	assert.Equal(t, []generated.RateTag{generated.RateTagFastest}, resp.Rates[3].Tags)
+	assert.Empty(t, resp.Rates[1].Tags)
+}
+
+func TestMutation_DelivroGetQuote_SortAndFilter(t *testing.T) {
+	resolver, _ := newTestResolver()
+	input := newGetQuoteInput()
+	sort := generated.RateSortFastest
+	maxPrice := shipper.DecimalFromFloat(30, 2)
+	input.Sort = &sort
+	input.Filter = &generated.RateFilterInput{MaxPrice: &maxPrice}
+	input.Options = &generated.ShippingOptionsInput{
+		Carriers:     []generated.Carrier{generated.CarrierPurolator},
+		ServiceTypes: []generated.ServiceType{generated.ServiceTypeExpress},
+	}
+
+	resp, err := resolver.Mutation().DelivroGetQuote(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Rates, 1)
+	assert.Equal(t, "EXPRESS", resp.Rates[0].ServiceCode)
+
+	guaranteedOnly := true
+	transitDays := 1
+	input.Filter = &generated.RateFilterInput{GuaranteedOnly: &guaranteedOnly, MaxTransitDays: &transitDays}
+
+	resp, err = resolver.Mutation().DelivroGetQuote(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success, "the carrier answered, with no matching rates")
+	assert.Empty(t, resp.Rates)
+	require.Len(t, resp.CarrierResults, 1)
+	assert.Equal(t, 2, resp.CarrierResults[0].RateCount)
+}
+
+// failingQuoteShipper fails every quote with err.
+type failingQuoteShipper struct {
+	shipper.Shipper
+	err error
+}
+
+func (s failingQuoteShipper) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	return nil, s.err
+}
+
+func TestMutation_DelivroGetQuote_CarrierResults(t *testing.T) {
+	resolver, registry := newTestResolver()
+	outage := shipper.NewShipperError("purolator", "HTTP_503", "Service Unavailable").WithRetryable(true)
+	registry.Register(failingQuoteShipper{Shipper: mock.New("purolator"), err: outage})
+	registry.Register(failingQuoteShipper{Shipper: mock.New("canadapost"), err: context.DeadlineExceeded})
+
+	resp, err := resolver.Mutation().DelivroGetQuote(context.Background(), newGetQuoteInput())
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Len(t, resp.Rates, 2)
+	require.Len(t, resp.Errors, 2)
+	require.Len(t, resp.CarrierResults, 3)
+
+	canadaPost, freightcom, purolator := resp.CarrierResults[0], resp.CarrierResults[1], resp.CarrierResults[2]
+	assert.Equal(t, generated.CarrierCanadaPost, canadaPost.Carrier)
+	assert.Equal(t, generated.CarrierQuoteStatusTimeout, canadaPost.Status)
+	assert.Nil(t, canadaPost.QuoteID)
+
+	assert.Equal(t, generated.CarrierFreightcom, freightcom.Carrier)
+	assert.Equal(t, generated.CarrierQuoteStatusOk, freightcom.Status)
+	require.NotNil(t, freightcom.QuoteID)
+	assert.Equal(t, *resp.QuoteID, *freightcom.QuoteID)
+	assert.Equal(t, 2, freightcom.RateCount)
+	assert.Nil(t, freightcom.ErrorCode)
+
+	assert.Equal(t, generated.CarrierPurolator, purolator.Carrier)
+	assert.Equal(t, generated.CarrierQuoteStatusError, purolator.Status)
+	require.NotNil(t, purolator.ErrorCode)
+	assert.Equal(t, "CARRIER_ERROR", *purolator.ErrorCode)
+	require.NotNil(t, purolator.CarrierCode)
+	assert.Equal(t, "HTTP_503", *purolator.CarrierCode)
+	assert.True(t, purolator.Retryable)
+}
+
+func TestMutation_DelivroGetQuote_AllCarriersFail(t *testing.T) {
+	resolver, registry := newTestResolver()
+	for _, name := range []string{"freightcom", "canadapost", "purolator"} {
+		registry.Register(failingQuoteShipper{Shipper: mock.New(name), err: errors.New("connection refused")})
+	}
+
+	resp, err := resolver.Mutation().DelivroGetQuote(context.Background(), newGetQuoteInput())
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Len(t, resp.Errors, 3)
+	for _, result := range resp.CarrierResults {
+		assert.Equal(t, generated.CarrierQuoteStatusError, result.Status)
+		assert.False(t, result.Retryable)
+	}
+}
+
 func TestMutation_DelivroGetQuote_WithCarrierFilter(t *testing.T) {
 	resolver, _ := newTestResolver()
 	mutation := resolver.Mutation()
@@ -89,7 +328,7 @@ func TestMutation_DelivroGetQuote_WithCarrierFilter(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 		Options: &generated.ShippingOptionsInput{
 			Carriers: []generated.Carrier{generated.CarrierFreightcom},
@@ -133,7 +372,7 @@ func TestMutation_DelivroGetQuote_EmptyRegistry(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -152,7 +391,7 @@ func TestMutation_DelivroCreateOrder_Success(t *testing.T) {
 
 	input := generated.CreateOrderInput{
 		ShipperID: "shipper-123",
-		RateID:    "fc-rate-standard-123", // freightcom prefix
+		RateID:    storeRate(t, resolver, "freightcom", time.Now().Add(time.Hour)),
 		Sender: &generated.ContactInput{
 			Name:  "John Doe",
 			Phone: "416-555-1234",
@@ -178,7 +417,7 @@ func TestMutation_DelivroCreateOrder_Success(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -193,13 +432,49 @@ func TestMutation_DelivroCreateOrder_Success(t *testing.T) {
 	assert.Equal(t, generated.ShipmentStatusConfirmed, *resp.Status)
 }
 
+func TestMutation_DelivroCreateOrder_International(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	input := newCreateOrderInput(storeRate(t, resolver, "freightcom", time.Now().Add(time.Hour)))
+	country := "US"
+	input.RecipientAddress.ProvinceCode = "CA"
+	input.RecipientAddress.PostalCode = "90210"
+	input.RecipientAddress.CountryCode = &country
+
+	resp, err := mutation.DelivroCreateOrder(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "INVALID_CUSTOMS", resp.Errors[0].Code)
+	require.NotNil(t, resp.Errors[0].Field)
+	assert.Equal(t, "customs", *resp.Errors[0].Field)
+
+	input.Customs = &generated.CustomsInput{
+		Items: []*generated.CustomsItemInput{{
+			Description:     "Cotton t-shirt",
+			Quantity:        2,
+			UnitValue:       shipper.DecimalFromFloat(25, 2),
+			UnitWeight:      shipper.DecimalFromFloat(0.2, 1),
+			CountryOfOrigin: "CA",
+		}},
+		ReasonForExport: generated.ExportReasonSale,
+	}
+
+	resp, err = mutation.DelivroCreateOrder(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
 func TestMutation_DelivroCreateOrder_CanadaPost(t *testing.T) {
 	resolver, _ := newTestResolver()
 	mutation := resolver.Mutation()
 
 	input := generated.CreateOrderInput{
 		ShipperID: "shipper-123",
-		RateID:    "cp-DOM.RP-123", // canadapost prefix
+		RateID:    storeRate(t, resolver, "canadapost", time.Now().Add(time.Hour)),
 		Sender: &generated.ContactInput{
 			Name:  "John Doe",
 			Phone: "416-555-1234",
@@ -225,7 +500,7 @@ func TestMutation_DelivroCreateOrder_CanadaPost(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -243,7 +518,7 @@ func TestMutation_DelivroCreateOrder_Purolator(t *testing.T) {
 
 	input := generated.CreateOrderInput{
 		ShipperID: "shipper-123",
-		RateID:    "puro-PurolatorGround-123", // purolator prefix
+		RateID:    storeRate(t, resolver, "purolator", time.Now().Add(time.Hour)),
 		Sender: &generated.ContactInput{
 			Name:  "John Doe",
 			Phone: "416-555-1234",
@@ -269,7 +544,7 @@ func TestMutation_DelivroCreateOrder_Purolator(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -287,7 +562,7 @@ func TestMutation_DelivroCreateOrder_CarrierNotFound(t *testing.T) {
 
 	input := generated.CreateOrderInput{
 		ShipperID: "shipper-123",
-		RateID:    "unknown-rate-123", // unknown carrier prefix
+		RateID:    storeRate(t, resolver, "unknown", time.Now().Add(time.Hour)),
 		Sender: &generated.ContactInput{
 			Name:  "John Doe",
 			Phone: "416-555-1234",
@@ -313,7 +588,7 @@ func TestMutation_DelivroCreateOrder_CarrierNotFound(t *testing.T) {
 			Phone:        "604-555-5678",
 		},
 		Packages: []*generated.PackageInput{
-			{Length: "10", Width: "10", Height: "10", Weight: "5"},
+			newPackageInput(),
 		},
 	}
 
@@ -326,12 +601,80 @@ func TestMutation_DelivroCreateOrder_CarrierNotFound(t *testing.T) {
 	assert.Equal(t, "CARRIER_NOT_FOUND", resp.Errors[0].Code)
 }
 
+func TestMutation_DelivroCreateOrder_FromQuote(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	carriers := []generated.Carrier{generated.CarrierPurolator}
+	quote, err := mutation.DelivroGetQuote(context.Background(), generated.GetQuoteInput{
+		ShipperID:   "shipper-123",
+		Origin:      &generated.AddressInput{Name: "Sender", Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", Phone: "416-555-1234"},
+		Destination: &generated.AddressInput{Name: "Receiver", Line1: "456 Oak Ave", City: "Vancouver", ProvinceCode: "BC", PostalCode: "V6B2W2", Phone: "604-555-5678"},
+		Packages:    []*generated.PackageInput{newPackageInput()},
+		Options:     &generated.ShippingOptionsInput{Carriers: carriers},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, quote.Rates)
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, newCreateOrderInput(quote.Rates[0].RateID))
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, generated.CarrierPurolator, *resp.Carrier)
+}
+
+func TestMutation_DelivroCreateOrder_QuoteNotFound(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, newCreateOrderInput("rate-does-not-exist"))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "QUOTE_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCreateOrder_QuoteExpired(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	rateID := storeRate(t, resolver, "freightcom", time.Now().Add(-time.Minute))
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, newCreateOrderInput(rateID))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "QUOTE_EXPIRED", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCreateOrder_OtherShippersRate(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	input := newCreateOrderInput(storeRate(t, resolver, "freightcom", time.Now().Add(time.Hour)))
+	input.ShipperID = "shipper-456"
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "QUOTE_NOT_FOUND", resp.Errors[0].Code)
+}
+
 func TestMutation_DelivroGetLabel_Success(t *testing.T) {
 	resolver, _ := newTestResolver()
 	mutation := resolver.Mutation()
 
 	input := generated.GetLabelInput{
-		OrderID: "fc-order-123", // freightcom prefix
+		ShipperID: "shipper-123",
+		OrderID:   storeOrder(t, resolver, "freightcom", "ref-1"),
 	}
 
 	ctx := context.Background()
@@ -349,8 +692,9 @@ func TestMutation_DelivroGetLabel_WithFormat(t *testing.T) {
 
 	format := generated.LabelFormatZpl
 	input := generated.GetLabelInput{
-		OrderID: "fc-order-123",
-		Format:  &format,
+		ShipperID: "shipper-123",
+		OrderID:   storeOrder(t, resolver, "freightcom", "ref-1"),
+		Format:    &format,
 	}
 
 	ctx := context.Background()
@@ -366,7 +710,8 @@ func TestMutation_DelivroGetLabel_CarrierNotFound(t *testing.T) {
 	mutation := resolver.Mutation()
 
 	input := generated.GetLabelInput{
-		OrderID: "unknown-order-123", // unknown carrier prefix
+		ShipperID: "shipper-123",
+		OrderID:   storeOrder(t, resolver, "unknown", "ref-1"),
 	}
 
 	ctx := context.Background()
@@ -378,14 +723,81 @@ func TestMutation_DelivroGetLabel_CarrierNotFound(t *testing.T) {
 	assert.Equal(t, "CARRIER_NOT_FOUND", resp.Errors[0].Code)
 }
 
+func TestMutation_DelivroGetLabel_OrderNotFound(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	input := generated.GetLabelInput{
+		ShipperID: "shipper-123",
+		OrderID:   "329012345678901",
+	}
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroGetLabel(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "ORDER_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroGetLabel_MultiplePieces(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var labelled, voided []string
+	mockAPI.OnGetLabel = func(ctx context.Context, shipmentID string, format string) (*canadapost.LabelResponse, error) {
+		labelled = append(labelled, shipmentID)
+		return &canadapost.LabelResponse{ShipmentID: shipmentID, Format: format, Data: []byte(shipmentID)}, nil
+	}
+	mockAPI.OnVoidShipment = func(ctx context.Context, shipmentID string) (*canadapost.VoidResponse, error) {
+		voided = append(voided, shipmentID)
+		return &canadapost.VoidResponse{ShipmentID: shipmentID, Status: "cancelled"}, nil
+	}
+
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(canadapost.NewWithAPIClient(canadapost.Config{}, mockAPI, logger, nil))
+	resolver := graphql.NewResolver(registry, logger, telemetry.NewMetrics())
+	mutation := resolver.Mutation()
+
+	ctx := context.Background()
+	now := time.Now()
+	err := resolver.OrderStore.SaveOrder(ctx, &shipper.Order{
+		OrderID:   "cp-ship-1",
+		ShipperID: "shipper-123",
+		Carrier:   "canadapost",
+		Response: shipper.CreateOrderResponse{
+			OrderID: "cp-ship-1",
+			Pieces:  []shipper.Piece{{ID: "cp-ship-1"}, {ID: "cp-ship-2"}},
+		},
+		Status:    shipper.StatusConfirmed,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	require.NoError(t, err)
+
+	labelResp, err := mutation.DelivroGetLabel(ctx, generated.GetLabelInput{ShipperID: "shipper-123", OrderID: "cp-ship-1"})
+	require.NoError(t, err)
+	assert.True(t, labelResp.Success)
+	assert.NotNil(t, labelResp.Label)
+	assert.Len(t, labelResp.AdditionalLabels, 1)
+	assert.Equal(t, []string{"cp-ship-1", "cp-ship-2"}, labelled)
+
+	cancelResp, err := mutation.DelivroCancelOrder(ctx, generated.CancelOrderInput{ShipperID: "shipper-123", OrderID: "cp-ship-1"})
+	require.NoError(t, err)
+	assert.True(t, cancelResp.Success)
+	assert.Equal(t, []string{"cp-ship-1", "cp-ship-2"}, voided)
+}
+
 func TestMutation_DelivroCancelOrder_Success(t *testing.T) {
 	resolver, _ := newTestResolver()
 	mutation := resolver.Mutation()
 
+	orderID := storeOrder(t, resolver, "freightcom", "ref-1")
 	reason := "Customer requested cancellation"
 	input := generated.CancelOrderInput{
-		OrderID: "fc-order-123", // freightcom prefix
-		Reason:  &reason,
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+		Reason:    &reason,
 	}
 
 	ctx := context.Background()
@@ -397,6 +809,12 @@ func TestMutation_DelivroCancelOrder_Success(t *testing.T) {
 	assert.NotNil(t, resp.Status)
 	assert.Equal(t, generated.ShipmentStatusCancelled, *resp.Status)
 	assert.NotNil(t, resp.ConfirmationNumber)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusCancelled, order.Status)
+	require.Len(t, order.History, 2)
+	assert.Equal(t, "cancel_order", order.History[1].Source)
 }
 
 func TestMutation_DelivroCancelOrder_CarrierNotFound(t *testing.T) {
@@ -404,7 +822,8 @@ func TestMutation_DelivroCancelOrder_CarrierNotFound(t *testing.T) {
 	mutation := resolver.Mutation()
 
 	input := generated.CancelOrderInput{
-		OrderID: "unknown-order-123", // unknown carrier prefix
+		ShipperID: "shipper-123",
+		OrderID:   storeOrder(t, resolver, "unknown", "ref-1"),
 	}
 
 	ctx := context.Background()
@@ -416,57 +835,1100 @@ func TestMutation_DelivroCancelOrder_CarrierNotFound(t *testing.T) {
 	assert.Equal(t, "CARRIER_NOT_FOUND", resp.Errors[0].Code)
 }
 
-func TestQuery_Health(t *testing.T) {
+func TestMutation_DelivroCancelOrder_OrderNotFound(t *testing.T) {
 	resolver, _ := newTestResolver()
-	query := resolver.Query()
+	mutation := resolver.Mutation()
+
+	input := generated.CancelOrderInput{
+		ShipperID: "shipper-123",
+		OrderID:   "329012345678901",
+	}
 
 	ctx := context.Background()
-	healthy, err := query.Health(ctx)
+	resp, err := mutation.DelivroCancelOrder(ctx, input)
 
 	require.NoError(t, err)
-	assert.True(t, healthy)
+	assert.False(t, resp.Success)
+	assert.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "ORDER_NOT_FOUND", resp.Errors[0].Code)
 }
 
-func TestQuery_Carriers(t *testing.T) {
+func TestMutation_DelivroTrackShipment_Success(t *testing.T) {
 	resolver, _ := newTestResolver()
-	query := resolver.Query()
+	mutation := resolver.Mutation()
+
+	trackingNumber := "1Zpur123456789"
+	carrier := generated.CarrierPurolator
+	input := generated.TrackShipmentInput{
+		ShipperID:      "shipper-123",
+		OrderID:        "329012345678901",
+		TrackingNumber: &trackingNumber,
+		Carrier:        &carrier,
+	}
 
 	ctx := context.Background()
-	carriers, err := query.Carriers(ctx)
+	resp, err := mutation.DelivroTrackShipment(ctx, input)
 
 	require.NoError(t, err)
-	assert.Len(t, carriers, 3)
-	assert.Contains(t, carriers, generated.CarrierFreightcom)
-	assert.Contains(t, carriers, generated.CarrierCanadaPost)
-	assert.Contains(t, carriers, generated.CarrierPurolator)
+	assert.True(t, resp.Success)
+	assert.Equal(t, trackingNumber, *resp.TrackingNumber)
+	assert.Equal(t, generated.ShipmentStatusInTransit, *resp.Status)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, generated.ShipmentStatusPickedUp, resp.Events[1].Status)
+	assert.Equal(t, generated.CarrierPurolator, *resp.Metadata.Carrier)
 }
 
-func TestQuery_ServiceTypes(t *testing.T) {
+func TestMutation_DelivroTrackShipment_StoredOrder(t *testing.T) {
 	resolver, _ := newTestResolver()
-	query := resolver.Query()
+	mutation := resolver.Mutation()
+
+	orderID := storeOrder(t, resolver, "canadapost", "ref-1")
+	input := generated.TrackShipmentInput{
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+	}
 
 	ctx := context.Background()
-	serviceTypes, err := query.ServiceTypes(ctx)
+	resp, err := mutation.DelivroTrackShipment(ctx, input)
 
 	require.NoError(t, err)
-	assert.Len(t, serviceTypes, 6)
-	assert.Contains(t, serviceTypes, generated.ServiceTypeStandard)
-	assert.Contains(t, serviceTypes, generated.ServiceTypeExpress)
-	assert.Contains(t, serviceTypes, generated.ServiceTypePriority)
-	assert.Contains(t, serviceTypes, generated.ServiceTypeOvernight)
-	assert.Contains(t, serviceTypes, generated.ServiceTypeEconomy)
-	assert.Contains(t, serviceTypes, generated.ServiceTypeFreight)
+	assert.True(t, resp.Success)
+	assert.Equal(t, "TRK-"+orderID, *resp.TrackingNumber)
+	assert.Equal(t, generated.CarrierCanadaPost, *resp.Metadata.Carrier)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusInTransit, order.Status)
+	require.Len(t, order.History, 2)
+	assert.Equal(t, "track", order.History[1].Source)
 }
 
-func TestResolver_NewResolver(t *testing.T) {
-	registry := shipper.NewRegistry()
-	logger := otelzap.New(zap.NewNop())
-	metrics := telemetry.NewMetrics()
+func TestMutation_OtherShippersOrder(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+	orderID := storeOrder(t, resolver, "canadapost", "ref-1")
+	carrier := generated.CarrierCanadaPost
 
-	resolver := graphql.NewResolver(registry, logger, metrics)
+	ctx := context.Background()
+	labelResp, err := mutation.DelivroGetLabel(ctx, generated.GetLabelInput{ShipperID: "shipper-456", OrderID: orderID})
+	require.NoError(t, err)
+	assert.False(t, labelResp.Success)
+	assert.Nil(t, labelResp.Label)
+	require.Len(t, labelResp.Errors, 1)
+	assert.Equal(t, "ORDER_NOT_FOUND", labelResp.Errors[0].Code)
 
-	assert.NotNil(t, resolver)
-	assert.Equal(t, registry, resolver.Registry)
-	assert.Equal(t, logger, resolver.Logger)
-	assert.Equal(t, metrics, resolver.Metrics)
+	cancelResp, err := mutation.DelivroCancelOrder(ctx, generated.CancelOrderInput{ShipperID: "shipper-456", OrderID: orderID})
+	require.NoError(t, err)
+	assert.False(t, cancelResp.Success)
+	require.Len(t, cancelResp.Errors, 1)
+	assert.Equal(t, "ORDER_NOT_FOUND", cancelResp.Errors[0].Code)
+
+	// Naming the carrier does not fall back to tracking the order directly
+	for _, input := range []generated.TrackShipmentInput{
+		{ShipperID: "shipper-456", OrderID: orderID},
+		{ShipperID: "shipper-456", OrderID: orderID, Carrier: &carrier},
+	} {
+		trackResp, err := mutation.DelivroTrackShipment(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, trackResp.Success)
+		assert.Empty(t, trackResp.Events)
+		require.Len(t, trackResp.Errors, 1)
+		assert.Equal(t, "ORDER_NOT_FOUND", trackResp.Errors[0].Code)
+	}
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusConfirmed, order.Status)
+	assert.Len(t, order.History, 1)
+}
+
+func TestMutation_DelivroTrackShipment_OrderNotFound(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	input := generated.TrackShipmentInput{
+		ShipperID: "shipper-123",
+		OrderID:   "329012345678901", // not stored and no carrier given
+	}
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroTrackShipment(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.NotEmpty(t, resp.Errors)
+	assert.Equal(t, "ORDER_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCreateOrder_RecordsOrder(t *testing.T) {
+	resolver, _ := newTestResolver()
+	mutation := resolver.Mutation()
+
+	reference := "INV-1001"
+	input := newCreateOrderInput(storeRate(t, resolver, "purolator", time.Now().Add(time.Hour)))
+	input.Reference = &reference
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+
+	order, err := resolver.Query().DelivroOrder(ctx, "shipper-123", *resp.OrderID)
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	assert.Equal(t, generated.CarrierPurolator, order.Carrier)
+	assert.Equal(t, "shipper-123", order.ShipperID)
+	assert.Equal(t, reference, *order.Reference)
+	assert.Equal(t, "Vancouver", order.RecipientAddress.City)
+	require.Len(t, order.StatusHistory, 1)
+	assert.Equal(t, generated.ShipmentStatusConfirmed, order.StatusHistory[0].Status)
+}
+
+// countingShipper counts the orders it creates and fails them with err
+// when set.
+type countingShipper struct {
+	shipper.Shipper
+	orders int
+	err    error
+}
+
+func (s *countingShipper) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	s.orders++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.Shipper.CreateOrder(ctx, req)
+}
+
+func TestMutation_DelivroCreateOrder_IdempotentReplay(t *testing.T) {
+	resolver, registry := newTestResolver()
+	carrier := &countingShipper{Shipper: mock.New("canadapost")}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	key := "order-attempt-1"
+	input := newCreateOrderInput(storeRate(t, resolver, "canadapost", time.Now().Add(time.Hour)))
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	first, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, first.Success)
+	assert.False(t, first.PreviouslyCreated)
+
+	replay, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, replay.Success)
+	assert.True(t, replay.PreviouslyCreated)
+	assert.Equal(t, *first.OrderID, *replay.OrderID)
+	assert.Equal(t, *first.TrackingNumber, *replay.TrackingNumber)
+	assert.Equal(t, generated.CarrierCanadaPost, *replay.Carrier)
+	assert.NotEqual(t, first.Metadata.RequestID, replay.Metadata.RequestID)
+	assert.Equal(t, 1, carrier.orders)
+}
+
+func TestMutation_DelivroCreateOrder_ReplayAfterRateExpired(t *testing.T) {
+	resolver, registry := newTestResolver()
+	carrier := &countingShipper{Shipper: mock.New("canadapost")}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	rateID := storeRate(t, resolver, "canadapost", time.Now().Add(time.Hour))
+	key := "order-attempt-1"
+	input := newCreateOrderInput(rateID)
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	first, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, first.Success)
+
+	expireRate := func() {
+		err := resolver.QuoteStore.SaveQuote(ctx, "shipper-123", &shipper.QuoteResponse{
+			QuoteID: "canadapost-quote-123",
+			Rates:   []shipper.RateOption{{RateID: rateID, Carrier: "canadapost", ServiceID: "STANDARD", ExpiresAt: time.Now().Add(-time.Minute)}},
+		})
+		require.NoError(t, err)
+	}
+	expireRate()
+
+	replay, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, replay.Success)
+	assert.True(t, replay.PreviouslyCreated)
+	assert.Equal(t, *first.OrderID, *replay.OrderID)
+	assert.Equal(t, 1, carrier.orders)
+
+	// A new key still needs a live rate, and stays free when it has none
+	other := "order-attempt-2"
+	input.IdempotencyKey = &other
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "QUOTE_EXPIRED", resp.Errors[0].Code)
+
+	input.RateID = storeRate(t, resolver, "canadapost", time.Now().Add(time.Hour))
+	resp, err = mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, 2, carrier.orders)
+}
+
+func TestMutation_DelivroCreateOrder_IdempotencyKeyReused(t *testing.T) {
+	resolver, registry := newTestResolver()
+	carrier := &countingShipper{Shipper: mock.New("purolator")}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	key := "order-attempt-1"
+	input := newCreateOrderInput(storeRate(t, resolver, "purolator", time.Now().Add(time.Hour)))
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	first, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.True(t, first.Success)
+
+	reference := "INV-1002"
+	input.Reference = &reference
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", resp.Errors[0].Code)
+	assert.Equal(t, 1, carrier.orders)
+
+	// The same key is free for another shipper
+	input.ShipperID = "shipper-456"
+	err = resolver.QuoteStore.SaveQuote(ctx, "shipper-456", &shipper.QuoteResponse{
+		QuoteID: "purolator-quote-456",
+		Rates:   []shipper.RateOption{{RateID: "purolator-rate-456", Carrier: "purolator", ServiceID: "STANDARD"}},
+	})
+	require.NoError(t, err)
+	input.RateID = "purolator-rate-456"
+	resp, err = mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.False(t, resp.PreviouslyCreated)
+}
+
+// dedupingShipper answers every order with an existing order, as carriers
+// that deduplicate on a unique ID do.
+type dedupingShipper struct {
+	shipper.Shipper
+	orderID string
+}
+
+func (s *dedupingShipper) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	return &shipper.CreateOrderResponse{OrderID: s.orderID, Status: shipper.StatusConfirmed, PreviouslyCreated: true}, nil
+}
+
+func TestMutation_DelivroCreateOrder_PreviouslyCreatedByAnotherShipper(t *testing.T) {
+	resolver, registry := newTestResolver()
+	orderID := storeOrder(t, resolver, "freightcom", "INV-1001")
+	registry.Register(&dedupingShipper{Shipper: mock.New("freightcom"), orderID: orderID})
+	mutation := resolver.Mutation()
+
+	ctx := context.Background()
+	err := resolver.QuoteStore.SaveQuote(ctx, "shipper-456", &shipper.QuoteResponse{
+		QuoteID: "freightcom-quote-456",
+		Rates:   []shipper.RateOption{{RateID: "freightcom-rate-456", Carrier: "freightcom", ServiceID: "STANDARD"}},
+	})
+	require.NoError(t, err)
+	key := "order-attempt-1"
+	input := newCreateOrderInput("freightcom-rate-456")
+	input.ShipperID = "shipper-456"
+	input.IdempotencyKey = &key
+
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", resp.Errors[0].Code)
+	assert.Nil(t, resp.OrderID)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, "shipper-123", order.ShipperID)
+}
+
+func TestMutation_DelivroCreateOrder_IdempotencyKeyReleasedOnRejection(t *testing.T) {
+	resolver, registry := newTestResolver()
+	carrier := &countingShipper{
+		Shipper: mock.New("freightcom"),
+		err:     shipper.NewShipperError("freightcom", "HTTP_400", "Bad Request").WithStatusCode(http.StatusBadRequest),
+	}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	key := "order-attempt-1"
+	input := newCreateOrderInput(storeRate(t, resolver, "freightcom", time.Now().Add(time.Hour)))
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.False(t, resp.Success)
+
+	carrier.err = nil
+	resp, err = mutation.DelivroCreateOrder(ctx, input)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.False(t, resp.PreviouslyCreated)
+	assert.Equal(t, 2, carrier.orders)
+}
+
+func TestMutation_DelivroCreateOrder_IdempotencyKeyKeptOnUnknownOutcome(t *testing.T) {
+	resolver, registry := newTestResolver()
+	carrier := &countingShipper{
+		Shipper: mock.New("purolator"),
+		err:     shipper.NewShipperError("purolator", "HTTP_503", "Service Unavailable").WithStatusCode(http.StatusServiceUnavailable),
+	}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	key := "order-attempt-1"
+	input := newCreateOrderInput(storeRate(t, resolver, "purolator", time.Now().Add(time.Hour)))
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.False(t, resp.Success)
+
+	// The carrier may have created the order: a replay must not book it
+	// again
+	carrier.err = nil
+	resp, err = mutation.DelivroCreateOrder(ctx, input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "IDEMPOTENCY_KEY_IN_USE", resp.Errors[0].Code)
+	assert.Equal(t, 1, carrier.orders)
+}
+
+// failingOrderStore fails to save orders.
+type failingOrderStore struct {
+	shipper.OrderStore
+}
+
+func (s failingOrderStore) SaveOrder(ctx context.Context, order *shipper.Order) error {
+	return errors.New("disk full")
+}
+
+func TestMutation_DelivroCreateOrder_NotRecorded(t *testing.T) {
+	resolver, registry := newTestResolver()
+	resolver.OrderStore = failingOrderStore{resolver.OrderStore}
+	carrier := &countingShipper{Shipper: mock.New("canadapost")}
+	registry.Register(carrier)
+	mutation := resolver.Mutation()
+
+	key := "order-attempt-1"
+	input := newCreateOrderInput(storeRate(t, resolver, "canadapost", time.Now().Add(time.Hour)))
+	input.IdempotencyKey = &key
+
+	ctx := context.Background()
+	resp, err := mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "ORDER_NOT_RECORDED", resp.Errors[0].Code)
+	require.NotNil(t, resp.OrderID)
+	assert.Contains(t, resp.Errors[0].Message, *resp.OrderID)
+
+	// The key is not pointed at an order that was never recorded
+	resp, err = mutation.DelivroCreateOrder(ctx, input)
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "IDEMPOTENCY_KEY_IN_USE", resp.Errors[0].Code)
+	assert.Equal(t, 1, carrier.orders)
+}
+
+func TestQuery_DelivroOrder_NotFound(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	order, err := resolver.Query().DelivroOrder(context.Background(), "shipper-123", "329012345678901")
+
+	require.NoError(t, err)
+	assert.Nil(t, order)
+}
+
+func TestQuery_DelivroOrder_OtherShippersOrder(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := storeOrder(t, resolver, "freightcom", "ref-1")
+
+	order, err := resolver.Query().DelivroOrder(context.Background(), "shipper-456", orderID)
+
+	require.NoError(t, err)
+	assert.Nil(t, order)
+}
+
+func TestQuery_DelivroOrderByReference(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	storeOrder(t, resolver, "freightcom", "ref-1")
+	orderID := storeOrder(t, resolver, "purolator", "ref-2")
+
+	ctx := context.Background()
+	order, err := query.DelivroOrderByReference(ctx, "shipper-123", "ref-2")
+
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	assert.Equal(t, orderID, order.OrderID)
+
+	order, err = query.DelivroOrderByReference(ctx, "shipper-456", "ref-2")
+
+	require.NoError(t, err)
+	assert.Nil(t, order)
+}
+
+func TestQuery_DelivroOrders_Pagination(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	for i := 0; i < 5; i++ {
+		storeOrder(t, resolver, "freightcom", "ref")
+	}
+
+	ctx := context.Background()
+	first := 2
+	seen := make(map[string]bool)
+	var after *string
+	pages := 0
+	for {
+		page, err := query.DelivroOrders(ctx, "shipper-123", &first, after)
+		require.NoError(t, err)
+		pages++
+		for _, o := range page.Orders {
+			assert.False(t, seen[o.OrderID], "order %s returned twice", o.OrderID)
+			seen[o.OrderID] = true
+		}
+		if !page.HasMore {
+			break
+		}
+		after = page.NextCursor
+	}
+
+	assert.Len(t, seen, 5)
+	assert.Equal(t, 3, pages)
+}
+
+func TestQuery_DelivroOrders_InvalidArguments(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	ctx := context.Background()
+	first := 1000
+	_, err := query.DelivroOrders(ctx, "shipper-123", &first, nil)
+	assert.Error(t, err)
+
+	cursor := "not a cursor"
+	_, err = query.DelivroOrders(ctx, "shipper-123", nil, &cursor)
+	assert.ErrorIs(t, err, shipper.ErrInvalidCursor)
+}
+
+func TestQuery_Health(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	ctx := context.Background()
+	healthy, err := query.Health(ctx)
+
+	require.NoError(t, err)
+	assert.True(t, healthy)
+}
+
+func TestQuery_Carriers(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	ctx := context.Background()
+	carriers, err := query.Carriers(ctx)
+
+	require.NoError(t, err)
+	assert.Len(t, carriers, 3)
+	assert.Contains(t, carriers, generated.CarrierFreightcom)
+	assert.Contains(t, carriers, generated.CarrierCanadaPost)
+	assert.Contains(t, carriers, generated.CarrierPurolator)
+}
+
+func TestQuery_ServiceTypes(t *testing.T) {
+	resolver, _ := newTestResolver()
+	query := resolver.Query()
+
+	ctx := context.Background()
+	serviceTypes, err := query.ServiceTypes(ctx)
+
+	require.NoError(t, err)
+	assert.Len(t, serviceTypes, 6)
+	assert.Contains(t, serviceTypes, generated.ServiceTypeStandard)
+	assert.Contains(t, serviceTypes, generated.ServiceTypeExpress)
+	assert.Contains(t, serviceTypes, generated.ServiceTypePriority)
+	assert.Contains(t, serviceTypes, generated.ServiceTypeOvernight)
+	assert.Contains(t, serviceTypes, generated.ServiceTypeEconomy)
+	assert.Contains(t, serviceTypes, generated.ServiceTypeFreight)
+}
+
+func TestResolver_NewResolver(t *testing.T) {
+	registry := shipper.NewRegistry()
+	logger := otelzap.New(zap.NewNop())
+	metrics := telemetry.NewMetrics()
+
+	resolver := graphql.NewResolver(registry, logger, metrics)
+
+	assert.NotNil(t, resolver)
+	assert.Equal(t, registry, resolver.Registry)
+	assert.Equal(t, logger, resolver.Logger)
+	assert.Equal(t, metrics, resolver.Metrics)
+	assert.NotNil(t, resolver.QuoteStore)
+	assert.NotNil(t, resolver.OrderStore)
+}
+
+func TestQuery_CarrierCapabilities(t *testing.T) {
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(canadapost.NewWithAPIClient(canadapost.Config{}, canadapost.NewMockAPIClient(), logger, nil))
+	registry.Register(mock.New("purolator"))
+	registry.Register(mock.New("unknown"))
+	resolver := graphql.NewResolver(registry, logger, telemetry.NewMetrics())
+	query := resolver.Query()
+
+	all, err := query.CarrierCapabilities(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, all, 2, "carriers without a GraphQL enum value are skipped")
+	assert.Equal(t, generated.CarrierCanadaPost, all[0].Carrier)
+	assert.Equal(t, generated.CarrierPurolator, all[1].Carrier)
+
+	carrier := generated.CarrierCanadaPost
+	filtered, err := query.CarrierCapabilities(context.Background(), &carrier)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	caps := filtered[0]
+	assert.Equal(t, []generated.LabelFormat{generated.LabelFormatPDF, generated.LabelFormatZpl}, caps.LabelFormats)
+	assert.False(t, caps.SaturdayDelivery)
+	assert.True(t, caps.MultiPiece)
+	require.NotNil(t, caps.MaxWeight)
+	assert.Equal(t, "30.000", caps.MaxWeight.Value.String())
+	assert.Equal(t, generated.WeightUnitKg, caps.MaxWeight.Unit)
+}
+
+func TestMutation_DelivroGetLabel_UnsupportedFormat(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	mockAPI.OnGetLabel = func(ctx context.Context, shipmentID string, format string) (*canadapost.LabelResponse, error) {
+		t.Fatal("unsupported formats must not be requested from the carrier")
+		return nil, nil
+	}
+
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(canadapost.NewWithAPIClient(canadapost.Config{}, mockAPI, logger, nil))
+	resolver := graphql.NewResolver(registry, logger, telemetry.NewMetrics())
+	orderID := storeOrder(t, resolver, "canadapost", "")
+
+	format := generated.LabelFormatPng
+	resp, err := resolver.Mutation().DelivroGetLabel(context.Background(), generated.GetLabelInput{ShipperID: "shipper-123", OrderID: orderID, Format: &format})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "UNSUPPORTED_OPTION", resp.Errors[0].Code)
+	assert.Contains(t, resp.Errors[0].Message, "label_format PNG is not supported by canadapost")
+}
+
+func newSchedulePickupInput(carrier generated.Carrier) generated.SchedulePickupInput {
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	return generated.SchedulePickupInput{
+		ShipperID: "shipper-123",
+		Carrier:   carrier,
+		Address: &generated.AddressInput{
+			Line1:        "123 Main St",
+			City:         "Toronto",
+			ProvinceCode: "ON",
+			PostalCode:   "M5V1A1",
+		},
+		Contact:      &generated.ContactInput{Name: "John Doe", Phone: "416-555-1234"},
+		Window:       &generated.PickupWindowInput{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)},
+		PackageCount: 2,
+		TotalWeight:  shipper.DecimalFromFloat(10, 0),
+	}
+}
+
+func TestMutation_DelivroPickupAvailability_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroPickupAvailability(context.Background(), generated.PickupAvailabilityInput{
+		Carrier: generated.CarrierCanadaPost,
+		Address: &generated.AddressInput{PostalCode: "M5V1A1"},
+		Date:    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	require.NotNil(t, resp.Available)
+	assert.True(t, *resp.Available)
+	assert.NotEmpty(t, resp.Windows)
+	assert.Equal(t, generated.CarrierCanadaPost, *resp.Metadata.Carrier)
+}
+
+func TestMutation_DelivroSchedulePickup_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+	input := newSchedulePickupInput(generated.CarrierPurolator)
+
+	resp, err := resolver.Mutation().DelivroSchedulePickup(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	require.NotNil(t, resp.PickupID)
+	assert.NotEmpty(t, *resp.PickupID)
+	assert.Equal(t, generated.CarrierPurolator, *resp.Carrier)
+	assert.Equal(t, generated.PickupStatusScheduled, *resp.Status)
+	assert.Equal(t, input.Window.ReadyAt, resp.Window.ReadyAt)
+}
+
+func TestMutation_DelivroSchedulePickup_InvalidWindow(t *testing.T) {
+	resolver, _ := newTestResolver()
+	input := newSchedulePickupInput(generated.CarrierPurolator)
+	input.Window.CloseAt = input.Window.ReadyAt.Add(-time.Hour)
+
+	resp, err := resolver.Mutation().DelivroSchedulePickup(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "INVALID_PICKUP", resp.Errors[0].Code)
+	assert.Equal(t, "window.closeAt", *resp.Errors[0].Field)
+}
+
+// noPickupShipper hides the optional interfaces of the shipper it embeds.
+type noPickupShipper struct {
+	shipper.Shipper
+}
+
+func TestMutation_DelivroSchedulePickup_Unsupported(t *testing.T) {
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(noPickupShipper{mock.New("freightcom")})
+	resolver := graphql.NewResolver(registry, logger, telemetry.NewMetrics())
+
+	resp, err := resolver.Mutation().DelivroSchedulePickup(context.Background(), newSchedulePickupInput(generated.CarrierFreightcom))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "UNSUPPORTED_OPTION", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroSchedulePickup_CarrierNotFound(t *testing.T) {
+	resolver := graphql.NewResolver(shipper.NewRegistry(), otelzap.New(zap.NewNop()), telemetry.NewMetrics())
+
+	resp, err := resolver.Mutation().DelivroSchedulePickup(context.Background(), newSchedulePickupInput(generated.CarrierPurolator))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "CARRIER_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCancelPickup_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroCancelPickup(context.Background(), generated.CancelPickupInput{
+		Carrier:  generated.CarrierCanadaPost,
+		PickupID: "pickup-123",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, "pickup-123", *resp.PickupID)
+	assert.Equal(t, generated.PickupStatusCancelled, *resp.Status)
+}
+
+func TestMutation_DelivroManifest_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := storeOrder(t, resolver, "canadapost", "REF-1")
+	storeOrder(t, resolver, "purolator", "REF-2")
+
+	resp, err := resolver.Mutation().DelivroManifest(context.Background(), generated.ManifestInput{
+		ShipperID: "shipper-123",
+		Carrier:   generated.CarrierCanadaPost,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	require.Len(t, resp.Groups, 1)
+	assert.Equal(t, []string{orderID}, resp.Groups[0].OrderIds)
+	require.Len(t, resp.Groups[0].Manifests, 1)
+	assert.NotEmpty(t, resp.Groups[0].Manifests[0].ManifestID)
+
+	order, err := resolver.OrderStore.GetOrder(context.Background(), orderID)
+	require.NoError(t, err)
+	assert.NotNil(t, order.ManifestedAt)
+}
+
+func TestMutation_DelivroManifest_Unsupported(t *testing.T) {
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(noPickupShipper{mock.New("freightcom")})
+	resolver := graphql.NewResolver(registry, logger, telemetry.NewMetrics())
+
+	resp, err := resolver.Mutation().DelivroManifest(context.Background(), generated.ManifestInput{
+		ShipperID: "shipper-123",
+		Carrier:   generated.CarrierFreightcom,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "UNSUPPORTED_OPTION", resp.Errors[0].Code)
+}
+
+// createOrder creates an order for shipper-123 through the mutation and
+// returns its ID.
+func createOrder(t *testing.T, resolver *graphql.Resolver, carrier string) string {
+	t.Helper()
+
+	resp, err := resolver.Mutation().DelivroCreateOrder(context.Background(), newCreateOrderInput(storeRate(t, resolver, carrier, time.Now().Add(time.Hour))))
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	return *resp.OrderID
+}
+
+func TestMutation_DelivroCreateReturn_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := createOrder(t, resolver, "purolator")
+	rma := "RMA-1001"
+
+	ctx := context.Background()
+	resp, err := resolver.Mutation().DelivroCreateReturn(ctx, generated.CreateReturnInput{
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+		RmaNumber: &rma,
+	})
+
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	assert.Equal(t, orderID, *resp.OriginalOrderID)
+	assert.Equal(t, generated.CarrierPurolator, *resp.Carrier)
+	require.NotNil(t, resp.Label)
+
+	order, err := resolver.Query().DelivroOrder(ctx, "shipper-123", *resp.OrderID)
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	assert.Equal(t, orderID, *order.ReturnOf)
+	assert.Equal(t, rma, *order.Reference)
+	assert.Equal(t, "Vancouver", order.SenderAddress.City)
+	assert.Equal(t, "Toronto", order.RecipientAddress.City)
+}
+
+func TestMutation_DelivroCreateReturn_NotRecorded(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := createOrder(t, resolver, "purolator")
+	resolver.OrderStore = failingOrderStore{resolver.OrderStore}
+	createWebhook(t, resolver, generated.WebhookEventTypeOrderCreated)
+
+	ctx := context.Background()
+	resp, err := resolver.Mutation().DelivroCreateReturn(ctx, generated.CreateReturnInput{
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "ORDER_NOT_RECORDED", resp.Errors[0].Code)
+	require.NotNil(t, resp.OrderID)
+	assert.Contains(t, resp.Errors[0].Message, *resp.OrderID)
+
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(ctx, "shipper-123", nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestMutation_DelivroCreateReturn_OtherShippersOrder(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := createOrder(t, resolver, "purolator")
+
+	resp, err := resolver.Mutation().DelivroCreateReturn(context.Background(), generated.CreateReturnInput{
+		ShipperID: "shipper-456",
+		OrderID:   orderID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "ORDER_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCreateReturn_CancelledOrder(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := storeOrder(t, resolver, "purolator", "REF-1")
+	ctx := context.Background()
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	order.Status = shipper.StatusCancelled
+	require.NoError(t, resolver.OrderStore.SaveOrder(ctx, order))
+
+	resp, err := resolver.Mutation().DelivroCreateReturn(ctx, generated.CreateReturnInput{
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "RETURN_NOT_ALLOWED", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroCreateReturn_Unsupported(t *testing.T) {
+	registry := shipper.NewRegistry()
+	registry.Register(noPickupShipper{mock.New("freightcom")})
+	resolver := graphql.NewResolver(registry, otelzap.New(zap.NewNop()), telemetry.NewMetrics())
+	orderID := storeOrder(t, resolver, "freightcom", "REF-1")
+
+	resp, err := resolver.Mutation().DelivroCreateReturn(context.Background(), generated.CreateReturnInput{
+		ShipperID: "shipper-123",
+		OrderID:   orderID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "UNSUPPORTED_OPTION", resp.Errors[0].Code)
+}
+
+func createWebhook(t *testing.T, resolver *graphql.Resolver, events ...generated.WebhookEventType) *generated.WebhookSubscription {
+	t.Helper()
+
+	resp, err := resolver.Mutation().DelivroCreateWebhook(context.Background(), generated.CreateWebhookInput{
+		ShipperID: "shipper-123",
+		URL:       "https://example.com/hooks/delivro",
+		Events:    events,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	return resp.Subscription
+}
+
+func TestMutation_DelivroCreateWebhook_Success(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroCreateWebhook(context.Background(), generated.CreateWebhookInput{
+		ShipperID: "shipper-123",
+		URL:       "https://example.com/hooks/delivro",
+		Events:    []generated.WebhookEventType{generated.WebhookEventTypeDelivered},
+	})
+
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	assert.NotEmpty(t, resp.Subscription.ID)
+	assert.Equal(t, []generated.WebhookEventType{generated.WebhookEventTypeDelivered}, resp.Subscription.Events)
+	require.NotNil(t, resp.Secret)
+	assert.Contains(t, *resp.Secret, "whsec_")
+
+	subs, err := resolver.Query().DelivroWebhooks(context.Background(), "shipper-123")
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, resp.Subscription.ID, subs[0].ID)
+}
+
+func TestMutation_DelivroCreateWebhook_InvalidURL(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroCreateWebhook(context.Background(), generated.CreateWebhookInput{
+		ShipperID: "shipper-123",
+		URL:       "example.com/hooks",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "INVALID_INPUT", resp.Errors[0].Code)
+}
+
+func TestMutation_DelivroDeleteWebhook_OtherShippersSubscription(t *testing.T) {
+	resolver, _ := newTestResolver()
+	sub := createWebhook(t, resolver)
+
+	resp, err := resolver.Mutation().DelivroDeleteWebhook(context.Background(), generated.DeleteWebhookInput{
+		ShipperID:      "shipper-456",
+		SubscriptionID: sub.ID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "WEBHOOK_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestWebhooks_OrderLifecycleEvents(t *testing.T) {
+	resolver, _ := newTestResolver()
+	createWebhook(t, resolver)
+	orderID := createOrder(t, resolver, "freightcom")
+
+	ctx := context.Background()
+	_, err := resolver.Mutation().DelivroCancelOrder(ctx, generated.CancelOrderInput{ShipperID: "shipper-123", OrderID: orderID})
+	require.NoError(t, err)
+
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(ctx, "shipper-123", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	types := make([]generated.WebhookEventType, len(deliveries))
+	for i, d := range deliveries {
+		assert.Equal(t, orderID, d.OrderID)
+		assert.Equal(t, generated.WebhookDeliveryStatePending, d.State)
+		types[i] = d.EventType
+	}
+	assert.ElementsMatch(t, []generated.WebhookEventType{
+		generated.WebhookEventTypeOrderCreated,
+		generated.WebhookEventTypeLabelReady,
+		generated.WebhookEventTypeOrderCancelled,
+	}, types)
+}
+
+func TestWebhooks_FilteredEvents(t *testing.T) {
+	resolver, _ := newTestResolver()
+	createWebhook(t, resolver, generated.WebhookEventTypeLabelReady)
+	createOrder(t, resolver, "purolator")
+
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(context.Background(), "shipper-123", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, generated.WebhookEventTypeLabelReady, deliveries[0].EventType)
+}
+
+func TestMutation_DelivroReplayWebhookDelivery_NotFound(t *testing.T) {
+	resolver, _ := newTestResolver()
+
+	resp, err := resolver.Mutation().DelivroReplayWebhookDelivery(context.Background(), generated.ReplayWebhookDeliveryInput{
+		ShipperID:  "shipper-123",
+		DeliveryID: "missing",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "WEBHOOK_DELIVERY_NOT_FOUND", resp.Errors[0].Code)
+}
+
+func TestResolver_RecordTrackingUpdates(t *testing.T) {
+	resolver, _ := newTestResolver()
+	createWebhook(t, resolver, generated.WebhookEventTypeDelivered)
+	orderID := createOrder(t, resolver, "canadapost")
+
+	ctx := context.Background()
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	deliveredAt := time.Now().Add(2 * time.Hour)
+	updates := []shipper.TrackingUpdate{
+		{TrackingNumber: order.Response.TrackingNumber, Event: shipper.TrackingEvent{Status: shipper.StatusDelivered, Timestamp: deliveredAt}},
+		{TrackingNumber: order.Response.TrackingNumber, Event: shipper.TrackingEvent{Status: shipper.StatusInTransit, Timestamp: deliveredAt.Add(-time.Hour)}},
+		{TrackingNumber: "unknown", Event: shipper.TrackingEvent{Status: shipper.StatusInTransit, Timestamp: deliveredAt}},
+	}
+
+	recorded, err := resolver.RecordTrackingUpdates(ctx, "canadapost", "push", updates)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, recorded)
+	order, err = resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(ctx, "shipper-123", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, generated.WebhookEventTypeDelivered, deliveries[0].EventType)
+
+	// The same push again is a duplicate
+	recorded, err = resolver.RecordTrackingUpdates(ctx, "canadapost", "push", updates)
+	require.NoError(t, err)
+	assert.Zero(t, recorded)
+}
+
+func TestResolver_RecordTrackingUpdates_NeverRegresses(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := createOrder(t, resolver, "canadapost")
+
+	ctx := context.Background()
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	deliveredAt := time.Now().Add(time.Minute)
+	_, err = resolver.RecordTrackingUpdates(ctx, "canadapost", "push", []shipper.TrackingUpdate{
+		{TrackingNumber: order.Response.TrackingNumber, Event: shipper.TrackingEvent{Status: shipper.StatusDelivered, Timestamp: deliveredAt}},
+	})
+	require.NoError(t, err)
+	createWebhook(t, resolver, generated.WebhookEventTypeTrackingUpdated)
+
+	recorded, err := resolver.RecordTrackingUpdates(ctx, "canadapost", "poll", []shipper.TrackingUpdate{
+		{TrackingNumber: order.Response.TrackingNumber, Event: shipper.TrackingEvent{Status: shipper.StatusPending, Timestamp: deliveredAt.Add(time.Minute)}},
+		{TrackingNumber: order.Response.TrackingNumber, Event: shipper.TrackingEvent{Status: shipper.StatusInTransit, Timestamp: deliveredAt.Add(time.Hour)}},
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, recorded)
+	order, err = resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(ctx, "shipper-123", nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestResolver_RecordTrackingUpdates_PollAfterPush(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := createOrder(t, resolver, "canadapost")
+
+	ctx := context.Background()
+	pickedUpAt := time.Now().Add(time.Hour)
+	inTransitAt := pickedUpAt.Add(time.Hour)
+	_, err := resolver.RecordTrackingUpdates(ctx, "canadapost", "push", []shipper.TrackingUpdate{
+		{OrderID: orderID, Event: shipper.TrackingEvent{Status: shipper.StatusInTransit, Timestamp: inTransitAt}},
+	})
+	require.NoError(t, err)
+	createWebhook(t, resolver, generated.WebhookEventTypeTrackingUpdated)
+
+	// The first poll reports the full history, already covered by the push
+	recorded, err := resolver.RecordTrackingUpdates(ctx, "canadapost", "poll", []shipper.TrackingUpdate{
+		{OrderID: orderID, Event: shipper.TrackingEvent{Status: shipper.StatusInTransit, Timestamp: inTransitAt}},
+		{OrderID: orderID, Event: shipper.TrackingEvent{Status: shipper.StatusPickedUp, Timestamp: pickedUpAt}},
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, recorded)
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusInTransit, order.Status)
+	deliveries, err := resolver.Query().DelivroWebhookDeliveries(ctx, "shipper-123", nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestResolver_RecordTrackingUpdates_PushAfterTrack(t *testing.T) {
+	resolver, _ := newTestResolver()
+	orderID := storeOrder(t, resolver, "canadapost", "ref-1")
+
+	ctx := context.Background()
+	resp, err := resolver.Mutation().DelivroTrackShipment(ctx, generated.TrackShipmentInput{ShipperID: "shipper-123", OrderID: orderID})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+
+	// The push arrives after the track call, stamped with the carrier's
+	// earlier event time
+	recorded, err := resolver.RecordTrackingUpdates(ctx, "canadapost", "push", []shipper.TrackingUpdate{
+		{OrderID: orderID, Event: shipper.TrackingEvent{Status: shipper.StatusOutForDelivery, Timestamp: time.Now().Add(-time.Minute)}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, recorded)
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusOutForDelivery, order.Status)
+	require.Len(t, order.History, 3)
+	assert.False(t, order.History[1].CarrierTime)
+	assert.True(t, order.History[2].CarrierTime)
 }
@@ -7,11 +7,18 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tournevent/logistic/internal/graphql/generated"
+	"github.com/tournevent/logistic/internal/manifest"
+	"github.com/tournevent/logistic/internal/webhook"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 	"go.uber.org/zap"
 )
 
@@ -25,47 +32,113 @@ func (r *mutationResolver) DelivroGetQuote(ctx context.Context, input generated.
 		zap.String("shipper_id", input.ShipperID),
 	)
 
+	packages, err := packagesInputToModel(input.Packages)
+	if err != nil {
+		return &generated.QuoteResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+	customs, err := customsInputToModel(input.Customs)
+	if err != nil {
+		return &generated.QuoteResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
 	// Convert input to shipper request
 	req := &shipper.QuoteRequest{
 		ShipperID:   input.ShipperID,
 		Origin:      addressInputToModel(input.Origin),
 		Destination: addressInputToModel(input.Destination),
-		Packages:    packagesInputToModel(input.Packages),
+		Packages:    packages,
+		Customs:     customs,
 	}
 
 	if input.Options != nil {
 		req.Options = optionsInputToModel(input.Options)
 	}
+	rankOpts, err := rankOptionsToModel(input, r.RatingWeights)
+	if err != nil {
+		return &generated.QuoteResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+	if err := shipper.Validate(req); err != nil {
+		return &generated.QuoteResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "INVALID_INPUT"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
 
 	// Get quotes from carriers
-	var responses []*shipper.QuoteResponse
-	var errs []error
-
-	if input.Options != nil && len(input.Options.Carriers) > 0 {
-		carrierNames := make([]string, len(input.Options.Carriers))
-		for i, c := range input.Options.Carriers {
-			carrierNames[i] = carrierEnumToName(c)
+	var carrierNames []string
+	if input.Options != nil {
+		for _, c := range input.Options.Carriers {
+			carrierNames = append(carrierNames, carrierEnumToName(c))
 		}
-		responses, errs = r.Registry.GetQuotesFromCarriers(ctx, req, carrierNames)
-	} else {
-		responses, errs = r.Registry.GetAllQuotes(ctx, req)
-	}
-
-	// Log any errors
-	for _, err := range errs {
-		r.Logger.Warn("Carrier quote error", zap.Error(err))
 	}
+	quotes := r.Registry.QuoteCarriers(ctx, req, carrierNames)
 
-	// Combine all rates
-	var allRates []*generated.RateOption
+	// Combine all rates and report each carrier's outcome
+	var allRates []shipper.RateOption
+	var errs []error
 	var quoteID string
-	for _, resp := range responses {
+	carrierResults := make([]*generated.CarrierQuoteResult, len(quotes))
+	answered := false
+	for i := range quotes {
+		q := &quotes[i]
+		carrierResults[i] = carrierQuoteToGraphQL(q)
+		r.Metrics.RecordRequest("get_quote", q.Carrier, quoteMetricStatus(q.Status), q.Latency.Seconds())
+
+		if q.Err != nil {
+			r.Logger.Warn("Carrier quote error",
+				zap.String("request_id", requestID),
+				zap.String("carrier", q.Carrier),
+				zap.String("status", string(q.Status)),
+				zap.Error(q.Err),
+			)
+			if q.Status != shipper.QuoteSkipped {
+				errorType := q.ErrorCode()
+				if errorType == "" {
+					errorType = string(q.Status)
+				}
+				r.Metrics.RecordError(q.Carrier, errorType)
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", q.Carrier, q.Err))
+			continue
+		}
+
+		answered = true
 		if quoteID == "" {
-			quoteID = resp.QuoteID
+			quoteID = q.Response.QuoteID
 		}
-		for _, rate := range resp.Rates {
-			allRates = append(allRates, rateToGraphQL(&rate))
+		// Record rates so CreateOrder can resolve the selected rate
+		if err := r.QuoteStore.SaveQuote(ctx, input.ShipperID, q.Response); err != nil {
+			r.Logger.Error("Failed to store quote",
+				zap.String("request_id", requestID),
+				zap.String("quote_id", q.Response.QuoteID),
+				zap.Error(err),
+			)
 		}
+		allRates = append(allRates, q.Response.Rates...)
+	}
+	if len(quotes) == 0 {
+		errs = append(errs, shipper.ErrCarrierNotFound)
+	}
+
+	// Rank the rates so they list in the same order whichever carrier
+	// answered first
+	ranked := rating.Rank(allRates, rankOpts)
+	rates := make([]*generated.RateOption, len(ranked))
+	for i := range ranked {
+		rates[i] = rankedRateToGraphQL(&ranked[i])
 	}
 
 	// Build response
@@ -74,15 +147,20 @@ func (r *mutationResolver) DelivroGetQuote(ctx context.Context, input generated.
 		ProcessedAt: time.Now(),
 	}
 
+	status := "success"
+	if !answered {
+		status = "error"
+	}
 	duration := time.Since(startTime).Seconds()
-	r.Metrics.RecordRequest("get_quote", "all", "success", duration)
+	r.Metrics.RecordRequest("get_quote", "all", status, duration)
 
 	return &generated.QuoteResponse{
-		Success:  len(allRates) > 0,
-		QuoteID:  &quoteID,
-		Rates:    allRates,
-		Errors:   errorsToGraphQL(errs),
-		Metadata: metadata,
+		Success:        answered,
+		QuoteID:        &quoteID,
+		Rates:          rates,
+		Errors:         errorsToGraphQL(errs),
+		CarrierResults: carrierResults,
+		Metadata:       metadata,
 	}, nil
 }
 
@@ -97,13 +175,19 @@ func (r *mutationResolver) DelivroCreateOrder(ctx context.Context, input generat
 		zap.String("rate_id", input.RateID),
 	)
 
-	// Determine carrier from rate ID prefix
-	carrierName := carrierFromRateID(input.RateID)
-	carrier, err := r.Registry.Get(carrierName)
+	packages, err := packagesInputToModel(input.Packages)
 	if err != nil {
 		return &generated.OrderResponse{
 			Success:  false,
-			Errors:   []*generated.Error{{Code: "CARRIER_NOT_FOUND", Message: err.Error()}},
+			Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+	customs, err := customsInputToModel(input.Customs)
+	if err != nil {
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
 			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
 		}, nil
 	}
@@ -116,12 +200,10 @@ func (r *mutationResolver) DelivroCreateOrder(ctx context.Context, input generat
 		SenderAddress:    addressInputToModel(input.SenderAddress),
 		Recipient:        contactInputToModel(input.Recipient),
 		RecipientAddress: addressInputToModel(input.RecipientAddress),
-		Packages:         packagesInputToModel(input.Packages),
+		Packages:         packages,
+		Customs:          customs,
 	}
 
-	if input.QuoteID != nil {
-		req.QuoteID = *input.QuoteID
-	}
 	if input.Reference != nil {
 		req.Reference = *input.Reference
 	}
@@ -131,33 +213,154 @@ func (r *mutationResolver) DelivroCreateOrder(ctx context.Context, input generat
 	if input.Instructions != nil {
 		req.Instructions = *input.Instructions
 	}
+	if input.IdempotencyKey != nil {
+		req.IdempotencyKey = *input.IdempotencyKey
+	}
+	if input.Options != nil {
+		req.Options = optionsInputToModel(input.Options)
+	}
+	if input.Pickup != nil {
+		pickup := pickupWindowInputToModel(input.Pickup)
+		req.Pickup = &pickup
+	}
+	if err := shipper.Validate(req); err != nil {
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "INVALID_INPUT"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	// Replays of an idempotency key return the order it first created, even
+	// once its rate has expired
+	previous, err := r.claimIdempotencyKey(ctx, req)
+	if err != nil {
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: idempotencyErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+	if previous != nil {
+		r.Logger.Info("Replaying order",
+			zap.String("request_id", requestID),
+			zap.String("order_id", previous.OrderID),
+		)
+		r.Metrics.RecordRequest("create_order", previous.Carrier, "replayed", time.Since(startTime).Seconds())
+		resp := previous.Response
+		resp.PreviouslyCreated = true
+		return createOrderResponseToGraphQL(&resp, previous.Carrier, requestID), nil
+	}
+
+	// Resolve the selected rate from the quote store
+	stored, err := r.QuoteStore.GetRate(ctx, input.RateID)
+	if err == nil && stored.ShipperID != input.ShipperID {
+		err = fmt.Errorf("%w: %s", shipper.ErrQuoteNotFound, input.RateID)
+	}
+	if err != nil {
+		r.releaseIdempotencyKey(ctx, req)
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: quoteErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	carrierName := stored.Rate.Carrier
+	carrier, err := r.Registry.Get(carrierName)
+	if err != nil {
+		r.releaseIdempotencyKey(ctx, req)
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "CARRIER_NOT_FOUND", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+	req.QuoteID = stored.QuoteID
+	req.ServiceID = stored.Rate.ServiceID
 
 	// Create order
 	resp, err := carrier.CreateOrder(ctx, req)
 	if err != nil {
 		r.Metrics.RecordRequest("create_order", carrierName, "error", time.Since(startTime).Seconds())
+		// The carrier may have booked the shipment before failing to
+		// answer: the key then stays claimed until the claim expires, so
+		// that a replay cannot book it again
+		if shipper.IsRejected(err) {
+			r.releaseIdempotencyKey(ctx, req)
+		}
 		return &generated.OrderResponse{
 			Success:  false,
-			Errors:   []*generated.Error{{Code: "CREATE_ORDER_FAILED", Message: err.Error()}},
+			Errors:   errorToGraphQL(err, "CREATE_ORDER_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	// Record the order so later calls can be routed to its carrier. An
+	// order the carrier deduplicated keeps the history already recorded,
+	// and is only returned to the shipper that created it.
+	existing, lookupErr := r.OrderStore.GetOrder(ctx, resp.OrderID)
+	if resp.PreviouslyCreated && lookupErr == nil && existing.ShipperID != input.ShipperID {
+		r.Metrics.RecordRequest("create_order", carrierName, "error", time.Since(startTime).Seconds())
+		r.Logger.Error("Carrier returned an order of another shipper",
+			zap.String("request_id", requestID),
+			zap.String("shipper_id", input.ShipperID),
+			zap.String("order_id", resp.OrderID),
+		)
+		r.releaseIdempotencyKey(ctx, req)
+		return &generated.OrderResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "IDEMPOTENCY_KEY_REUSED", Message: "the carrier matched the request to an order of another shipper"}},
 			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
 		}, nil
 	}
 
 	r.Metrics.RecordRequest("create_order", carrierName, "success", time.Since(startTime).Seconds())
 
-	return &generated.OrderResponse{
-		Success:           true,
-		OrderID:           &resp.OrderID,
-		TrackingNumber:    &resp.TrackingNumber,
-		TrackingURL:       &resp.TrackingURL,
-		Status:            statusToEnum(resp.Status),
-		Carrier:           carrierNameToEnum(carrierName),
-		ServiceName:       &resp.ServiceName,
-		TotalCharged:      moneyToGraphQL(&resp.TotalCharged),
-		EstimatedDelivery: resp.EstimatedDelivery,
-		LabelURL:          &resp.LabelURL,
-		Metadata:          &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
-	}, nil
+	if lookupErr != nil || !resp.PreviouslyCreated {
+		now := time.Now()
+		order := &shipper.Order{
+			OrderID:   resp.OrderID,
+			ShipperID: input.ShipperID,
+			Carrier:   carrierName,
+			Reference: req.Reference,
+			Request:   *req,
+			Response:  *resp,
+			Status:    resp.Status,
+			History:   []shipper.StatusChange{{Status: resp.Status, Timestamp: now, Source: "create_order"}},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := r.OrderStore.SaveOrder(ctx, order); err != nil {
+			r.Logger.Error("Failed to store order",
+				zap.String("request_id", requestID),
+				zap.String("order_id", resp.OrderID),
+				zap.Error(err),
+			)
+			// The idempotency key stays claimed, since a replay could not
+			// find the order. The carrier order is returned so that it can
+			// be reconciled or cancelled.
+			result := createOrderResponseToGraphQL(resp, carrierName, requestID)
+			result.Success = false
+			result.Errors = []*generated.Error{{
+				Code:    "ORDER_NOT_RECORDED",
+				Message: fmt.Sprintf("order %s was created by %s but could not be recorded: %v", resp.OrderID, carrierName, err),
+			}}
+			return result, nil
+		}
+		r.publishCreated(ctx, order)
+	}
+	if req.IdempotencyKey != "" {
+		if err := r.IdempotencyStore.Complete(ctx, req.ShipperID, req.IdempotencyKey, resp.OrderID); err != nil {
+			r.Logger.Error("Failed to record idempotency key",
+				zap.String("request_id", requestID),
+				zap.String("order_id", resp.OrderID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return createOrderResponseToGraphQL(resp, carrierName, requestID), nil
 }
 
 // DelivroGetLabel implements the delivro_get_label mutation.
@@ -167,11 +370,20 @@ func (r *mutationResolver) DelivroGetLabel(ctx context.Context, input generated.
 
 	r.Logger.Info("Getting label",
 		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
 		zap.String("order_id", input.OrderID),
 	)
 
-	// Determine carrier from order ID prefix
-	carrierName := carrierFromOrderID(input.OrderID)
+	order, err := r.shipperOrder(ctx, input.ShipperID, input.OrderID)
+	if err != nil {
+		return &generated.LabelResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: orderErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	carrierName := order.Carrier
 	carrier, err := r.Registry.Get(carrierName)
 	if err != nil {
 		return &generated.LabelResponse{
@@ -185,10 +397,19 @@ func (r *mutationResolver) DelivroGetLabel(ctx context.Context, input generated.
 	if input.Format != nil {
 		format = labelFormatToModel(*input.Format)
 	}
+	if !shipper.CapabilitiesOf(carrier).SupportsLabelFormat(format) {
+		err := shipper.NewUnsupportedOptionError(carrierName, shipper.OptionLabelFormat+" "+strings.ToUpper(string(format)))
+		return &generated.LabelResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "GET_LABEL_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
 
 	req := &shipper.GetLabelRequest{
-		OrderID: input.OrderID,
-		Format:  format,
+		OrderID:  input.OrderID,
+		Format:   format,
+		PieceIDs: order.Response.PieceIDs(),
 	}
 
 	resp, err := carrier.GetLabel(ctx, req)
@@ -204,10 +425,11 @@ func (r *mutationResolver) DelivroGetLabel(ctx context.Context, input generated.
 	r.Metrics.RecordRequest("get_label", carrierName, "success", time.Since(startTime).Seconds())
 
 	return &generated.LabelResponse{
-		Success:  true,
-		OrderID:  &resp.OrderID,
-		Label:    labelToGraphQL(&resp.Label),
-		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		Success:          true,
+		OrderID:          &resp.OrderID,
+		Label:            labelToGraphQL(&resp.Label),
+		AdditionalLabels: labelsToGraphQL(resp.AdditionalLabels),
+		Metadata:         &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
 	}, nil
 }
 
@@ -218,11 +440,20 @@ func (r *mutationResolver) DelivroCancelOrder(ctx context.Context, input generat
 
 	r.Logger.Info("Cancelling order",
 		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
 		zap.String("order_id", input.OrderID),
 	)
 
-	// Determine carrier from order ID prefix
-	carrierName := carrierFromOrderID(input.OrderID)
+	order, err := r.shipperOrder(ctx, input.ShipperID, input.OrderID)
+	if err != nil {
+		return &generated.CancelResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: orderErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	carrierName := order.Carrier
 	carrier, err := r.Registry.Get(carrierName)
 	if err != nil {
 		return &generated.CancelResponse{
@@ -233,7 +464,8 @@ func (r *mutationResolver) DelivroCancelOrder(ctx context.Context, input generat
 	}
 
 	req := &shipper.CancelOrderRequest{
-		OrderID: input.OrderID,
+		OrderID:  input.OrderID,
+		PieceIDs: order.Response.PieceIDs(),
 	}
 	if input.Reason != nil {
 		req.Reason = *input.Reason
@@ -251,6 +483,8 @@ func (r *mutationResolver) DelivroCancelOrder(ctx context.Context, input generat
 
 	r.Metrics.RecordRequest("cancel_order", carrierName, "success", time.Since(startTime).Seconds())
 
+	r.recordStatus(ctx, order, resp.Status, "cancel_order")
+
 	return &generated.CancelResponse{
 		Success:            true,
 		OrderID:            &resp.OrderID,
@@ -261,6 +495,517 @@ func (r *mutationResolver) DelivroCancelOrder(ctx context.Context, input generat
 	}, nil
 }
 
+// DelivroTrackShipment implements the delivro_track_shipment mutation.
+func (r *mutationResolver) DelivroTrackShipment(ctx context.Context, input generated.TrackShipmentInput) (*generated.TrackingResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+
+	r.Logger.Info("Tracking shipment",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("order_id", input.OrderID),
+	)
+
+	req := &shipper.TrackRequest{
+		OrderID: input.OrderID,
+	}
+	if input.TrackingNumber != nil {
+		req.TrackingNumber = *input.TrackingNumber
+	}
+
+	// Orders created here are routed by the order store. Shipments booked
+	// elsewhere can still be tracked when the carrier is given explicitly,
+	// but not the orders of other shippers.
+	var carrierName string
+	order, err := r.OrderStore.GetOrder(ctx, input.OrderID)
+	if err == nil && order.ShipperID != input.ShipperID {
+		order, err = nil, fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, input.OrderID)
+		input.Carrier = nil
+	}
+	switch {
+	case err == nil:
+		carrierName = order.Carrier
+		if req.TrackingNumber == "" {
+			req.TrackingNumber = order.Response.TrackingNumber
+		}
+	case input.Carrier != nil && errors.Is(err, shipper.ErrOrderNotFound):
+		carrierName = carrierEnumToName(*input.Carrier)
+	default:
+		return &generated.TrackingResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: orderErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	carrier, err := r.Registry.Get(carrierName)
+	if err != nil {
+		return &generated.TrackingResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "CARRIER_NOT_FOUND", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	resp, err := carrier.Track(ctx, req)
+	if err != nil {
+		r.Metrics.RecordRequest("track_shipment", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.TrackingResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "TRACK_SHIPMENT_FAILED", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("track_shipment", carrierName, "success", time.Since(startTime).Seconds())
+
+	if order != nil {
+		r.recordStatus(ctx, order, resp.Status, "track")
+	}
+
+	return &generated.TrackingResponse{
+		Success:           true,
+		OrderID:           &input.OrderID,
+		TrackingNumber:    &resp.TrackingNumber,
+		Status:            statusToEnum(resp.Status),
+		EstimatedDelivery: resp.EstimatedDelivery,
+		Events:            trackingEventsToGraphQL(resp.Events),
+		Metadata:          &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}, nil
+}
+
+// DelivroPickupAvailability is the resolver for the delivro_pickup_availability field.
+func (r *mutationResolver) DelivroPickupAvailability(ctx context.Context, input generated.PickupAvailabilityInput) (*generated.PickupAvailabilityResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+	carrierName := carrierEnumToName(input.Carrier)
+
+	r.Logger.Info("Getting pickup availability",
+		zap.String("request_id", requestID),
+		zap.String("carrier", carrierName),
+		zap.Time("date", input.Date),
+	)
+
+	scheduler, err := r.pickupScheduler(carrierName)
+	if err != nil {
+		return &generated.PickupAvailabilityResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CARRIER_NOT_FOUND"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	resp, err := scheduler.GetPickupAvailability(ctx, &shipper.PickupAvailabilityRequest{
+		Address: addressInputToModel(input.Address),
+		Date:    input.Date,
+	})
+	if err != nil {
+		r.Metrics.RecordRequest("get_pickup_availability", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.PickupAvailabilityResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "PICKUP_AVAILABILITY_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("get_pickup_availability", carrierName, "success", time.Since(startTime).Seconds())
+
+	return &generated.PickupAvailabilityResponse{
+		Success:   true,
+		Available: &resp.Available,
+		BookBy:    resp.BookBy,
+		Windows:   pickupWindowsToGraphQL(resp.Windows),
+		Metadata:  &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}, nil
+}
+
+// DelivroSchedulePickup is the resolver for the delivro_schedule_pickup field.
+func (r *mutationResolver) DelivroSchedulePickup(ctx context.Context, input generated.SchedulePickupInput) (*generated.PickupResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+	carrierName := carrierEnumToName(input.Carrier)
+
+	r.Logger.Info("Scheduling pickup",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("carrier", carrierName),
+	)
+
+	scheduler, err := r.pickupScheduler(carrierName)
+	if err != nil {
+		return &generated.PickupResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CARRIER_NOT_FOUND"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	req := &shipper.SchedulePickupRequest{
+		ShipperID:    input.ShipperID,
+		Address:      addressInputToModel(input.Address),
+		Contact:      contactInputToModel(input.Contact),
+		Window:       pickupWindowInputToModel(input.Window),
+		OrderIDs:     input.OrderIds,
+		PackageCount: input.PackageCount,
+		TotalWeight:  input.TotalWeight.Float64(),
+		WeightUnit:   shipper.WeightKG,
+	}
+	if input.WeightUnit != nil {
+		req.WeightUnit = weightUnitToModel(*input.WeightUnit)
+	}
+	if input.Instructions != nil {
+		req.Instructions = *input.Instructions
+	}
+	if err := shipper.Validate(req); err != nil {
+		return &generated.PickupResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "INVALID_INPUT"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	resp, err := scheduler.SchedulePickup(ctx, req)
+	if err != nil {
+		r.Metrics.RecordRequest("schedule_pickup", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.PickupResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "SCHEDULE_PICKUP_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("schedule_pickup", carrierName, "success", time.Since(startTime).Seconds())
+
+	r.Logger.Info("Pickup scheduled",
+		zap.String("request_id", requestID),
+		zap.String("pickup_id", resp.PickupID),
+	)
+
+	return &generated.PickupResponse{
+		Success:  true,
+		PickupID: &resp.PickupID,
+		Carrier:  carrierNameToEnum(carrierName),
+		Status:   pickupStatusToEnum(resp.Status),
+		Window:   pickupWindowToGraphQL(resp.Window),
+		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}, nil
+}
+
+// DelivroCancelPickup is the resolver for the delivro_cancel_pickup field.
+func (r *mutationResolver) DelivroCancelPickup(ctx context.Context, input generated.CancelPickupInput) (*generated.CancelPickupResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+	carrierName := carrierEnumToName(input.Carrier)
+
+	r.Logger.Info("Cancelling pickup",
+		zap.String("request_id", requestID),
+		zap.String("carrier", carrierName),
+		zap.String("pickup_id", input.PickupID),
+	)
+
+	scheduler, err := r.pickupScheduler(carrierName)
+	if err != nil {
+		return &generated.CancelPickupResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CARRIER_NOT_FOUND"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	resp, err := scheduler.CancelPickup(ctx, &shipper.CancelPickupRequest{PickupID: input.PickupID})
+	if err != nil {
+		r.Metrics.RecordRequest("cancel_pickup", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.CancelPickupResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CANCEL_PICKUP_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("cancel_pickup", carrierName, "success", time.Since(startTime).Seconds())
+
+	return &generated.CancelPickupResponse{
+		Success:  true,
+		PickupID: &resp.PickupID,
+		Status:   pickupStatusToEnum(resp.Status),
+		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}, nil
+}
+
+// DelivroManifest is the resolver for the delivro_manifest field.
+func (r *mutationResolver) DelivroManifest(ctx context.Context, input generated.ManifestInput) (*generated.ManifestResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+	carrierName := carrierEnumToName(input.Carrier)
+
+	shipDate := time.Now()
+	if input.ShipDate != nil {
+		shipDate = *input.ShipDate
+	}
+
+	r.Logger.Info("Manifesting orders",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("carrier", carrierName),
+		zap.Time("ship_date", shipDate),
+	)
+
+	closer := manifest.NewCloser(r.Registry, r.OrderStore, r.Logger)
+	results, err := closer.Close(ctx, manifest.Request{
+		ShipperID: input.ShipperID,
+		Carrier:   carrierName,
+		ShipDate:  shipDate,
+	})
+	if err != nil {
+		r.Metrics.RecordRequest("manifest", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.ManifestResponse{
+			Success:  false,
+			Groups:   manifestResultsToGraphQL(results),
+			Errors:   errorToGraphQL(err, "MANIFEST_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("manifest", carrierName, "success", time.Since(startTime).Seconds())
+
+	return &generated.ManifestResponse{
+		Success:  true,
+		Groups:   manifestResultsToGraphQL(results),
+		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}, nil
+}
+
+// DelivroCreateReturn is the resolver for the delivro_create_return field.
+func (r *mutationResolver) DelivroCreateReturn(ctx context.Context, input generated.CreateReturnInput) (*generated.ReturnResponse, error) {
+	requestID := uuid.New().String()
+	startTime := time.Now()
+
+	r.Logger.Info("Creating return",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("order_id", input.OrderID),
+	)
+
+	original, err := r.shipperOrder(ctx, input.ShipperID, input.OrderID)
+	if err != nil {
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: orderErrorCode(err), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	carrierName := original.Carrier
+	if original.Status == shipper.StatusCancelled || original.ReturnOf != "" {
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "RETURN_NOT_ALLOWED", Message: "cancelled orders and returns cannot be returned"}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	carrier, err := r.Registry.Get(carrierName)
+	if err != nil {
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: "CARRIER_NOT_FOUND", Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	format := shipper.LabelPDF
+	if input.Format != nil {
+		format = labelFormatToModel(*input.Format)
+	}
+	returner, ok := shipper.As[shipper.Returner](carrier)
+	if !ok {
+		err = shipper.NewUnsupportedOptionError(carrierName, shipper.OptionReturn)
+	} else if !shipper.CapabilitiesOf(carrier).SupportsLabelFormat(format) {
+		err = shipper.NewUnsupportedOptionError(carrierName, shipper.OptionLabelFormat+" "+strings.ToUpper(string(format)))
+	}
+	if err != nil {
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CREATE_RETURN_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	rmaNumber := ""
+	if input.RmaNumber != nil {
+		rmaNumber = *input.RmaNumber
+	}
+	req := original.ReturnRequest(rmaNumber, format)
+	if input.Packages != nil {
+		req.Packages, err = packagesInputToModel(input.Packages)
+		if err != nil {
+			return &generated.ReturnResponse{
+				Success:  false,
+				Errors:   []*generated.Error{{Code: "INVALID_INPUT", Message: err.Error()}},
+				Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+			}, nil
+		}
+	}
+	if err := shipper.Validate(req); err != nil {
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "INVALID_INPUT"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	resp, err := returner.CreateReturn(ctx, req)
+	if err != nil {
+		r.Metrics.RecordRequest("create_return", carrierName, "error", time.Since(startTime).Seconds())
+		return &generated.ReturnResponse{
+			Success:  false,
+			Errors:   errorToGraphQL(err, "CREATE_RETURN_FAILED"),
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+		}, nil
+	}
+
+	r.Metrics.RecordRequest("create_return", carrierName, "success", time.Since(startTime).Seconds())
+
+	// Record the return so it can be tracked and found from its order
+	now := time.Now()
+	order := &shipper.Order{
+		OrderID:   resp.OrderID,
+		ShipperID: input.ShipperID,
+		Carrier:   carrierName,
+		Reference: rmaNumber,
+		Request:   req.OrderRequest(),
+		Response: shipper.CreateOrderResponse{
+			OrderID:        resp.OrderID,
+			TrackingNumber: resp.TrackingNumber,
+			Status:         resp.Status,
+			Carrier:        carrierName,
+			TotalCharged:   resp.TotalCharged,
+			LabelURL:       resp.Label.URL,
+		},
+		Status:    resp.Status,
+		History:   []shipper.StatusChange{{Status: resp.Status, Timestamp: now, Source: "create_return"}},
+		CreatedAt: now,
+		UpdatedAt: now,
+		ReturnOf:  original.OrderID,
+	}
+	result := &generated.ReturnResponse{
+		Success:          true,
+		OrderID:          &resp.OrderID,
+		OriginalOrderID:  &original.OrderID,
+		TrackingNumber:   &resp.TrackingNumber,
+		Status:           statusToEnum(resp.Status),
+		Carrier:          carrierNameToEnum(carrierName),
+		TotalCharged:     moneyToGraphQL(&resp.TotalCharged),
+		Label:            labelToGraphQL(&resp.Label),
+		AdditionalLabels: labelsToGraphQL(resp.AdditionalLabels),
+		Metadata:         &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now(), Carrier: carrierNameToEnum(carrierName)},
+	}
+	if err := r.OrderStore.SaveOrder(ctx, order); err != nil {
+		r.Logger.Error("Failed to store return",
+			zap.String("request_id", requestID),
+			zap.String("order_id", resp.OrderID),
+			zap.Error(err),
+		)
+		// The carrier return is returned so that it can be reconciled or
+		// cancelled
+		result.Success = false
+		result.Errors = []*generated.Error{{
+			Code:    "ORDER_NOT_RECORDED",
+			Message: fmt.Sprintf("return %s was created by %s but could not be recorded: %v", resp.OrderID, carrierName, err),
+		}}
+		return result, nil
+	}
+	r.publishCreated(ctx, order)
+
+	return result, nil
+}
+
+// DelivroCreateWebhook implements the delivro_create_webhook mutation.
+func (r *mutationResolver) DelivroCreateWebhook(ctx context.Context, input generated.CreateWebhookInput) (*generated.WebhookResponse, error) {
+	requestID := uuid.New().String()
+
+	r.Logger.Info("Creating webhook subscription",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("url", input.URL),
+	)
+
+	sub := &webhook.Subscription{
+		ShipperID: input.ShipperID,
+		URL:       input.URL,
+	}
+	if input.Secret != nil {
+		sub.Secret = *input.Secret
+	}
+	for _, t := range input.Events {
+		sub.Events = append(sub.Events, webhookEventTypeToModel(t))
+	}
+	if err := r.Webhooks.Subscribe(ctx, sub); err != nil {
+		return &generated.WebhookResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: webhookErrorCode(err, "CREATE_WEBHOOK_FAILED"), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	return &generated.WebhookResponse{
+		Success:      true,
+		Subscription: webhookSubscriptionToGraphQL(sub),
+		Secret:       &sub.Secret,
+		Metadata:     &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+	}, nil
+}
+
+// DelivroDeleteWebhook implements the delivro_delete_webhook mutation.
+func (r *mutationResolver) DelivroDeleteWebhook(ctx context.Context, input generated.DeleteWebhookInput) (*generated.WebhookResponse, error) {
+	requestID := uuid.New().String()
+
+	r.Logger.Info("Deleting webhook subscription",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("subscription_id", input.SubscriptionID),
+	)
+
+	if err := r.Webhooks.Unsubscribe(ctx, input.ShipperID, input.SubscriptionID); err != nil {
+		return &generated.WebhookResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: webhookErrorCode(err, "DELETE_WEBHOOK_FAILED"), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	return &generated.WebhookResponse{
+		Success:  true,
+		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+	}, nil
+}
+
+// DelivroReplayWebhookDelivery implements the delivro_replay_webhook_delivery mutation.
+func (r *mutationResolver) DelivroReplayWebhookDelivery(ctx context.Context, input generated.ReplayWebhookDeliveryInput) (*generated.WebhookDeliveryResponse, error) {
+	requestID := uuid.New().String()
+
+	r.Logger.Info("Replaying webhook delivery",
+		zap.String("request_id", requestID),
+		zap.String("shipper_id", input.ShipperID),
+		zap.String("delivery_id", input.DeliveryID),
+	)
+
+	delivery, err := r.Webhooks.Replay(ctx, input.ShipperID, input.DeliveryID)
+	if err != nil {
+		return &generated.WebhookDeliveryResponse{
+			Success:  false,
+			Errors:   []*generated.Error{{Code: webhookErrorCode(err, "REPLAY_WEBHOOK_FAILED"), Message: err.Error()}},
+			Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+		}, nil
+	}
+
+	return &generated.WebhookDeliveryResponse{
+		Success:  true,
+		Delivery: webhookDeliveryToGraphQL(delivery),
+		Metadata: &generated.ResponseMetadata{RequestID: requestID, ProcessedAt: time.Now()},
+	}, nil
+}
+
 // Health implements the health query.
 func (r *queryResolver) Health(ctx context.Context) (bool, error) {
 	return true, nil
@@ -295,6 +1040,120 @@ func (r *queryResolver) ServiceTypes(ctx context.Context) ([]generated.ServiceTy
 	}, nil
 }
 
+// CarrierCapabilities implements the carrierCapabilities query.
+func (r *queryResolver) CarrierCapabilities(ctx context.Context, carrier *generated.Carrier) ([]*generated.CarrierCapabilities, error) {
+	capabilities := r.Registry.Capabilities()
+	names := make([]string, 0, len(capabilities))
+	for name := range capabilities {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	result := make([]*generated.CarrierCapabilities, 0, len(names))
+	for _, name := range names {
+		enum := carrierNameToEnum(name)
+		if enum == nil || (carrier != nil && *enum != *carrier) {
+			continue
+		}
+		result = append(result, capabilitiesToGraphQL(*enum, capabilities[name]))
+	}
+	return result, nil
+}
+
+// DelivroOrder implements the delivro_order query.
+func (r *queryResolver) DelivroOrder(ctx context.Context, shipperID string, orderID string) (*generated.Order, error) {
+	order, err := r.shipperOrder(ctx, shipperID, orderID)
+	if errors.Is(err, shipper.ErrOrderNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return orderToGraphQL(order), nil
+}
+
+// DelivroOrderByReference implements the delivro_order_by_reference query.
+func (r *queryResolver) DelivroOrderByReference(ctx context.Context, shipperID string, reference string) (*generated.Order, error) {
+	order, err := r.OrderStore.GetOrderByReference(ctx, shipperID, reference)
+	if errors.Is(err, shipper.ErrOrderNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return orderToGraphQL(order), nil
+}
+
+// DelivroOrders implements the delivro_orders query.
+func (r *queryResolver) DelivroOrders(ctx context.Context, shipperID string, first *int, after *string) (*generated.OrderConnection, error) {
+	limit := 0
+	if first != nil {
+		if *first < 1 || *first > maxOrdersPageSize {
+			return nil, fmt.Errorf("first must be between 1 and %d", maxOrdersPageSize)
+		}
+		limit = *first
+	}
+	cursor := ""
+	if after != nil {
+		cursor = *after
+	}
+
+	page, err := r.OrderStore.ListOrders(ctx, shipperID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*generated.Order, len(page.Orders))
+	for i, o := range page.Orders {
+		orders[i] = orderToGraphQL(o)
+	}
+	return &generated.OrderConnection{
+		Orders:     orders,
+		NextCursor: optionalString(page.NextCursor),
+		HasMore:    page.NextCursor != "",
+	}, nil
+}
+
+// DelivroWebhooks implements the delivro_webhooks query.
+func (r *queryResolver) DelivroWebhooks(ctx context.Context, shipperID string) ([]*generated.WebhookSubscription, error) {
+	subs, err := r.Webhooks.Subscriptions(ctx, shipperID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*generated.WebhookSubscription, len(subs))
+	for i, sub := range subs {
+		result[i] = webhookSubscriptionToGraphQL(sub)
+	}
+	return result, nil
+}
+
+// DelivroWebhookDeliveries implements the delivro_webhook_deliveries query.
+func (r *queryResolver) DelivroWebhookDeliveries(ctx context.Context, shipperID string, state *generated.WebhookDeliveryState, first *int) ([]*generated.WebhookDelivery, error) {
+	limit := 0
+	if first != nil {
+		if *first < 1 || *first > maxOrdersPageSize {
+			return nil, fmt.Errorf("first must be between 1 and %d", maxOrdersPageSize)
+		}
+		limit = *first
+	}
+	var deliveryState webhook.DeliveryState
+	if state != nil {
+		deliveryState = webhookDeliveryStateToModel(*state)
+	}
+
+	deliveries, err := r.Webhooks.Deliveries(ctx, shipperID, deliveryState, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*generated.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = webhookDeliveryToGraphQL(d)
+	}
+	return result, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
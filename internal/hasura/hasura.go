@@ -0,0 +1,72 @@
+// Package hasura contains the wire types of Hasura Actions and the session
+// carried with each action call.
+package hasura
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Session variable names set by Hasura.
+const (
+	SessionUserID = "x-hasura-user-id"
+	SessionRole   = "x-hasura-role"
+)
+
+// SecretHeader is the header Hasura is configured to forward with every
+// action call so the handler can verify the caller.
+const SecretHeader = "X-Delivro-Action-Secret"
+
+// ActionPayload is the request body Hasura posts to an action handler.
+type ActionPayload struct {
+	Action struct {
+		Name string `json:"name"`
+	} `json:"action"`
+	Input            json.RawMessage   `json:"input"`
+	SessionVariables map[string]string `json:"session_variables"`
+	RequestQuery     string            `json:"request_query,omitempty"`
+}
+
+// ActionError is the body returned with a 4xx status to report an error
+// back to the Hasura client.
+type ActionError struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// Session holds the session variables of an action call.
+// Keys are lower-cased since Hasura treats them case-insensitively.
+type Session map[string]string
+
+// NewSession creates a session from the variables sent by Hasura.
+func NewSession(vars map[string]string) Session {
+	s := make(Session, len(vars))
+	for k, v := range vars {
+		s[strings.ToLower(k)] = v
+	}
+	return s
+}
+
+// UserID returns the x-hasura-user-id session variable.
+func (s Session) UserID() string {
+	return s[SessionUserID]
+}
+
+// Role returns the x-hasura-role session variable.
+func (s Session) Role() string {
+	return s[SessionRole]
+}
+
+type sessionKey struct{}
+
+// WithSession returns a copy of ctx carrying the session.
+func WithSession(ctx context.Context, s Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+// SessionFromContext returns the session of the current action call, if any.
+func SessionFromContext(ctx context.Context) (Session, bool) {
+	s, ok := ctx.Value(sessionKey{}).(Session)
+	return s, ok
+}
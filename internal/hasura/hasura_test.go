@@ -0,0 +1,35 @@
+package hasura_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/hasura"
+)
+
+func TestNewSession(t *testing.T) {
+	s := hasura.NewSession(map[string]string{
+		"X-Hasura-User-Id": "user-1",
+		"x-hasura-role":    "shipper",
+		"x-hasura-org-id":  "org-1",
+	})
+
+	assert.Equal(t, "user-1", s.UserID())
+	assert.Equal(t, "shipper", s.Role())
+	assert.Equal(t, "org-1", s["x-hasura-org-id"])
+}
+
+func TestSessionFromContext(t *testing.T) {
+	_, ok := hasura.SessionFromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := hasura.WithSession(context.Background(), hasura.NewSession(map[string]string{
+		hasura.SessionUserID: "user-1",
+	}))
+
+	s, ok := hasura.SessionFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "user-1", s.UserID())
+}
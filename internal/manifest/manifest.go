@@ -0,0 +1,200 @@
+// Package manifest closes the shipping day: it transmits the open orders of
+// carriers that require an end-of-day manifest and marks them as manifested.
+package manifest
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+// pageSize is the number of orders read from the store at a time.
+const pageSize = 100
+
+// Request selects the orders to transmit.
+type Request struct {
+	ShipperID string
+	Carrier   string
+	ShipDate  time.Time // Only the date is used
+}
+
+// Result is the outcome of transmitting the orders sent from one origin.
+type Result struct {
+	Origin    shipper.Address // Zero for carriers that transmit the whole account
+	ShipDate  time.Time
+	OrderIDs  []string
+	Manifests []shipper.Manifest
+}
+
+// Closer transmits open orders to their carrier.
+type Closer struct {
+	registry *shipper.Registry
+	orders   shipper.OrderStore
+	logger   *otelzap.Logger
+}
+
+// NewCloser creates a closer for the carriers of registry and the orders of
+// store.
+func NewCloser(registry *shipper.Registry, store shipper.OrderStore, logger *otelzap.Logger) *Closer {
+	return &Closer{
+		registry: registry,
+		orders:   store,
+		logger:   logger,
+	}
+}
+
+// Close transmits the shipper's open orders with the carrier for the ship
+// date, one transmit per origin, and records the manifests on the orders.
+// Cancelled and already manifested orders are skipped. Carriers that
+// transmit every open shipment of the account at once are called once, and
+// the manifests are recorded on all the open orders of the carrier, of any
+// shipper. It fails with ErrCarrierNotFound for unknown carriers and
+// ErrUnsupportedOption for carriers that do not manifest. If a transmit
+// fails, the results of the origins already transmitted are returned with
+// the error.
+func (c *Closer) Close(ctx context.Context, req Request) ([]Result, error) {
+	carrier, err := c.registry.Get(req.Carrier)
+	if err != nil {
+		return nil, err
+	}
+	manifester, ok := shipper.As[shipper.Manifester](carrier)
+	if !ok {
+		return nil, shipper.NewUnsupportedOptionError(req.Carrier, shipper.OptionManifest)
+	}
+
+	groups, err := c.openOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	account := shipper.CapabilitiesOf(carrier).ManifestsAccount
+	if account && len(groups) > 0 {
+		orders, err := c.accountOrders(ctx, req.Carrier)
+		if err != nil {
+			return nil, err
+		}
+		groups = [][]*shipper.Order{orders}
+	}
+
+	results := make([]Result, 0, len(groups))
+	for _, orders := range groups {
+		var origin shipper.Address
+		if !account {
+			origin = orders[0].Request.SenderAddress
+		}
+		resp, err := manifester.Manifest(ctx, &shipper.ManifestRequest{
+			ShipperID: req.ShipperID,
+			Origin:    origin,
+			ShipDate:  req.ShipDate,
+		})
+		if err != nil {
+			return results, err
+		}
+
+		result := Result{
+			Origin:    origin,
+			ShipDate:  req.ShipDate,
+			OrderIDs:  make([]string, len(orders)),
+			Manifests: resp.Manifests,
+		}
+		for i, order := range orders {
+			result.OrderIDs[i] = order.OrderID
+			c.markManifested(ctx, order, resp.Manifests)
+		}
+		results = append(results, result)
+
+		c.logger.Info("Orders manifested",
+			zap.String("carrier", req.Carrier),
+			zap.String("origin", origin.PostalCode),
+			zap.Int("order_count", len(orders)),
+			zap.Int("manifest_count", len(resp.Manifests)),
+		)
+	}
+	return results, nil
+}
+
+// openOrders returns the orders to transmit, grouped by origin postal code
+// in postal code order.
+func (c *Closer) openOrders(ctx context.Context, req Request) ([][]*shipper.Order, error) {
+	byOrigin := make(map[string][]*shipper.Order)
+	day := req.ShipDate.Format("2006-01-02")
+
+	cursor := ""
+	for {
+		page, err := c.orders.ListOrders(ctx, req.ShipperID, cursor, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range page.Orders {
+			if order.Carrier != req.Carrier || order.Status == shipper.StatusCancelled || order.ManifestedAt != nil {
+				continue
+			}
+			if order.ShipDate().Format("2006-01-02") != day {
+				continue
+			}
+			key := originKey(order.Request.SenderAddress)
+			byOrigin[key] = append(byOrigin[key], order)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	keys := make([]string, 0, len(byOrigin))
+	for key := range byOrigin {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	groups := make([][]*shipper.Order, len(keys))
+	for i, key := range keys {
+		groups[i] = byOrigin[key]
+	}
+	return groups, nil
+}
+
+// accountOrders returns the open orders of the carrier, of all shippers,
+// origins and ship dates: those a carrier that transmits the whole account
+// transmits.
+func (c *Closer) accountOrders(ctx context.Context, carrier string) ([]*shipper.Order, error) {
+	active, err := c.orders.ListActiveOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var orders []*shipper.Order
+	for _, order := range active {
+		if order.Carrier == carrier && order.ManifestedAt == nil {
+			orders = append(orders, order)
+		}
+	}
+	return orders, nil
+}
+
+// markManifested records the manifests on an order. Failures are logged
+// rather than returned since the carrier has already accepted the orders.
+func (c *Closer) markManifested(ctx context.Context, order *shipper.Order, manifests []shipper.Manifest) {
+	manifestIDs := make([]string, len(manifests))
+	for i, m := range manifests {
+		manifestIDs[i] = m.ManifestID
+	}
+
+	// Only the manifest fields are written: the order may have changed
+	// since it was listed, e.g. by a tracking update
+	if err := c.orders.MarkManifested(ctx, order.OrderID, manifestIDs, time.Now()); err != nil {
+		c.logger.Error("Failed to mark order as manifested",
+			zap.String("order_id", order.OrderID),
+			zap.Error(err),
+		)
+	}
+}
+
+// originKey identifies an origin by its postal code, ignoring case and
+// spaces.
+func originKey(addr shipper.Address) string {
+	return strings.ReplaceAll(strings.ToUpper(addr.PostalCode), " ", "")
+}
@@ -0,0 +1,237 @@
+package manifest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/manifest"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+var shipDate = time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+// recordingManifester records the origins it is asked to transmit and fails
+// for the origin in fail. onManifest, if set, is called during each
+// transmit.
+type recordingManifester struct {
+	*mock.Client
+	origins    []string
+	fail       string
+	onManifest func()
+}
+
+func (m *recordingManifester) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	if m.onManifest != nil {
+		m.onManifest()
+	}
+	if m.fail != "" && req.Origin.PostalCode == m.fail {
+		return nil, errors.New("transmit failed")
+	}
+	m.origins = append(m.origins, req.Origin.PostalCode)
+	return &shipper.ManifestResponse{
+		Carrier:   m.Name(),
+		Manifests: []shipper.Manifest{{ManifestID: "manifest-" + req.Origin.PostalCode}},
+	}, nil
+}
+
+func saveOrder(t *testing.T, orders shipper.OrderStore, orderID, carrier, postalCode string, createdAt time.Time, status shipper.ShipmentStatus) {
+	t.Helper()
+	err := orders.SaveOrder(context.Background(), &shipper.Order{
+		OrderID:   orderID,
+		ShipperID: "shipper-123",
+		Carrier:   carrier,
+		Request:   shipper.CreateOrderRequest{SenderAddress: shipper.Address{PostalCode: postalCode}},
+		Status:    status,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+	require.NoError(t, err)
+}
+
+func newTestCloser(carrier shipper.Shipper) (*manifest.Closer, shipper.OrderStore) {
+	registry := shipper.NewRegistry()
+	registry.Register(carrier)
+	orders := store.NewMemoryOrderStore()
+	return manifest.NewCloser(registry, orders, otelzap.New(zap.NewNop())), orders
+}
+
+func TestCloser_Close(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("canadapost")}
+	closer, orders := newTestCloser(carrier)
+	saveOrder(t, orders, "order-1", "canadapost", "M5V 1A1", shipDate, shipper.StatusConfirmed)
+	saveOrder(t, orders, "order-2", "canadapost", "H2X1Y4", shipDate.Add(time.Hour), shipper.StatusConfirmed)
+	saveOrder(t, orders, "order-3", "canadapost", "m5v1a1", shipDate.Add(2*time.Hour), shipper.StatusConfirmed)
+	saveOrder(t, orders, "cancelled", "canadapost", "M5V1A1", shipDate, shipper.StatusCancelled)
+	saveOrder(t, orders, "other-carrier", "purolator", "M5V1A1", shipDate, shipper.StatusConfirmed)
+	saveOrder(t, orders, "other-day", "canadapost", "M5V1A1", shipDate.AddDate(0, 0, -1), shipper.StatusConfirmed)
+
+	results, err := closer.Close(context.Background(), manifest.Request{
+		ShipperID: "shipper-123",
+		Carrier:   "canadapost",
+		ShipDate:  shipDate,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, []string{"order-2"}, results[0].OrderIDs)
+	assert.ElementsMatch(t, []string{"order-1", "order-3"}, results[1].OrderIDs)
+	assert.Equal(t, "manifest-H2X1Y4", results[0].Manifests[0].ManifestID)
+	assert.Len(t, carrier.origins, 2)
+
+	order, err := orders.GetOrder(context.Background(), "order-1")
+	require.NoError(t, err)
+	assert.NotNil(t, order.ManifestedAt)
+	assert.Len(t, order.ManifestIDs, 1)
+
+	order, err = orders.GetOrder(context.Background(), "other-day")
+	require.NoError(t, err)
+	assert.Nil(t, order.ManifestedAt)
+}
+
+func TestCloser_Close_SkipsManifestedOrders(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("canadapost")}
+	closer, orders := newTestCloser(carrier)
+	saveOrder(t, orders, "order-1", "canadapost", "M5V1A1", shipDate, shipper.StatusConfirmed)
+	req := manifest.Request{ShipperID: "shipper-123", Carrier: "canadapost", ShipDate: shipDate}
+
+	_, err := closer.Close(context.Background(), req)
+	require.NoError(t, err)
+	results, err := closer.Close(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Len(t, carrier.origins, 1)
+}
+
+func TestCloser_Close_KeepsConcurrentChanges(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("canadapost")}
+	closer, orders := newTestCloser(carrier)
+	saveOrder(t, orders, "order-1", "canadapost", "M5V1A1", shipDate, shipper.StatusConfirmed)
+	carrier.onManifest = func() {
+		_, err := orders.UpdateStatus(context.Background(), "order-1", shipper.StatusChange{Status: shipper.StatusPickedUp, Source: "push"})
+		require.NoError(t, err)
+	}
+
+	_, err := closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-123", Carrier: "canadapost", ShipDate: shipDate})
+
+	require.NoError(t, err)
+	order, err := orders.GetOrder(context.Background(), "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusPickedUp, order.Status)
+	assert.Equal(t, []string{"manifest-M5V1A1"}, order.ManifestIDs)
+}
+
+func TestCloser_Close_PartialFailure(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("canadapost"), fail: "M5V1A1"}
+	closer, orders := newTestCloser(carrier)
+	saveOrder(t, orders, "order-1", "canadapost", "H2X1Y4", shipDate, shipper.StatusConfirmed)
+	saveOrder(t, orders, "order-2", "canadapost", "M5V1A1", shipDate, shipper.StatusConfirmed)
+
+	results, err := closer.Close(context.Background(), manifest.Request{
+		ShipperID: "shipper-123",
+		Carrier:   "canadapost",
+		ShipDate:  shipDate,
+	})
+
+	require.Error(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"order-1"}, results[0].OrderIDs)
+
+	order, err := orders.GetOrder(context.Background(), "order-2")
+	require.NoError(t, err)
+	assert.Nil(t, order.ManifestedAt)
+}
+
+// accountManifester transmits every open shipment of the account at once.
+type accountManifester struct {
+	*recordingManifester
+}
+
+func (m accountManifester) Capabilities() shipper.Capabilities {
+	caps := m.recordingManifester.Capabilities()
+	caps.ManifestsAccount = true
+	return caps
+}
+
+func TestCloser_Close_AccountManifest(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("purolator")}
+	closer, orders := newTestCloser(accountManifester{carrier})
+	saveOrder(t, orders, "order-1", "purolator", "M5V1A1", shipDate, shipper.StatusConfirmed)
+	saveOrder(t, orders, "order-2", "purolator", "H2X1Y4", shipDate, shipper.StatusConfirmed)
+	saveOrder(t, orders, "cancelled", "purolator", "M5V1A1", shipDate, shipper.StatusCancelled)
+	saveOrder(t, orders, "other-carrier", "canadapost", "M5V1A1", shipDate, shipper.StatusConfirmed)
+	err := orders.SaveOrder(context.Background(), &shipper.Order{
+		OrderID:   "other-shipper",
+		ShipperID: "shipper-456",
+		Carrier:   "purolator",
+		Request:   shipper.CreateOrderRequest{SenderAddress: shipper.Address{PostalCode: "V6B2W2"}},
+		Status:    shipper.StatusConfirmed,
+		CreatedAt: shipDate,
+		UpdatedAt: shipDate,
+	})
+	require.NoError(t, err)
+
+	results, err := closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-123", Carrier: "purolator", ShipDate: shipDate})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{""}, carrier.origins)
+	assert.Empty(t, results[0].Origin)
+	assert.ElementsMatch(t, []string{"order-1", "order-2", "other-shipper"}, results[0].OrderIDs)
+
+	// The other shipper's order was transmitted with the account
+	order, err := orders.GetOrder(context.Background(), "other-shipper")
+	require.NoError(t, err)
+	assert.NotNil(t, order.ManifestedAt)
+	order, err = orders.GetOrder(context.Background(), "other-carrier")
+	require.NoError(t, err)
+	assert.Nil(t, order.ManifestedAt)
+
+	// Nothing is left to transmit
+	results, err = closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-456", Carrier: "purolator", ShipDate: shipDate})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Len(t, carrier.origins, 1)
+}
+
+func TestCloser_Close_AccountManifestWithoutOrders(t *testing.T) {
+	carrier := &recordingManifester{Client: mock.New("purolator")}
+	closer, orders := newTestCloser(accountManifester{carrier})
+	saveOrder(t, orders, "order-1", "purolator", "M5V1A1", shipDate, shipper.StatusConfirmed)
+
+	// Only shipper-123 has open orders: shipper-456 does not consolidate them
+	results, err := closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-456", Carrier: "purolator", ShipDate: shipDate})
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Empty(t, carrier.origins)
+}
+
+// noManifestShipper hides the optional interfaces of the shipper it embeds.
+type noManifestShipper struct {
+	shipper.Shipper
+}
+
+func TestCloser_Close_Unsupported(t *testing.T) {
+	closer, _ := newTestCloser(noManifestShipper{mock.New("freightcom")})
+
+	_, err := closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-123", Carrier: "freightcom", ShipDate: shipDate})
+
+	assert.ErrorIs(t, err, shipper.ErrUnsupportedOption)
+}
+
+func TestCloser_Close_CarrierNotFound(t *testing.T) {
+	closer, _ := newTestCloser(mock.New("canadapost"))
+
+	_, err := closer.Close(context.Background(), manifest.Request{ShipperID: "shipper-123", Carrier: "purolator", ShipDate: shipDate})
+
+	assert.ErrorIs(t, err, shipper.ErrCarrierNotFound)
+}
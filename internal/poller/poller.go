@@ -0,0 +1,371 @@
+// Package poller tracks the shipments of carriers that do not push
+// tracking events: it polls active orders on an adaptive schedule and
+// records their status changes until they are delivered or cancelled.
+package poller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// source is the history source of the statuses recorded by the poller.
+const source = "poll"
+
+// defaultConcurrencyKey is the CarrierConcurrency entry of carriers without
+// their own cap.
+const defaultConcurrencyKey = "default"
+
+// Recorder records tracking events in the history of their orders and
+// notifies shippers of status changes. It is implemented by
+// graphql.Resolver.
+type Recorder interface {
+	RecordTrackingUpdates(ctx context.Context, carrierName, source string, updates []shipper.TrackingUpdate) (int, error)
+}
+
+// Config controls which shipments are polled and how often.
+type Config struct {
+	Carriers               []string      // Carriers to poll, empty = all
+	Interval               time.Duration // Between polls of a shipment, doubled while its status is unchanged
+	OutForDeliveryInterval time.Duration // Between polls of a shipment out for delivery
+	MaxInterval            time.Duration // Upper bound of a backed off interval
+	ScanInterval           time.Duration // How often Run looks for due shipments
+	Timeout                time.Duration // Of a single poll
+	Workers                int           // Polls at once across carriers
+
+	// Polls at once per carrier, keyed by carrier name. The "default"
+	// entry applies to carriers without their own cap.
+	CarrierConcurrency map[string]int
+}
+
+// DefaultConfig returns the polling settings used for zero Config fields.
+func DefaultConfig() Config {
+	return Config{
+		Interval:               30 * time.Minute,
+		OutForDeliveryInterval: 10 * time.Minute,
+		MaxInterval:            6 * time.Hour,
+		ScanInterval:           time.Minute,
+		Timeout:                30 * time.Second,
+		Workers:                8,
+		CarrierConcurrency:     map[string]int{defaultConcurrencyKey: 2},
+	}
+}
+
+// NextInterval returns the delay before the next poll of a shipment in
+// status after unchanged consecutive polls without a status change.
+// Shipments out for delivery are polled often and exceptions at the base
+// interval. Others, waiting for pickup or on a long haul, are polled less
+// and less often while nothing happens.
+func (c Config) NextInterval(status shipper.ShipmentStatus, unchanged int) time.Duration {
+	switch status {
+	case shipper.StatusOutForDelivery:
+		return c.OutForDeliveryInterval
+	case shipper.StatusException:
+		return c.Interval
+	}
+	delay := c.Interval
+	for i := 0; i < unchanged && delay < c.MaxInterval; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxInterval)
+}
+
+// schedule is the polling state of a shipment.
+type schedule struct {
+	status     shipper.ShipmentStatus
+	unchanged  int // Consecutive polls without a status change
+	nextPollAt time.Time
+}
+
+// Poller polls the tracking of active shipments.
+type Poller struct {
+	registry *shipper.Registry
+	orders   shipper.OrderStore
+	recorder Recorder
+	logger   *otelzap.Logger
+	metrics  *telemetry.Metrics
+	cfg      Config
+	workers  chan struct{}
+
+	mu        sync.Mutex
+	schedules map[string]*schedule // By order ID
+	carriers  map[string]bool      // Carriers whose metrics have been reported
+}
+
+// NewPoller creates a poller for the active orders of store, recording
+// status changes with recorder. Zero fields of cfg take their
+// DefaultConfig value.
+func NewPoller(registry *shipper.Registry, store shipper.OrderStore, recorder Recorder, logger *otelzap.Logger, metrics *telemetry.Metrics, cfg Config) *Poller {
+	defaults := DefaultConfig()
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaults.Interval
+	}
+	if cfg.OutForDeliveryInterval <= 0 {
+		cfg.OutForDeliveryInterval = defaults.OutForDeliveryInterval
+	}
+	if cfg.MaxInterval <= 0 {
+		cfg.MaxInterval = defaults.MaxInterval
+	}
+	if cfg.ScanInterval <= 0 {
+		cfg.ScanInterval = defaults.ScanInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaults.Workers
+	}
+	cfg.CarrierConcurrency = maps.Clone(cfg.CarrierConcurrency)
+	if cfg.CarrierConcurrency == nil {
+		cfg.CarrierConcurrency = make(map[string]int)
+	}
+	if cfg.CarrierConcurrency[defaultConcurrencyKey] <= 0 {
+		cfg.CarrierConcurrency[defaultConcurrencyKey] = defaults.CarrierConcurrency[defaultConcurrencyKey]
+	}
+
+	return &Poller{
+		registry:  registry,
+		orders:    store,
+		recorder:  recorder,
+		logger:    logger,
+		metrics:   metrics,
+		cfg:       cfg,
+		workers:   make(chan struct{}, cfg.Workers),
+		schedules: make(map[string]*schedule),
+		carriers:  make(map[string]bool),
+	}
+}
+
+// Run polls due shipments at every scan interval until ctx is cancelled.
+func (p *Poller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.ScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PollDue(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("Failed to poll tracking", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PollDue polls every active shipment whose next poll is due and returns
+// the number of polls made. Failed polls are logged and retried later.
+func (p *Poller) PollDue(ctx context.Context) (int, error) {
+	due, err := p.due(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	var polled atomic.Int64
+	var wg sync.WaitGroup
+	for carrier, orders := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var g errgroup.Group
+			g.SetLimit(p.carrierConcurrency(carrier))
+			for _, order := range orders {
+				g.Go(func() error {
+					select {
+					case p.workers <- struct{}{}:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+					defer func() { <-p.workers }()
+
+					p.poll(ctx, order)
+					polled.Add(1)
+					return nil
+				})
+			}
+			g.Wait()
+		}()
+	}
+	wg.Wait()
+	return int(polled.Load()), ctx.Err()
+}
+
+// due returns the active orders whose next poll is at or before now,
+// grouped by carrier, and reports the poller metrics. Orders seen for the
+// first time are scheduled from their last status change.
+func (p *Poller) due(ctx context.Context, now time.Time) (map[string][]*shipper.Order, error) {
+	orders, err := p.orders.ListActiveOrders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing active orders: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	active := make(map[string]bool, len(orders))
+	counts := make(map[string]int)
+	lags := make(map[string]time.Duration)
+	due := make(map[string][]*shipper.Order)
+	for _, order := range orders {
+		if !p.polls(order.Carrier) {
+			continue
+		}
+		active[order.OrderID] = true
+		counts[order.Carrier]++
+		p.carriers[order.Carrier] = true
+
+		s, ok := p.schedules[order.OrderID]
+		switch {
+		case !ok:
+			s = &schedule{status: order.Status, nextPollAt: order.UpdatedAt.Add(p.cfg.NextInterval(order.Status, 0))}
+			p.schedules[order.OrderID] = s
+		case s.status != order.Status:
+			// The status was changed elsewhere, e.g. by a cancellation
+			s.status = order.Status
+			s.unchanged = 0
+			if next := order.UpdatedAt.Add(p.cfg.NextInterval(order.Status, 0)); next.Before(s.nextPollAt) {
+				s.nextPollAt = next
+			}
+		}
+		if s.nextPollAt.After(now) {
+			continue
+		}
+		due[order.Carrier] = append(due[order.Carrier], order)
+		lags[order.Carrier] = max(lags[order.Carrier], now.Sub(s.nextPollAt))
+	}
+
+	// Shipments delivered or cancelled since the last scan are no longer
+	// polled
+	for orderID := range p.schedules {
+		if !active[orderID] {
+			delete(p.schedules, orderID)
+		}
+	}
+
+	for carrier := range p.carriers {
+		p.metrics.RecordPollerActive(carrier, counts[carrier])
+		p.metrics.RecordPollerLag(carrier, lags[carrier].Seconds())
+	}
+	return due, nil
+}
+
+// poll tracks a shipment with its carrier, records its events and
+// schedules its next poll.
+func (p *Poller) poll(ctx context.Context, order *shipper.Order) {
+	startTime := time.Now()
+
+	status, err := p.track(ctx, order)
+	if err != nil {
+		p.metrics.RecordRequest("poll_tracking", order.Carrier, "error", time.Since(startTime).Seconds())
+		p.logger.Warn("Failed to poll shipment tracking",
+			zap.String("order_id", order.OrderID),
+			zap.String("carrier", order.Carrier),
+			zap.Error(err),
+		)
+		status = order.Status
+	} else {
+		p.metrics.RecordRequest("poll_tracking", order.Carrier, "success", time.Since(startTime).Seconds())
+	}
+	p.reschedule(order.OrderID, status)
+}
+
+// track records the tracking events of a shipment reported by its carrier
+// and returns the resulting status of the order.
+func (p *Poller) track(ctx context.Context, order *shipper.Order) (shipper.ShipmentStatus, error) {
+	carrier, err := p.registry.Get(order.Carrier)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	resp, err := carrier.Track(ctx, &shipper.TrackRequest{
+		OrderID:        order.OrderID,
+		TrackingNumber: order.Response.TrackingNumber,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	events := resp.Events
+	if len(events) == 0 {
+		// The carrier only reported the current status. It is left without
+		// a time, which would be ours rather than the carrier's.
+		events = []shipper.TrackingEvent{{Status: resp.Status}}
+	}
+	updates := make([]shipper.TrackingUpdate, len(events))
+	for i, e := range events {
+		updates[i] = shipper.TrackingUpdate{
+			OrderID:        order.OrderID,
+			TrackingNumber: order.Response.TrackingNumber,
+			Event:          e,
+		}
+	}
+	recorded, err := p.recorder.RecordTrackingUpdates(ctx, order.Carrier, source, updates)
+	if err != nil {
+		return "", fmt.Errorf("recording tracking events: %w", err)
+	}
+	if recorded == 0 {
+		return order.Status, nil
+	}
+
+	// Not every reported status is recorded, e.g. one that would move the
+	// order back, so the order is read again
+	updated, err := p.orders.GetOrder(ctx, order.OrderID)
+	if err != nil {
+		return "", fmt.Errorf("reading polled order: %w", err)
+	}
+	p.logger.Info("Polled shipment status changed",
+		zap.String("order_id", order.OrderID),
+		zap.String("carrier", order.Carrier),
+		zap.String("status", string(updated.Status)),
+	)
+	return updated.Status, nil
+}
+
+// reschedule schedules the next poll of a shipment now in status, or stops
+// polling it once delivered or cancelled.
+func (p *Poller) reschedule(orderID string, status shipper.ShipmentStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s, ok := p.schedules[orderID]
+	if !ok {
+		return
+	}
+	if status.Terminal() {
+		delete(p.schedules, orderID)
+		return
+	}
+	if status == s.status {
+		s.unchanged++
+	} else {
+		s.status = status
+		s.unchanged = 0
+	}
+	s.nextPollAt = time.Now().Add(p.cfg.NextInterval(status, s.unchanged))
+}
+
+// polls reports whether the shipments of a carrier are polled.
+func (p *Poller) polls(carrier string) bool {
+	return len(p.cfg.Carriers) == 0 || slices.Contains(p.cfg.Carriers, carrier)
+}
+
+// carrierConcurrency returns the number of polls of a carrier made at once.
+func (p *Poller) carrierConcurrency(carrier string) int {
+	if n := p.cfg.CarrierConcurrency[carrier]; n > 0 {
+		return n
+	}
+	return p.cfg.CarrierConcurrency[defaultConcurrencyKey]
+}
@@ -0,0 +1,250 @@
+package poller_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/graphql"
+	"github.com/tournevent/logistic/internal/poller"
+	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+// tracker is a mock carrier reporting status for every shipment it tracks,
+// taking delay to answer.
+type tracker struct {
+	shipper.Shipper
+
+	delay    time.Duration
+	calls    atomic.Int64
+	inFlight atomic.Int64
+	peak     atomic.Int64
+
+	mu       sync.Mutex
+	status   shipper.ShipmentStatus
+	noEvents bool // Report the status only
+}
+
+func newTracker(name string, status shipper.ShipmentStatus) *tracker {
+	return &tracker{Shipper: mock.New(name), status: status}
+}
+
+func (t *tracker) setStatus(status shipper.ShipmentStatus) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status = status
+}
+
+func (t *tracker) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	t.calls.Add(1)
+	n := t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+	for {
+		peak := t.peak.Load()
+		if n <= peak || t.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(t.delay)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	resp := &shipper.TrackResponse{
+		TrackingNumber: req.TrackingNumber,
+		Status:         t.status,
+	}
+	if !t.noEvents {
+		resp.Events = []shipper.TrackingEvent{{Status: t.status, Timestamp: time.Now()}}
+	}
+	return resp, nil
+}
+
+func newTestPoller(cfg poller.Config, carriers ...*tracker) (*poller.Poller, *graphql.Resolver) {
+	registry := shipper.NewRegistry()
+	for _, c := range carriers {
+		registry.Register(c)
+	}
+	logger := otelzap.New(zap.NewNop())
+	metrics := telemetry.NewMetrics()
+
+	resolver := graphql.NewResolver(registry, logger, metrics)
+	return poller.NewPoller(registry, resolver.OrderStore, resolver, logger, metrics, cfg), resolver
+}
+
+// storeOrder records a confirmed order last updated at updatedAt and
+// returns its ID.
+func storeOrder(t *testing.T, resolver *graphql.Resolver, carrier string, updatedAt time.Time) string {
+	t.Helper()
+
+	orderID := uuid.New().String()
+	err := resolver.OrderStore.SaveOrder(context.Background(), &shipper.Order{
+		OrderID:   orderID,
+		ShipperID: "shipper-123",
+		Carrier:   carrier,
+		Response:  shipper.CreateOrderResponse{OrderID: orderID, TrackingNumber: "TRK-" + orderID, Status: shipper.StatusConfirmed},
+		Status:    shipper.StatusConfirmed,
+		History:   []shipper.StatusChange{{Status: shipper.StatusConfirmed, Timestamp: updatedAt, Source: "create_order"}},
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	})
+	require.NoError(t, err)
+	return orderID
+}
+
+func TestPoller_PollDue_RecordsStatusChanges(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusInTransit)
+	p, resolver := newTestPoller(poller.Config{Interval: 30 * time.Minute}, puro)
+	orderID := storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+
+	ctx := context.Background()
+	polled, err := p.PollDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, polled)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusInTransit, order.Status)
+	require.Len(t, order.History, 2)
+	assert.Equal(t, "poll", order.History[1].Source)
+
+	// The next poll is an interval away
+	polled, err = p.PollDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, polled)
+	assert.EqualValues(t, 1, puro.calls.Load())
+}
+
+func TestPoller_PollDue_IgnoresRegressions(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusPending)
+	p, resolver := newTestPoller(poller.Config{Interval: 30 * time.Minute}, puro)
+	orderID := storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+
+	ctx := context.Background()
+	polled, err := p.PollDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, polled)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusConfirmed, order.Status)
+	assert.Len(t, order.History, 1)
+}
+
+func TestPoller_PollDue_StatusOnly(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusInTransit)
+	puro.noEvents = true
+	p, resolver := newTestPoller(poller.Config{Interval: 30 * time.Minute}, puro)
+	orderID := storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+
+	ctx := context.Background()
+	_, err := p.PollDue(ctx)
+	require.NoError(t, err)
+
+	// The polled status has no carrier time, so a later push of an
+	// earlier event is still recorded
+	recorded, err := resolver.RecordTrackingUpdates(ctx, "purolator", "push", []shipper.TrackingUpdate{
+		{OrderID: orderID, Event: shipper.TrackingEvent{Status: shipper.StatusOutForDelivery, Timestamp: time.Now().Add(-time.Minute)}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, recorded)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusOutForDelivery, order.Status)
+	require.Len(t, order.History, 3)
+	assert.Equal(t, "poll", order.History[1].Source)
+	assert.False(t, order.History[1].CarrierTime)
+}
+
+func TestPoller_PollDue_NotDueYet(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusInTransit)
+	p, resolver := newTestPoller(poller.Config{Interval: 30 * time.Minute}, puro)
+	storeOrder(t, resolver, "purolator", time.Now())
+
+	polled, err := p.PollDue(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, polled)
+	assert.Zero(t, puro.calls.Load())
+}
+
+func TestPoller_PollDue_StopsWhenDelivered(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusOutForDelivery)
+	p, resolver := newTestPoller(poller.Config{Interval: time.Millisecond, OutForDeliveryInterval: time.Millisecond}, puro)
+	orderID := storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+
+	ctx := context.Background()
+	_, err := p.PollDue(ctx)
+	require.NoError(t, err)
+
+	puro.setStatus(shipper.StatusDelivered)
+	time.Sleep(5 * time.Millisecond)
+	polled, err := p.PollDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, polled)
+
+	order, err := resolver.OrderStore.GetOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+
+	// Delivered shipments are no longer polled
+	time.Sleep(5 * time.Millisecond)
+	polled, err = p.PollDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, polled)
+	assert.EqualValues(t, 2, puro.calls.Load())
+}
+
+func TestPoller_PollDue_CarrierConcurrency(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusInTransit)
+	puro.delay = 10 * time.Millisecond
+	cp := newTracker("canadapost", shipper.StatusInTransit)
+	cp.delay = 10 * time.Millisecond
+	p, resolver := newTestPoller(poller.Config{
+		Workers:            3,
+		CarrierConcurrency: map[string]int{"purolator": 1, "default": 4},
+	}, puro, cp)
+	for range 6 {
+		storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+		storeOrder(t, resolver, "canadapost", time.Now().Add(-time.Hour))
+	}
+
+	polled, err := p.PollDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 12, polled)
+	assert.EqualValues(t, 1, puro.peak.Load())
+	assert.LessOrEqual(t, cp.peak.Load(), int64(3), "bounded by the workers")
+}
+
+func TestPoller_PollDue_Carriers(t *testing.T) {
+	puro := newTracker("purolator", shipper.StatusInTransit)
+	fc := newTracker("freightcom", shipper.StatusInTransit)
+	p, resolver := newTestPoller(poller.Config{Carriers: []string{"purolator"}}, puro, fc)
+	storeOrder(t, resolver, "purolator", time.Now().Add(-time.Hour))
+	storeOrder(t, resolver, "freightcom", time.Now().Add(-time.Hour))
+
+	polled, err := p.PollDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, polled)
+	assert.EqualValues(t, 1, puro.calls.Load())
+	assert.Zero(t, fc.calls.Load())
+}
+
+func TestConfig_NextInterval(t *testing.T) {
+	cfg := poller.Config{Interval: 30 * time.Minute, OutForDeliveryInterval: 10 * time.Minute, MaxInterval: 3 * time.Hour}
+
+	assert.Equal(t, 30*time.Minute, cfg.NextInterval(shipper.StatusConfirmed, 0))
+	assert.Equal(t, time.Hour, cfg.NextInterval(shipper.StatusInTransit, 1))
+	assert.Equal(t, 2*time.Hour, cfg.NextInterval(shipper.StatusInTransit, 2))
+	assert.Equal(t, 3*time.Hour, cfg.NextInterval(shipper.StatusInTransit, 100))
+	assert.Equal(t, 10*time.Minute, cfg.NextInterval(shipper.StatusOutForDelivery, 5))
+	assert.Equal(t, 30*time.Minute, cfg.NextInterval(shipper.StatusException, 5))
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tournevent/logistic/internal/graphql/generated"
+	"github.com/tournevent/logistic/internal/hasura"
+	"go.uber.org/zap"
+)
+
+// maxActionBodySize bounds the size of an action payload.
+const maxActionBodySize = 1 << 20
+
+// errInvalidInput marks errors caused by malformed action input.
+var errInvalidInput = errors.New("invalid action input")
+
+// actionFunc decodes the input of an action and resolves it.
+type actionFunc func(ctx context.Context, input json.RawMessage) (any, error)
+
+// resolveAction adapts a mutation resolver to an actionFunc.
+// Hasura passes the action arguments by name, so the payload input is
+// {"input": {...}} for all Delivro mutations. The input must already hold
+// the schema defaults, see withDefaults.
+func resolveAction[T, R any](resolve func(context.Context, T) (R, error)) actionFunc {
+	return func(ctx context.Context, input json.RawMessage) (any, error) {
+		var args struct {
+			Input *T `json:"input"`
+		}
+		if err := json.Unmarshal(input, &args); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidInput, err)
+		}
+		if args.Input == nil {
+			return nil, fmt.Errorf("%w: missing argument input", errInvalidInput)
+		}
+		return resolve(ctx, *args.Input)
+	}
+}
+
+// actions maps Hasura action names to their resolvers.
+func (s *Server) actions() map[string]actionFunc {
+	mutation := s.resolver.Mutation()
+	return map[string]actionFunc{
+		"delivro_get_quote":               resolveAction[generated.GetQuoteInput](mutation.DelivroGetQuote),
+		"delivro_create_order":            resolveAction[generated.CreateOrderInput](mutation.DelivroCreateOrder),
+		"delivro_get_label":               resolveAction[generated.GetLabelInput](mutation.DelivroGetLabel),
+		"delivro_cancel_order":            resolveAction[generated.CancelOrderInput](mutation.DelivroCancelOrder),
+		"delivro_track_shipment":          resolveAction[generated.TrackShipmentInput](mutation.DelivroTrackShipment),
+		"delivro_pickup_availability":     resolveAction[generated.PickupAvailabilityInput](mutation.DelivroPickupAvailability),
+		"delivro_schedule_pickup":         resolveAction[generated.SchedulePickupInput](mutation.DelivroSchedulePickup),
+		"delivro_cancel_pickup":           resolveAction[generated.CancelPickupInput](mutation.DelivroCancelPickup),
+		"delivro_manifest":                resolveAction[generated.ManifestInput](mutation.DelivroManifest),
+		"delivro_create_return":           resolveAction[generated.CreateReturnInput](mutation.DelivroCreateReturn),
+		"delivro_create_webhook":          resolveAction[generated.CreateWebhookInput](mutation.DelivroCreateWebhook),
+		"delivro_delete_webhook":          resolveAction[generated.DeleteWebhookInput](mutation.DelivroDeleteWebhook),
+		"delivro_replay_webhook_delivery": resolveAction[generated.ReplayWebhookDeliveryInput](mutation.DelivroReplayWebhookDelivery),
+	}
+}
+
+// actionsHandler serves Hasura Actions, dispatching on the action name.
+func (s *Server) actionsHandler() http.Handler {
+	actions := s.actions()
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: s.resolver}).Schema()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeActionError(w, http.StatusMethodNotAllowed, "method not allowed", "method_not_allowed")
+			return
+		}
+
+		if !s.validActionSecret(r) {
+			writeActionError(w, http.StatusUnauthorized, "invalid action secret", "unauthorized")
+			return
+		}
+
+		var payload hasura.ActionPayload
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxActionBodySize)).Decode(&payload); err != nil {
+			writeActionError(w, http.StatusBadRequest, "invalid action payload: "+err.Error(), "invalid_payload")
+			return
+		}
+
+		action, ok := actions[payload.Action.Name]
+		if !ok {
+			writeActionError(w, http.StatusBadRequest, "unknown action: "+payload.Action.Name, "unknown_action")
+			return
+		}
+
+		session := hasura.NewSession(payload.SessionVariables)
+		ctx := hasura.WithSession(r.Context(), session)
+
+		s.logger.Info("Handling action",
+			zap.String("action", payload.Action.Name),
+			zap.String("user_id", session.UserID()),
+			zap.String("role", session.Role()),
+		)
+
+		input, err := withDefaults(schema, schema.Mutation.Fields.ForName(payload.Action.Name), payload.Input)
+		if err != nil {
+			writeActionError(w, http.StatusBadRequest, err.Error(), "invalid_input")
+			return
+		}
+
+		result, err := action(ctx, input)
+		if errors.Is(err, errInvalidInput) {
+			writeActionError(w, http.StatusBadRequest, err.Error(), "invalid_input")
+			return
+		}
+		if err != nil {
+			s.logger.Error("Action failed",
+				zap.String("action", payload.Action.Name),
+				zap.Error(err),
+			)
+			writeActionError(w, http.StatusBadRequest, err.Error(), "action_failed")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, result)
+	})
+}
+
+// validActionSecret reports whether the request carries the configured
+// shared secret. Verification is skipped when no secret is configured.
+func (s *Server) validActionSecret(r *http.Request) bool {
+	if s.actionSecret == "" {
+		return true
+	}
+	got := r.Header.Get(hasura.SecretHeader)
+	return subtle.ConstantTimeCompare([]byte(got), []byte(s.actionSecret)) == 1
+}
+
+func writeActionError(w http.ResponseWriter, status int, message, code string) {
+	writeJSON(w, status, hasura.ActionError{
+		Message:    message,
+		Extensions: map[string]any{"code": code},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
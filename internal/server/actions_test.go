@@ -0,0 +1,220 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/hasura"
+	"github.com/tournevent/logistic/internal/server"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+const testActionSecret = "s3cret"
+
+func newTestActionServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(mock.New("test-shipper"))
+
+	return server.New(server.Config{Port: 8080, ActionSecret: testActionSecret}, registry, logger)
+}
+
+func postAction(t *testing.T, srv *server.Server, secret, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/actions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(hasura.SecretHeader, secret)
+	}
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeActionError(t *testing.T, rec *httptest.ResponseRecorder) hasura.ActionError {
+	t.Helper()
+
+	var actionErr hasura.ActionError
+	err := json.NewDecoder(rec.Body).Decode(&actionErr)
+	require.NoError(t, err)
+	return actionErr
+}
+
+const getQuoteAction = `{
+	"action": {"name": "delivro_get_quote"},
+	"session_variables": {"x-hasura-user-id": "user-1", "x-hasura-role": "shipper"},
+	"input": {
+		"input": {
+			"shipperId": "shipper-1",
+			"origin": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+			"destination": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+			"packages": [{"length": "10", "width": "10", "height": "10", "weight": "5", "weightUnit": "LB", "declaredValue": "100.00"}]
+		}
+	}
+}`
+
+func TestActions_GetQuote(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, testActionSecret, getQuoteAction)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var result struct {
+		Success bool `json:"success"`
+		Rates   []struct {
+			RateID string `json:"rateId"`
+		} `json:"rates"`
+	}
+	err := json.NewDecoder(rec.Body).Decode(&result)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.NotEmpty(t, result.Rates)
+}
+
+func TestActions_CreateOrderThenLabel(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, testActionSecret, getQuoteAction)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var quote struct {
+		Rates []struct {
+			RateID string `json:"rateId"`
+		} `json:"rates"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&quote))
+	require.NotEmpty(t, quote.Rates)
+
+	body := `{
+		"action": {"name": "delivro_create_order"},
+		"input": {
+			"input": {
+				"shipperId": "shipper-1",
+				"rateId": "` + quote.Rates[0].RateID + `",
+				"sender": {"name": "Sender", "phone": "416-555-1234"},
+				"senderAddress": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+				"recipient": {"name": "Receiver", "phone": "604-555-5678"},
+				"recipientAddress": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+				"packages": [{"length": "10", "width": "10", "height": "10", "weight": "5", "weightUnit": "LB", "declaredValue": "100.00"}]
+			}
+		}
+	}`
+	rec = postAction(t, srv, testActionSecret, body)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var order struct {
+		Success bool   `json:"success"`
+		OrderID string `json:"orderId"`
+		Status  string `json:"status"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&order))
+	require.True(t, order.Success)
+	assert.Equal(t, "CONFIRMED", order.Status)
+
+	rec = postAction(t, srv, testActionSecret, `{
+		"action": {"name": "delivro_get_label"},
+		"input": {"input": {"shipperId": "shipper-1", "orderId": "`+order.OrderID+`", "format": "PDF"}}
+	}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var label struct {
+		Success bool `json:"success"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&label))
+	assert.True(t, label.Success)
+}
+
+func TestActions_InvalidSecret(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, "wrong", getQuoteAction)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	actionErr := decodeActionError(t, rec)
+	assert.NotEmpty(t, actionErr.Message)
+	assert.Equal(t, "unauthorized", actionErr.Extensions["code"])
+}
+
+func TestActions_MissingSecret(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, "", getQuoteAction)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestActions_NoSecretConfigured(t *testing.T) {
+	srv := newTestServer(t)
+
+	rec := postAction(t, srv, "", getQuoteAction)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestActions_UnknownAction(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, testActionSecret, `{"action": {"name": "delivro_unknown"}, "input": {}}`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	actionErr := decodeActionError(t, rec)
+	assert.Contains(t, actionErr.Message, "delivro_unknown")
+	assert.Equal(t, "unknown_action", actionErr.Extensions["code"])
+}
+
+func TestActions_InvalidPayload(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	rec := postAction(t, srv, testActionSecret, "invalid json")
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	actionErr := decodeActionError(t, rec)
+	assert.Equal(t, "invalid_payload", actionErr.Extensions["code"])
+}
+
+func TestActions_InvalidInput(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing input argument", `{"action": {"name": "delivro_get_label"}, "input": {}}`},
+		{"wrong type", `{"action": {"name": "delivro_get_label"}, "input": {"input": {"orderId": 42}}}`},
+		{"unknown enum", `{"action": {"name": "delivro_get_label"}, "input": {"input": {"orderId": "1", "format": "GIF"}}}`},
+		{"malformed decimal", `{"action": {"name": "delivro_get_quote"}, "input": {"input": {"shipperId": "1", "packages": [{"length": "10", "width": "10", "height": "10", "weight": "1e3"}]}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postAction(t, srv, testActionSecret, tt.body)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			actionErr := decodeActionError(t, rec)
+			assert.Equal(t, "invalid_input", actionErr.Extensions["code"])
+		})
+	}
+}
+
+func TestActions_MethodNotAllowed(t *testing.T) {
+	srv := newTestActionServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/actions", nil)
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+}
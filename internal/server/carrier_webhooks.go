@@ -0,0 +1,80 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+	"go.uber.org/zap"
+)
+
+// maxPushBodySize bounds the size of a tracking push.
+const maxPushBodySize = 1 << 20
+
+// pushResult is the response to an accepted tracking push.
+type pushResult struct {
+	Received int `json:"received"` // Events in the push
+	Recorded int `json:"recorded"` // Status changes recorded from them
+}
+
+// carrierWebhookHandler serves /webhooks/{carrier}, recording the tracking
+// events carriers push. Failures to record are answered with a 5xx so
+// that the carrier retries the push.
+func (s *Server) carrierWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		carrierName := r.PathValue("carrier")
+
+		carrier, err := s.registry.Get(carrierName)
+		if err != nil {
+			http.Error(w, "unknown carrier", http.StatusNotFound)
+			return
+		}
+		tracker, ok := shipper.As[shipper.PushTracker](carrier)
+		if !ok {
+			http.Error(w, carrierName+" does not push tracking events", http.StatusNotFound)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPushBodySize))
+		if err != nil {
+			http.Error(w, "invalid body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		updates, err := tracker.ParseTrackingPush(r.Header, body)
+		switch {
+		case errors.Is(err, shipper.ErrAuthenticationFailed):
+			s.logger.Warn("Rejected tracking push", zap.String("carrier", carrierName), zap.Error(err))
+			s.metrics.RecordRequest("tracking_push", carrierName, "unauthorized", time.Since(startTime).Seconds())
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		case err != nil:
+			s.metrics.RecordRequest("tracking_push", carrierName, "invalid", time.Since(startTime).Seconds())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		recorded, err := s.resolver.RecordTrackingUpdates(r.Context(), carrierName, "push", updates)
+		if err != nil {
+			s.logger.Error("Failed to record tracking push",
+				zap.String("carrier", carrierName),
+				zap.Int("events", len(updates)),
+				zap.Error(err),
+			)
+			s.metrics.RecordRequest("tracking_push", carrierName, "error", time.Since(startTime).Seconds())
+			http.Error(w, "failed to record tracking events", http.StatusInternalServerError)
+			return
+		}
+
+		s.logger.Info("Recorded tracking push",
+			zap.String("carrier", carrierName),
+			zap.Int("events", len(updates)),
+			zap.Int("recorded", recorded),
+		)
+		s.metrics.RecordRequest("tracking_push", carrierName, "success", time.Since(startTime).Seconds())
+		writeJSON(w, http.StatusOK, pushResult{Received: len(updates), Recorded: recorded})
+	})
+}
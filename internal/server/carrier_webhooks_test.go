@@ -0,0 +1,178 @@
+package server_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/server"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/freightcom"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+const testWebhookSecret = "fc-webhook-secret"
+
+func newTestPushServer(t *testing.T) (*server.Server, shipper.OrderStore) {
+	t.Helper()
+
+	logger := otelzap.New(zap.NewNop())
+	registry := shipper.NewRegistry()
+	registry.Register(freightcom.New(freightcom.Config{UseMock: true, WebhookSecret: testWebhookSecret}, logger, nil))
+	registry.Register(mock.New("purolator"))
+
+	orders := store.NewMemoryOrderStore()
+	createdAt := time.Date(2026, 10, 13, 15, 0, 0, 0, time.UTC)
+	err := orders.SaveOrder(context.Background(), &shipper.Order{
+		OrderID:   "fc-ship-123",
+		ShipperID: "shipper-123",
+		Carrier:   "freightcom",
+		Response:  shipper.CreateOrderResponse{OrderID: "fc-ship-123", TrackingNumber: "1Z999AA10123456784"},
+		Status:    shipper.StatusConfirmed,
+		History:   []shipper.StatusChange{{Status: shipper.StatusConfirmed, Timestamp: createdAt, Source: "create_order"}},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+	require.NoError(t, err)
+
+	return server.New(server.Config{Port: 8080, OrderStore: orders}, registry, logger), orders
+}
+
+func freightcomPush(shipmentID string, events ...string) string {
+	return `{"type": "shipment.tracking_updated", "shipment_id": "` + shipmentID + `", "events": [` + strings.Join(events, ",") + `]}`
+}
+
+func freightcomEvent(timestamp, status string) string {
+	return `{"timestamp": "` + timestamp + `", "description": "Scan", "location": "Toronto, ON", "status": "` + status + `"}`
+}
+
+func postPush(t *testing.T, srv *server.Server, carrier, secret, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(body))
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/"+carrier, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(freightcom.SignatureHeader, hex.EncodeToString(h.Sum(nil)))
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func decodePushResult(t *testing.T, rec *httptest.ResponseRecorder) (received, recorded int) {
+	t.Helper()
+
+	var result struct {
+		Received int `json:"received"`
+		Recorded int `json:"recorded"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&result))
+	return result.Received, result.Recorded
+}
+
+func TestCarrierWebhooks_RecordsStatusHistory(t *testing.T) {
+	srv, orders := newTestPushServer(t)
+	body := freightcomPush("fc-ship-123",
+		freightcomEvent("2026-10-14T16:20:00Z", "delivered"),
+		freightcomEvent("2026-10-14T08:05:00Z", "in_transit"),
+	)
+
+	rec := postPush(t, srv, "freightcom", testWebhookSecret, body)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	received, recorded := decodePushResult(t, rec)
+	assert.Equal(t, 2, received)
+	assert.Equal(t, 2, recorded)
+
+	order, err := orders.GetOrder(context.Background(), "fc-ship-123")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+	require.Len(t, order.History, 3)
+	assert.Equal(t, shipper.StatusInTransit, order.History[1].Status)
+	assert.Equal(t, "push", order.History[1].Source)
+	assert.Equal(t, time.Date(2026, 10, 14, 8, 5, 0, 0, time.UTC), order.History[1].Timestamp)
+	assert.Equal(t, shipper.StatusDelivered, order.History[2].Status)
+}
+
+func TestCarrierWebhooks_DeduplicatesEvents(t *testing.T) {
+	srv, orders := newTestPushServer(t)
+	inTransit := freightcomEvent("2026-10-14T08:05:00Z", "in_transit")
+	outForDelivery := freightcomEvent("2026-10-14T09:30:00Z", "out_for_delivery")
+
+	rec := postPush(t, srv, "freightcom", testWebhookSecret, freightcomPush("fc-ship-123", inTransit, outForDelivery))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// A retried push and a late, older event change nothing
+	rec = postPush(t, srv, "freightcom", testWebhookSecret, freightcomPush("fc-ship-123", inTransit, outForDelivery))
+	require.Equal(t, http.StatusOK, rec.Code)
+	_, recorded := decodePushResult(t, rec)
+	assert.Zero(t, recorded)
+
+	order, err := orders.GetOrder(context.Background(), "fc-ship-123")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusOutForDelivery, order.Status)
+	assert.Len(t, order.History, 3)
+}
+
+func TestCarrierWebhooks_UnknownShipment(t *testing.T) {
+	srv, _ := newTestPushServer(t)
+
+	rec := postPush(t, srv, "freightcom", testWebhookSecret, freightcomPush("fc-ship-999", freightcomEvent("2026-10-14T08:05:00Z", "in_transit")))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	received, recorded := decodePushResult(t, rec)
+	assert.Equal(t, 1, received)
+	assert.Zero(t, recorded)
+}
+
+func TestCarrierWebhooks_InvalidSignature(t *testing.T) {
+	srv, orders := newTestPushServer(t)
+
+	rec := postPush(t, srv, "freightcom", "guess", freightcomPush("fc-ship-123", freightcomEvent("2026-10-14T16:20:00Z", "delivered")))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	order, err := orders.GetOrder(context.Background(), "fc-ship-123")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusConfirmed, order.Status)
+}
+
+func TestCarrierWebhooks_InvalidBody(t *testing.T) {
+	srv, _ := newTestPushServer(t)
+
+	rec := postPush(t, srv, "freightcom", testWebhookSecret, `{"events": [`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCarrierWebhooks_UnsupportedCarrier(t *testing.T) {
+	srv, _ := newTestPushServer(t)
+
+	for _, carrier := range []string{"purolator", "ups"} {
+		rec := postPush(t, srv, carrier, testWebhookSecret, freightcomPush("fc-ship-123"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code, carrier)
+	}
+}
+
+func TestCarrierWebhooks_MethodNotAllowed(t *testing.T) {
+	srv, _ := newTestPushServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/freightcom", nil)
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
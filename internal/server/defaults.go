@@ -0,0 +1,84 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// withDefaults returns the action input with the default values of the
+// schema set, as the GraphQL executor does: Hasura forwards the arguments
+// as sent and encoding/json leaves absent fields unset. Arguments and input
+// object fields that are absent take their default; explicit nulls are
+// kept. The input is returned as is if field is nil.
+func withDefaults(schema *ast.Schema, field *ast.FieldDefinition, input json.RawMessage) (json.RawMessage, error) {
+	if field == nil {
+		return input, nil
+	}
+
+	var args map[string]any
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.UseNumber() // Keeps Decimal amounts as sent
+	if err := dec.Decode(&args); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidInput, err)
+	}
+	if args == nil {
+		args = make(map[string]any)
+	}
+
+	fields := make(ast.FieldList, len(field.Arguments))
+	for i, arg := range field.Arguments {
+		fields[i] = &ast.FieldDefinition{Name: arg.Name, Type: arg.Type, DefaultValue: arg.DefaultValue}
+	}
+	if err := setDefaults(schema, fields, args); err != nil {
+		return nil, err
+	}
+	return json.Marshal(args)
+}
+
+// setDefaults sets the absent fields of obj that have a default value, and
+// the defaults of the input objects nested in obj.
+func setDefaults(schema *ast.Schema, fields ast.FieldList, obj map[string]any) error {
+	for _, f := range fields {
+		v, ok := obj[f.Name]
+		if !ok {
+			if f.DefaultValue == nil {
+				continue
+			}
+			def, err := f.DefaultValue.Value(nil)
+			if err != nil {
+				return fmt.Errorf("default value of %s: %w", f.Name, err)
+			}
+			obj[f.Name] = def
+			v = def
+		}
+		if err := setNestedDefaults(schema, f.Type, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setNestedDefaults sets the defaults of the input objects in v, a value of
+// type t.
+func setNestedDefaults(schema *ast.Schema, t *ast.Type, v any) error {
+	switch v := v.(type) {
+	case []any:
+		if t.Elem == nil {
+			return nil
+		}
+		for _, e := range v {
+			if err := setNestedDefaults(schema, t.Elem, e); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		def := schema.Types[t.Name()]
+		if def != nil && def.Kind == ast.InputObject {
+			return setDefaults(schema, def.Fields, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/graphql/generated"
+)
+
+func TestWithDefaults(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	tests := []struct {
+		name   string
+		action string
+		input  string
+		want   string
+	}{
+		{
+			name:   "input object fields",
+			action: "delivro_get_quote",
+			input: `{"input": {
+				"shipperId": "1",
+				"origin": {"postalCode": "M5V 1A1"},
+				"packages": [{"weight": 5.10}, {"weight": "2", "weightUnit": "LB"}],
+				"filter": {"maxPrice": "20.00"}
+			}}`,
+			want: `{"input": {
+				"shipperId": "1",
+				"origin": {"postalCode": "M5V 1A1", "countryCode": "CA", "isResidential": false},
+				"packages": [
+					{"weight": 5.10, "dimensionUnit": "CM", "weightUnit": "KG", "packageType": "BOX", "currency": "CAD"},
+					{"weight": "2", "dimensionUnit": "CM", "weightUnit": "LB", "packageType": "BOX", "currency": "CAD"}
+				],
+				"filter": {"maxPrice": "20.00", "guaranteedOnly": false, "maxPriceCurrency": "CAD"},
+				"sort": "CHEAPEST"
+			}}`,
+		},
+		{
+			name:   "explicit null",
+			action: "delivro_get_label",
+			input:  `{"input": {"orderId": "1", "format": null}}`,
+			want:   `{"input": {"orderId": "1", "format": null}}`,
+		},
+		{
+			name:   "missing argument",
+			action: "delivro_get_label",
+			input:  `{}`,
+			want:   `{}`,
+		},
+		{
+			name:   "unknown action",
+			action: "delivro_unknown",
+			input:  `{"input": {"orderId": "1"}}`,
+			want:   `{"input": {"orderId": "1"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withDefaults(schema, schema.Mutation.Fields.ForName(tt.action), json.RawMessage(tt.input))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestWithDefaults_InvalidInput(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	field := schema.Mutation.Fields.ForName("delivro_get_label")
+
+	for _, input := range []string{``, `[]`, `"input"`} {
+		_, err := withDefaults(schema, field, json.RawMessage(input))
+		assert.ErrorIs(t, err, errInvalidInput, input)
+	}
+}
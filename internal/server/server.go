@@ -2,17 +2,25 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/tournevent/logistic/internal/graphql"
 	"github.com/tournevent/logistic/internal/graphql/generated"
+	"github.com/tournevent/logistic/internal/poller"
 	"github.com/tournevent/logistic/internal/telemetry"
+	"github.com/tournevent/logistic/internal/webhook"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 )
 
@@ -23,29 +31,64 @@ type Server struct {
 	logger   *otelzap.Logger
 	metrics  *telemetry.Metrics
 	resolver *graphql.Resolver
+	poller   *poller.Poller // Nil when tracking is not polled
+
+	actionSecret string
 }
 
 // Config holds server configuration.
 type Config struct {
-	Port int
+	Port             int
+	ActionSecret     string                   // Shared secret expected from Hasura; empty disables the check
+	QuoteStore       shipper.QuoteStore       // Optional, defaults to in-memory
+	OrderStore       shipper.OrderStore       // Optional, defaults to in-memory
+	IdempotencyStore shipper.IdempotencyStore // Optional, defaults to in-memory
+	Webhooks         *webhook.Dispatcher      // Optional, defaults to in-memory subscriptions and deliveries
+	Poller           *poller.Config           // Optional, tracking is not polled when nil
+
+	RatingWeights rating.Weights // Optional, defaults to rating.DefaultWeights
 }
 
 // New creates a new server instance.
 func New(cfg Config, registry *shipper.Registry, logger *otelzap.Logger) *Server {
 	metrics := telemetry.NewMetrics()
 	resolver := graphql.NewResolver(registry, logger, metrics)
+	if cfg.QuoteStore != nil {
+		resolver.QuoteStore = cfg.QuoteStore
+	}
+	if cfg.OrderStore != nil {
+		resolver.OrderStore = cfg.OrderStore
+	}
+	if cfg.IdempotencyStore != nil {
+		resolver.IdempotencyStore = cfg.IdempotencyStore
+	}
+	if cfg.Webhooks != nil {
+		resolver.Webhooks = cfg.Webhooks
+	}
+	if cfg.RatingWeights != (rating.Weights{}) {
+		resolver.RatingWeights = cfg.RatingWeights
+	}
+	var p *poller.Poller
+	if cfg.Poller != nil {
+		p = poller.NewPoller(registry, resolver.OrderStore, resolver, logger, metrics, *cfg.Poller)
+	}
+	if cfg.ActionSecret == "" {
+		logger.Warn("No action secret configured, /actions accepts unauthenticated calls")
+	}
 
 	return &Server{
-		port:     cfg.Port,
-		registry: registry,
-		logger:   logger,
-		metrics:  metrics,
-		resolver: resolver,
+		port:         cfg.Port,
+		registry:     registry,
+		logger:       logger,
+		metrics:      metrics,
+		resolver:     resolver,
+		poller:       p,
+		actionSecret: cfg.ActionSecret,
 	}
 }
 
-// Run starts the HTTP server and blocks until context is cancelled.
-func (s *Server) Run(ctx context.Context) error {
+// Handler returns the HTTP handler serving all endpoints.
+func (s *Server) Handler() http.Handler {
 	mux := http.NewServeMux()
 
 	// Health check
@@ -55,15 +98,34 @@ func (s *Server) Run(ctx context.Context) error {
 	mux.Handle("/metrics", promhttp.Handler())
 
 	// GraphQL endpoint
-	mux.HandleFunc("/graphql", s.handleGraphQL)
+	mux.Handle("/graphql", otelhttp.NewHandler(s.graphQLHandler(), "/graphql"))
 
+	// Hasura Actions endpoint
+	mux.Handle("/actions", otelhttp.NewHandler(s.actionsHandler(), "/actions"))
+
+	// Tracking pushed by carriers
+	mux.Handle("POST /webhooks/{carrier}", otelhttp.NewHandler(s.carrierWebhookHandler(), "/webhooks/{carrier}"))
+
+	return mux
+}
+
+// Run starts the HTTP server and blocks until context is cancelled.
+func (s *Server) Run(ctx context.Context) error {
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%d", s.port),
-		Handler:      mux,
+		Handler:      s.Handler(),
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 	}
 
+	// Deliver webhooks until shutdown
+	go s.resolver.Webhooks.Run(ctx)
+
+	// Poll the tracking of carriers that do not push it
+	if s.poller != nil {
+		go s.poller.Run(ctx)
+	}
+
 	// Start server in goroutine
 	errCh := make(chan error, 1)
 	go func() {
@@ -90,294 +152,20 @@ func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte("ok"))
 }
 
-// GraphQL request/response types
-type graphQLRequest struct {
-	Query         string                 `json:"query"`
-	OperationName string                 `json:"operationName,omitempty"`
-	Variables     map[string]interface{} `json:"variables,omitempty"`
-}
+// graphQLHandler builds the gqlgen handler serving the executable schema.
+func (s *Server) graphQLHandler() http.Handler {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: s.resolver}))
 
-type graphQLResponse struct {
-	Data   interface{}      `json:"data,omitempty"`
-	Errors []graphQLError   `json:"errors,omitempty"`
-}
-
-type graphQLError struct {
-	Message string `json:"message"`
-}
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
 
-func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
-	if r.Method != http.MethodPost {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		json.NewEncoder(w).Encode(graphQLResponse{
-			Errors: []graphQLError{{Message: "Method not allowed, use POST"}},
-		})
-		return
-	}
-
-	var req graphQLRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(graphQLResponse{
-			Errors: []graphQLError{{Message: "Invalid JSON: " + err.Error()}},
-		})
-		return
-	}
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
 
-	ctx := r.Context()
-
-	// Simple query router based on operation
-	// In production, use gqlgen's generated handler
-	var response interface{}
-	var err error
-
-	switch {
-	case containsQuery(req.Query, "health"):
-		health, _ := s.resolver.Query().Health(ctx)
-		response = map[string]interface{}{"health": health}
-
-	case containsQuery(req.Query, "carriers"):
-		carriers, _ := s.resolver.Query().Carriers(ctx)
-		response = map[string]interface{}{"carriers": carriers}
-
-	case containsQuery(req.Query, "serviceTypes"):
-		types, _ := s.resolver.Query().ServiceTypes(ctx)
-		response = map[string]interface{}{"serviceTypes": types}
-
-	case containsQuery(req.Query, "delivro_get_quote"):
-		input, err := parseGetQuoteInput(req.Variables)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			json.NewEncoder(w).Encode(graphQLResponse{
-				Errors: []graphQLError{{Message: err.Error()}},
-			})
-			return
-		}
-		result, _ := s.resolver.Mutation().DelivroGetQuote(ctx, input)
-		response = map[string]interface{}{"delivro_get_quote": result}
-
-	case containsQuery(req.Query, "delivro_create_order"):
-		input, err := parseCreateOrderInput(req.Variables)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			json.NewEncoder(w).Encode(graphQLResponse{
-				Errors: []graphQLError{{Message: err.Error()}},
-			})
-			return
-		}
-		result, _ := s.resolver.Mutation().DelivroCreateOrder(ctx, input)
-		response = map[string]interface{}{"delivro_create_order": result}
-
-	case containsQuery(req.Query, "delivro_get_label"):
-		input, err := parseGetLabelInput(req.Variables)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			json.NewEncoder(w).Encode(graphQLResponse{
-				Errors: []graphQLError{{Message: err.Error()}},
-			})
-			return
-		}
-		result, _ := s.resolver.Mutation().DelivroGetLabel(ctx, input)
-		response = map[string]interface{}{"delivro_get_label": result}
-
-	case containsQuery(req.Query, "delivro_cancel_order"):
-		input, err := parseCancelOrderInput(req.Variables)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			json.NewEncoder(w).Encode(graphQLResponse{
-				Errors: []graphQLError{{Message: err.Error()}},
-			})
-			return
-		}
-		result, _ := s.resolver.Mutation().DelivroCancelOrder(ctx, input)
-		response = map[string]interface{}{"delivro_cancel_order": result}
-
-	default:
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(graphQLResponse{
-			Errors: []graphQLError{{Message: "Unknown operation"}},
-		})
-		return
-	}
-
-	if err != nil {
-		json.NewEncoder(w).Encode(graphQLResponse{
-			Errors: []graphQLError{{Message: err.Error()}},
-		})
-		return
-	}
-
-	json.NewEncoder(w).Encode(graphQLResponse{Data: response})
-}
-
-func containsQuery(query, operation string) bool {
-	return len(query) > 0 && (contains(query, operation) || contains(query, camelCase(operation)))
-}
-
-func contains(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
-	}
-	return false
-}
-
-func camelCase(s string) string {
-	// Simple conversion: delivro_get_quote -> delivroGetQuote
-	result := make([]byte, 0, len(s))
-	capitalizeNext := false
-	for i := 0; i < len(s); i++ {
-		if s[i] == '_' {
-			capitalizeNext = true
-			continue
-		}
-		if capitalizeNext && s[i] >= 'a' && s[i] <= 'z' {
-			result = append(result, s[i]-32)
-			capitalizeNext = false
-		} else {
-			result = append(result, s[i])
-		}
-	}
-	return string(result)
-}
-
-// Input parsing helpers
-func parseGetQuoteInput(vars map[string]interface{}) (generated.GetQuoteInput, error) {
-	var input generated.GetQuoteInput
-	inputData, ok := vars["input"].(map[string]interface{})
-	if !ok {
-		return input, fmt.Errorf("missing or invalid 'input' variable")
-	}
-
-	// Parse required fields
-	input.ShipperID, _ = inputData["shipperId"].(string)
-
-	if origin, ok := inputData["origin"].(map[string]interface{}); ok {
-		input.Origin = parseAddressInputPtr(origin)
-	}
-	if dest, ok := inputData["destination"].(map[string]interface{}); ok {
-		input.Destination = parseAddressInputPtr(dest)
-	}
-	if pkgs, ok := inputData["packages"].([]interface{}); ok {
-		input.Packages = parsePackagesInput(pkgs)
-	}
-
-	return input, nil
-}
-
-func parseCreateOrderInput(vars map[string]interface{}) (generated.CreateOrderInput, error) {
-	var input generated.CreateOrderInput
-	inputData, ok := vars["input"].(map[string]interface{})
-	if !ok {
-		return input, fmt.Errorf("missing or invalid 'input' variable")
-	}
-
-	input.ShipperID, _ = inputData["shipperId"].(string)
-	input.RateID, _ = inputData["rateId"].(string)
-
-	if quoteID, ok := inputData["quoteId"].(string); ok {
-		input.QuoteID = &quoteID
-	}
-	if sender, ok := inputData["sender"].(map[string]interface{}); ok {
-		input.Sender = parseContactInputPtr(sender)
-	}
-	if senderAddr, ok := inputData["senderAddress"].(map[string]interface{}); ok {
-		input.SenderAddress = parseAddressInputPtr(senderAddr)
-	}
-	if recipient, ok := inputData["recipient"].(map[string]interface{}); ok {
-		input.Recipient = parseContactInputPtr(recipient)
-	}
-	if recipientAddr, ok := inputData["recipientAddress"].(map[string]interface{}); ok {
-		input.RecipientAddress = parseAddressInputPtr(recipientAddr)
-	}
-	if pkgs, ok := inputData["packages"].([]interface{}); ok {
-		input.Packages = parsePackagesInput(pkgs)
-	}
-
-	return input, nil
-}
-
-func parseGetLabelInput(vars map[string]interface{}) (generated.GetLabelInput, error) {
-	var input generated.GetLabelInput
-	inputData, ok := vars["input"].(map[string]interface{})
-	if !ok {
-		return input, fmt.Errorf("missing or invalid 'input' variable")
-	}
-
-	input.OrderID, _ = inputData["orderId"].(string)
-
-	return input, nil
-}
-
-func parseCancelOrderInput(vars map[string]interface{}) (generated.CancelOrderInput, error) {
-	var input generated.CancelOrderInput
-	inputData, ok := vars["input"].(map[string]interface{})
-	if !ok {
-		return input, fmt.Errorf("missing or invalid 'input' variable")
-	}
-
-	input.OrderID, _ = inputData["orderId"].(string)
-	if reason, ok := inputData["reason"].(string); ok {
-		input.Reason = &reason
-	}
-
-	return input, nil
-}
-
-func parseAddressInputPtr(data map[string]interface{}) *generated.AddressInput {
-	addr := &generated.AddressInput{}
-	addr.Name, _ = data["name"].(string)
-	addr.Line1, _ = data["line1"].(string)
-	addr.City, _ = data["city"].(string)
-	addr.ProvinceCode, _ = data["provinceCode"].(string)
-	addr.PostalCode, _ = data["postalCode"].(string)
-	addr.Phone, _ = data["phone"].(string)
-
-	if company, ok := data["company"].(string); ok {
-		addr.Company = &company
-	}
-	if line2, ok := data["line2"].(string); ok {
-		addr.Line2 = &line2
-	}
-	if countryCode, ok := data["countryCode"].(string); ok {
-		addr.CountryCode = &countryCode
-	}
-	if email, ok := data["email"].(string); ok {
-		addr.Email = &email
-	}
-
-	return addr
-}
-
-func parseContactInputPtr(data map[string]interface{}) *generated.ContactInput {
-	contact := &generated.ContactInput{}
-	contact.Name, _ = data["name"].(string)
-	contact.Phone, _ = data["phone"].(string)
-
-	if company, ok := data["company"].(string); ok {
-		contact.Company = &company
-	}
-	if email, ok := data["email"].(string); ok {
-		contact.Email = &email
-	}
-
-	return contact
-}
-
-func parsePackagesInput(pkgs []interface{}) []*generated.PackageInput {
-	result := make([]*generated.PackageInput, 0, len(pkgs))
-	for _, p := range pkgs {
-		if pkg, ok := p.(map[string]interface{}); ok {
-			input := &generated.PackageInput{}
-			input.Length, _ = pkg["length"].(string)
-			input.Width, _ = pkg["width"].(string)
-			input.Height, _ = pkg["height"].(string)
-			input.Weight, _ = pkg["weight"].(string)
-			result = append(result, input)
-		}
-	}
-	return result
+	return srv
 }
@@ -13,6 +13,10 @@ import (
 	"github.com/tournevent/logistic/pkg/shipper"
 	"github.com/tournevent/logistic/pkg/shipper/mock"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/zap"
 )
 
@@ -26,135 +30,277 @@ func newTestServer(t *testing.T) *server.Server {
 	return server.New(server.Config{Port: 8080}, registry, logger)
 }
 
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func postGraphQL(t *testing.T, srv *server.Server, body string) (*httptest.ResponseRecorder, graphQLResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+
+	var resp graphQLResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	require.NoError(t, err)
+
+	return rec, resp
+}
+
 func TestServer_Health(t *testing.T) {
-	// Create a simple handler to test health endpoint
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("ok"))
-	})
+	srv := newTestServer(t)
 
 	req := httptest.NewRequest(http.MethodGet, "/health", nil)
 	rec := httptest.NewRecorder()
 
-	handler.ServeHTTP(rec, req)
+	srv.Handler().ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 	assert.Equal(t, "ok", rec.Body.String())
 }
 
-func TestServer_GraphQL_MethodNotAllowed(t *testing.T) {
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-
-		if r.Method != http.MethodPost {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			json.NewEncoder(w).Encode(map[string]interface{}{
-				"errors": []map[string]string{
-					{"message": "Method not allowed, use POST"},
-				},
-			})
-			return
-		}
-	})
+func TestServer_GraphQL_UnsupportedMethod(t *testing.T) {
+	srv := newTestServer(t)
 
-	req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	req := httptest.NewRequest(http.MethodPut, "/graphql", nil)
 	rec := httptest.NewRecorder()
 
-	handler.ServeHTTP(rec, req)
+	srv.Handler().ServeHTTP(rec, req)
 
-	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-	var resp map[string]interface{}
+	var resp graphQLResponse
 	err := json.NewDecoder(rec.Body).Decode(&resp)
 	require.NoError(t, err)
-
-	errors, ok := resp["errors"].([]interface{})
-	require.True(t, ok)
-	assert.Len(t, errors, 1)
+	assert.Len(t, resp.Errors, 1)
 }
 
 func TestServer_GraphQL_InvalidJSON(t *testing.T) {
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
+	srv := newTestServer(t)
 
-		if r.Method != http.MethodPost {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
+	rec, resp := postGraphQL(t, srv, "invalid json")
 
-		var req struct {
-			Query string `json:"query"`
-		}
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			json.NewEncoder(w).Encode(map[string]interface{}{
-				"errors": []map[string]string{
-					{"message": "Invalid JSON: " + err.Error()},
-				},
-			})
-			return
-		}
-	})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.NotEmpty(t, resp.Errors)
+}
 
-	body := strings.NewReader("invalid json")
-	req := httptest.NewRequest(http.MethodPost, "/graphql", body)
-	req.Header.Set("Content-Type", "application/json")
+func TestServer_GraphQL_HealthQuery(t *testing.T) {
+	srv := newTestServer(t)
+
+	rec, resp := postGraphQL(t, srv, `{"query": "query { health }"}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, resp.Errors)
+	assert.JSONEq(t, "true", string(resp.Data["health"]))
+}
+
+func TestServer_GraphQL_HealthQuery_GET(t *testing.T) {
+	srv := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/graphql?query={health}", nil)
 	rec := httptest.NewRecorder()
 
-	handler.ServeHTTP(rec, req)
+	srv.Handler().ServeHTTP(rec, req)
 
-	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":{"health":true}}`, rec.Body.String())
 }
 
-func TestServer_GraphQL_HealthQuery(t *testing.T) {
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
+func TestServer_GraphQL_MultipleFields(t *testing.T) {
+	srv := newTestServer(t)
 
-		if r.Method != http.MethodPost {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
+	rec, resp := postGraphQL(t, srv, `{"query": "query { health carriers serviceTypes }"}`)
 
-		var req struct {
-			Query string `json:"query"`
-		}
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, resp.Errors)
+	assert.Contains(t, resp.Data, "health")
+	assert.Contains(t, resp.Data, "carriers")
+	assert.Contains(t, resp.Data, "serviceTypes")
+}
+
+func TestServer_GraphQL_OperationName(t *testing.T) {
+	srv := newTestServer(t)
+
+	body := `{
+		"query": "query Health { health } query Carriers { carriers }",
+		"operationName": "Carriers"
+	}`
+	rec, resp := postGraphQL(t, srv, body)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, resp.Errors)
+	assert.Contains(t, resp.Data, "carriers")
+	assert.NotContains(t, resp.Data, "health")
+}
 
-		// Simple mock response for health query
-		if strings.Contains(req.Query, "health") {
-			json.NewEncoder(w).Encode(map[string]interface{}{
-				"data": map[string]interface{}{
-					"health": true,
-				},
-			})
-			return
+func TestServer_GraphQL_GetQuoteWithVariables(t *testing.T) {
+	srv := newTestServer(t)
+
+	body := `{
+		"query": "mutation GetQuote($input: GetQuoteInput!) { delivro_get_quote(input: $input) { success rates { rateId serviceName } } }",
+		"variables": {
+			"input": {
+				"shipperId": "shipper-1",
+				"origin": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+				"destination": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+				"packages": [{"length": "10", "width": "10", "height": "10", "weight": "5", "weightUnit": "LB", "declaredValue": "100.00"}]
+			}
 		}
+	}`
+	rec, resp := postGraphQL(t, srv, body)
 
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"errors": []map[string]string{
-				{"message": "Unknown operation"},
-			},
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, resp.Errors)
+
+	var result struct {
+		Success bool `json:"success"`
+		Rates   []struct {
+			RateID      string `json:"rateId"`
+			ServiceName string `json:"serviceName"`
+		} `json:"rates"`
+	}
+	err := json.Unmarshal(resp.Data["delivro_get_quote"], &result)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.NotEmpty(t, result.Rates)
+}
+
+func TestServer_GraphQL_MalformedDecimal(t *testing.T) {
+	srv := newTestServer(t)
+
+	for _, value := range []string{"12abc", "1e3", "+5", ".5", "1,000.00", ""} {
+		t.Run(value, func(t *testing.T) {
+			body := `{
+				"query": "mutation GetQuote($input: GetQuoteInput!) { delivro_get_quote(input: $input) { success } }",
+				"variables": {
+					"input": {
+						"shipperId": "shipper-1",
+						"origin": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+						"destination": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+						"packages": [{"length": "10", "width": "10", "height": "10", "weight": "` + value + `"}]
+					}
+				}
+			}`
+			rec, resp := postGraphQL(t, srv, body)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			require.NotEmpty(t, resp.Errors)
+			assert.Contains(t, resp.Errors[0].Message, "invalid amount")
+			assert.Nil(t, resp.Data["delivro_get_quote"])
 		})
-	})
+	}
+}
 
-	body := strings.NewReader(`{"query": "query { health }"}`)
-	req := httptest.NewRequest(http.MethodPost, "/graphql", body)
-	req.Header.Set("Content-Type", "application/json")
-	rec := httptest.NewRecorder()
+func TestServer_GraphQL_DeclaredValueTooPrecise(t *testing.T) {
+	srv := newTestServer(t)
+
+	body := `{
+		"query": "mutation GetQuote($input: GetQuoteInput!) { delivro_get_quote(input: $input) { success errors { code message } } }",
+		"variables": {
+			"input": {
+				"shipperId": "shipper-1",
+				"origin": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+				"destination": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+				"packages": [{"length": "10", "width": "10", "height": "10", "weight": "5", "declaredValue": "100.005"}]
+			}
+		}
+	}`
+	rec, resp := postGraphQL(t, srv, body)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, resp.Errors)
+
+	var result struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	err := json.Unmarshal(resp.Data["delivro_get_quote"], &result)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "INVALID_INPUT", result.Errors[0].Code)
+	assert.Contains(t, result.Errors[0].Message, "packages[0].declaredValue")
+}
+
+func TestServer_GraphQL_MissingRequiredVariable(t *testing.T) {
+	srv := newTestServer(t)
+
+	body := `{
+		"query": "mutation Label($input: GetLabelInput!) { delivro_get_label(input: $input) { success } }",
+		"variables": {"input": {}}
+	}`
+	rec, resp := postGraphQL(t, srv, body)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.NotEmpty(t, resp.Errors)
+}
+
+func TestServer_GraphQL_UnknownField(t *testing.T) {
+	srv := newTestServer(t)
+
+	rec, resp := postGraphQL(t, srv, `{"query": "query { unknownField }"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.NotEmpty(t, resp.Errors)
+	assert.Contains(t, resp.Errors[0].Message, "unknownField")
+}
+
+func TestServer_GraphQL_Introspection(t *testing.T) {
+	srv := newTestServer(t)
 
-	handler.ServeHTTP(rec, req)
+	rec, resp := postGraphQL(t, srv, `{"query": "{ __schema { mutationType { name } } }"}`)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"mutationType":{"name":"Mutation"}}`, string(resp.Data["__schema"]))
+}
 
-	var resp map[string]interface{}
-	err := json.NewDecoder(rec.Body).Decode(&resp)
-	require.NoError(t, err)
+func TestServer_GraphQL_ContinuesIncomingTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	srv := newTestServer(t)
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	body := `{
+		"query": "mutation GetQuote($input: GetQuoteInput!) { delivro_get_quote(input: $input) { success } }",
+		"variables": {
+			"input": {
+				"shipperId": "shipper-1",
+				"origin": {"name": "Sender", "line1": "123 Main St", "city": "Toronto", "provinceCode": "ON", "postalCode": "M5V 1A1", "phone": "416-555-1234"},
+				"destination": {"name": "Receiver", "line1": "456 Oak Ave", "city": "Vancouver", "provinceCode": "BC", "postalCode": "V6B 2W2", "phone": "604-555-5678"},
+				"packages": [{"length": "10", "width": "10", "height": "10", "weight": "5", "weightUnit": "LB", "declaredValue": "100.00"}]
+			}
+		}
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+
+	srv.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
 
-	data, ok := resp["data"].(map[string]interface{})
-	require.True(t, ok)
-	assert.Equal(t, true, data["health"])
+	names := make([]string, 0)
+	for _, span := range recorder.Ended() {
+		assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+		names = append(names, span.Name())
+	}
+	assert.Contains(t, names, "/graphql")
+	assert.Contains(t, names, "registry.get_quote")
 }
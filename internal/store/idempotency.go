@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// DefaultIdempotencyTTL is how long idempotency keys are remembered after
+// their first use.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// abandonedClaimTimeout is how long a key can stay claimed without an
+// order before the claim is considered lost, e.g. to a crash, and the key
+// can be claimed again.
+const abandonedClaimTimeout = 5 * time.Minute
+
+// idempotencyTable is the in-memory index shared by the idempotency store
+// implementations. Callers must hold the owning store's lock.
+type idempotencyTable map[string]*shipper.IdempotencyRecord
+
+func idempotencyKey(shipperID, key string) string {
+	return shipperID + "|" + key
+}
+
+func (t idempotencyTable) claim(shipperID, key, fingerprint string, now time.Time) (*shipper.IdempotencyRecord, bool) {
+	id := idempotencyKey(shipperID, key)
+	if record, ok := t[id]; ok && !abandoned(record, now) {
+		result := *record
+		return &result, false
+	}
+	record := &shipper.IdempotencyRecord{
+		ShipperID:   shipperID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+	}
+	t[id] = record
+	result := *record
+	return &result, true
+}
+
+// abandoned reports whether a claim was never completed nor released.
+func abandoned(record *shipper.IdempotencyRecord, now time.Time) bool {
+	return record.Pending() && record.CreatedAt.Add(abandonedClaimTimeout).Before(now)
+}
+
+func (t idempotencyTable) complete(shipperID, key, orderID string) {
+	if record, ok := t[idempotencyKey(shipperID, key)]; ok {
+		record.OrderID = orderID
+	}
+}
+
+func (t idempotencyTable) release(shipperID, key string) {
+	id := idempotencyKey(shipperID, key)
+	if record, ok := t[id]; ok && record.Pending() {
+		delete(t, id)
+	}
+}
+
+func (t idempotencyTable) prune(now time.Time) {
+	for id, record := range t {
+		if record.CreatedAt.Add(DefaultIdempotencyTTL).Before(now) {
+			delete(t, id)
+		}
+	}
+}
+
+// MemoryIdempotencyStore keeps idempotency keys in process memory.
+type MemoryIdempotencyStore struct {
+	mu   sync.Mutex
+	keys idempotencyTable
+}
+
+// NewMemoryIdempotencyStore creates an empty in-memory idempotency store.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		keys: make(idempotencyTable),
+	}
+}
+
+// Claim implements shipper.IdempotencyStore.
+func (s *MemoryIdempotencyStore) Claim(ctx context.Context, shipperID, key, fingerprint string) (*shipper.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.keys.prune(now)
+	record, claimed := s.keys.claim(shipperID, key, fingerprint, now)
+	return record, claimed, nil
+}
+
+// Complete implements shipper.IdempotencyStore.
+func (s *MemoryIdempotencyStore) Complete(ctx context.Context, shipperID, key, orderID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys.complete(shipperID, key, orderID)
+	return nil
+}
+
+// Release implements shipper.IdempotencyStore.
+func (s *MemoryIdempotencyStore) Release(ctx context.Context, shipperID, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys.release(shipperID, key)
+	return nil
+}
+
+// FileIdempotencyStore keeps idempotency keys in memory and persists them
+// to a JSON file after every write, so replays are detected across
+// restarts.
+type FileIdempotencyStore struct {
+	mu   sync.Mutex
+	path string
+	keys idempotencyTable
+}
+
+// NewFileIdempotencyStore opens the idempotency store at path, loading any
+// existing keys. The file is created on the first write.
+func NewFileIdempotencyStore(path string) (*FileIdempotencyStore, error) {
+	s := &FileIdempotencyStore{
+		path: path,
+		keys: make(idempotencyTable),
+	}
+	if err := readJSONFile(path, &s.keys); err != nil {
+		return nil, fmt.Errorf("loading idempotency store: %w", err)
+	}
+	return s, nil
+}
+
+// Claim implements shipper.IdempotencyStore.
+func (s *FileIdempotencyStore) Claim(ctx context.Context, shipperID, key, fingerprint string) (*shipper.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.keys.prune(now)
+	record, claimed := s.keys.claim(shipperID, key, fingerprint, now)
+	if !claimed {
+		return record, false, nil
+	}
+	if err := s.persist(); err != nil {
+		delete(s.keys, idempotencyKey(shipperID, key))
+		return nil, false, err
+	}
+	return record, true, nil
+}
+
+// Complete implements shipper.IdempotencyStore.
+func (s *FileIdempotencyStore) Complete(ctx context.Context, shipperID, key, orderID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys.complete(shipperID, key, orderID)
+	return s.persist()
+}
+
+// Release implements shipper.IdempotencyStore.
+func (s *FileIdempotencyStore) Release(ctx context.Context, shipperID, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys.release(shipperID, key)
+	return s.persist()
+}
+
+func (s *FileIdempotencyStore) persist() error {
+	if err := writeJSONFile(s.path, s.keys); err != nil {
+		return fmt.Errorf("persisting idempotency store: %w", err)
+	}
+	return nil
+}
+
+// Ensure implementations satisfy the interface
+var (
+	_ shipper.IdempotencyStore = (*MemoryIdempotencyStore)(nil)
+	_ shipper.IdempotencyStore = (*FileIdempotencyStore)(nil)
+)
@@ -0,0 +1,80 @@
+package store_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/store"
+)
+
+func TestMemoryIdempotencyStore_Claim(t *testing.T) {
+	s := store.NewMemoryIdempotencyStore()
+	ctx := context.Background()
+
+	record, claimed, err := s.Claim(ctx, "shipper-123", "key-1", "fp-1")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.True(t, record.Pending())
+
+	record, claimed, err = s.Claim(ctx, "shipper-123", "key-1", "fp-2")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "fp-1", record.Fingerprint)
+	assert.True(t, record.Pending())
+
+	require.NoError(t, s.Complete(ctx, "shipper-123", "key-1", "order-1"))
+	record, claimed, err = s.Claim(ctx, "shipper-123", "key-1", "fp-1")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "order-1", record.OrderID)
+
+	// Keys are scoped to the shipper
+	_, claimed, err = s.Claim(ctx, "shipper-456", "key-1", "fp-1")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestMemoryIdempotencyStore_Release(t *testing.T) {
+	s := store.NewMemoryIdempotencyStore()
+	ctx := context.Background()
+
+	_, _, err := s.Claim(ctx, "shipper-123", "key-1", "fp-1")
+	require.NoError(t, err)
+	require.NoError(t, s.Release(ctx, "shipper-123", "key-1"))
+
+	_, claimed, err := s.Claim(ctx, "shipper-123", "key-1", "fp-2")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Completed keys are kept
+	require.NoError(t, s.Complete(ctx, "shipper-123", "key-1", "order-1"))
+	require.NoError(t, s.Release(ctx, "shipper-123", "key-1"))
+	record, claimed, err := s.Claim(ctx, "shipper-123", "key-1", "fp-2")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "order-1", record.OrderID)
+}
+
+func TestFileIdempotencyStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "idempotency.json")
+	ctx := context.Background()
+
+	s, err := store.NewFileIdempotencyStore(path)
+	require.NoError(t, err)
+
+	_, _, err = s.Claim(ctx, "shipper-123", "key-1", "fp-1")
+	require.NoError(t, err)
+	require.NoError(t, s.Complete(ctx, "shipper-123", "key-1", "order-1"))
+
+	reopened, err := store.NewFileIdempotencyStore(path)
+	require.NoError(t, err)
+
+	record, claimed, err := reopened.Claim(ctx, "shipper-123", "key-1", "fp-1")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "fp-1", record.Fingerprint)
+	assert.Equal(t, "order-1", record.OrderID)
+}
@@ -0,0 +1,371 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// DefaultPageSize is used when ListOrders is called without a limit.
+const DefaultPageSize = 20
+
+// orderTable is the in-memory index shared by the order store implementations.
+// Callers must hold the owning store's lock.
+type orderTable map[string]*shipper.Order
+
+func (t orderTable) put(order *shipper.Order) {
+	t[order.OrderID] = copyOrder(order)
+}
+
+func (t orderTable) get(orderID string) (*shipper.Order, error) {
+	order, ok := t[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, orderID)
+	}
+	return copyOrder(order), nil
+}
+
+func (t orderTable) getByReference(shipperID, reference string) (*shipper.Order, error) {
+	var latest *shipper.Order
+	for _, order := range t {
+		if order.ShipperID != shipperID || order.Reference != reference {
+			continue
+		}
+		if latest == nil || order.CreatedAt.After(latest.CreatedAt) {
+			latest = order
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%w: reference %s", shipper.ErrOrderNotFound, reference)
+	}
+	return copyOrder(latest), nil
+}
+
+func (t orderTable) getByTrackingNumber(carrier, trackingNumber string) (*shipper.Order, error) {
+	var latest *shipper.Order
+	for _, order := range t {
+		if order.Carrier != carrier || order.Response.TrackingNumber != trackingNumber {
+			continue
+		}
+		if latest == nil || order.CreatedAt.After(latest.CreatedAt) {
+			latest = order
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%w: tracking number %s", shipper.ErrOrderNotFound, trackingNumber)
+	}
+	return copyOrder(latest), nil
+}
+
+func (t orderTable) list(shipperID, cursor string, limit int) (*shipper.OrderPage, error) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	orders := make([]*shipper.Order, 0)
+	for _, order := range t {
+		if order.ShipperID == shipperID {
+			orders = append(orders, order)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orderBefore(orders[i].CreatedAt, orders[i].OrderID, orders[j].CreatedAt, orders[j].OrderID)
+	})
+
+	start := 0
+	if cursor != "" {
+		createdAt, orderID, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(orders), func(i int) bool {
+			return orderBefore(createdAt, orderID, orders[i].CreatedAt, orders[i].OrderID)
+		})
+	}
+
+	end := min(start+limit, len(orders))
+	page := &shipper.OrderPage{
+		Orders: make([]*shipper.Order, 0, end-start),
+	}
+	for _, order := range orders[start:end] {
+		page.Orders = append(page.Orders, copyOrder(order))
+	}
+	if end < len(orders) {
+		last := orders[end-1]
+		page.NextCursor = encodeCursor(last.CreatedAt, last.OrderID)
+	}
+	return page, nil
+}
+
+func (t orderTable) listActive() []*shipper.Order {
+	orders := make([]*shipper.Order, 0)
+	for _, order := range t {
+		if !order.Status.Terminal() {
+			orders = append(orders, copyOrder(order))
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orderBefore(orders[j].CreatedAt, orders[j].OrderID, orders[i].CreatedAt, orders[i].OrderID)
+	})
+	return orders
+}
+
+func (t orderTable) updateStatus(orderID string, change shipper.StatusChange) (bool, error) {
+	order, ok := t[orderID]
+	if !ok {
+		return false, fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, orderID)
+	}
+	if !order.AcceptsStatus(change.Status) {
+		return false, nil
+	}
+	if change.Timestamp.IsZero() {
+		change.Timestamp = time.Now()
+	}
+	// Orders are replaced rather than changed in place, so that a clone of
+	// the table is unaffected
+	updated := copyOrder(order)
+	updated.Status = change.Status
+	updated.History = append(updated.History, change)
+	updated.UpdatedAt = change.Timestamp
+	t[orderID] = updated
+	return true, nil
+}
+
+func (t orderTable) markManifested(orderID string, manifestIDs []string, at time.Time) error {
+	order, ok := t[orderID]
+	if !ok {
+		return fmt.Errorf("%w: %s", shipper.ErrOrderNotFound, orderID)
+	}
+	updated := copyOrder(order)
+	updated.ManifestIDs = append([]string(nil), manifestIDs...)
+	updated.ManifestedAt = &at
+	updated.UpdatedAt = at
+	t[orderID] = updated
+	return nil
+}
+
+// orderBefore reports whether order a sorts before order b: newest first,
+// ties broken by order ID so pagination is stable.
+func orderBefore(aCreated time.Time, aID string, bCreated time.Time, bID string) bool {
+	if !aCreated.Equal(bCreated) {
+		return aCreated.After(bCreated)
+	}
+	return aID < bID
+}
+
+func encodeCursor(createdAt time.Time, orderID string) string {
+	raw := strconv.FormatInt(createdAt.UnixNano(), 10) + "|" + orderID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", shipper.ErrInvalidCursor
+	}
+	nanos, orderID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, "", shipper.ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", shipper.ErrInvalidCursor
+	}
+	return time.Unix(0, n), orderID, nil
+}
+
+func copyOrder(order *shipper.Order) *shipper.Order {
+	result := *order
+	result.History = append([]shipper.StatusChange(nil), order.History...)
+	result.ManifestIDs = append([]string(nil), order.ManifestIDs...)
+	return &result
+}
+
+// MemoryOrderStore keeps orders in process memory.
+type MemoryOrderStore struct {
+	mu     sync.RWMutex
+	orders orderTable
+}
+
+// NewMemoryOrderStore creates an empty in-memory order store.
+func NewMemoryOrderStore() *MemoryOrderStore {
+	return &MemoryOrderStore{
+		orders: make(orderTable),
+	}
+}
+
+// SaveOrder implements shipper.OrderStore.
+func (s *MemoryOrderStore) SaveOrder(ctx context.Context, order *shipper.Order) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orders.put(order)
+	return nil
+}
+
+// GetOrder implements shipper.OrderStore.
+func (s *MemoryOrderStore) GetOrder(ctx context.Context, orderID string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.get(orderID)
+}
+
+// GetOrderByReference implements shipper.OrderStore.
+func (s *MemoryOrderStore) GetOrderByReference(ctx context.Context, shipperID, reference string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.getByReference(shipperID, reference)
+}
+
+// GetOrderByTrackingNumber implements shipper.OrderStore.
+func (s *MemoryOrderStore) GetOrderByTrackingNumber(ctx context.Context, carrier, trackingNumber string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.getByTrackingNumber(carrier, trackingNumber)
+}
+
+// ListOrders implements shipper.OrderStore.
+func (s *MemoryOrderStore) ListOrders(ctx context.Context, shipperID, cursor string, limit int) (*shipper.OrderPage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.list(shipperID, cursor, limit)
+}
+
+// ListActiveOrders implements shipper.OrderStore.
+func (s *MemoryOrderStore) ListActiveOrders(ctx context.Context) ([]*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.listActive(), nil
+}
+
+// UpdateStatus implements shipper.OrderStore.
+func (s *MemoryOrderStore) UpdateStatus(ctx context.Context, orderID string, change shipper.StatusChange) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.orders.updateStatus(orderID, change)
+}
+
+// MarkManifested implements shipper.OrderStore.
+func (s *MemoryOrderStore) MarkManifested(ctx context.Context, orderID string, manifestIDs []string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.orders.markManifested(orderID, manifestIDs, at)
+}
+
+// FileOrderStore keeps orders in memory and persists them to a JSON file
+// after every write.
+type FileOrderStore struct {
+	mu     sync.RWMutex
+	path   string
+	orders orderTable
+}
+
+// NewFileOrderStore opens the order store at path, loading any existing orders.
+// The file is created on the first write.
+func NewFileOrderStore(path string) (*FileOrderStore, error) {
+	s := &FileOrderStore{
+		path:   path,
+		orders: make(orderTable),
+	}
+	if err := readJSONFile(path, &s.orders); err != nil {
+		return nil, fmt.Errorf("loading order store: %w", err)
+	}
+	return s, nil
+}
+
+// SaveOrder implements shipper.OrderStore.
+func (s *FileOrderStore) SaveOrder(ctx context.Context, order *shipper.Order) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.update(func(orders orderTable) (bool, error) {
+		orders.put(order)
+		return true, nil
+	})
+	return err
+}
+
+// GetOrder implements shipper.OrderStore.
+func (s *FileOrderStore) GetOrder(ctx context.Context, orderID string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.get(orderID)
+}
+
+// GetOrderByReference implements shipper.OrderStore.
+func (s *FileOrderStore) GetOrderByReference(ctx context.Context, shipperID, reference string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.getByReference(shipperID, reference)
+}
+
+// GetOrderByTrackingNumber implements shipper.OrderStore.
+func (s *FileOrderStore) GetOrderByTrackingNumber(ctx context.Context, carrier, trackingNumber string) (*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.getByTrackingNumber(carrier, trackingNumber)
+}
+
+// ListOrders implements shipper.OrderStore.
+func (s *FileOrderStore) ListOrders(ctx context.Context, shipperID, cursor string, limit int) (*shipper.OrderPage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.list(shipperID, cursor, limit)
+}
+
+// ListActiveOrders implements shipper.OrderStore.
+func (s *FileOrderStore) ListActiveOrders(ctx context.Context) ([]*shipper.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orders.listActive(), nil
+}
+
+// UpdateStatus implements shipper.OrderStore.
+func (s *FileOrderStore) UpdateStatus(ctx context.Context, orderID string, change shipper.StatusChange) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.update(func(orders orderTable) (bool, error) {
+		return orders.updateStatus(orderID, change)
+	})
+}
+
+// MarkManifested implements shipper.OrderStore.
+func (s *FileOrderStore) MarkManifested(ctx context.Context, orderID string, manifestIDs []string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.update(func(orders orderTable) (bool, error) {
+		return true, orders.markManifested(orderID, manifestIDs, at)
+	})
+	return err
+}
+
+// update applies fn to a copy of the orders and swaps it in once persisted,
+// so that a failed write leaves the store as it was. The caller must hold
+// the write lock.
+func (s *FileOrderStore) update(fn func(orderTable) (bool, error)) (bool, error) {
+	orders := maps.Clone(s.orders)
+	changed, err := fn(orders)
+	if err != nil || !changed {
+		return changed, err
+	}
+	if err := writeJSONFile(s.path, orders); err != nil {
+		return false, fmt.Errorf("persisting order store: %w", err)
+	}
+	s.orders = orders
+	return true, nil
+}
+
+// Ensure implementations satisfy the interface
+var (
+	_ shipper.OrderStore = (*MemoryOrderStore)(nil)
+	_ shipper.OrderStore = (*FileOrderStore)(nil)
+)
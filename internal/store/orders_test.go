@@ -0,0 +1,248 @@
+package store_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func newTestOrder(orderID, shipperID, reference string, createdAt time.Time) *shipper.Order {
+	return &shipper.Order{
+		OrderID:   orderID,
+		ShipperID: shipperID,
+		Carrier:   "freightcom",
+		Reference: reference,
+		Response: shipper.CreateOrderResponse{
+			OrderID:        orderID,
+			TrackingNumber: "TRK-" + orderID,
+			Status:         shipper.StatusConfirmed,
+		},
+		Status: shipper.StatusConfirmed,
+		History: []shipper.StatusChange{
+			{Status: shipper.StatusConfirmed, Timestamp: createdAt, Source: "create_order"},
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+func TestMemoryOrderStore_SaveAndGet(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+
+	err := s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", time.Now()))
+	require.NoError(t, err)
+
+	order, err := s.GetOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, "shipper-123", order.ShipperID)
+	assert.Equal(t, "freightcom", order.Carrier)
+	assert.Equal(t, "TRK-order-1", order.Response.TrackingNumber)
+	assert.Len(t, order.History, 1)
+}
+
+func TestMemoryOrderStore_NotFound(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+
+	_, err := s.GetOrder(context.Background(), "order-unknown")
+
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
+
+func TestMemoryOrderStore_GetOrderByReference(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", now.Add(-time.Hour))))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-2", "shipper-123", "INV-1", now)))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-3", "shipper-456", "INV-1", now.Add(time.Hour))))
+
+	order, err := s.GetOrderByReference(ctx, "shipper-123", "INV-1")
+	require.NoError(t, err)
+	assert.Equal(t, "order-2", order.OrderID)
+
+	_, err = s.GetOrderByReference(ctx, "shipper-123", "INV-2")
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
+
+func TestMemoryOrderStore_GetOrderByTrackingNumber(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", time.Now())))
+
+	order, err := s.GetOrderByTrackingNumber(ctx, "freightcom", "TRK-order-1")
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", order.OrderID)
+
+	// Tracking numbers are scoped to the carrier
+	_, err = s.GetOrderByTrackingNumber(ctx, "canadapost", "TRK-order-1")
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
+
+func TestMemoryOrderStore_ListActiveOrders(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-2", "shipper-456", "INV-2", now)))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", now.Add(-time.Hour))))
+	delivered := newTestOrder("order-3", "shipper-123", "INV-3", now.Add(-2*time.Hour))
+	delivered.Status = shipper.StatusDelivered
+	require.NoError(t, s.SaveOrder(ctx, delivered))
+	cancelled := newTestOrder("order-4", "shipper-123", "INV-4", now.Add(-3*time.Hour))
+	cancelled.Status = shipper.StatusCancelled
+	require.NoError(t, s.SaveOrder(ctx, cancelled))
+
+	orders, err := s.ListActiveOrders(ctx)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, "order-1", orders[0].OrderID)
+	assert.Equal(t, "order-2", orders[1].OrderID)
+}
+
+func TestMemoryOrderStore_ListOrders(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "", now.Add(-2*time.Hour))))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-2", "shipper-123", "", now.Add(-time.Hour))))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-3", "shipper-123", "", now)))
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-4", "shipper-456", "", now)))
+
+	page, err := s.ListOrders(ctx, "shipper-123", "", 2)
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 2)
+	assert.Equal(t, "order-3", page.Orders[0].OrderID)
+	assert.Equal(t, "order-2", page.Orders[1].OrderID)
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = s.ListOrders(ctx, "shipper-123", page.NextCursor, 2)
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, "order-1", page.Orders[0].OrderID)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestMemoryOrderStore_ListOrders_InvalidCursor(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+
+	_, err := s.ListOrders(context.Background(), "shipper-123", "not-a-cursor", 10)
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidCursor)
+}
+
+func TestMemoryOrderStore_UpdateStatus(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "", time.Now())))
+
+	changed, err := s.UpdateStatus(ctx, "order-1", shipper.StatusChange{Status: shipper.StatusInTransit, Source: "track"})
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = s.UpdateStatus(ctx, "order-1", shipper.StatusChange{Status: shipper.StatusInTransit, Source: "track"})
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	order, err := s.GetOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusInTransit, order.Status)
+	require.Len(t, order.History, 2)
+	assert.Equal(t, "track", order.History[1].Source)
+	assert.False(t, order.History[1].Timestamp.IsZero())
+
+	_, err = s.UpdateStatus(ctx, "order-unknown", shipper.StatusChange{Status: shipper.StatusInTransit})
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
+
+func TestMemoryOrderStore_UpdateStatus_ForwardOnly(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "", time.Now())))
+
+	for _, status := range []shipper.ShipmentStatus{shipper.StatusInTransit, shipper.StatusPending, shipper.StatusDelivered, shipper.StatusException} {
+		_, err := s.UpdateStatus(ctx, "order-1", shipper.StatusChange{Status: status, Source: "push"})
+		require.NoError(t, err)
+	}
+
+	order, err := s.GetOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+	assert.Len(t, order.History, 3)
+}
+
+func TestMemoryOrderStore_MarkManifested(t *testing.T) {
+	s := store.NewMemoryOrderStore()
+	ctx := context.Background()
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "", time.Now())))
+
+	at := time.Now()
+	require.NoError(t, s.MarkManifested(ctx, "order-1", []string{"manifest-1"}, at))
+
+	order, err := s.GetOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifest-1"}, order.ManifestIDs)
+	require.NotNil(t, order.ManifestedAt)
+	assert.True(t, order.ManifestedAt.Equal(at))
+	assert.Equal(t, shipper.StatusConfirmed, order.Status)
+
+	err = s.MarkManifested(ctx, "order-unknown", nil, at)
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
+
+func TestFileOrderStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.json")
+	ctx := context.Background()
+
+	s, err := store.NewFileOrderStore(path)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", time.Now())))
+	_, err = s.UpdateStatus(ctx, "order-1", shipper.StatusChange{Status: shipper.StatusDelivered, Source: "track"})
+	require.NoError(t, err)
+
+	reopened, err := store.NewFileOrderStore(path)
+	require.NoError(t, err)
+
+	order, err := reopened.GetOrderByReference(ctx, "shipper-123", "INV-1")
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", order.OrderID)
+	assert.Equal(t, shipper.StatusDelivered, order.Status)
+	assert.Len(t, order.History, 2)
+}
+
+func TestFileOrderStore_FailedWriteLeavesStoreUnchanged(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	s, err := store.NewFileOrderStore(filepath.Join(dir, "orders.json"))
+	require.NoError(t, err)
+	require.NoError(t, s.SaveOrder(ctx, newTestOrder("order-1", "shipper-123", "INV-1", time.Now())))
+
+	// Writes fail once the directory of the file is gone
+	require.NoError(t, os.RemoveAll(dir))
+
+	_, err = s.UpdateStatus(ctx, "order-1", shipper.StatusChange{Status: shipper.StatusDelivered, Source: "track"})
+	assert.Error(t, err)
+	err = s.SaveOrder(ctx, newTestOrder("order-2", "shipper-123", "INV-2", time.Now()))
+	assert.Error(t, err)
+
+	order, err := s.GetOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusConfirmed, order.Status)
+	assert.Len(t, order.History, 1)
+	_, err = s.GetOrder(ctx, "order-2")
+	assert.ErrorIs(t, err, shipper.ErrOrderNotFound)
+}
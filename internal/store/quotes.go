@@ -0,0 +1,137 @@
+// Package store provides persistence for quotes, orders and webhooks.
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// DefaultQuoteTTL is applied to rates that carriers return without an expiry.
+const DefaultQuoteTTL = 30 * time.Minute
+
+// expiredRetention is how long expired rates are kept around so that lookups
+// report ErrQuoteExpired instead of ErrQuoteNotFound.
+const expiredRetention = 24 * time.Hour
+
+// quoteTable is the in-memory index shared by the quote store implementations.
+// Callers must hold the owning store's lock.
+type quoteTable map[string]*shipper.StoredRate
+
+func (t quoteTable) put(shipperID string, quote *shipper.QuoteResponse, now time.Time) {
+	for _, rate := range quote.Rates {
+		if rate.ExpiresAt.IsZero() {
+			rate.ExpiresAt = now.Add(DefaultQuoteTTL)
+		}
+		t[rate.RateID] = &shipper.StoredRate{
+			QuoteID:   quote.QuoteID,
+			ShipperID: shipperID,
+			Rate:      rate,
+			CreatedAt: now,
+		}
+	}
+}
+
+func (t quoteTable) get(rateID string, now time.Time) (*shipper.StoredRate, error) {
+	stored, ok := t[rateID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", shipper.ErrQuoteNotFound, rateID)
+	}
+	if stored.Expired(now) {
+		return nil, fmt.Errorf("%w: %s", shipper.ErrQuoteExpired, rateID)
+	}
+	result := *stored
+	return &result, nil
+}
+
+func (t quoteTable) prune(now time.Time) {
+	for id, stored := range t {
+		if stored.Rate.ExpiresAt.Add(expiredRetention).Before(now) {
+			delete(t, id)
+		}
+	}
+}
+
+// MemoryQuoteStore keeps quotes in process memory.
+// Rates are lost on restart, which is acceptable for single-instance deployments.
+type MemoryQuoteStore struct {
+	mu    sync.RWMutex
+	rates quoteTable
+}
+
+// NewMemoryQuoteStore creates an empty in-memory quote store.
+func NewMemoryQuoteStore() *MemoryQuoteStore {
+	return &MemoryQuoteStore{
+		rates: make(quoteTable),
+	}
+}
+
+// SaveQuote implements shipper.QuoteStore.
+func (s *MemoryQuoteStore) SaveQuote(ctx context.Context, shipperID string, quote *shipper.QuoteResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.rates.prune(now)
+	s.rates.put(shipperID, quote, now)
+	return nil
+}
+
+// GetRate implements shipper.QuoteStore.
+func (s *MemoryQuoteStore) GetRate(ctx context.Context, rateID string) (*shipper.StoredRate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rates.get(rateID, time.Now())
+}
+
+// FileQuoteStore keeps quotes in memory and persists them to a JSON file
+// after every write, so rates survive restarts.
+type FileQuoteStore struct {
+	mu    sync.RWMutex
+	path  string
+	rates quoteTable
+}
+
+// NewFileQuoteStore opens the quote store at path, loading any existing rates.
+// The file is created on the first write.
+func NewFileQuoteStore(path string) (*FileQuoteStore, error) {
+	s := &FileQuoteStore{
+		path:  path,
+		rates: make(quoteTable),
+	}
+	if err := readJSONFile(path, &s.rates); err != nil {
+		return nil, fmt.Errorf("loading quote store: %w", err)
+	}
+	return s, nil
+}
+
+// SaveQuote implements shipper.QuoteStore.
+func (s *FileQuoteStore) SaveQuote(ctx context.Context, shipperID string, quote *shipper.QuoteResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.rates.prune(now)
+	s.rates.put(shipperID, quote, now)
+
+	if err := writeJSONFile(s.path, s.rates); err != nil {
+		return fmt.Errorf("persisting quote store: %w", err)
+	}
+	return nil
+}
+
+// GetRate implements shipper.QuoteStore.
+func (s *FileQuoteStore) GetRate(ctx context.Context, rateID string) (*shipper.StoredRate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rates.get(rateID, time.Now())
+}
+
+// Ensure implementations satisfy the interface
+var (
+	_ shipper.QuoteStore = (*MemoryQuoteStore)(nil)
+	_ shipper.QuoteStore = (*FileQuoteStore)(nil)
+)
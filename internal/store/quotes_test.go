@@ -0,0 +1,110 @@
+package store_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func newTestQuote(expiresAt time.Time) *shipper.QuoteResponse {
+	return &shipper.QuoteResponse{
+		QuoteID: "quote-123",
+		Rates: []shipper.RateOption{
+			{
+				RateID:     "rate-ground",
+				Carrier:    "freightcom",
+				ServiceID:  "101",
+				TotalPrice: shipper.Money{Minor: 1582, Currency: "CAD"},
+				ExpiresAt:  expiresAt,
+			},
+			{
+				RateID:    "rate-express",
+				Carrier:   "freightcom",
+				ServiceID: "102",
+				ExpiresAt: expiresAt,
+			},
+		},
+	}
+}
+
+func TestMemoryQuoteStore_SaveAndGet(t *testing.T) {
+	s := store.NewMemoryQuoteStore()
+	ctx := context.Background()
+
+	err := s.SaveQuote(ctx, "shipper-123", newTestQuote(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	stored, err := s.GetRate(ctx, "rate-express")
+	require.NoError(t, err)
+	assert.Equal(t, "quote-123", stored.QuoteID)
+	assert.Equal(t, "shipper-123", stored.ShipperID)
+	assert.Equal(t, "freightcom", stored.Rate.Carrier)
+	assert.Equal(t, "102", stored.Rate.ServiceID)
+}
+
+func TestMemoryQuoteStore_NotFound(t *testing.T) {
+	s := store.NewMemoryQuoteStore()
+
+	_, err := s.GetRate(context.Background(), "rate-unknown")
+
+	assert.ErrorIs(t, err, shipper.ErrQuoteNotFound)
+}
+
+func TestMemoryQuoteStore_Expired(t *testing.T) {
+	s := store.NewMemoryQuoteStore()
+	ctx := context.Background()
+
+	err := s.SaveQuote(ctx, "shipper-123", newTestQuote(time.Now().Add(-time.Minute)))
+	require.NoError(t, err)
+
+	_, err = s.GetRate(ctx, "rate-ground")
+
+	assert.ErrorIs(t, err, shipper.ErrQuoteExpired)
+}
+
+func TestMemoryQuoteStore_DefaultTTL(t *testing.T) {
+	s := store.NewMemoryQuoteStore()
+	ctx := context.Background()
+
+	err := s.SaveQuote(ctx, "shipper-123", newTestQuote(time.Time{}))
+	require.NoError(t, err)
+
+	stored, err := s.GetRate(ctx, "rate-ground")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(store.DefaultQuoteTTL), stored.Rate.ExpiresAt, time.Minute)
+}
+
+func TestFileQuoteStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotes.json")
+	ctx := context.Background()
+
+	s, err := store.NewFileQuoteStore(path)
+	require.NoError(t, err)
+
+	err = s.SaveQuote(ctx, "shipper-123", newTestQuote(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	reopened, err := store.NewFileQuoteStore(path)
+	require.NoError(t, err)
+
+	stored, err := reopened.GetRate(ctx, "rate-ground")
+	require.NoError(t, err)
+	assert.Equal(t, "101", stored.Rate.ServiceID)
+	assert.Equal(t, shipper.Money{Minor: 1582, Currency: "CAD"}, stored.Rate.TotalPrice)
+}
+
+func TestFileQuoteStore_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotes.json")
+
+	s, err := store.NewFileQuoteStore(path)
+	require.NoError(t, err)
+
+	_, err = s.GetRate(context.Background(), "rate-ground")
+	assert.ErrorIs(t, err, shipper.ErrQuoteNotFound)
+}
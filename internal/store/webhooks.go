@@ -0,0 +1,305 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tournevent/logistic/internal/webhook"
+)
+
+// deliveryRetention is how long delivered and dead deliveries stay in the
+// log, for inspection and replay.
+const deliveryRetention = 7 * 24 * time.Hour
+
+// webhookTables is the in-memory index shared by the webhook store
+// implementations. Callers must hold the owning store's lock.
+type webhookTables struct {
+	Subscriptions map[string]*webhook.Subscription
+	Deliveries    map[string]*webhook.Delivery
+}
+
+func newWebhookTables() *webhookTables {
+	return &webhookTables{
+		Subscriptions: make(map[string]*webhook.Subscription),
+		Deliveries:    make(map[string]*webhook.Delivery),
+	}
+}
+
+func (t *webhookTables) getSubscription(id string) (*webhook.Subscription, error) {
+	sub, ok := t.Subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", webhook.ErrSubscriptionNotFound, id)
+	}
+	return copySubscription(sub), nil
+}
+
+func (t *webhookTables) listSubscriptions(shipperID string) []*webhook.Subscription {
+	subs := make([]*webhook.Subscription, 0)
+	for _, sub := range t.Subscriptions {
+		if sub.ShipperID == shipperID {
+			subs = append(subs, copySubscription(sub))
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if !subs[i].CreatedAt.Equal(subs[j].CreatedAt) {
+			return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+		}
+		return subs[i].ID < subs[j].ID
+	})
+	return subs
+}
+
+func (t *webhookTables) deleteSubscription(id string) error {
+	if _, ok := t.Subscriptions[id]; !ok {
+		return fmt.Errorf("%w: %s", webhook.ErrSubscriptionNotFound, id)
+	}
+	delete(t.Subscriptions, id)
+	return nil
+}
+
+func (t *webhookTables) getDelivery(id string) (*webhook.Delivery, error) {
+	delivery, ok := t.Deliveries[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", webhook.ErrDeliveryNotFound, id)
+	}
+	return copyDelivery(delivery), nil
+}
+
+func (t *webhookTables) listDeliveries(shipperID string, state webhook.DeliveryState, limit int) []*webhook.Delivery {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	deliveries := make([]*webhook.Delivery, 0)
+	for _, delivery := range t.Deliveries {
+		if delivery.ShipperID == shipperID && (state == "" || delivery.State == state) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return orderBefore(deliveries[i].CreatedAt, deliveries[i].ID, deliveries[j].CreatedAt, deliveries[j].ID)
+	})
+	deliveries = deliveries[:min(limit, len(deliveries))]
+	for i, delivery := range deliveries {
+		deliveries[i] = copyDelivery(delivery)
+	}
+	return deliveries
+}
+
+func (t *webhookTables) dueDeliveries(now time.Time, limit int) []*webhook.Delivery {
+	due := make([]*webhook.Delivery, 0)
+	for _, delivery := range t.Deliveries {
+		if delivery.State.Due() && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	if limit > 0 {
+		due = due[:min(limit, len(due))]
+	}
+	for i, delivery := range due {
+		due[i] = copyDelivery(delivery)
+	}
+	return due
+}
+
+// prune drops finished deliveries past their retention.
+func (t *webhookTables) prune(now time.Time) {
+	for id, delivery := range t.Deliveries {
+		if !delivery.State.Due() && delivery.UpdatedAt.Add(deliveryRetention).Before(now) {
+			delete(t.Deliveries, id)
+		}
+	}
+}
+
+func copySubscription(sub *webhook.Subscription) *webhook.Subscription {
+	result := *sub
+	result.Events = append([]webhook.EventType(nil), sub.Events...)
+	return &result
+}
+
+func copyDelivery(delivery *webhook.Delivery) *webhook.Delivery {
+	result := *delivery
+	if delivery.DeliveredAt != nil {
+		deliveredAt := *delivery.DeliveredAt
+		result.DeliveredAt = &deliveredAt
+	}
+	return &result
+}
+
+// MemoryWebhookStore keeps webhook subscriptions and deliveries in process
+// memory.
+type MemoryWebhookStore struct {
+	mu     sync.RWMutex
+	tables *webhookTables
+}
+
+// NewMemoryWebhookStore creates an empty in-memory webhook store.
+func NewMemoryWebhookStore() *MemoryWebhookStore {
+	return &MemoryWebhookStore{
+		tables: newWebhookTables(),
+	}
+}
+
+// SaveSubscription implements webhook.Store.
+func (s *MemoryWebhookStore) SaveSubscription(ctx context.Context, sub *webhook.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables.Subscriptions[sub.ID] = copySubscription(sub)
+	return nil
+}
+
+// GetSubscription implements webhook.Store.
+func (s *MemoryWebhookStore) GetSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.getSubscription(id)
+}
+
+// ListSubscriptions implements webhook.Store.
+func (s *MemoryWebhookStore) ListSubscriptions(ctx context.Context, shipperID string) ([]*webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.listSubscriptions(shipperID), nil
+}
+
+// DeleteSubscription implements webhook.Store.
+func (s *MemoryWebhookStore) DeleteSubscription(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tables.deleteSubscription(id)
+}
+
+// SaveDelivery implements webhook.Store.
+func (s *MemoryWebhookStore) SaveDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables.prune(time.Now())
+	s.tables.Deliveries[delivery.ID] = copyDelivery(delivery)
+	return nil
+}
+
+// GetDelivery implements webhook.Store.
+func (s *MemoryWebhookStore) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.getDelivery(id)
+}
+
+// ListDeliveries implements webhook.Store.
+func (s *MemoryWebhookStore) ListDeliveries(ctx context.Context, shipperID string, state webhook.DeliveryState, limit int) ([]*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.listDeliveries(shipperID, state, limit), nil
+}
+
+// DueDeliveries implements webhook.Store.
+func (s *MemoryWebhookStore) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.dueDeliveries(now, limit), nil
+}
+
+// FileWebhookStore keeps webhook subscriptions and deliveries in memory and
+// persists them to a JSON file after every write, so that pending
+// deliveries survive restarts.
+type FileWebhookStore struct {
+	mu     sync.RWMutex
+	path   string
+	tables *webhookTables
+}
+
+// NewFileWebhookStore opens the webhook store at path, loading any existing
+// subscriptions and deliveries. The file is created on the first write.
+func NewFileWebhookStore(path string) (*FileWebhookStore, error) {
+	s := &FileWebhookStore{
+		path:   path,
+		tables: newWebhookTables(),
+	}
+	if err := readJSONFile(path, s.tables); err != nil {
+		return nil, fmt.Errorf("loading webhook store: %w", err)
+	}
+	return s, nil
+}
+
+// SaveSubscription implements webhook.Store.
+func (s *FileWebhookStore) SaveSubscription(ctx context.Context, sub *webhook.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables.Subscriptions[sub.ID] = copySubscription(sub)
+	return s.persist()
+}
+
+// GetSubscription implements webhook.Store.
+func (s *FileWebhookStore) GetSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.getSubscription(id)
+}
+
+// ListSubscriptions implements webhook.Store.
+func (s *FileWebhookStore) ListSubscriptions(ctx context.Context, shipperID string) ([]*webhook.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.listSubscriptions(shipperID), nil
+}
+
+// DeleteSubscription implements webhook.Store.
+func (s *FileWebhookStore) DeleteSubscription(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.tables.deleteSubscription(id); err != nil {
+		return err
+	}
+	return s.persist()
+}
+
+// SaveDelivery implements webhook.Store.
+func (s *FileWebhookStore) SaveDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables.prune(time.Now())
+	s.tables.Deliveries[delivery.ID] = copyDelivery(delivery)
+	return s.persist()
+}
+
+// GetDelivery implements webhook.Store.
+func (s *FileWebhookStore) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.getDelivery(id)
+}
+
+// ListDeliveries implements webhook.Store.
+func (s *FileWebhookStore) ListDeliveries(ctx context.Context, shipperID string, state webhook.DeliveryState, limit int) ([]*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.listDeliveries(shipperID, state, limit), nil
+}
+
+// DueDeliveries implements webhook.Store.
+func (s *FileWebhookStore) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tables.dueDeliveries(now, limit), nil
+}
+
+func (s *FileWebhookStore) persist() error {
+	if err := writeJSONFile(s.path, s.tables); err != nil {
+		return fmt.Errorf("persisting webhook store: %w", err)
+	}
+	return nil
+}
+
+// Ensure implementations satisfy the interface
+var (
+	_ webhook.Store = (*MemoryWebhookStore)(nil)
+	_ webhook.Store = (*FileWebhookStore)(nil)
+)
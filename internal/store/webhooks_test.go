@@ -0,0 +1,130 @@
+package store_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/internal/webhook"
+)
+
+func newDelivery(id string, state webhook.DeliveryState, nextAttemptAt time.Time) *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:             id,
+		SubscriptionID: "sub-1",
+		ShipperID:      "shipper-123",
+		Event:          webhook.Event{ID: "event-" + id, Type: webhook.EventOrderCreated, ShipperID: "shipper-123"},
+		State:          state,
+		NextAttemptAt:  nextAttemptAt,
+		CreatedAt:      nextAttemptAt,
+		UpdatedAt:      time.Now(),
+	}
+}
+
+func TestMemoryWebhookStore_Subscriptions(t *testing.T) {
+	s := store.NewMemoryWebhookStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, s.SaveSubscription(ctx, &webhook.Subscription{ID: "sub-2", ShipperID: "shipper-123", CreatedAt: now}))
+	require.NoError(t, s.SaveSubscription(ctx, &webhook.Subscription{ID: "sub-1", ShipperID: "shipper-123", CreatedAt: now.Add(-time.Minute)}))
+	require.NoError(t, s.SaveSubscription(ctx, &webhook.Subscription{ID: "sub-3", ShipperID: "shipper-456", CreatedAt: now}))
+
+	subs, err := s.ListSubscriptions(ctx, "shipper-123")
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal(t, "sub-1", subs[0].ID)
+	assert.Equal(t, "sub-2", subs[1].ID)
+
+	require.NoError(t, s.DeleteSubscription(ctx, "sub-1"))
+	_, err = s.GetSubscription(ctx, "sub-1")
+	assert.ErrorIs(t, err, webhook.ErrSubscriptionNotFound)
+	assert.ErrorIs(t, s.DeleteSubscription(ctx, "sub-1"), webhook.ErrSubscriptionNotFound)
+}
+
+func TestMemoryWebhookStore_DueDeliveries(t *testing.T) {
+	s := store.NewMemoryWebhookStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("later", webhook.DeliveryFailed, now.Add(time.Minute))))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("retry", webhook.DeliveryFailed, now.Add(-time.Minute))))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("new", webhook.DeliveryPending, now)))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("done", webhook.DeliveryDelivered, now.Add(-time.Hour))))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("dead", webhook.DeliveryDead, now.Add(-time.Hour))))
+
+	due, err := s.DueDeliveries(ctx, now, 0)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, "retry", due[0].ID)
+	assert.Equal(t, "new", due[1].ID)
+
+	due, err = s.DueDeliveries(ctx, now, 1)
+	require.NoError(t, err)
+	assert.Len(t, due, 1)
+
+	dead, err := s.ListDeliveries(ctx, "shipper-123", webhook.DeliveryDead, 0)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, "dead", dead[0].ID)
+
+	all, err := s.ListDeliveries(ctx, "shipper-123", "", 0)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+	assert.Equal(t, "later", all[0].ID)
+}
+
+func TestMemoryWebhookStore_PrunesFinishedDeliveries(t *testing.T) {
+	s := store.NewMemoryWebhookStore()
+	ctx := context.Background()
+
+	old := newDelivery("old", webhook.DeliveryDelivered, time.Now())
+	old.UpdatedAt = time.Now().Add(-8 * 24 * time.Hour)
+	stuck := newDelivery("stuck", webhook.DeliveryFailed, time.Now())
+	stuck.UpdatedAt = old.UpdatedAt
+	require.NoError(t, s.SaveDelivery(ctx, old))
+	require.NoError(t, s.SaveDelivery(ctx, stuck))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("new", webhook.DeliveryPending, time.Now())))
+
+	_, err := s.GetDelivery(ctx, "old")
+	assert.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+	_, err = s.GetDelivery(ctx, "stuck")
+	assert.NoError(t, err)
+}
+
+func TestFileWebhookStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	ctx := context.Background()
+
+	s, err := store.NewFileWebhookStore(path)
+	require.NoError(t, err)
+	sub := &webhook.Subscription{
+		ID:        "sub-1",
+		ShipperID: "shipper-123",
+		URL:       "https://example.com/hook",
+		Secret:    "whsec_test",
+		Events:    []webhook.EventType{webhook.EventDelivered},
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, s.SaveSubscription(ctx, sub))
+	require.NoError(t, s.SaveDelivery(ctx, newDelivery("delivery-1", webhook.DeliveryFailed, time.Now())))
+
+	reopened, err := store.NewFileWebhookStore(path)
+	require.NoError(t, err)
+
+	got, err := reopened.GetSubscription(ctx, "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, sub.URL, got.URL)
+	assert.Equal(t, sub.Secret, got.Secret)
+	assert.Equal(t, sub.Events, got.Events)
+
+	due, err := reopened.DueDeliveries(ctx, time.Now(), 0)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "delivery-1", due[0].ID)
+	assert.Equal(t, webhook.EventOrderCreated, due[0].Event.Type)
+}
@@ -8,6 +8,16 @@ import (
 
 // NewLogger creates a new OpenTelemetry-aware zap logger.
 func NewLogger(level string) (*otelzap.Logger, error) {
+	return newLogger(level, "stdout")
+}
+
+// NewStderrLogger creates a logger like NewLogger that writes to stderr,
+// leaving stdout to the output of command line tools.
+func NewStderrLogger(level string) (*otelzap.Logger, error) {
+	return newLogger(level, "stderr")
+}
+
+func newLogger(level, output string) (*otelzap.Logger, error) {
 	var zapLevel zapcore.Level
 	switch level {
 	case "debug", "DEBUG":
@@ -23,7 +33,7 @@ func NewLogger(level string) (*otelzap.Logger, error) {
 	config := zap.NewProductionConfig()
 	config.Level = zap.NewAtomicLevelAt(zapLevel)
 	config.Encoding = "json"
-	config.OutputPaths = []string{"stdout"}
+	config.OutputPaths = []string{output}
 	config.ErrorOutputPaths = []string{"stderr"}
 
 	zapLogger, err := config.Build(zap.AddCallerSkip(1))
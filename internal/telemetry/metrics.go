@@ -12,6 +12,11 @@ type Metrics struct {
 	RequestsTotal   *prometheus.CounterVec
 	RequestDuration *prometheus.HistogramVec
 	CarrierErrors   *prometheus.CounterVec
+	CarrierRetries  *prometheus.CounterVec
+	CircuitState    *prometheus.GaugeVec
+	RateLimitWait   *prometheus.HistogramVec
+	PollerLag       *prometheus.GaugeVec
+	PollerActive    *prometheus.GaugeVec
 }
 
 var (
@@ -47,6 +52,42 @@ func NewMetrics() *Metrics {
 				},
 				[]string{"carrier", "error_type"},
 			),
+			CarrierRetries: promauto.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "delivro_carrier_retries_total",
+					Help: "Total carrier call retries by operation and carrier",
+				},
+				[]string{"operation", "carrier"},
+			),
+			CircuitState: promauto.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "delivro_circuit_breaker_state",
+					Help: "Circuit breaker state by carrier (0 = closed, 1 = half-open, 2 = open)",
+				},
+				[]string{"carrier"},
+			),
+			RateLimitWait: promauto.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Name:    "delivro_rate_limit_wait_seconds",
+					Help:    "Time spent waiting for the client-side rate limiter by carrier and operation",
+					Buckets: []float64{.001, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+				},
+				[]string{"carrier", "operation"},
+			),
+			PollerLag: promauto.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "delivro_poller_lag_seconds",
+					Help: "How far behind schedule the most overdue tracking poll is, by carrier",
+				},
+				[]string{"carrier"},
+			),
+			PollerActive: promauto.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "delivro_poller_active_shipments",
+					Help: "Shipments whose tracking is polled, by carrier",
+				},
+				[]string{"carrier"},
+			),
 		}
 	})
 	return globalMetrics
@@ -62,3 +103,30 @@ func (m *Metrics) RecordRequest(operation, carrier, status string, duration floa
 func (m *Metrics) RecordError(carrier, errorType string) {
 	m.CarrierErrors.WithLabelValues(carrier, errorType).Inc()
 }
+
+// RecordRetry records a retried carrier call.
+func (m *Metrics) RecordRetry(operation, carrier string) {
+	m.CarrierRetries.WithLabelValues(operation, carrier).Inc()
+}
+
+// RecordRateLimitWait records time spent waiting for a rate limiter token.
+func (m *Metrics) RecordRateLimitWait(carrier, operation string, seconds float64) {
+	m.RateLimitWait.WithLabelValues(carrier, operation).Observe(seconds)
+}
+
+// RecordCircuitState records the circuit breaker state of a carrier.
+func (m *Metrics) RecordCircuitState(carrier string, state int) {
+	m.CircuitState.WithLabelValues(carrier).Set(float64(state))
+}
+
+// RecordPollerLag records how far behind schedule the tracking polls of a
+// carrier are.
+func (m *Metrics) RecordPollerLag(carrier string, seconds float64) {
+	m.PollerLag.WithLabelValues(carrier).Set(seconds)
+}
+
+// RecordPollerActive records the number of shipments of a carrier whose
+// tracking is polled.
+func (m *Metrics) RecordPollerActive(carrier string, count int) {
+	m.PollerActive.WithLabelValues(carrier).Set(float64(count))
+}
@@ -0,0 +1,352 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// batchSize is the number of due deliveries read from the store at a time.
+const batchSize = 100
+
+// maxResponseSize bounds how much of a receiver's response is read.
+const maxResponseSize = 64 << 10
+
+// Config controls how events are delivered.
+type Config struct {
+	MaxAttempts  int           // Total attempts before a delivery is dead
+	BaseDelay    time.Duration // Delay before the first retry, doubled on each attempt
+	MaxDelay     time.Duration // Upper bound on a single delay
+	Timeout      time.Duration // Of a single attempt
+	PollInterval time.Duration // How often Run looks for due deliveries
+	Concurrency  int           // Deliveries attempted at once
+}
+
+// DefaultConfig returns the delivery settings used for zero Config fields.
+// A delivery is retried for about an hour before it is dead.
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:  8,
+		BaseDelay:    30 * time.Second,
+		MaxDelay:     time.Hour,
+		Timeout:      10 * time.Second,
+		PollInterval: 5 * time.Second,
+		Concurrency:  4,
+	}
+}
+
+// Dispatcher records the events of subscribed shippers in the delivery log
+// and delivers them.
+type Dispatcher struct {
+	store  Store
+	cfg    Config
+	client *http.Client
+	logger *otelzap.Logger
+	wake   chan struct{}
+}
+
+// NewDispatcher creates a dispatcher for the subscriptions and deliveries
+// of store. Zero fields of cfg take their DefaultConfig value.
+func NewDispatcher(store Store, logger *otelzap.Logger, cfg Config) *Dispatcher {
+	defaults := DefaultConfig()
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaults.BaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaults.MaxDelay
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaults.Concurrency
+	}
+	return &Dispatcher{
+		store:  store,
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		logger: logger,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Subscribe validates and records a subscription, assigning its ID and
+// creation time. A random secret is generated when none is set. It fails
+// with ErrInvalidSubscription unless the URL is an absolute HTTP(S) URL and
+// every event type is known.
+func (d *Dispatcher) Subscribe(ctx context.Context, sub *Subscription) error {
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidSubscription)
+	}
+	for _, t := range sub.Events {
+		if !t.Valid() {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidSubscription, t)
+		}
+	}
+	if sub.Secret == "" {
+		sub.Secret = newSecret()
+	}
+	sub.ID = uuid.New().String()
+	sub.CreatedAt = time.Now()
+	return d.store.SaveSubscription(ctx, sub)
+}
+
+// Unsubscribe removes a subscription of the shipper. Pending deliveries to
+// it are dropped when they come due.
+func (d *Dispatcher) Unsubscribe(ctx context.Context, shipperID, id string) error {
+	if _, err := d.subscription(ctx, shipperID, id); err != nil {
+		return err
+	}
+	return d.store.DeleteSubscription(ctx, id)
+}
+
+// Subscriptions returns the subscriptions of a shipper, oldest first.
+func (d *Dispatcher) Subscriptions(ctx context.Context, shipperID string) ([]*Subscription, error) {
+	return d.store.ListSubscriptions(ctx, shipperID)
+}
+
+// Deliveries returns up to limit deliveries of a shipper, newest first,
+// optionally only those in the given state.
+func (d *Dispatcher) Deliveries(ctx context.Context, shipperID string, state DeliveryState, limit int) ([]*Delivery, error) {
+	return d.store.ListDeliveries(ctx, shipperID, state, limit)
+}
+
+// Publish queues a delivery of event to every subscription of its shipper
+// that wants it. The event ID and time are set if missing.
+func (d *Dispatcher) Publish(ctx context.Context, event Event) error {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	subs, err := d.store.ListSubscriptions(ctx, event.ShipperID)
+	if err != nil {
+		return fmt.Errorf("listing webhook subscriptions: %w", err)
+	}
+
+	queued := false
+	now := time.Now()
+	for _, sub := range subs {
+		if !sub.Wants(event.Type) {
+			continue
+		}
+		delivery := &Delivery{
+			ID:             uuid.New().String(),
+			SubscriptionID: sub.ID,
+			ShipperID:      event.ShipperID,
+			Event:          event,
+			State:          DeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := d.store.SaveDelivery(ctx, delivery); err != nil {
+			return fmt.Errorf("queueing webhook delivery: %w", err)
+		}
+		queued = true
+	}
+	if queued {
+		d.notify()
+	}
+	return nil
+}
+
+// Replay queues a delivery of the shipper again, whatever its state, with
+// a fresh budget of attempts.
+func (d *Dispatcher) Replay(ctx context.Context, shipperID, deliveryID string) (*Delivery, error) {
+	delivery, err := d.store.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.ShipperID != shipperID {
+		return nil, fmt.Errorf("%w: %s", ErrDeliveryNotFound, deliveryID)
+	}
+
+	now := time.Now()
+	delivery.State = DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+	if err := d.store.SaveDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	d.notify()
+	return delivery, nil
+}
+
+// Run delivers due deliveries until ctx is cancelled, as soon as events
+// are published and at every poll interval for retries.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error("Failed to deliver webhooks", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// DeliverDue attempts every delivery that is due and returns the number of
+// attempts made. Failed attempts are scheduled for a retry, or dead once
+// out of attempts.
+func (d *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	attempted := 0
+	for {
+		due, err := d.store.DueDeliveries(ctx, time.Now(), batchSize)
+		if err != nil {
+			return attempted, fmt.Errorf("listing due webhook deliveries: %w", err)
+		}
+
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(d.cfg.Concurrency)
+		for _, delivery := range due {
+			g.Go(func() error {
+				return d.attempt(gctx, delivery)
+			})
+		}
+		err = g.Wait()
+		attempted += len(due)
+		if err != nil || len(due) < batchSize {
+			return attempted, err
+		}
+	}
+}
+
+// attempt sends a delivery once and records the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *Delivery) error {
+	sub, err := d.store.GetSubscription(ctx, delivery.SubscriptionID)
+	if errors.Is(err, ErrSubscriptionNotFound) {
+		delivery.State = DeliveryDead
+		delivery.LastError = "subscription deleted"
+		delivery.UpdatedAt = time.Now()
+		return d.store.SaveDelivery(ctx, delivery)
+	}
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := d.send(ctx, sub, delivery)
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = now
+	switch {
+	case err == nil:
+		delivery.State = DeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.State = DeliveryDead
+		delivery.LastError = err.Error()
+		d.logger.Warn("Webhook delivery dead",
+			zap.String("delivery_id", delivery.ID),
+			zap.String("subscription_id", sub.ID),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Error(err),
+		)
+	default:
+		delivery.State = DeliveryFailed
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+	}
+	return d.store.SaveDelivery(ctx, delivery)
+}
+
+// send posts the signed event to the subscription URL and returns the
+// response status. Any status but 2xx is a failure.
+func (d *Dispatcher) send(ctx context.Context, sub *Subscription, delivery *Delivery) (int, error) {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, fmt.Errorf("encoding event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.Event.Type))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the retry following the given attempt.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > d.cfg.MaxDelay {
+		delay = d.cfg.MaxDelay
+	}
+	return delay
+}
+
+// subscription returns the subscription of the shipper with the given ID.
+func (d *Dispatcher) subscription(ctx context.Context, shipperID, id string) (*Subscription, error) {
+	sub, err := d.store.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub.ShipperID != shipperID {
+		return nil, fmt.Errorf("%w: %s", ErrSubscriptionNotFound, id)
+	}
+	return sub, nil
+}
+
+// notify wakes Run without blocking.
+func (d *Dispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func newSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
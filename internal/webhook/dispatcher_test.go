@@ -0,0 +1,294 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/internal/store"
+	"github.com/tournevent/logistic/internal/webhook"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+const testSecret = "whsec_test"
+
+// receiver is a local webhook endpoint that records the events it
+// accepts, answering with status.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	events   []webhook.Event
+	received chan struct{}
+}
+
+func newReceiver(t *testing.T) *receiver {
+	t.Helper()
+
+	r := &receiver{status: http.StatusOK, received: make(chan struct{}, 10)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		if !webhook.Verify(testSecret, req.Header.Get(webhook.HeaderSignature), req.Header.Get(webhook.HeaderTimestamp), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		w.WriteHeader(r.status)
+		if r.status != http.StatusOK {
+			return
+		}
+		var event webhook.Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, string(event.Type), req.Header.Get(webhook.HeaderEvent))
+		assert.NotEmpty(t, req.Header.Get(webhook.HeaderDelivery))
+		r.events = append(r.events, event)
+		r.received <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) accepted() []webhook.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhook.Event(nil), r.events...)
+}
+
+func newTestDispatcher(cfg webhook.Config) (*webhook.Dispatcher, webhook.Store) {
+	s := store.NewMemoryWebhookStore()
+	return webhook.NewDispatcher(s, otelzap.New(zap.NewNop()), cfg), s
+}
+
+func subscribe(t *testing.T, d *webhook.Dispatcher, url string, events ...webhook.EventType) *webhook.Subscription {
+	t.Helper()
+
+	sub := &webhook.Subscription{ShipperID: "shipper-123", URL: url, Secret: testSecret, Events: events}
+	require.NoError(t, d.Subscribe(context.Background(), sub))
+	return sub
+}
+
+func orderEvent(t webhook.EventType) webhook.Event {
+	return webhook.OrderEvent(t, &shipper.Order{
+		OrderID:   "order-1",
+		ShipperID: "shipper-123",
+		Carrier:   "purolator",
+		Reference: "INV-1001",
+		Status:    shipper.StatusDelivered,
+		Response:  shipper.CreateOrderResponse{TrackingNumber: "329012345678901"},
+	})
+}
+
+func TestDispatcher_DeliversSignedEvents(t *testing.T) {
+	recv := newReceiver(t)
+	d, s := newTestDispatcher(webhook.Config{})
+	sub := subscribe(t, d, recv.URL)
+
+	ctx := context.Background()
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventDelivered)))
+
+	attempted, err := d.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+
+	events := recv.accepted()
+	require.Len(t, events, 1)
+	assert.Equal(t, webhook.EventDelivered, events[0].Type)
+	assert.Equal(t, "order-1", events[0].OrderID)
+	assert.Equal(t, "329012345678901", events[0].TrackingNumber)
+	assert.Equal(t, shipper.StatusDelivered, events[0].Status)
+	assert.NotEmpty(t, events[0].ID)
+
+	deliveries, err := s.ListDeliveries(ctx, "shipper-123", "", 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, sub.ID, deliveries[0].SubscriptionID)
+	assert.Equal(t, webhook.DeliveryDelivered, deliveries[0].State)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusOK, deliveries[0].LastStatusCode)
+	assert.NotNil(t, deliveries[0].DeliveredAt)
+
+	// Nothing is left to deliver
+	attempted, err = d.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, attempted)
+}
+
+func TestDispatcher_Publish_FiltersSubscriptions(t *testing.T) {
+	d, s := newTestDispatcher(webhook.Config{})
+	subscribe(t, d, "https://example.com/created", webhook.EventOrderCreated)
+	all := subscribe(t, d, "https://example.com/all")
+	other := &webhook.Subscription{ShipperID: "shipper-456", URL: "https://example.com/other"}
+	require.NoError(t, d.Subscribe(context.Background(), other))
+
+	ctx := context.Background()
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventDelivered)))
+
+	deliveries, err := s.ListDeliveries(ctx, "shipper-123", "", 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, all.ID, deliveries[0].SubscriptionID)
+	assert.Equal(t, webhook.DeliveryPending, deliveries[0].State)
+}
+
+func TestDispatcher_RetriesUntilDead(t *testing.T) {
+	recv := newReceiver(t)
+	recv.setStatus(http.StatusServiceUnavailable)
+	d, s := newTestDispatcher(webhook.Config{MaxAttempts: 2, BaseDelay: time.Millisecond})
+	subscribe(t, d, recv.URL)
+
+	ctx := context.Background()
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventException)))
+	_, err := d.DeliverDue(ctx)
+	require.NoError(t, err)
+
+	deliveries, err := s.ListDeliveries(ctx, "shipper-123", webhook.DeliveryFailed, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, deliveries[0].LastStatusCode)
+	assert.Equal(t, "unexpected status 503", deliveries[0].LastError)
+	assert.True(t, deliveries[0].NextAttemptAt.After(deliveries[0].CreatedAt))
+
+	time.Sleep(5 * time.Millisecond)
+	_, err = d.DeliverDue(ctx)
+	require.NoError(t, err)
+
+	delivery, err := s.GetDelivery(ctx, deliveries[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryDead, delivery.State)
+	assert.Equal(t, 2, delivery.Attempts)
+
+	// Dead deliveries are not retried
+	time.Sleep(5 * time.Millisecond)
+	attempted, err := d.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, attempted)
+}
+
+func TestDispatcher_Replay(t *testing.T) {
+	recv := newReceiver(t)
+	recv.setStatus(http.StatusInternalServerError)
+	d, s := newTestDispatcher(webhook.Config{MaxAttempts: 1})
+	subscribe(t, d, recv.URL)
+
+	ctx := context.Background()
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventOrderCreated)))
+	_, err := d.DeliverDue(ctx)
+	require.NoError(t, err)
+	dead, err := s.ListDeliveries(ctx, "shipper-123", webhook.DeliveryDead, 0)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+
+	_, err = d.Replay(ctx, "shipper-456", dead[0].ID)
+	assert.ErrorIs(t, err, webhook.ErrDeliveryNotFound)
+
+	recv.setStatus(http.StatusOK)
+	replayed, err := d.Replay(ctx, "shipper-123", dead[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryPending, replayed.State)
+	assert.Zero(t, replayed.Attempts)
+
+	_, err = d.DeliverDue(ctx)
+	require.NoError(t, err)
+
+	delivery, err := s.GetDelivery(ctx, dead[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryDelivered, delivery.State)
+	assert.Len(t, recv.accepted(), 1)
+}
+
+func TestDispatcher_UnsubscribedDeliveryIsDead(t *testing.T) {
+	d, s := newTestDispatcher(webhook.Config{})
+	sub := subscribe(t, d, "https://example.com/hook")
+
+	ctx := context.Background()
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventOrderCreated)))
+	require.NoError(t, d.Unsubscribe(ctx, "shipper-123", sub.ID))
+	_, err := d.DeliverDue(ctx)
+	require.NoError(t, err)
+
+	deliveries, err := s.ListDeliveries(ctx, "shipper-123", webhook.DeliveryDead, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "subscription deleted", deliveries[0].LastError)
+	assert.Zero(t, deliveries[0].Attempts)
+}
+
+func TestDispatcher_Subscribe(t *testing.T) {
+	d, _ := newTestDispatcher(webhook.Config{})
+	ctx := context.Background()
+
+	sub := &webhook.Subscription{ShipperID: "shipper-123", URL: "https://example.com/hook"}
+	require.NoError(t, d.Subscribe(ctx, sub))
+	assert.NotEmpty(t, sub.ID)
+	assert.Contains(t, sub.Secret, "whsec_")
+	assert.False(t, sub.CreatedAt.IsZero())
+
+	tests := []struct {
+		name string
+		sub  webhook.Subscription
+	}{
+		{"relative url", webhook.Subscription{URL: "/hook"}},
+		{"unsupported scheme", webhook.Subscription{URL: "ftp://example.com/hook"}},
+		{"unknown event", webhook.Subscription{URL: "https://example.com/hook", Events: []webhook.EventType{"order.lost"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := d.Subscribe(ctx, &tt.sub)
+
+			assert.ErrorIs(t, err, webhook.ErrInvalidSubscription)
+		})
+	}
+
+	err := d.Unsubscribe(ctx, "shipper-456", sub.ID)
+	assert.ErrorIs(t, err, webhook.ErrSubscriptionNotFound)
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	recv := newReceiver(t)
+	d, _ := newTestDispatcher(webhook.Config{PollInterval: time.Hour})
+	subscribe(t, d, recv.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+
+	require.NoError(t, d.Publish(ctx, orderEvent(webhook.EventTrackingUpdated)))
+
+	select {
+	case <-recv.received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not delivered")
+	}
+	cancel()
+	<-done
+}
+
+func TestStatusEvents(t *testing.T) {
+	assert.Equal(t, []webhook.EventType{webhook.EventTrackingUpdated}, webhook.StatusEvents(shipper.StatusInTransit))
+	assert.Equal(t, []webhook.EventType{webhook.EventTrackingUpdated, webhook.EventDelivered}, webhook.StatusEvents(shipper.StatusDelivered))
+	assert.Equal(t, []webhook.EventType{webhook.EventTrackingUpdated, webhook.EventException}, webhook.StatusEvents(shipper.StatusException))
+	assert.Equal(t, []webhook.EventType{webhook.EventOrderCancelled}, webhook.StatusEvents(shipper.StatusCancelled))
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers of a delivery request.
+const (
+	HeaderEvent     = "X-Delivro-Event"
+	HeaderDelivery  = "X-Delivro-Delivery"
+	HeaderTimestamp = "X-Delivro-Timestamp" // Unix seconds of the attempt
+	HeaderSignature = "X-Delivro-Signature" // "sha256=" and the hex HMAC-SHA256 of the timestamp, "." and the body
+)
+
+const signaturePrefix = "sha256="
+
+// Sign returns the signature header of a delivery attempted at timestamp.
+// Signing the timestamp with the body lets receivers reject replayed
+// requests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+// Verify reports whether signature is the signature of body sent with the
+// timestamp header value, under secret.
+func Verify(secret, signature, timestamp string, body []byte) bool {
+	sum, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sum)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, mac(secret, timestamp, body))
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
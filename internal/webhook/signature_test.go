@@ -0,0 +1,26 @@
+package webhook_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tournevent/logistic/internal/webhook"
+)
+
+func TestSignVerify(t *testing.T) {
+	body := []byte(`{"type":"delivered"}`)
+	timestamp := time.Unix(1760000000, 0)
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	signature := webhook.Sign("whsec_test", timestamp, body)
+	assert.Contains(t, signature, "sha256=")
+
+	assert.True(t, webhook.Verify("whsec_test", signature, ts, body))
+	assert.False(t, webhook.Verify("whsec_other", signature, ts, body))
+	assert.False(t, webhook.Verify("whsec_test", signature, "1760000001", body))
+	assert.False(t, webhook.Verify("whsec_test", signature, ts, []byte(`{"type":"exception"}`)))
+	assert.False(t, webhook.Verify("whsec_test", signature[len("sha256="):], ts, body))
+	assert.False(t, webhook.Verify("whsec_test", "sha256=zz", ts, body))
+}
@@ -0,0 +1,179 @@
+// Package webhook notifies shippers of shipment lifecycle events: it signs
+// events, delivers them to the URLs shippers subscribed, retries failed
+// deliveries with backoff and keeps a log of every delivery for replay.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// EventType is the kind of a lifecycle event.
+type EventType string
+
+const (
+	EventOrderCreated    EventType = "order.created"
+	EventOrderCancelled  EventType = "order.cancelled"
+	EventLabelReady      EventType = "label.ready"
+	EventTrackingUpdated EventType = "tracking.updated"
+	EventDelivered       EventType = "delivered"
+	EventException       EventType = "exception"
+)
+
+// EventTypes lists every event type.
+var EventTypes = []EventType{
+	EventOrderCreated,
+	EventOrderCancelled,
+	EventLabelReady,
+	EventTrackingUpdated,
+	EventDelivered,
+	EventException,
+}
+
+// Valid reports whether t is a known event type.
+func (t EventType) Valid() bool {
+	return slices.Contains(EventTypes, t)
+}
+
+// StatusEvents returns the events of an order entering status: cancellations
+// are reported as such, and every other status as a tracking update,
+// followed by a delivered or exception event for those statuses.
+func StatusEvents(status shipper.ShipmentStatus) []EventType {
+	switch status {
+	case shipper.StatusCancelled:
+		return []EventType{EventOrderCancelled}
+	case shipper.StatusDelivered:
+		return []EventType{EventTrackingUpdated, EventDelivered}
+	case shipper.StatusException:
+		return []EventType{EventTrackingUpdated, EventException}
+	default:
+		return []EventType{EventTrackingUpdated}
+	}
+}
+
+// Event is a lifecycle event of an order, sent as the JSON body of a
+// delivery.
+type Event struct {
+	ID             string                 `json:"id"`
+	Type           EventType              `json:"type"`
+	ShipperID      string                 `json:"shipperId"`
+	OrderID        string                 `json:"orderId"`
+	Carrier        string                 `json:"carrier"`
+	Reference      string                 `json:"reference,omitempty"`
+	TrackingNumber string                 `json:"trackingNumber,omitempty"`
+	Status         shipper.ShipmentStatus `json:"status"`
+	LabelURL       string                 `json:"labelUrl,omitempty"`
+	ReturnOf       string                 `json:"returnOf,omitempty"` // Order returned by a return order
+	OccurredAt     time.Time              `json:"occurredAt"`
+}
+
+// OrderEvent returns an event of type t about order, in its current status.
+func OrderEvent(t EventType, order *shipper.Order) Event {
+	return Event{
+		Type:           t,
+		ShipperID:      order.ShipperID,
+		OrderID:        order.OrderID,
+		Carrier:        order.Carrier,
+		Reference:      order.Reference,
+		TrackingNumber: order.Response.TrackingNumber,
+		Status:         order.Status,
+		LabelURL:       order.Response.LabelURL,
+		ReturnOf:       order.ReturnOf,
+	}
+}
+
+// Subscription is a shipper's request to receive events at a URL.
+type Subscription struct {
+	ID        string
+	ShipperID string
+	URL       string
+	Secret    string      // Key of the HMAC-SHA256 delivery signatures
+	Events    []EventType // Empty = all
+	CreatedAt time.Time
+}
+
+// Wants reports whether the subscription receives events of type t.
+func (s *Subscription) Wants(t EventType) bool {
+	return len(s.Events) == 0 || slices.Contains(s.Events, t)
+}
+
+// DeliveryState is the progress of a delivery.
+type DeliveryState string
+
+const (
+	DeliveryPending   DeliveryState = "pending"   // Not attempted yet
+	DeliveryDelivered DeliveryState = "delivered" // Acknowledged with a 2xx response
+	DeliveryFailed    DeliveryState = "failed"    // Last attempt failed, another is scheduled
+	DeliveryDead      DeliveryState = "dead"      // Out of attempts, only delivered again on replay
+)
+
+// Due reports whether a delivery in this state awaits an attempt.
+func (s DeliveryState) Due() bool {
+	return s == DeliveryPending || s == DeliveryFailed
+}
+
+// Delivery is the delivery of an event to a subscription, with the outcome
+// of its attempts.
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	ShipperID      string
+	Event          Event
+	State          DeliveryState
+	Attempts       int
+	LastStatusCode int    // HTTP status of the last attempt, zero if no response was received
+	LastError      string // Empty after a successful attempt
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Sentinel errors of the webhook subsystem.
+var (
+	// ErrSubscriptionNotFound indicates the subscription ID was not found.
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+
+	// ErrDeliveryNotFound indicates the delivery ID was not found.
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// ErrInvalidSubscription indicates a subscription URL or event type is
+	// invalid.
+	ErrInvalidSubscription = errors.New("invalid webhook subscription")
+)
+
+// Store persists subscriptions and the delivery log.
+type Store interface {
+	// SaveSubscription creates or replaces a subscription.
+	SaveSubscription(ctx context.Context, sub *Subscription) error
+
+	// GetSubscription returns the subscription with the given ID.
+	// Returns ErrSubscriptionNotFound if it is unknown.
+	GetSubscription(ctx context.Context, id string) (*Subscription, error)
+
+	// ListSubscriptions returns the subscriptions of a shipper, oldest first.
+	ListSubscriptions(ctx context.Context, shipperID string) ([]*Subscription, error)
+
+	// DeleteSubscription removes a subscription. Its deliveries are kept.
+	// Returns ErrSubscriptionNotFound if it is unknown.
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// SaveDelivery creates or replaces a delivery.
+	SaveDelivery(ctx context.Context, delivery *Delivery) error
+
+	// GetDelivery returns the delivery with the given ID.
+	// Returns ErrDeliveryNotFound if it is unknown.
+	GetDelivery(ctx context.Context, id string) (*Delivery, error)
+
+	// ListDeliveries returns up to limit deliveries of a shipper, newest
+	// first, optionally only those in the given state.
+	ListDeliveries(ctx context.Context, shipperID string, state DeliveryState, limit int) ([]*Delivery, error)
+
+	// DueDeliveries returns up to limit pending or failed deliveries whose
+	// next attempt is at or before now, oldest first.
+	DueDeliveries(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+}
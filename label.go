@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+var labelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Retrieve shipping labels from a carrier",
+}
+
+var labelGetFlags struct {
+	requestFlags
+	carrier  string
+	orderID  string
+	format   string
+	pieceIDs []string
+	out      string
+}
+
+var labelGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Download the labels of an order",
+	Long: `Download the labels of an order from its carrier and write them to
+disk, decoded. The first label is written to --out, which defaults to
+<order-id>.<format>; the labels of further packages are numbered, e.g.
+<order-id>-2.pdf. Labels the carrier only hosts are listed by URL.
+
+The request is given with --order-id, --format and --piece, or as a
+shipper.GetLabelRequest in JSON or YAML that these flags override.`,
+	SilenceUsage: true,
+	RunE:         runLabelGet,
+}
+
+func init() {
+	labelGetFlags.register(labelGetCmd)
+	labelGetCmd.Flags().StringVar(&labelGetFlags.carrier, "carrier", "", "carrier of the order")
+	labelGetCmd.Flags().StringVar(&labelGetFlags.orderID, "order-id", "", "carrier order ID")
+	labelGetCmd.Flags().StringVar(&labelGetFlags.format, "format", "", "label format: pdf, png or zpl (default pdf)")
+	labelGetCmd.Flags().StringSliceVar(&labelGetFlags.pieceIDs, "piece", nil, "piece IDs, for carriers that label each package separately")
+	labelGetCmd.Flags().StringVar(&labelGetFlags.out, "out", "", "file of the first label (default <order-id>.<format>)")
+	labelGetCmd.MarkFlagRequired("carrier")
+
+	labelCmd.AddCommand(labelGetCmd)
+	rootCmd.AddCommand(labelCmd)
+}
+
+// labelFile is a label written by label get.
+type labelFile struct {
+	Format shipper.LabelFormat
+	File   string `json:",omitempty"` // Empty for hosted labels
+	Bytes  int    `json:",omitempty"`
+	URL    string `json:",omitempty"`
+}
+
+func runLabelGet(cmd *cobra.Command, args []string) error {
+	if err := validateOutput(labelGetFlags.output); err != nil {
+		return err
+	}
+
+	req, err := labelRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	_, registry, err := cliRegistry()
+	if err != nil {
+		return err
+	}
+	carrier, err := registry.Get(labelGetFlags.carrier)
+	if err != nil {
+		return err
+	}
+
+	resp, err := carrier.GetLabel(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	labels := append([]shipper.Label{resp.Label}, resp.AdditionalLabels...)
+	files := make([]labelFile, len(labels))
+	for i, label := range labels {
+		f, err := writeLabel(label, labelPath(labelGetFlags.out, *req, label, i))
+		if err != nil {
+			return err
+		}
+		files[i] = f
+	}
+
+	if labelGetFlags.output == outputJSON {
+		return printJSON(cmd.OutOrStdout(), files)
+	}
+	tw := newTable(cmd.OutOrStdout())
+	fmt.Fprintln(tw, "FORMAT\tFILE\tBYTES\tURL")
+	for _, f := range files {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", f.Format, f.File, f.Bytes, f.URL)
+	}
+	return tw.Flush()
+}
+
+// labelRequest returns the request of label get: the request read, if
+// any, with the flags applied over it.
+func labelRequest(cmd *cobra.Command) (*shipper.GetLabelRequest, error) {
+	var req shipper.GetLabelRequest
+	if labelGetFlags.provided() {
+		if err := labelGetFlags.read(cmd, &req); err != nil {
+			return nil, err
+		}
+	}
+	if labelGetFlags.orderID != "" {
+		req.OrderID = labelGetFlags.orderID
+	}
+	if labelGetFlags.format != "" {
+		req.Format = shipper.LabelFormat(labelGetFlags.format)
+	}
+	if len(labelGetFlags.pieceIDs) > 0 {
+		req.PieceIDs = labelGetFlags.pieceIDs
+	}
+	if req.OrderID == "" {
+		return nil, errors.New("an order ID is required, use --order-id")
+	}
+	switch req.Format {
+	case "":
+		req.Format = shipper.LabelPDF
+	case shipper.LabelPDF, shipper.LabelPNG, shipper.LabelZPL:
+	default:
+		return nil, fmt.Errorf("unsupported label format %q, use pdf, png or zpl", req.Format)
+	}
+	return &req, nil
+}
+
+// labelPath returns the file of the i-th label of an order: out, or
+// <order-id>.<format>, numbered from the second label on.
+func labelPath(out string, req shipper.GetLabelRequest, label shipper.Label, i int) string {
+	format := label.Format
+	if format == "" {
+		format = req.Format
+	}
+	if out == "" {
+		// Order IDs may contain characters that are not valid in file names
+		name := strings.Map(func(r rune) rune {
+			if r == '/' || r == '\\' || r == os.PathSeparator {
+				return '_'
+			}
+			return r
+		}, req.OrderID)
+		out = name + "." + string(format)
+	}
+	if i == 0 {
+		return out
+	}
+	ext := filepath.Ext(out)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(out, ext), i+1, ext)
+}
+
+// writeLabel decodes an inline label to path. Hosted labels are returned
+// with their URL and not downloaded.
+func writeLabel(label shipper.Label, path string) (labelFile, error) {
+	f := labelFile{Format: label.Format, URL: label.URL}
+	if label.Data == "" {
+		if label.URL == "" {
+			return labelFile{}, errors.New("carrier returned an empty label")
+		}
+		return f, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(label.Data)
+	if err != nil {
+		return labelFile{}, fmt.Errorf("decoding label: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return labelFile{}, fmt.Errorf("writing label: %w", err)
+	}
+	f.File = path
+	f.Bytes = len(data)
+	return f, nil
+}
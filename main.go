@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tournevent/logistic/internal/server"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
 	"go.uber.org/zap"
 )
 
@@ -56,7 +57,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}
 	defer logger.Sync()
 
-	tracerShutdown, err := initTracer(ctx, cfg)
+	tracer, tracerShutdown, err := initTracer(ctx, cfg)
 	if err != nil {
 		logger.Warn("Failed to initialize tracer", zap.Error(err))
 	} else {
@@ -64,7 +65,30 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}
 
 	// Initialize shipper registry with all carriers
-	registry := initShipperRegistry(cfg, logger)
+	registry, err := initShipperRegistry(cfg, logger, tracer)
+	if err != nil {
+		return err
+	}
+
+	quoteStore, err := initQuoteStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	orderStore, err := initOrderStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	idempotencyStore, err := initIdempotencyStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := initWebhooks(cfg, logger)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("Starting Delivro Logistics Bridge",
 		zap.Int("port", cfg.Port),
@@ -72,7 +96,19 @@ func runServe(cmd *cobra.Command, args []string) error {
 	)
 
 	// Start HTTP server
-	srv := server.New(server.Config{Port: cfg.Port}, registry, logger)
+	srv := server.New(server.Config{
+		Port:             cfg.Port,
+		ActionSecret:     cfg.HasuraActionSecret,
+		QuoteStore:       quoteStore,
+		OrderStore:       orderStore,
+		IdempotencyStore: idempotencyStore,
+		Webhooks:         webhooks,
+		Poller:           initPoller(cfg),
+		RatingWeights: rating.Weights{
+			Price: cfg.RatingPriceWeight,
+			Speed: cfg.RatingSpeedWeight,
+		},
+	}, registry, logger)
 	if err := srv.Run(ctx); err != nil {
 		return fmt.Errorf("server error: %w", err)
 	}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tournevent/logistic/internal/manifest"
+)
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Transmit the day's orders to a carrier and save the manifests",
+	Long: `Transmit a shipper's open orders for a ship date to a carrier that
+requires an end-of-day manifest, one transmit per origin, and save the
+manifests to print for the driver. Orders are read from ORDER_STORE_PATH.`,
+	RunE: runManifest,
+}
+
+func init() {
+	manifestCmd.Flags().String("shipper", "", "shipper whose orders to transmit")
+	manifestCmd.Flags().String("carrier", "", "carrier to transmit to, e.g. canadapost")
+	manifestCmd.Flags().String("date", "", "ship date as YYYY-MM-DD (default today)")
+	manifestCmd.Flags().String("output", ".", "directory to save manifest PDFs in")
+	manifestCmd.MarkFlagRequired("shipper")
+	manifestCmd.MarkFlagRequired("carrier")
+	rootCmd.AddCommand(manifestCmd)
+}
+
+func runManifest(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	shipperID, _ := cmd.Flags().GetString("shipper")
+	carrier, _ := cmd.Flags().GetString("carrier")
+	date, _ := cmd.Flags().GetString("date")
+	output, _ := cmd.Flags().GetString("output")
+
+	shipDate := time.Now()
+	if date != "" {
+		var err error
+		shipDate, err = time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: %w", date, err)
+		}
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.OrderStorePath == "" {
+		return errors.New("ORDER_STORE_PATH must be set to read the orders to transmit")
+	}
+
+	logger, err := initLogger(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	defer logger.Sync()
+
+	registry, err := initShipperRegistry(cfg, logger, nil)
+	if err != nil {
+		return err
+	}
+	orderStore, err := initOrderStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	closer := manifest.NewCloser(registry, orderStore, logger)
+	results, closeErr := closer.Close(ctx, manifest.Request{
+		ShipperID: shipperID,
+		Carrier:   carrier,
+		ShipDate:  shipDate,
+	})
+
+	// Report the origins transmitted before any failure
+	out := cmd.OutOrStdout()
+	for _, r := range results {
+		origin := r.Origin.PostalCode
+		if origin == "" {
+			origin = "all origins" // The carrier transmits the whole account
+		}
+		fmt.Fprintf(out, "%s: %d orders transmitted\n", origin, len(r.OrderIDs))
+		for _, m := range r.Manifests {
+			location, err := saveManifest(output, carrier, m.ManifestID, m.Document.Data, m.Document.URL)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "  manifest %s: %s\n", m.ManifestID, location)
+		}
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(out, "No open orders to transmit")
+	}
+	return nil
+}
+
+// saveManifest writes an inline manifest to dir and returns its path. Hosted
+// manifests are not downloaded; their URL is returned instead.
+func saveManifest(dir, carrier, manifestID, data, url string) (string, error) {
+	if data == "" {
+		return url, nil
+	}
+	pdf, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", fmt.Errorf("decoding manifest %s: %w", manifestID, err)
+	}
+	path := filepath.Join(dir, carrier+"-"+manifestID+".pdf")
+	if err := os.WriteFile(path, pdf, 0o644); err != nil {
+		return "", fmt.Errorf("saving manifest %s: %w", manifestID, err)
+	}
+	return path, nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+var orderCmd = &cobra.Command{
+	Use:   "order",
+	Short: "Create and cancel orders with a carrier",
+}
+
+var orderCreateFlags struct {
+	requestFlags
+	carrier string
+}
+
+var orderCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an order with a carrier",
+	Long: `Create an order directly with a carrier. The request is a
+shipper.CreateOrderRequest in JSON or YAML, with the ServiceID of a rate
+returned by quote.
+
+The order is not recorded in the order store: use the GraphQL API for
+orders that the service must track.`,
+	SilenceUsage: true,
+	RunE:         runOrderCreate,
+}
+
+var orderCancelFlags struct {
+	requestFlags
+	carrier  string
+	orderID  string
+	reason   string
+	pieceIDs []string
+}
+
+var orderCancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel an order with a carrier",
+	Long: `Cancel an order directly with a carrier. The request is given with
+--order-id, --reason and --piece, or as a shipper.CancelOrderRequest in
+JSON or YAML that these flags override.`,
+	SilenceUsage: true,
+	RunE:         runOrderCancel,
+}
+
+func init() {
+	orderCreateFlags.register(orderCreateCmd)
+	orderCreateCmd.Flags().StringVar(&orderCreateFlags.carrier, "carrier", "", "carrier to create the order with")
+	orderCreateCmd.MarkFlagRequired("carrier")
+
+	orderCancelFlags.register(orderCancelCmd)
+	orderCancelCmd.Flags().StringVar(&orderCancelFlags.carrier, "carrier", "", "carrier of the order")
+	orderCancelCmd.Flags().StringVar(&orderCancelFlags.orderID, "order-id", "", "carrier order ID")
+	orderCancelCmd.Flags().StringVar(&orderCancelFlags.reason, "reason", "", "cancellation reason")
+	orderCancelCmd.Flags().StringSliceVar(&orderCancelFlags.pieceIDs, "piece", nil, "piece IDs, for carriers that cancel each package separately")
+	orderCancelCmd.MarkFlagRequired("carrier")
+
+	orderCmd.AddCommand(orderCreateCmd, orderCancelCmd)
+	rootCmd.AddCommand(orderCmd)
+}
+
+func runOrderCreate(cmd *cobra.Command, args []string) error {
+	if err := validateOutput(orderCreateFlags.output); err != nil {
+		return err
+	}
+
+	var req shipper.CreateOrderRequest
+	if err := orderCreateFlags.read(cmd, &req); err != nil {
+		return err
+	}
+	if err := shipper.Validate(&req); err != nil {
+		return err
+	}
+
+	_, registry, err := cliRegistry()
+	if err != nil {
+		return err
+	}
+	carrier, err := registry.Get(orderCreateFlags.carrier)
+	if err != nil {
+		return err
+	}
+
+	resp, err := carrier.CreateOrder(cmd.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	if orderCreateFlags.output == outputJSON {
+		return printJSON(cmd.OutOrStdout(), resp)
+	}
+	var estimatedDelivery string
+	if resp.EstimatedDelivery != nil {
+		estimatedDelivery = resp.EstimatedDelivery.Format("2006-01-02")
+	}
+	var totalCharged string
+	if !resp.TotalCharged.IsZero() {
+		totalCharged = resp.TotalCharged.String()
+	}
+	return printFields(cmd.OutOrStdout(), [][2]string{
+		{"Order ID", resp.OrderID},
+		{"Carrier", resp.Carrier},
+		{"Status", string(resp.Status)},
+		{"Service", resp.ServiceName},
+		{"Tracking number", resp.TrackingNumber},
+		{"Tracking URL", resp.TrackingURL},
+		{"Total charged", totalCharged},
+		{"Estimated delivery", estimatedDelivery},
+		{"Label URL", resp.LabelURL},
+		{"Pieces", strings.Join(resp.PieceIDs(), ",")},
+	})
+}
+
+func runOrderCancel(cmd *cobra.Command, args []string) error {
+	if err := validateOutput(orderCancelFlags.output); err != nil {
+		return err
+	}
+
+	req, err := cancelRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	_, registry, err := cliRegistry()
+	if err != nil {
+		return err
+	}
+	carrier, err := registry.Get(orderCancelFlags.carrier)
+	if err != nil {
+		return err
+	}
+
+	resp, err := carrier.CancelOrder(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if orderCancelFlags.output == outputJSON {
+		return printJSON(cmd.OutOrStdout(), resp)
+	}
+	var refund string
+	if resp.RefundAmount != nil {
+		refund = resp.RefundAmount.String()
+	}
+	return printFields(cmd.OutOrStdout(), [][2]string{
+		{"Order ID", resp.OrderID},
+		{"Status", string(resp.Status)},
+		{"Refund", refund},
+		{"Confirmation number", resp.ConfirmationNumber},
+	})
+}
+
+// cancelRequest returns the request of order cancel: the request read, if
+// any, with the flags applied over it.
+func cancelRequest(cmd *cobra.Command) (*shipper.CancelOrderRequest, error) {
+	var req shipper.CancelOrderRequest
+	if orderCancelFlags.provided() {
+		if err := orderCancelFlags.read(cmd, &req); err != nil {
+			return nil, err
+		}
+	}
+	if orderCancelFlags.orderID != "" {
+		req.OrderID = orderCancelFlags.orderID
+	}
+	if orderCancelFlags.reason != "" {
+		req.Reason = orderCancelFlags.reason
+	}
+	if len(orderCancelFlags.pieceIDs) > 0 {
+		req.PieceIDs = orderCancelFlags.pieceIDs
+	}
+	if req.OrderID == "" {
+		return nil, errors.New("an order ID is required, use --order-id")
+	}
+	return &req, nil
+}
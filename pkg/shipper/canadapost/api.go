@@ -2,6 +2,7 @@ package canadapost
 
 import (
 	"context"
+	"net/http"
 )
 
 // APIClient defines the interface for Canada Post API operations.
@@ -22,6 +23,24 @@ type APIClient interface {
 
 	// GetTracking retrieves tracking information
 	GetTracking(ctx context.Context, trackingNumber string) (*TrackingResponse, error)
+
+	// GetPickupAvailability checks whether pickups are offered at a postal code
+	GetPickupAvailability(ctx context.Context, postalCode string) (*PickupAvailabilityResponse, error)
+
+	// CreatePickupRequest books an on-demand pickup
+	CreatePickupRequest(ctx context.Context, req *PickupRequest) (*PickupRequestResponse, error)
+
+	// CancelPickupRequest cancels a pickup request
+	CancelPickupRequest(ctx context.Context, requestID string) (*PickupRequestResponse, error)
+
+	// TransmitShipments transmits shipment groups, producing manifests
+	TransmitShipments(ctx context.Context, req *TransmitRequest) (*TransmitResponse, error)
+
+	// GetManifest retrieves a manifest and its printable artifact
+	GetManifest(ctx context.Context, manifestID string) (*ManifestResponse, error)
+
+	// CreateAuthorizedReturn creates an authorized return and retrieves its label
+	CreateAuthorizedReturn(ctx context.Context, req *AuthorizedReturnRequest) (*AuthorizedReturnResponse, error)
 }
 
 // ============================================================================
@@ -31,6 +50,8 @@ type APIClient interface {
 // RatesRequest represents a Canada Post rate quote request.
 type RatesRequest struct {
 	CustomerNumber string     `xml:"customer-number"`
+	MailingDate    string     `xml:"expected-mailing-date,omitempty"` // YYYY-MM-DD
+	Options        []Option   `xml:"options>option,omitempty"`
 	ParcelType     string     `xml:"parcel-characteristics>parcel-type"`
 	Weight         float64    `xml:"parcel-characteristics>weight"`
 	Dimensions     Dimensions `xml:"parcel-characteristics>dimensions,omitempty"`
@@ -90,6 +111,29 @@ type ShipmentRequest struct {
 	ParcelWeight      float64
 	ParcelDimensions  Dimensions
 	Options           []Option
+	MailingDate       string   // YYYY-MM-DD
+	Customs           *Customs // For shipments leaving Canada
+}
+
+// Customs is the customs declaration of an international shipment.
+type Customs struct {
+	Currency        string
+	ReasonForExport string // "SOG", "GIF", "SAM", "REP" or "OTH"
+	OtherReason     string // Required with OTH
+	InvoiceNumber   string
+	AdditionalInfo  string // Printed on the customs form
+	Items           []CustomsItem
+}
+
+// CustomsItem is a line of a customs declaration.
+type CustomsItem struct {
+	Description     string
+	HSTariffCode    string // NNNN.NN.NN.NN
+	SKU             string
+	Quantity        int
+	UnitWeight      float64 // kg
+	UnitValue       float64
+	CountryOfOrigin string
 }
 
 // ServiceCode represents the shipping service.
@@ -113,8 +157,8 @@ type Address struct {
 
 // Option represents shipping options.
 type Option struct {
-	Code  string
-	Value string
+	Code  string // e.g. "SO" (signature), "COV" (coverage)
+	Value string // Option amount, e.g. the coverage amount for COV
 }
 
 // ShipmentResponse represents the Canada Post shipment creation response.
@@ -163,12 +207,91 @@ type TrackingEvent struct {
 	Type        string
 }
 
+// PickupAvailabilityResponse represents pickup availability at a postal code.
+type PickupAvailabilityResponse struct {
+	PostalCode     string
+	OnDemandTour   bool   // Whether on-demand pickups are offered
+	OnDemandCutoff string // HH:MM, latest time to book a same-day pickup
+}
+
+// PickupRequest represents an on-demand pickup request.
+type PickupRequest struct {
+	Address       Address
+	Instructions  string
+	ItemCount     int
+	Weight        float64 // Total weight in kg
+	Date          string  // YYYY-MM-DD
+	PreferredTime string  // HH:MM
+	ClosingTime   string  // HH:MM
+}
+
+// PickupRequestResponse represents the status of a pickup request.
+type PickupRequestResponse struct {
+	RequestID string
+	Status    string // "Active", "Cancelled"
+}
+
+// TransmitRequest represents a request to transmit shipment groups.
+type TransmitRequest struct {
+	GroupIDs        []string
+	ShippingPoint   string  // Postal code the shipments are mailed from
+	ManifestAddress Address // Printed on the manifests
+}
+
+// TransmitResponse represents the manifests produced by a transmit.
+type TransmitResponse struct {
+	ManifestIDs []string
+}
+
+// ManifestResponse represents a manifest and its printable artifact.
+type ManifestResponse struct {
+	ManifestID string
+	PONumber   string
+	Data       []byte // Raw manifest PDF
+}
+
+// AuthorizedReturnRequest represents an authorized return request. The
+// receiver's account pays for the return.
+type AuthorizedReturnRequest struct {
+	ServiceCode      string
+	Returner         Address // Customer sending the parcel back
+	Receiver         Address
+	ParcelWeight     float64
+	ParcelDimensions Dimensions
+	Encoding         string // "PDF" or "ZPL"
+	CustomerRef      string // Printed on the label, e.g. an RMA number
+}
+
+// AuthorizedReturnResponse represents an authorized return and its label.
+type AuthorizedReturnResponse struct {
+	TrackingPIN string
+	Data        []byte // Raw label data
+}
+
 // APIError represents an error from the Canada Post API.
 type APIError struct {
 	Code        string
 	Description string
+	StatusCode  int // HTTP status, 0 if not from a response
 }
 
 func (e *APIError) Error() string {
 	return e.Code + ": " + e.Description
 }
+
+// Retryable reports whether the request may succeed if sent again.
+func (e *APIError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// ErrorCode returns the carrier error code.
+func (e *APIError) ErrorCode() string {
+	return e.Code
+}
+
+// Rejected reports whether the carrier refused the request without acting
+// on it.
+func (e *APIError) Rejected() bool {
+	return e.StatusCode >= http.StatusBadRequest && e.StatusCode < http.StatusInternalServerError &&
+		e.StatusCode != http.StatusRequestTimeout
+}
@@ -8,8 +8,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // HTTPAPIClient is the production implementation of APIClient using HTTP/XML.
@@ -19,15 +24,17 @@ type HTTPAPIClient struct {
 	apiSecret  string
 	accountID  string
 	httpClient *http.Client
+	limiter    *ratelimit.Limiter
 }
 
 // HTTPAPIClientConfig holds configuration for the HTTP client.
 type HTTPAPIClientConfig struct {
-	BaseURL   string
-	APIKey    string
-	APISecret string // Password for Basic Auth
-	AccountID string
-	Timeout   time.Duration
+	BaseURL     string
+	APIKey      string
+	APISecret   string // Password for Basic Auth
+	AccountID   string
+	Timeout     time.Duration
+	RateLimiter *ratelimit.Limiter // Optional client-side rate limiter
 }
 
 // NewHTTPAPIClient creates a new HTTP-based API client for production use.
@@ -43,8 +50,10 @@ func NewHTTPAPIClient(cfg HTTPAPIClientConfig) *HTTPAPIClient {
 		apiSecret: cfg.APISecret,
 		accountID: cfg.AccountID,
 		httpClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
+		limiter: cfg.RateLimiter,
 	}
 }
 
@@ -58,6 +67,8 @@ type mailingScenario struct {
 	Xmlns            string   `xml:"xmlns,attr"`
 	CustomerNumber   string   `xml:"customer-number,omitempty"`
 	ContractID       string   `xml:"contract-id,omitempty"`
+	ExpectedMailingDate string `xml:"expected-mailing-date,omitempty"`
+	Options          *xmlOptions `xml:"options,omitempty"`
 	ParcelCharacter  parcelCharacteristics `xml:"parcel-characteristics"`
 	OriginPostalCode string   `xml:"origin-postal-code"`
 	Destination      xmlDestination `xml:"destination"`
@@ -68,6 +79,15 @@ type parcelCharacteristics struct {
 	Dimensions *xmlDimensions `xml:"dimensions,omitempty"`
 }
 
+type xmlOptions struct {
+	Option []xmlOption `xml:"option"`
+}
+
+type xmlOption struct {
+	OptionCode   string `xml:"option-code"`
+	OptionAmount string `xml:"option-amount,omitempty"`
+}
+
 type xmlDimensions struct {
 	Length float64 `xml:"length"`
 	Width  float64 `xml:"width"`
@@ -146,6 +166,7 @@ type shipmentInfo struct {
 	GroupID            string                 `xml:"group-id,omitempty"`
 	CpcPickupIndicator bool                   `xml:"cpc-pickup-indicator"`
 	RequestedShipping  requestedShipping      `xml:"requested-shipping-point,omitempty"`
+	ExpectedMailingDate string                `xml:"expected-mailing-date,omitempty"`
 	DeliverySpec       deliverySpec           `xml:"delivery-spec"`
 }
 
@@ -157,8 +178,10 @@ type deliverySpec struct {
 	ServiceCode       string           `xml:"service-code"`
 	Sender            xmlSenderInfo    `xml:"sender"`
 	Destination       xmlDestinationInfo `xml:"destination"`
+	Options           *xmlOptions      `xml:"options,omitempty"`
 	ParcelCharacter   parcelCharacteristics `xml:"parcel-characteristics"`
 	PrintPreferences  printPreferences `xml:"print-preferences,omitempty"`
+	Customs           *xmlCustoms      `xml:"customs,omitempty"`
 }
 
 type xmlSenderInfo struct {
@@ -183,6 +206,29 @@ type xmlAddressDetails struct {
 	CountryCode  string `xml:"country-code"`
 }
 
+type xmlCustoms struct {
+	Currency              string     `xml:"currency"`
+	ReasonForExport       string     `xml:"reason-for-export"`
+	OtherReason           string     `xml:"other-reason,omitempty"`
+	AdditionalCustomsInfo string     `xml:"additional-customs-info,omitempty"`
+	SKUList               xmlSKUList `xml:"sku-list"`
+	InvoiceNumber         string     `xml:"invoice-number,omitempty"`
+}
+
+type xmlSKUList struct {
+	Items []xmlSKU `xml:"item"`
+}
+
+type xmlSKU struct {
+	CustomsNumberOfUnits int     `xml:"customs-number-of-units"`
+	CustomsDescription   string  `xml:"customs-description"`
+	SKU                  string  `xml:"sku,omitempty"`
+	HSTariffCode         string  `xml:"hs-tariff-code,omitempty"`
+	UnitWeight           float64 `xml:"unit-weight"`
+	CustomsValuePerUnit  float64 `xml:"customs-value-per-unit"`
+	CountryOfOrigin      string  `xml:"country-of-origin,omitempty"`
+}
+
 type printPreferences struct {
 	OutputFormat     string `xml:"output-format"` // "4x6", "8.5x11"
 	Encoding         string `xml:"encoding"`      // "PDF", "ZPL"
@@ -223,6 +269,148 @@ type pinSummary struct {
 	EventLocation      string `xml:"event-location"`
 }
 
+// pickupAvailability is the XML response for pickup availability
+type pickupAvailability struct {
+	XMLName        xml.Name `xml:"pickup-availability"`
+	PostalCode     string   `xml:"postal-code"`
+	OnDemandCutoff string   `xml:"on-demand-cutoff"`
+	OnDemandTour   bool     `xml:"on-demand-tour"`
+}
+
+// pickupRequestDetails is the XML structure for pickup requests
+type pickupRequestDetails struct {
+	XMLName         xml.Name              `xml:"pickup-request-details"`
+	Xmlns           string                `xml:"xmlns,attr"`
+	PickupType      string                `xml:"pickup-type"`
+	PickupLocation  pickupLocation        `xml:"pickup-location"`
+	ContactInfo     pickupContactInfo     `xml:"contact-info"`
+	LocationDetails pickupLocationDetails `xml:"location-details"`
+	PickupVolume    string                `xml:"pickup-volume"`
+	PickupTimes     pickupTimes           `xml:"pickup-times"`
+}
+
+type pickupLocation struct {
+	BusinessAddressFlag bool          `xml:"business-address-flag"`
+	AlternateAddress    pickupAddress `xml:"alternate-address"`
+}
+
+type pickupAddress struct {
+	Company      string `xml:"company"`
+	AddressLine1 string `xml:"address-line-1"`
+	City         string `xml:"city"`
+	Province     string `xml:"province"`
+	PostalCode   string `xml:"postal-code"`
+}
+
+type pickupContactInfo struct {
+	ContactName  string `xml:"contact-name"`
+	Email        string `xml:"email,omitempty"`
+	ContactPhone string `xml:"contact-phone"`
+}
+
+type pickupLocationDetails struct {
+	PickupInstructions string `xml:"pickup-instructions,omitempty"`
+}
+
+type pickupTimes struct {
+	OnDemand onDemandPickupTime `xml:"on-demand-pickup-time"`
+}
+
+type onDemandPickupTime struct {
+	Date          string `xml:"date"`
+	PreferredTime string `xml:"preferred-time"`
+	ClosingTime   string `xml:"closing-time"`
+}
+
+// pickupRequestInfo is the XML response for pickup requests
+type pickupRequestInfo struct {
+	XMLName xml.Name            `xml:"pickup-request-info"`
+	Header  pickupRequestHeader `xml:"pickup-request-header"`
+}
+
+type pickupRequestHeader struct {
+	RequestID     string `xml:"request-id"`
+	RequestStatus string `xml:"request-status"`
+}
+
+// transmitSet is the XML structure for transmit requests
+type transmitSet struct {
+	XMLName            xml.Name           `xml:"transmit-set"`
+	Xmlns              string             `xml:"xmlns,attr"`
+	GroupIDs           []string           `xml:"group-ids>group-id"`
+	CpcPickupIndicator bool               `xml:"cpc-pickup-indicator"`
+	RequestedShipping  string             `xml:"requested-shipping-point"`
+	DetailedManifests  bool               `xml:"detailed-manifests"`
+	MethodOfPayment    string             `xml:"method-of-payment"`
+	ManifestAddress    xmlManifestAddress `xml:"manifest-address"`
+}
+
+type xmlManifestAddress struct {
+	ManifestCompany string                    `xml:"manifest-company"`
+	ManifestName    string                    `xml:"manifest-name,omitempty"`
+	PhoneNumber     string                    `xml:"phone-number"`
+	AddressDetails  xmlManifestAddressDetails `xml:"address-details"`
+}
+
+type xmlManifestAddressDetails struct {
+	AddressLine1  string `xml:"address-line-1"`
+	AddressLine2  string `xml:"address-line-2,omitempty"`
+	City          string `xml:"city"`
+	ProvState     string `xml:"prov-state"`
+	PostalZipCode string `xml:"postal-zip-code"`
+}
+
+// manifestLinks is the XML response for transmit requests
+type manifestLinks struct {
+	XMLName xml.Name  `xml:"manifests"`
+	Link    []xmlLink `xml:"link"`
+}
+
+// manifestInfo is the XML response for manifest details
+type manifestInfo struct {
+	XMLName  xml.Name `xml:"manifest"`
+	PONumber string   `xml:"po-number"`
+	Links    xmlLinks `xml:"links"`
+}
+
+// authorizedReturn is the XML structure for authorized return requests
+type authorizedReturn struct {
+	XMLName          xml.Name              `xml:"authorized-return"`
+	Xmlns            string                `xml:"xmlns,attr"`
+	CreateShipment   bool                  `xml:"create-shipment"`
+	ServiceCode      string                `xml:"service-code"`
+	Returner         xmlReturnParty        `xml:"returner"`
+	Receiver         xmlReturnParty        `xml:"receiver"`
+	ParcelCharacter  parcelCharacteristics `xml:"parcel-characteristics"`
+	PrintPreferences printPreferences      `xml:"print-preferences"`
+	References       *xmlReferences        `xml:"references,omitempty"`
+}
+
+type xmlReturnParty struct {
+	Name            string           `xml:"name"`
+	Company         string           `xml:"company,omitempty"`
+	DomesticAddress xmlReturnAddress `xml:"domestic-address"`
+}
+
+type xmlReturnAddress struct {
+	AddressLine1 string `xml:"address-line-1"`
+	AddressLine2 string `xml:"address-line-2,omitempty"`
+	City         string `xml:"city"`
+	Province     string `xml:"province"`
+	PostalCode   string `xml:"postal-code"`
+}
+
+type xmlReferences struct {
+	CustomerRef1 string `xml:"customer-ref-1"`
+}
+
+// authorizedReturnInfo is the XML response for authorized returns
+type authorizedReturnInfo struct {
+	XMLName     xml.Name `xml:"authorized-return-info"`
+	TrackingPIN string   `xml:"tracking-pin"`
+	Links       xmlLinks `xml:"links"`
+}
+
 // messages is the XML error response structure
 type messages struct {
 	XMLName xml.Name `xml:"messages"`
@@ -243,8 +431,10 @@ func (c *HTTPAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 	// Build XML request
 	scenario := mailingScenario{
 		Xmlns:            "http://www.canadapost.ca/ws/ship/rate-v4",
-		CustomerNumber:   req.CustomerNumber,
-		OriginPostalCode: normalizePostalCode(req.OriginPostal),
+		CustomerNumber:      req.CustomerNumber,
+		ExpectedMailingDate: req.MailingDate,
+		Options:             optionsToXML(req.Options),
+		OriginPostalCode:    normalizePostalCode(req.OriginPostal),
 		ParcelCharacter: parcelCharacteristics{
 			Weight: req.Weight,
 		},
@@ -281,7 +471,7 @@ func (c *HTTPAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 	}
 
 	path := "/rs/ship/price"
-	resp, err := c.doRequest(ctx, http.MethodPost, path, "application/vnd.cpc.ship.rate-v4+xml", xmlBody)
+	resp, err := c.doRequest(ctx, "get_rates", http.MethodPost, path, "application/vnd.cpc.ship.rate-v4+xml", xmlBody)
 	if err != nil {
 		return nil, err
 	}
@@ -335,14 +525,52 @@ func (c *HTTPAPIClient) convertRatesResponse(quotes *priceQuotes) *RatesResponse
 	}
 }
 
+// optionsToXML converts shipping options, returning nil when there are none
+// so the options element is omitted.
+func customsToXML(customs *Customs) *xmlCustoms {
+	items := make([]xmlSKU, len(customs.Items))
+	for i, item := range customs.Items {
+		items[i] = xmlSKU{
+			CustomsNumberOfUnits: item.Quantity,
+			CustomsDescription:   item.Description,
+			SKU:                  item.SKU,
+			HSTariffCode:         item.HSTariffCode,
+			UnitWeight:           item.UnitWeight,
+			CustomsValuePerUnit:  item.UnitValue,
+			CountryOfOrigin:      item.CountryOfOrigin,
+		}
+	}
+	return &xmlCustoms{
+		Currency:              customs.Currency,
+		ReasonForExport:       customs.ReasonForExport,
+		OtherReason:           customs.OtherReason,
+		AdditionalCustomsInfo: customs.AdditionalInfo,
+		SKUList:               xmlSKUList{Items: items},
+		InvoiceNumber:         customs.InvoiceNumber,
+	}
+}
+
+func optionsToXML(options []Option) *xmlOptions {
+	if len(options) == 0 {
+		return nil
+	}
+	result := &xmlOptions{Option: make([]xmlOption, len(options))}
+	for i, o := range options {
+		result.Option[i] = xmlOption{OptionCode: o.Code, OptionAmount: o.Value}
+	}
+	return result
+}
+
 // CreateShipment creates a new shipment via the Canada Post API.
 func (c *HTTPAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest) (*ShipmentResponse, error) {
 	shipment := shipmentInfo{
-		Xmlns:              "http://www.canadapost.ca/ws/shipment-v8",
-		GroupID:            req.GroupID,
-		CpcPickupIndicator: true,
+		Xmlns:               "http://www.canadapost.ca/ws/shipment-v8",
+		GroupID:             req.GroupID,
+		CpcPickupIndicator:  true,
+		ExpectedMailingDate: req.MailingDate,
 		DeliverySpec: deliverySpec{
 			ServiceCode: req.RequestedShipping.Code,
+			Options:     optionsToXML(req.Options),
 			Sender: xmlSenderInfo{
 				Name:         req.Sender.Name,
 				Company:      req.Sender.Company,
@@ -385,6 +613,9 @@ func (c *HTTPAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest
 			Height: req.ParcelDimensions.Height,
 		}
 	}
+	if req.Customs != nil {
+		shipment.DeliverySpec.Customs = customsToXML(req.Customs)
+	}
 
 	xmlBody, err := xml.Marshal(shipment)
 	if err != nil {
@@ -392,7 +623,7 @@ func (c *HTTPAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest
 	}
 
 	path := fmt.Sprintf("/rs/%s/%s/shipment", c.accountID, req.GroupID)
-	resp, err := c.doRequest(ctx, http.MethodPost, path, "application/vnd.cpc.shipment-v8+xml", xmlBody)
+	resp, err := c.doRequest(ctx, "create_shipment", http.MethodPost, path, "application/vnd.cpc.shipment-v8+xml", xmlBody)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +662,7 @@ func (c *HTTPAPIClient) GetLabel(ctx context.Context, shipmentID string, format
 	}
 
 	path := fmt.Sprintf("/rs/%s/artifact/%s", c.accountID, shipmentID)
-	resp, err := c.doRequestWithAccept(ctx, http.MethodGet, path, format, nil)
+	resp, err := c.doRequestWithAccept(ctx, "get_label", http.MethodGet, path, format, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +687,7 @@ func (c *HTTPAPIClient) GetLabel(ctx context.Context, shipmentID string, format
 // VoidShipment voids a shipment via the Canada Post API.
 func (c *HTTPAPIClient) VoidShipment(ctx context.Context, shipmentID string) (*VoidResponse, error) {
 	path := fmt.Sprintf("/rs/%s/shipment/%s", c.accountID, shipmentID)
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, "", nil)
+	resp, err := c.doRequest(ctx, "void_shipment", http.MethodDelete, path, "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -475,7 +706,7 @@ func (c *HTTPAPIClient) VoidShipment(ctx context.Context, shipmentID string) (*V
 // GetTracking retrieves tracking information from the Canada Post API.
 func (c *HTTPAPIClient) GetTracking(ctx context.Context, trackingNumber string) (*TrackingResponse, error) {
 	path := fmt.Sprintf("/vis/track/pin/%s/summary", trackingNumber)
-	resp, err := c.doRequest(ctx, http.MethodGet, path, "application/vnd.cpc.track-v2+xml", nil)
+	resp, err := c.doRequest(ctx, "get_tracking", http.MethodGet, path, "application/vnd.cpc.track-v2+xml", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -504,15 +735,327 @@ func (c *HTTPAPIClient) GetTracking(ctx context.Context, trackingNumber string)
 	}, nil
 }
 
+// GetPickupAvailability checks pickup availability via the Canada Post API.
+func (c *HTTPAPIClient) GetPickupAvailability(ctx context.Context, postalCode string) (*PickupAvailabilityResponse, error) {
+	path := fmt.Sprintf("/ad/pickup/pickupavailability/%s", normalizePostalCode(postalCode))
+	resp, err := c.doRequest(ctx, "get_pickup_availability", http.MethodGet, path, "application/vnd.cpc.pickup+xml", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var availability pickupAvailability
+	if err := xml.NewDecoder(resp.Body).Decode(&availability); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &PickupAvailabilityResponse{
+		PostalCode:     availability.PostalCode,
+		OnDemandTour:   availability.OnDemandTour,
+		OnDemandCutoff: availability.OnDemandCutoff,
+	}, nil
+}
+
+// CreatePickupRequest books an on-demand pickup via the Canada Post API.
+func (c *HTTPAPIClient) CreatePickupRequest(ctx context.Context, req *PickupRequest) (*PickupRequestResponse, error) {
+	details := pickupRequestDetails{
+		Xmlns:      "http://www.canadapost.ca/ws/pickuprequest",
+		PickupType: "OnDemand",
+		PickupLocation: pickupLocation{
+			AlternateAddress: pickupAddress{
+				Company:      req.Address.Company,
+				AddressLine1: req.Address.AddressLine1,
+				City:         req.Address.City,
+				Province:     req.Address.Province,
+				PostalCode:   normalizePostalCode(req.Address.PostalCode),
+			},
+		},
+		ContactInfo: pickupContactInfo{
+			ContactName:  req.Address.Name,
+			Email:        req.Address.Email,
+			ContactPhone: req.Address.Phone,
+		},
+		LocationDetails: pickupLocationDetails{
+			PickupInstructions: req.Instructions,
+		},
+		PickupVolume: fmt.Sprintf("%d items, %g kg", req.ItemCount, req.Weight),
+		PickupTimes: pickupTimes{
+			OnDemand: onDemandPickupTime{
+				Date:          req.Date,
+				PreferredTime: req.PreferredTime,
+				ClosingTime:   req.ClosingTime,
+			},
+		},
+	}
+
+	xmlBody, err := xml.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/enab/%s/pickuprequest", c.accountID)
+	resp, err := c.doRequest(ctx, "create_pickup", http.MethodPost, path, "application/vnd.cpc.pickuprequest+xml", xmlBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var info pickupRequestInfo
+	if err := xml.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &PickupRequestResponse{
+		RequestID: info.Header.RequestID,
+		Status:    info.Header.RequestStatus,
+	}, nil
+}
+
+// CancelPickupRequest cancels a pickup request via the Canada Post API.
+func (c *HTTPAPIClient) CancelPickupRequest(ctx context.Context, requestID string) (*PickupRequestResponse, error) {
+	path := fmt.Sprintf("/enab/%s/pickuprequest/%s", c.accountID, requestID)
+	resp, err := c.doRequest(ctx, "cancel_pickup", http.MethodDelete, path, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, c.parseError(resp)
+	}
+
+	return &PickupRequestResponse{
+		RequestID: requestID,
+		Status:    "Cancelled",
+	}, nil
+}
+
+// TransmitShipments transmits shipment groups via the Canada Post API.
+func (c *HTTPAPIClient) TransmitShipments(ctx context.Context, req *TransmitRequest) (*TransmitResponse, error) {
+	company := req.ManifestAddress.Company
+	if company == "" {
+		company = req.ManifestAddress.Name
+	}
+	set := transmitSet{
+		Xmlns:              "http://www.canadapost.ca/ws/manifest-v8",
+		GroupIDs:           req.GroupIDs,
+		CpcPickupIndicator: true,
+		RequestedShipping:  normalizePostalCode(req.ShippingPoint),
+		DetailedManifests:  true,
+		MethodOfPayment:    "Account",
+		ManifestAddress: xmlManifestAddress{
+			ManifestCompany: company,
+			ManifestName:    req.ManifestAddress.Name,
+			PhoneNumber:     req.ManifestAddress.Phone,
+			AddressDetails: xmlManifestAddressDetails{
+				AddressLine1:  req.ManifestAddress.AddressLine1,
+				AddressLine2:  req.ManifestAddress.AddressLine2,
+				City:          req.ManifestAddress.City,
+				ProvState:     req.ManifestAddress.Province,
+				PostalZipCode: normalizePostalCode(req.ManifestAddress.PostalCode),
+			},
+		},
+	}
+
+	xmlBody, err := xml.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/rs/%s/%s/manifest", c.accountID, c.accountID)
+	resp, err := c.doRequest(ctx, "transmit_shipments", http.MethodPost, path, "application/vnd.cpc.manifest-v8+xml", xmlBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var links manifestLinks
+	if err := xml.NewDecoder(resp.Body).Decode(&links); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// Manifests are identified by the last segment of their link
+	result := &TransmitResponse{}
+	for _, l := range links.Link {
+		if l.Rel == "manifest" {
+			result.ManifestIDs = append(result.ManifestIDs, l.Href[strings.LastIndex(l.Href, "/")+1:])
+		}
+	}
+	return result, nil
+}
+
+// GetManifest retrieves a manifest and its PDF artifact via the Canada Post API.
+func (c *HTTPAPIClient) GetManifest(ctx context.Context, manifestID string) (*ManifestResponse, error) {
+	manifestPath := fmt.Sprintf("/rs/%s/%s/manifest/%s", c.accountID, c.accountID, manifestID)
+	resp, err := c.doRequest(ctx, "get_manifest", http.MethodGet, manifestPath, "application/vnd.cpc.manifest-v8+xml", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	var info manifestInfo
+	if err := xml.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	data, err := c.getArtifact(ctx, "get_manifest", info.Links, "artifact", "application/pdf")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManifestResponse{
+		ManifestID: manifestID,
+		PONumber:   info.PONumber,
+		Data:       data,
+	}, nil
+}
+
+// CreateAuthorizedReturn creates an authorized return via the Canada Post
+// API and retrieves its label.
+func (c *HTTPAPIClient) CreateAuthorizedReturn(ctx context.Context, req *AuthorizedReturnRequest) (*AuthorizedReturnResponse, error) {
+	authReturn := authorizedReturn{
+		Xmlns:          "http://www.canadapost.ca/ws/authreturn-v2",
+		CreateShipment: true,
+		ServiceCode:    req.ServiceCode,
+		Returner:       returnPartyToXML(req.Returner),
+		Receiver:       returnPartyToXML(req.Receiver),
+		ParcelCharacter: parcelCharacteristics{
+			Weight: req.ParcelWeight,
+		},
+		PrintPreferences: printPreferences{
+			OutputFormat: "4x6",
+			Encoding:     req.Encoding,
+		},
+	}
+	if req.ParcelDimensions.Length > 0 {
+		authReturn.ParcelCharacter.Dimensions = &xmlDimensions{
+			Length: req.ParcelDimensions.Length,
+			Width:  req.ParcelDimensions.Width,
+			Height: req.ParcelDimensions.Height,
+		}
+	}
+	if req.CustomerRef != "" {
+		authReturn.References = &xmlReferences{CustomerRef1: req.CustomerRef}
+	}
+
+	xmlBody, err := xml.Marshal(authReturn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/rs/%s/%s/authorizedreturn", c.accountID, c.accountID)
+	resp, err := c.doRequest(ctx, "create_return", http.MethodPost, path, "application/vnd.cpc.authreturn-v2+xml", xmlBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var info authorizedReturnInfo
+	if err := xml.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	accept := "application/pdf"
+	if req.Encoding == "ZPL" {
+		accept = "application/zpl"
+	}
+	data, err := c.getArtifact(ctx, "create_return", info.Links, "returnLabel", accept)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorizedReturnResponse{
+		TrackingPIN: info.TrackingPIN,
+		Data:        data,
+	}, nil
+}
+
+func returnPartyToXML(addr Address) xmlReturnParty {
+	return xmlReturnParty{
+		Name:    addr.Name,
+		Company: addr.Company,
+		DomesticAddress: xmlReturnAddress{
+			AddressLine1: addr.AddressLine1,
+			AddressLine2: addr.AddressLine2,
+			City:         addr.City,
+			Province:     addr.Province,
+			PostalCode:   normalizePostalCode(addr.PostalCode),
+		},
+	}
+}
+
+// getArtifact downloads the document behind the link with the given rel.
+func (c *HTTPAPIClient) getArtifact(ctx context.Context, operation string, links xmlLinks, rel, accept string) ([]byte, error) {
+	var artifactURL string
+	for _, l := range links.Link {
+		if l.Rel == rel {
+			artifactURL = l.Href
+			break
+		}
+	}
+	if artifactURL == "" {
+		return nil, &APIError{
+			Code:        "ARTIFACT_NOT_FOUND",
+			Description: "No " + rel + " link in response",
+		}
+	}
+	u, err := url.Parse(artifactURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact link: %w", err)
+	}
+
+	resp, err := c.doRequestWithAccept(ctx, operation, http.MethodGet, u.Path, accept, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseError(resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact data: %w", err)
+	}
+	return data, nil
+}
+
 // ============================================================================
 // HTTP Helpers
 // ============================================================================
 
-func (c *HTTPAPIClient) doRequest(ctx context.Context, method, path, contentType string, body []byte) (*http.Response, error) {
-	return c.doRequestWithAccept(ctx, method, path, contentType, body)
+func (c *HTTPAPIClient) doRequest(ctx context.Context, operation, method, path, contentType string, body []byte) (*http.Response, error) {
+	return c.doRequestWithAccept(ctx, operation, method, path, contentType, body)
 }
 
-func (c *HTTPAPIClient) doRequestWithAccept(ctx context.Context, method, path, accept string, body []byte) (*http.Response, error) {
+// doRequestWithAccept waits for the rate limiter, sends the request and
+// turns 429 responses into rate limit errors.
+func (c *HTTPAPIClient) doRequestWithAccept(ctx context.Context, operation, method, path, accept string, body []byte) (*http.Response, error) {
+	if err := c.limiter.Wait(ctx, operation); err != nil {
+		return nil, err
+	}
+
 	url := c.baseURL + path
 
 	var bodyReader io.Reader
@@ -541,7 +1084,17 @@ func (c *HTTPAPIClient) doRequestWithAccept(ctx context.Context, method, path, a
 		req.Header.Set("Accept", accept)
 	}
 
-	return c.httpClient.Do(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		retryAfter := ratelimit.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		c.limiter.Pause(retryAfter)
+		return nil, shipper.NewRateLimitError(carrierName, retryAfter)
+	}
+	return resp, nil
 }
 
 func (c *HTTPAPIClient) parseError(resp *http.Response) error {
@@ -553,12 +1106,14 @@ func (c *HTTPAPIClient) parseError(resp *http.Response) error {
 		return &APIError{
 			Code:        msgs.Message[0].Code,
 			Description: msgs.Message[0].Description,
+			StatusCode:  resp.StatusCode,
 		}
 	}
 
 	return &APIError{
 		Code:        fmt.Sprintf("HTTP_%d", resp.StatusCode),
 		Description: string(body),
+		StatusCode:  resp.StatusCode,
 	}
 }
 
@@ -18,6 +18,15 @@ type MockAPIClient struct {
 	OnGetLabel       func(ctx context.Context, shipmentID string, format string) (*LabelResponse, error)
 	OnVoidShipment   func(ctx context.Context, shipmentID string) (*VoidResponse, error)
 	OnGetTracking    func(ctx context.Context, trackingNumber string) (*TrackingResponse, error)
+
+	OnGetPickupAvailability func(ctx context.Context, postalCode string) (*PickupAvailabilityResponse, error)
+	OnCreatePickupRequest   func(ctx context.Context, req *PickupRequest) (*PickupRequestResponse, error)
+	OnCancelPickupRequest   func(ctx context.Context, requestID string) (*PickupRequestResponse, error)
+
+	OnTransmitShipments func(ctx context.Context, req *TransmitRequest) (*TransmitResponse, error)
+	OnGetManifest       func(ctx context.Context, manifestID string) (*ManifestResponse, error)
+
+	OnCreateAuthorizedReturn func(ctx context.Context, req *AuthorizedReturnRequest) (*AuthorizedReturnResponse, error)
 }
 
 // NewMockAPIClient creates a new mock API client with default behavior.
@@ -194,4 +203,130 @@ func (m *MockAPIClient) GetTracking(ctx context.Context, trackingNumber string)
 	}, nil
 }
 
+// GetPickupAvailability returns mock pickup availability.
+func (m *MockAPIClient) GetPickupAvailability(ctx context.Context, postalCode string) (*PickupAvailabilityResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnGetPickupAvailability != nil {
+		return m.OnGetPickupAvailability(ctx, postalCode)
+	}
+
+	return &PickupAvailabilityResponse{
+		PostalCode:     postalCode,
+		OnDemandTour:   true,
+		OnDemandCutoff: "15:00",
+	}, nil
+}
+
+// CreatePickupRequest books a mock pickup.
+func (m *MockAPIClient) CreatePickupRequest(ctx context.Context, req *PickupRequest) (*PickupRequestResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnCreatePickupRequest != nil {
+		return m.OnCreatePickupRequest(ctx, req)
+	}
+
+	return &PickupRequestResponse{
+		RequestID: fmt.Sprintf("%010d", time.Now().UnixNano()%10000000000),
+		Status:    "Active",
+	}, nil
+}
+
+// CancelPickupRequest cancels a mock pickup.
+func (m *MockAPIClient) CancelPickupRequest(ctx context.Context, requestID string) (*PickupRequestResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnCancelPickupRequest != nil {
+		return m.OnCancelPickupRequest(ctx, requestID)
+	}
+
+	return &PickupRequestResponse{
+		RequestID: requestID,
+		Status:    "Cancelled",
+	}, nil
+}
+
+// TransmitShipments transmits mock shipment groups, producing one manifest.
+func (m *MockAPIClient) TransmitShipments(ctx context.Context, req *TransmitRequest) (*TransmitResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnTransmitShipments != nil {
+		return m.OnTransmitShipments(ctx, req)
+	}
+
+	return &TransmitResponse{
+		ManifestIDs: []string{fmt.Sprintf("%012d", time.Now().UnixNano()%1000000000000)},
+	}, nil
+}
+
+// GetManifest returns a mock manifest.
+func (m *MockAPIClient) GetManifest(ctx context.Context, manifestID string) (*ManifestResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnGetManifest != nil {
+		return m.OnGetManifest(ctx, manifestID)
+	}
+
+	return &ManifestResponse{
+		ManifestID: manifestID,
+		PONumber:   "P" + manifestID,
+		Data:       []byte("%PDF-1.4 mock manifest data"),
+	}, nil
+}
+
+// CreateAuthorizedReturn creates a mock authorized return.
+func (m *MockAPIClient) CreateAuthorizedReturn(ctx context.Context, req *AuthorizedReturnRequest) (*AuthorizedReturnResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnCreateAuthorizedReturn != nil {
+		return m.OnCreateAuthorizedReturn(ctx, req)
+	}
+
+	data := []byte("%PDF-1.4 mock return label data")
+	if req.Encoding == "ZPL" {
+		data = []byte("^XA^FDmock return label^FS^XZ")
+	}
+
+	return &AuthorizedReturnResponse{
+		TrackingPIN: fmt.Sprintf("%d", 1000000000000+time.Now().UnixNano()%9000000000000),
+		Data:        data,
+	}, nil
+}
+
 var _ APIClient = (*MockAPIClient)(nil)
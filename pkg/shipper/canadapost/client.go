@@ -3,10 +3,16 @@ package canadapost
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"github.com/tournevent/logistic/pkg/shipper/units"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -14,12 +20,32 @@ import (
 
 const carrierName = "canadapost"
 
+// packageUnits is how Canada Post measures parcels: kilograms to three
+// decimals and centimeters to one. Parcels are limited to 30 kg, 2 m on the
+// longest side and 3 m in length plus girth.
+var packageUnits = units.Spec{
+	WeightUnit:    shipper.WeightKG,
+	DimensionUnit: shipper.DimensionCM,
+	WeightStep:    0.001,
+	DimensionStep: 0.1,
+
+	MaxWeight:          30,
+	MaxLength:          200,
+	MaxLengthPlusGirth: 300,
+}
+
 // Config holds Canada Post configuration.
 type Config struct {
-	APIKey    string
-	AccountID string
-	BaseURL   string
-	UseMock   bool
+	APIKey      string
+	AccountID   string
+	BaseURL     string
+	UseMock     bool
+	RateLimiter *ratelimit.Limiter // Optional client-side rate limiter for the HTTP client
+
+	// Basic auth credentials expected on tracking pushes; pushes are
+	// rejected when empty
+	WebhookUsername string
+	WebhookPassword string
 }
 
 // Client is the Canada Post shipper client.
@@ -38,10 +64,11 @@ func New(cfg Config, logger *otelzap.Logger, tracer trace.Tracer) *Client {
 		apiClient = NewMockAPIClient()
 	} else {
 		apiClient = NewHTTPAPIClient(HTTPAPIClientConfig{
-			BaseURL:   cfg.BaseURL,
-			APIKey:    cfg.APIKey,
-			AccountID: cfg.AccountID,
-			Timeout:   30 * time.Second,
+			BaseURL:     cfg.BaseURL,
+			APIKey:      cfg.APIKey,
+			AccountID:   cfg.AccountID,
+			Timeout:     30 * time.Second,
+			RateLimiter: cfg.RateLimiter,
 		})
 	}
 
@@ -68,95 +95,198 @@ func (c *Client) Name() string {
 	return carrierName
 }
 
+// Capabilities describes what Canada Post supports. Labels come as PDF or
+// ZPL, and there is no Saturday delivery.
+func (c *Client) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{
+		LabelFormats:      []shipper.LabelFormat{shipper.LabelPDF, shipper.LabelZPL},
+		International:     true,
+		MultiPiece:        true,
+		Pickups:           true,
+		Manifests:         true,
+		Returns:           true,
+		Insurance:         true,
+		SignatureRequired: true,
+		MaxWeight:         packageUnits.MaxWeight,
+		MaxWeightUnit:     packageUnits.WeightUnit,
+	}
+}
+
 // GetQuote returns shipping quotes from Canada Post.
+// Canada Post rates a single parcel per request, so each package is rated
+// on its own and the rates are combined per service. Customs declarations
+// do not change Canada Post rates and are not sent.
 func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_quote",
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Getting Canada Post quotes",
 		zap.String("origin_postal", req.Origin.PostalCode),
 		zap.String("destination_postal", req.Destination.PostalCode),
 		zap.Int("package_count", len(req.Packages)),
 	)
 
-	// Convert to API request
-	apiReq := &RatesRequest{
-		CustomerNumber: c.config.AccountID,
-		OriginPostal:   req.Origin.PostalCode,
+	if err := validateOptions(req.Options, req.Packages); err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
 	}
 
 	// Set destination
+	var destination Destination
 	if req.Destination.CountryCode == "" || req.Destination.CountryCode == "CA" {
-		apiReq.Destination.Domestic = &DomesticDestination{
+		destination.Domestic = &DomesticDestination{
 			PostalCode: req.Destination.PostalCode,
 		}
 	} else {
-		apiReq.Destination.International = &InternationalDestination{
+		destination.International = &InternationalDestination{
 			CountryCode: req.Destination.CountryCode,
 		}
 	}
 
-	// Use first package for dimensions/weight
-	if len(req.Packages) > 0 {
-		pkg := req.Packages[0]
-		apiReq.Weight = pkg.Weight
-		apiReq.Dimensions = Dimensions{
-			Length: pkg.Length,
-			Width:  pkg.Width,
-			Height: pkg.Height,
-		}
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
 	}
+	quotes := make([]*shipper.QuoteResponse, 0, len(pkgs))
+	for _, pkg := range parcels(pkgs) {
+		// Convert to API request
+		apiReq := &RatesRequest{
+			CustomerNumber: c.config.AccountID,
+			MailingDate:    formatMailingDate(req.Options.ShipDate),
+			Options:        optionsToAPI(req.Options, pkg),
+			Weight:         pkg.Weight,
+			Dimensions: Dimensions{
+				Length: pkg.Length,
+				Width:  pkg.Width,
+				Height: pkg.Height,
+			},
+			OriginPostal: req.Origin.PostalCode,
+			Destination:  destination,
+		}
 
-	// Call API
-	apiResp, err := c.apiClient.GetRates(ctx, apiReq)
-	if err != nil {
-		c.logger.Error("Canada Post API error", zap.Error(err))
-		return nil, err
+		// Call API
+		apiResp, err := c.apiClient.GetRates(ctx, apiReq)
+		if err != nil {
+			c.logger.Error("Canada Post API error", zap.Error(err))
+			shipper.RecordError(span, err)
+			return nil, err
+		}
+
+		// Convert to shipper response
+		quotes = append(quotes, ratesResponseToShipper(apiResp))
 	}
 
-	// Convert to shipper response
-	return ratesResponseToShipper(apiResp), nil
+	resp := combineQuotes(quotes)
+	resp.SetBillableWeight(packageUnits.TotalWeight(pkgs), packageUnits.WeightUnit)
+	return resp, nil
 }
 
 // CreateOrder creates a shipment with Canada Post.
+// Each package becomes its own Canada Post shipment, grouped with the other
+// shipments mailed from the same origin on the same day so that Manifest
+// can transmit them together. The first shipment identifies the order.
 func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_order",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Creating Canada Post order",
 		zap.String("rate_id", req.RateID),
 		zap.String("recipient", req.Recipient.Name),
 	)
 
-	// Extract service code from rate ID (e.g., "cp-rate-regular-xxx" -> DOM.RP)
-	serviceCode := extractServiceCode(req.RateID)
-
-	// Convert to API request
-	apiReq := &ShipmentRequest{
-		CustomerNumber:    c.config.AccountID,
-		GroupID:           "default",
-		RequestedShipping: ServiceCode{Code: serviceCode},
-		Sender:            addressToAPI(req.SenderAddress),
-		Destination:       addressToAPI(req.RecipientAddress),
-	}
-
-	if len(req.Packages) > 0 {
-		pkg := req.Packages[0]
-		apiReq.ParcelWeight = pkg.Weight
-		apiReq.ParcelDimensions = Dimensions{
-			Length: pkg.Length,
-			Width:  pkg.Width,
-			Height: pkg.Height,
-		}
+	if req.ServiceID == "" {
+		err := shipper.NewShipperError(carrierName, "invalid_service", "unknown service code for rate "+req.RateID)
+		shipper.RecordError(span, err)
+		return nil, err
 	}
 
-	// Call API
-	apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
+	if err := validateOptions(req.Options, req.Packages); err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	if req.Pickup != nil {
+		// Pickups are booked separately, with SchedulePickup
+		err := shipper.NewUnsupportedOptionError(carrierName, shipper.OptionPickup)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	shipDate := time.Now()
+	if req.Options.ShipDate != nil {
+		shipDate = *req.Options.ShipDate
+	}
+	groupID := shipmentGroupID(req.ShipperID, req.SenderAddress.PostalCode, shipDate)
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	customs, err := customsToAPI(req.Customs, len(pkgs))
 	if err != nil {
-		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
+	shipments := make([]*ShipmentResponse, 0, len(pkgs))
+	for _, pkg := range parcels(pkgs) {
+		// Convert to API request
+		apiReq := &ShipmentRequest{
+			CustomerNumber:    c.config.AccountID,
+			GroupID:           groupID,
+			RequestedShipping: ServiceCode{Code: req.ServiceID},
+			Sender:            addressToAPI(req.SenderAddress),
+			Destination:       addressToAPI(req.RecipientAddress),
+			ParcelWeight:      pkg.Weight,
+			ParcelDimensions: Dimensions{
+				Length: pkg.Length,
+				Width:  pkg.Width,
+				Height: pkg.Height,
+			},
+			Options:     optionsToAPI(req.Options, pkg),
+			MailingDate: formatMailingDate(req.Options.ShipDate),
+			Customs:     customs,
+		}
+
+		// Call API
+		apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
+		if err != nil {
+			c.logger.Error("Canada Post API error", zap.Error(err))
+			c.voidShipments(ctx, shipments)
+			shipper.RecordError(span, err)
+			return nil, err
+		}
+		shipments = append(shipments, apiResp)
+	}
 
 	// Convert to shipper response
-	return shipmentResponseToShipper(apiResp), nil
+	return shipmentsResponseToShipper(shipments), nil
 }
 
-// GetLabel retrieves the shipping label from Canada Post.
+// voidShipments voids the shipments already created for an order that
+// could not be completed. Failures are logged, not returned.
+func (c *Client) voidShipments(ctx context.Context, shipments []*ShipmentResponse) {
+	for _, s := range shipments {
+		if _, err := c.apiClient.VoidShipment(ctx, s.ShipmentID); err != nil {
+			c.logger.Warn("Failed to void Canada Post shipment of incomplete order",
+				zap.String("shipment_id", s.ShipmentID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// GetLabel retrieves the shipping labels from Canada Post, one per piece.
 func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_label")
+	defer span.End()
+
 	c.logger.Info("Getting Canada Post label",
 		zap.String("order_id", req.OrderID),
 		zap.String("format", string(req.Format)),
@@ -167,39 +297,436 @@ func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*s
 		format = "application/zpl"
 	}
 
+	var result *shipper.GetLabelResponse
+	for _, shipmentID := range shipmentIDs(req.OrderID, req.PieceIDs) {
+		// Call API
+		apiResp, err := c.apiClient.GetLabel(ctx, shipmentID, format)
+		if err != nil {
+			c.logger.Error("Canada Post API error", zap.Error(err))
+			shipper.RecordError(span, err)
+			return nil, err
+		}
+
+		// Convert to shipper response
+		label := labelResponseToShipper(apiResp)
+		if result == nil {
+			result = label
+		} else {
+			result.AdditionalLabels = append(result.AdditionalLabels, label.Label)
+		}
+	}
+	return result, nil
+}
+
+// CancelOrder voids every shipment of an order with Canada Post.
+func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_order")
+	defer span.End()
+
+	c.logger.Info("Cancelling Canada Post order",
+		zap.String("order_id", req.OrderID),
+		zap.String("reason", req.Reason),
+	)
+
+	var result *shipper.CancelOrderResponse
+	for _, shipmentID := range shipmentIDs(req.OrderID, req.PieceIDs) {
+		// Call API
+		apiResp, err := c.apiClient.VoidShipment(ctx, shipmentID)
+		if err != nil {
+			c.logger.Error("Canada Post API error", zap.Error(err))
+			shipper.RecordError(span, err)
+			return nil, err
+		}
+
+		// Convert to shipper response
+		if result == nil {
+			result = voidResponseToShipper(apiResp)
+		}
+	}
+	return result, nil
+}
+
+// Track retrieves the tracking history of a Canada Post shipment by PIN.
+func (c *Client) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "track_shipment")
+	defer span.End()
+
+	c.logger.Info("Tracking Canada Post shipment",
+		zap.String("order_id", req.OrderID),
+		zap.String("tracking_number", req.TrackingNumber),
+	)
+
+	pin := req.TrackingNumber
+	if pin == "" {
+		pin = req.OrderID
+	}
+
 	// Call API
-	apiResp, err := c.apiClient.GetLabel(ctx, req.OrderID, format)
+	apiResp, err := c.apiClient.GetTracking(ctx, pin)
 	if err != nil {
 		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
 	// Convert to shipper response
-	return labelResponseToShipper(apiResp), nil
+	resp := trackingResponseToShipper(apiResp)
+	resp.OrderID = req.OrderID
+	return resp, nil
 }
 
-// CancelOrder cancels a shipment with Canada Post.
-func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
-	c.logger.Info("Cancelling Canada Post order",
-		zap.String("order_id", req.OrderID),
-		zap.String("reason", req.Reason),
+// GetPickupAvailability reports whether Canada Post offers on-demand
+// pickups at the address, and until when a pickup can be booked on the day.
+func (c *Client) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_pickup_availability")
+	defer span.End()
+
+	c.logger.Info("Getting Canada Post pickup availability",
+		zap.String("postal_code", req.Address.PostalCode),
 	)
 
 	// Call API
-	apiResp, err := c.apiClient.VoidShipment(ctx, req.OrderID)
+	apiResp, err := c.apiClient.GetPickupAvailability(ctx, req.Address.PostalCode)
 	if err != nil {
 		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
-	// Convert to shipper response
-	return voidResponseToShipper(apiResp), nil
+	resp := &shipper.PickupAvailabilityResponse{Available: apiResp.OnDemandTour}
+	if cutoff, err := time.Parse("15:04", apiResp.OnDemandCutoff); err == nil {
+		y, m, d := req.Date.Date()
+		bookBy := time.Date(y, m, d, cutoff.Hour(), cutoff.Minute(), 0, 0, req.Date.Location())
+		resp.BookBy = &bookBy
+	}
+	return resp, nil
+}
+
+// SchedulePickup books an on-demand Canada Post pickup. The pickup ID is the
+// pickup request number.
+func (c *Client) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "schedule_pickup",
+		shipper.AttrPackageCount.Int(req.PackageCount),
+	)
+	defer span.End()
+
+	c.logger.Info("Scheduling Canada Post pickup",
+		zap.String("postal_code", req.Address.PostalCode),
+		zap.Time("ready_at", req.Window.ReadyAt),
+	)
+
+	address := addressToAPI(req.Address)
+	address.Name = req.Contact.Name
+	address.Phone = req.Contact.Phone
+	address.Email = req.Contact.Email
+
+	// Call API
+	apiResp, err := c.apiClient.CreatePickupRequest(ctx, &PickupRequest{
+		Address:       address,
+		Instructions:  req.Instructions,
+		ItemCount:     req.PackageCount,
+		Weight:        packageUnits.Weight(req.TotalWeight, req.WeightUnit),
+		Date:          req.Window.ReadyAt.Format("2006-01-02"),
+		PreferredTime: req.Window.ReadyAt.Format("15:04"),
+		ClosingTime:   req.Window.CloseAt.In(req.Window.ReadyAt.Location()).Format("15:04"),
+	})
+	if err != nil {
+		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.SchedulePickupResponse{
+		PickupID: apiResp.RequestID,
+		Carrier:  carrierName,
+		Status:   mapPickupStatus(apiResp.Status),
+		Window:   req.Window,
+	}, nil
+}
+
+// CancelPickup cancels a Canada Post pickup request.
+func (c *Client) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_pickup")
+	defer span.End()
+
+	c.logger.Info("Cancelling Canada Post pickup",
+		zap.String("pickup_id", req.PickupID),
+	)
+
+	// Call API
+	apiResp, err := c.apiClient.CancelPickupRequest(ctx, req.PickupID)
+	if err != nil {
+		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.CancelPickupResponse{
+		PickupID: apiResp.RequestID,
+		Status:   mapPickupStatus(apiResp.Status),
+	}, nil
+}
+
+var _ shipper.PickupScheduler = (*Client)(nil)
+
+// Manifest transmits the shipment group of the origin and ship date and
+// retrieves the resulting manifests.
+func (c *Client) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "manifest")
+	defer span.End()
+
+	groupID := shipmentGroupID(req.ShipperID, req.Origin.PostalCode, req.ShipDate)
+	c.logger.Info("Transmitting Canada Post shipments",
+		zap.String("group_id", groupID),
+	)
+
+	// Call API
+	transmitResp, err := c.apiClient.TransmitShipments(ctx, &TransmitRequest{
+		GroupIDs:        []string{groupID},
+		ShippingPoint:   req.Origin.PostalCode,
+		ManifestAddress: addressToAPI(req.Origin),
+	})
+	if err != nil {
+		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	resp := &shipper.ManifestResponse{
+		Carrier:   carrierName,
+		Manifests: make([]shipper.Manifest, 0, len(transmitResp.ManifestIDs)),
+	}
+	for _, manifestID := range transmitResp.ManifestIDs {
+		apiResp, err := c.apiClient.GetManifest(ctx, manifestID)
+		if err != nil {
+			c.logger.Error("Canada Post API error", zap.Error(err))
+			shipper.RecordError(span, err)
+			return nil, err
+		}
+		resp.Manifests = append(resp.Manifests, shipper.Manifest{
+			ManifestID: apiResp.ManifestID,
+			Document: shipper.Label{
+				Format: shipper.LabelPDF,
+				Data:   base64.StdEncoding.EncodeToString(apiResp.Data),
+			},
+		})
+	}
+	return resp, nil
+}
+
+var _ shipper.Manifester = (*Client)(nil)
+
+// CreateReturn creates a Canada Post authorized return. Authorized returns
+// carry a single parcel from within Canada and are charged only when the
+// label is used, so the order ID is the tracking PIN and nothing is charged
+// up front.
+func (c *Client) CreateReturn(ctx context.Context, req *shipper.CreateReturnRequest) (*shipper.CreateReturnResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_return",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
+	c.logger.Info("Creating Canada Post return",
+		zap.String("original_order_id", req.OriginalOrderID),
+		zap.String("rma_number", req.RMANumber),
+	)
+
+	if req.ReturnerAddress.CountryCode != "" && req.ReturnerAddress.CountryCode != "CA" {
+		err := shipper.NewUnsupportedOptionError(carrierName, shipper.OptionReturn+" from outside Canada")
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	pkgs := packageUnits.NormalizeAll(parcels(req.Packages))
+	if len(pkgs) > 1 {
+		err := shipper.NewShipperError(carrierName, "invalid_package", "authorized returns carry a single parcel").
+			WithCause(shipper.ErrInvalidPackage)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	format := shipper.LabelPDF
+	encoding := "PDF"
+	if req.LabelFormat == shipper.LabelZPL {
+		format = shipper.LabelZPL
+		encoding = "ZPL"
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.CreateAuthorizedReturn(ctx, &AuthorizedReturnRequest{
+		ServiceCode:  req.ServiceID,
+		Returner:     contactAddressToAPI(req.Returner, req.ReturnerAddress),
+		Receiver:     contactAddressToAPI(req.Receiver, req.ReceiverAddress),
+		ParcelWeight: pkgs[0].Weight,
+		ParcelDimensions: Dimensions{
+			Length: pkgs[0].Length,
+			Width:  pkgs[0].Width,
+			Height: pkgs[0].Height,
+		},
+		Encoding:    encoding,
+		CustomerRef: req.RMANumber,
+	})
+	if err != nil {
+		c.logger.Error("Canada Post API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.CreateReturnResponse{
+		OrderID:        apiResp.TrackingPIN,
+		TrackingNumber: apiResp.TrackingPIN,
+		Carrier:        carrierName,
+		Status:         shipper.StatusConfirmed,
+		TotalCharged:   shipper.Money{Currency: "CAD"},
+		Label: shipper.Label{
+			Format: format,
+			Data:   base64.StdEncoding.EncodeToString(apiResp.Data),
+		},
+	}, nil
 }
 
+var _ shipper.Returner = (*Client)(nil)
+
 // ============================================================================
 // Conversion helpers
 // ============================================================================
 
+// validateOptions reports options Canada Post cannot honor for the order.
+// Canada Post has no Saturday delivery option.
+func validateOptions(opts shipper.ShippingOptions, pkgs []shipper.Package) error {
+	if opts.SaturdayDelivery {
+		return shipper.NewUnsupportedOptionError(carrierName, shipper.OptionSaturdayDelivery)
+	}
+	if opts.InsuranceRequired {
+		if _, err := shipper.InsuredValue(carrierName, pkgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// optionsToAPI maps shipping options to Canada Post option codes for a
+// single parcel: SO for signature and COV for coverage of the parcel's
+// declared value.
+func optionsToAPI(opts shipper.ShippingOptions, pkg shipper.Package) []Option {
+	var options []Option
+	if opts.SignatureRequired {
+		options = append(options, Option{Code: "SO"})
+	}
+	if opts.InsuranceRequired && pkg.DeclaredValue.Minor > 0 {
+		options = append(options, Option{Code: "COV", Value: pkg.DeclaredValue.Amount().String()})
+	}
+	return options
+}
+
+// exportReasons maps reasons for export to Canada Post codes. Returns have
+// no code of their own and are declared as other.
+var exportReasons = map[shipper.ExportReason]string{
+	shipper.ExportSale:   "SOG",
+	shipper.ExportGift:   "GIF",
+	shipper.ExportSample: "SAM",
+	shipper.ExportRepair: "REP",
+	shipper.ExportReturn: "OTH",
+}
+
+// customsToAPI converts the customs declaration of an order of parcels, or
+// returns nil without one. Canada Post declares the contents of each parcel,
+// so international orders carry a single parcel. Duties are always billed
+// to the recipient, and values are declared in CAD since other currencies
+// need a conversion rate. Canada Post takes no tax IDs.
+func customsToAPI(c *shipper.Customs, parcelCount int) (*Customs, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Incoterm == shipper.IncotermDDP {
+		return nil, shipper.NewUnsupportedOptionError(carrierName, shipper.OptionIncotermDDP)
+	}
+	if c.Currency != "CAD" {
+		return nil, shipper.NewShipperError(carrierName, "invalid_customs", "customs values must be in CAD").
+			WithCause(shipper.ErrInvalidCustoms)
+	}
+	if parcelCount > 1 {
+		return nil, shipper.NewShipperError(carrierName, "invalid_package", "international shipments carry a single parcel").
+			WithCause(shipper.ErrInvalidPackage)
+	}
+
+	customs := &Customs{
+		Currency:        c.Currency,
+		ReasonForExport: exportReasons[c.ReasonForExport],
+		InvoiceNumber:   c.InvoiceNumber,
+		AdditionalInfo:  c.ExportDeclarationNumber,
+		Items:           make([]CustomsItem, len(c.Items)),
+	}
+	if c.ReasonForExport == shipper.ExportReturn {
+		customs.OtherReason = "Return"
+	}
+	for i, item := range c.Items {
+		customs.Items[i] = CustomsItem{
+			Description:     item.Description,
+			HSTariffCode:    hsTariffCode(item.HSCode),
+			SKU:             item.SKU,
+			Quantity:        item.Quantity,
+			UnitWeight:      packageUnits.Weight(item.UnitWeight, item.WeightUnit),
+			UnitValue:       item.UnitValue.Float64(),
+			CountryOfOrigin: item.CountryOfOrigin,
+		}
+	}
+	return customs, nil
+}
+
+// hsTariffCode formats a Harmonized System code the way Canada Post
+// expects, grouped with dots, e.g. "6109100010" as "6109.10.00.10".
+func hsTariffCode(code string) string {
+	digits := strings.ReplaceAll(code, ".", "")
+	if len(digits) <= 4 {
+		return digits
+	}
+	groups := []string{digits[:4]}
+	for i := 4; i < len(digits); i += 2 {
+		groups = append(groups, digits[i:min(i+2, len(digits))])
+	}
+	return strings.Join(groups, ".")
+}
+
+// parcels returns the packages to ship, one Canada Post parcel each. An
+// order without packages is sent as a single empty parcel.
+func parcels(pkgs []shipper.Package) []shipper.Package {
+	if len(pkgs) == 0 {
+		return []shipper.Package{{}}
+	}
+	return pkgs
+}
+
+// shipmentIDs returns the shipment IDs of an order's pieces, or the order
+// ID alone for orders created before pieces were recorded.
+func shipmentIDs(orderID string, pieceIDs []string) []string {
+	if len(pieceIDs) == 0 {
+		return []string{orderID}
+	}
+	return pieceIDs
+}
+
+// shipmentGroupID returns the group of the shipments a shipper mails from a
+// postal code on a day. Canada Post transmits shipments by group, so
+// shippers sharing the contract account and an origin must not share one.
+// The shipper ID is hashed to fit the 32 characters allowed.
+func shipmentGroupID(shipperID, postalCode string, shipDate time.Time) string {
+	sum := sha256.Sum256([]byte(shipperID))
+	return "delivro-" + hex.EncodeToString(sum[:4]) + "-" + shipDate.Format("20060102") + "-" + normalizePostalCode(postalCode)
+}
+
+func formatMailingDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
 func addressToAPI(addr shipper.Address) Address {
 	return Address{
 		Name:         addr.Name,
@@ -215,6 +742,19 @@ func addressToAPI(addr shipper.Address) Address {
 	}
 }
 
+// contactAddressToAPI converts an address, taking the name and company from
+// the contact when the address has none.
+func contactAddressToAPI(contact shipper.Contact, addr shipper.Address) Address {
+	result := addressToAPI(addr)
+	if result.Name == "" {
+		result.Name = contact.Name
+	}
+	if result.Company == "" {
+		result.Company = contact.Company
+	}
+	return result
+}
+
 func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 	rates := make([]shipper.RateOption, len(resp.Rates))
 	expiresAt := time.Now().Add(30 * time.Minute)
@@ -230,13 +770,14 @@ func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 		rates[i] = shipper.RateOption{
 			RateID:            generateRateID(r.ServiceCode),
 			Carrier:           carrierName,
+			ServiceID:         r.ServiceCode,
 			ServiceCode:       r.ServiceCode,
 			ServiceName:       r.ServiceName,
 			ServiceType:       mapServiceType(r.ServiceCode),
-			BaseRate:          shipper.Money{Amount: r.BaseRate, Currency: "CAD"},
-			FuelSurcharge:     shipper.Money{Amount: r.FuelSurcharge, Currency: "CAD"},
-			Taxes:             shipper.Money{Amount: r.Taxes, Currency: "CAD"},
-			TotalPrice:        shipper.Money{Amount: r.TotalPrice, Currency: "CAD"},
+			BaseRate:          shipper.MoneyFromFloat(r.BaseRate, "CAD"),
+			FuelSurcharge:     shipper.MoneyFromFloat(r.FuelSurcharge, "CAD"),
+			Taxes:             shipper.MoneyFromFloat(r.Taxes, "CAD"),
+			TotalPrice:        shipper.MoneyFromFloat(r.TotalPrice, "CAD"),
 			TransitDays:       r.ExpectedTransit,
 			EstimatedDelivery: estimatedDelivery,
 			ExpiresAt:         expiresAt,
@@ -251,6 +792,65 @@ func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 	}
 }
 
+// combineQuotes merges per-parcel quotes into one rate per service,
+// keeping only the services offered for every parcel. Canada Post amounts
+// are all in CAD, so they add in minor units.
+func combineQuotes(quotes []*shipper.QuoteResponse) *shipper.QuoteResponse {
+	combined := &shipper.QuoteResponse{QuoteID: quotes[0].QuoteID, ExpiresAt: quotes[0].ExpiresAt}
+	for _, rate := range quotes[0].Rates {
+		total := rate
+		offered := true
+		for _, quote := range quotes[1:] {
+			r, ok := findRate(quote.Rates, rate.ServiceCode)
+			if !ok {
+				offered = false
+				break
+			}
+			total.BaseRate.Minor += r.BaseRate.Minor
+			total.FuelSurcharge.Minor += r.FuelSurcharge.Minor
+			total.Taxes.Minor += r.Taxes.Minor
+			total.TotalPrice.Minor += r.TotalPrice.Minor
+			total.TransitDays = max(total.TransitDays, r.TransitDays)
+			if r.EstimatedDelivery != nil && (total.EstimatedDelivery == nil || r.EstimatedDelivery.After(*total.EstimatedDelivery)) {
+				total.EstimatedDelivery = r.EstimatedDelivery
+			}
+			total.Guaranteed = total.Guaranteed && r.Guaranteed
+		}
+		if offered {
+			combined.Rates = append(combined.Rates, total)
+		}
+	}
+	return combined
+}
+
+func findRate(rates []shipper.RateOption, serviceCode string) (shipper.RateOption, bool) {
+	for _, r := range rates {
+		if r.ServiceCode == serviceCode {
+			return r, true
+		}
+	}
+	return shipper.RateOption{}, false
+}
+
+// shipmentsResponseToShipper converts the shipments of an order, one per
+// piece, into a single order response identified by the first shipment.
+func shipmentsResponseToShipper(shipments []*ShipmentResponse) *shipper.CreateOrderResponse {
+	resp := shipmentResponseToShipper(shipments[0])
+	resp.Pieces = make([]shipper.Piece, len(shipments))
+	for i, s := range shipments {
+		labelURL, _ := shipmentLinks(s)
+		resp.Pieces[i] = shipper.Piece{
+			ID:             s.ShipmentID,
+			TrackingNumber: s.TrackingPIN,
+			LabelURL:       labelURL,
+		}
+		if i > 0 {
+			resp.TotalCharged.Minor += shipper.MoneyFromFloat(s.TotalCharged, "CAD").Minor
+		}
+	}
+	return resp
+}
+
 func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderResponse {
 	var estimatedDelivery *time.Time
 	if resp.ExpectedDelivery != "" {
@@ -259,16 +859,7 @@ func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderRespo
 		}
 	}
 
-	// Find label and tracking URLs from links
-	var labelURL, trackingURL string
-	for _, link := range resp.Links {
-		switch link.Rel {
-		case "label":
-			labelURL = link.Href
-		case "tracking":
-			trackingURL = link.Href
-		}
-	}
+	labelURL, trackingURL := shipmentLinks(resp)
 
 	return &shipper.CreateOrderResponse{
 		OrderID:           resp.ShipmentID,
@@ -277,12 +868,25 @@ func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderRespo
 		Status:            mapStatus(resp.ShipmentStatus),
 		Carrier:           carrierName,
 		ServiceName:       resp.ServiceName,
-		TotalCharged:      shipper.Money{Amount: resp.TotalCharged, Currency: "CAD"},
+		TotalCharged:      shipper.MoneyFromFloat(resp.TotalCharged, "CAD"),
 		EstimatedDelivery: estimatedDelivery,
 		LabelURL:          labelURL,
 	}
 }
 
+// shipmentLinks finds the label and tracking URLs among a shipment's links.
+func shipmentLinks(resp *ShipmentResponse) (labelURL, trackingURL string) {
+	for _, link := range resp.Links {
+		switch link.Rel {
+		case "label":
+			labelURL = link.Href
+		case "tracking":
+			trackingURL = link.Href
+		}
+	}
+	return labelURL, trackingURL
+}
+
 func labelResponseToShipper(resp *LabelResponse) *shipper.GetLabelResponse {
 	format := shipper.LabelPDF
 	if resp.Format == "application/zpl" {
@@ -312,35 +916,38 @@ func voidResponseToShipper(resp *VoidResponse) *shipper.CancelOrderResponse {
 	}
 }
 
-func generateRateID(serviceCode string) string {
-	return "cp-" + serviceCode + "-" + time.Now().Format("20060102150405")
-}
-
-func extractServiceCode(rateID string) string {
-	// Parse rate ID like "cp-DOM.RP-20231215120000" -> "DOM.RP"
-	// For simplicity, default to Regular Parcel
-	if len(rateID) > 3 {
-		// Try to extract service code
-		for _, code := range []string{"DOM.RP", "DOM.XP", "DOM.PC", "DOM.EP"} {
-			if contains(rateID, code) {
-				return code
-			}
+func trackingResponseToShipper(resp *TrackingResponse) *shipper.TrackResponse {
+	events := make([]shipper.TrackingEvent, len(resp.Events))
+	for i, e := range resp.Events {
+		events[i] = shipper.TrackingEvent{
+			Timestamp:   parseTimestamp(e.Timestamp),
+			Description: e.Description,
+			Location:    e.Location,
+			Status:      mapStatus(e.Type),
+			CarrierCode: e.Type,
 		}
 	}
-	return "DOM.RP"
-}
+	shipper.SortEventsNewestFirst(events)
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && (s[:len(substr)] == substr || s[len(s)-len(substr):] == substr || containsMiddle(s, substr)))
+	return &shipper.TrackResponse{
+		TrackingNumber: resp.TrackingPIN,
+		Status:         mapStatus(resp.Status),
+		Events:         events,
+	}
 }
 
-func containsMiddle(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+// parseTimestamp accepts the timestamp layouts used by the tracking API.
+func parseTimestamp(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
 		}
 	}
-	return false
+	return time.Time{}
+}
+
+func generateRateID(serviceCode string) string {
+	return "cp-" + serviceCode + "-" + uuid.New().String()
 }
 
 func mapServiceType(code string) shipper.ServiceType {
@@ -358,17 +965,34 @@ func mapServiceType(code string) shipper.ServiceType {
 	}
 }
 
+// statuses maps Canada Post shipment statuses and tracking event types to
+// shipment statuses.
+var statuses = map[string]shipper.ShipmentStatus{
+	"created":          shipper.StatusConfirmed,
+	"transmitted":      shipper.StatusConfirmed,
+	"voided":           shipper.StatusCancelled,
+	"accepted":         shipper.StatusPickedUp,
+	"picked_up":        shipper.StatusPickedUp,
+	"in_transit":       shipper.StatusInTransit,
+	"out_for_delivery": shipper.StatusOutForDelivery,
+	"delivered":        shipper.StatusDelivered,
+	"attempted":        shipper.StatusException,
+	"exception":        shipper.StatusException,
+	"returned":         shipper.StatusException,
+}
+
+// mapStatus returns the shipment status of a Canada Post status, pending if
+// the status is unknown.
 func mapStatus(status string) shipper.ShipmentStatus {
-	switch status {
-	case "created", "transmitted":
-		return shipper.StatusConfirmed
-	case "voided":
-		return shipper.StatusCancelled
-	case "in_transit":
-		return shipper.StatusInTransit
-	case "delivered":
-		return shipper.StatusDelivered
-	default:
-		return shipper.StatusPending
+	if s, ok := statuses[status]; ok {
+		return s
+	}
+	return shipper.StatusPending
+}
+
+func mapPickupStatus(status string) shipper.PickupStatus {
+	if status == "Cancelled" {
+		return shipper.PickupCancelled
 	}
+	return shipper.PickupScheduled
 }
@@ -2,8 +2,11 @@ package canadapost_test
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -140,8 +143,9 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 	client := newTestClient(mockAPI)
 
 	req := &shipper.CreateOrderRequest{
-		RateID: "cp-DOM.RP-20231215120000",
-		Sender: shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
+		RateID:    "cp-DOM.RP-20231215120000",
+		ServiceID: "DOM.RP",
+		Sender:    shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
 		SenderAddress: shipper.Address{
 			Line1:        "123 Main St",
 			City:         "Toronto",
@@ -180,6 +184,7 @@ func TestClient_CreateOrder_APIError(t *testing.T) {
 
 	req := &shipper.CreateOrderRequest{
 		RateID:           "cp-DOM.RP-123",
+		ServiceID:        "DOM.RP",
 		SenderAddress:    shipper.Address{City: "Toronto"},
 		RecipientAddress: shipper.Address{City: "Vancouver"},
 	}
@@ -190,6 +195,24 @@ func TestClient_CreateOrder_APIError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_CreateOrder_MissingService(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.CreateOrderRequest{
+		RateID:           "cp-DOM.RP-123",
+		SenderAddress:    shipper.Address{City: "Toronto"},
+		RecipientAddress: shipper.Address{City: "Vancouver"},
+	}
+
+	ctx := context.Background()
+	_, err := client.CreateOrder(ctx, req)
+
+	var shipperErr *shipper.ShipperError
+	require.ErrorAs(t, err, &shipperErr)
+	assert.Equal(t, "invalid_service", shipperErr.Code)
+}
+
 func TestClient_GetLabel_Success(t *testing.T) {
 	mockAPI := canadapost.NewMockAPIClient()
 	client := newTestClient(mockAPI)
@@ -303,6 +326,45 @@ func TestClient_CancelOrder_CustomError(t *testing.T) {
 	assert.Contains(t, err.Error(), "cannot cancel")
 }
 
+func TestClient_Track_Success(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.TrackRequest{
+		OrderID:        "cp-ship-12345678",
+		TrackingNumber: "1234567890123",
+	}
+
+	ctx := context.Background()
+	resp, err := client.Track(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "cp-ship-12345678", resp.OrderID)
+	assert.Equal(t, "1234567890123", resp.TrackingNumber)
+	assert.Equal(t, shipper.StatusInTransit, resp.Status)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, shipper.StatusInTransit, resp.Events[0].Status)
+	assert.Equal(t, shipper.StatusPickedUp, resp.Events[1].Status)
+}
+
+func TestClient_Track_FallsBackToOrderID(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var trackedPIN string
+	mockAPI.OnGetTracking = func(ctx context.Context, trackingNumber string) (*canadapost.TrackingResponse, error) {
+		trackedPIN = trackingNumber
+		return &canadapost.TrackingResponse{TrackingPIN: trackingNumber, Status: "delivered"}, nil
+	}
+
+	client := newTestClient(mockAPI)
+
+	ctx := context.Background()
+	resp, err := client.Track(ctx, &shipper.TrackRequest{OrderID: "1234567890123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890123", trackedPIN)
+	assert.Equal(t, shipper.StatusDelivered, resp.Status)
+}
+
 func TestClient_Name(t *testing.T) {
 	mockAPI := canadapost.NewMockAPIClient()
 	client := newTestClient(mockAPI)
@@ -333,3 +395,493 @@ func TestClient_New_WithMock(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, resp.Rates)
 }
+
+func TestClient_GetQuote_Options(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured []*canadapost.RatesRequest
+	mockAPI.OnGetRates = func(ctx context.Context, req *canadapost.RatesRequest) (*canadapost.RatesResponse, error) {
+		captured = append(captured, req)
+		return &canadapost.RatesResponse{QuoteID: "cp-quote-1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	shipDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 1, DeclaredValue: shipper.Money{Minor: 10000, Currency: "CAD"}},
+			{Weight: 2, DeclaredValue: shipper.Money{Minor: 5050, Currency: "CAD"}},
+		},
+		Options: shipper.ShippingOptions{
+			SignatureRequired: true,
+			InsuranceRequired: true,
+			ShipDate:          &shipDate,
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, captured, 2)
+	assert.Equal(t, []canadapost.Option{{Code: "SO"}, {Code: "COV", Value: "100.00"}}, captured[0].Options)
+	assert.Equal(t, []canadapost.Option{{Code: "SO"}, {Code: "COV", Value: "50.50"}}, captured[1].Options)
+	assert.Equal(t, "2025-03-14", captured[0].MailingDate)
+}
+
+func TestClient_CreateOrder_SaturdayDeliveryUnsupported(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "DOM.EP",
+		Options:   shipper.ShippingOptions{SaturdayDelivery: true},
+	})
+
+	var shipperErr *shipper.ShipperError
+	require.True(t, errors.As(err, &shipperErr))
+	assert.Equal(t, "unsupported_option", shipperErr.Code)
+	assert.ErrorIs(t, err, shipper.ErrUnsupportedOption)
+}
+
+func TestClient_GetQuote_InsuranceWithoutDeclaredValue(t *testing.T) {
+	client := newTestClient(canadapost.NewMockAPIClient())
+
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{{Weight: 1}},
+		Options:  shipper.ShippingOptions{InsuranceRequired: true},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidPackage)
+}
+
+func TestClient_GetQuote_MultiplePackages(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	mockAPI.OnGetRates = func(ctx context.Context, req *canadapost.RatesRequest) (*canadapost.RatesResponse, error) {
+		rates := []canadapost.Rate{
+			{ServiceCode: "DOM.RP", BaseRate: req.Weight, TotalPrice: req.Weight * 2, ExpectedTransit: 2, ExpectedDelivery: "2025-03-12", GuaranteedDelivery: true},
+		}
+		// Priority is only offered for light parcels, and heavy ones take longer
+		if req.Weight < 2 {
+			rates = append(rates, canadapost.Rate{ServiceCode: "DOM.PC", TotalPrice: 30})
+		} else {
+			rates[0].ExpectedTransit = 3
+			rates[0].ExpectedDelivery = "2025-03-13"
+		}
+		return &canadapost.RatesResponse{QuoteID: "cp-quote-1", Rates: rates}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 1, Length: 10},
+			{Weight: 3, Length: 20},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Rates, 1)
+	rate := resp.Rates[0]
+	assert.Equal(t, "DOM.RP", rate.ServiceCode)
+	assert.Equal(t, shipper.Money{Minor: 800, Currency: "CAD"}, rate.TotalPrice)
+	assert.Equal(t, shipper.Money{Minor: 400, Currency: "CAD"}, rate.BaseRate)
+	assert.Equal(t, 3, rate.TransitDays)
+	assert.Equal(t, "2025-03-13", rate.EstimatedDelivery.Format("2006-01-02"))
+	assert.True(t, rate.Guaranteed)
+}
+
+func TestClient_CreateOrder_MultiplePackages(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var requests []*canadapost.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *canadapost.ShipmentRequest) (*canadapost.ShipmentResponse, error) {
+		requests = append(requests, req)
+		n := strconv.Itoa(len(requests))
+		return &canadapost.ShipmentResponse{
+			ShipmentID:   "cp-ship-" + n,
+			TrackingPIN:  "PIN" + n,
+			TotalCharged: 10,
+			Links:        []canadapost.Link{{Rel: "label", Href: "https://label/" + n}},
+		}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "DOM.EP",
+		Packages: []shipper.Package{
+			{Weight: 1, Length: 10},
+			{Weight: 2, Length: 20},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].GroupID, requests[1].GroupID)
+	assert.NotEqual(t, "default", requests[0].GroupID)
+	assert.Equal(t, 2.0, requests[1].ParcelWeight)
+	assert.Equal(t, 20.0, requests[1].ParcelDimensions.Length)
+
+	assert.Equal(t, "cp-ship-1", resp.OrderID)
+	assert.Equal(t, "PIN1", resp.TrackingNumber)
+	assert.Equal(t, shipper.Money{Minor: 2000, Currency: "CAD"}, resp.TotalCharged)
+	assert.Equal(t, []shipper.Piece{
+		{ID: "cp-ship-1", TrackingNumber: "PIN1", LabelURL: "https://label/1"},
+		{ID: "cp-ship-2", TrackingNumber: "PIN2", LabelURL: "https://label/2"},
+	}, resp.Pieces)
+	assert.Equal(t, []string{"cp-ship-1", "cp-ship-2"}, resp.PieceIDs())
+}
+
+func TestClient_CreateOrder_VoidsPiecesOnFailure(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var calls int
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *canadapost.ShipmentRequest) (*canadapost.ShipmentResponse, error) {
+		calls++
+		if calls == 2 {
+			return nil, &canadapost.APIError{Code: "9999", Description: "Parcel too heavy"}
+		}
+		return &canadapost.ShipmentResponse{ShipmentID: "cp-ship-1"}, nil
+	}
+	var voided []string
+	mockAPI.OnVoidShipment = func(ctx context.Context, shipmentID string) (*canadapost.VoidResponse, error) {
+		voided = append(voided, shipmentID)
+		return &canadapost.VoidResponse{ShipmentID: shipmentID, Status: "cancelled"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "DOM.EP",
+		Packages:  []shipper.Package{{Weight: 1}, {Weight: 2}},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, []string{"cp-ship-1"}, voided)
+}
+
+func TestClient_GetLabel_MultiplePieces(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	mockAPI.OnGetLabel = func(ctx context.Context, shipmentID string, format string) (*canadapost.LabelResponse, error) {
+		return &canadapost.LabelResponse{ShipmentID: shipmentID, Format: format, Data: []byte(shipmentID)}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetLabel(context.Background(), &shipper.GetLabelRequest{
+		OrderID:  "cp-ship-1",
+		Format:   shipper.LabelPDF,
+		PieceIDs: []string{"cp-ship-1", "cp-ship-2", "cp-ship-3"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cp-ship-1", resp.OrderID)
+	require.Len(t, resp.AdditionalLabels, 2)
+	assert.NotEqual(t, resp.Label.Data, resp.AdditionalLabels[0].Data)
+	assert.NotEqual(t, resp.AdditionalLabels[0].Data, resp.AdditionalLabels[1].Data)
+}
+
+func TestClient_CancelOrder_MultiplePieces(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var voided []string
+	mockAPI.OnVoidShipment = func(ctx context.Context, shipmentID string) (*canadapost.VoidResponse, error) {
+		voided = append(voided, shipmentID)
+		return &canadapost.VoidResponse{ShipmentID: shipmentID, Status: "cancelled"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CancelOrder(context.Background(), &shipper.CancelOrderRequest{
+		OrderID:  "cp-ship-1",
+		PieceIDs: []string{"cp-ship-1", "cp-ship-2"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cp-ship-1", resp.OrderID)
+	assert.Equal(t, []string{"cp-ship-1", "cp-ship-2"}, voided)
+}
+
+func TestClient_GetQuote_ConvertsImperialPackages(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured *canadapost.RatesRequest
+	mockAPI.OnGetRates = func(ctx context.Context, req *canadapost.RatesRequest) (*canadapost.RatesResponse, error) {
+		captured = req
+		return &canadapost.RatesResponse{Rates: []canadapost.Rate{{ServiceCode: "DOM.RP"}}}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 10, Length: 12, Width: 8.5, Height: 3.25, WeightUnit: shipper.WeightLB, DimensionUnit: shipper.DimensionIN},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, 4.536, captured.Weight) // 4.5359237 kg
+	assert.Equal(t, canadapost.Dimensions{Length: 30.5, Width: 21.6, Height: 8.3}, captured.Dimensions)
+
+	require.Len(t, resp.Rates, 1)
+	assert.Equal(t, 4.536, resp.Rates[0].BillableWeight)
+	assert.Equal(t, shipper.WeightKG, resp.Rates[0].BillableWeightUnit)
+}
+
+func TestClient_GetPickupAvailability(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	mockAPI.OnGetPickupAvailability = func(ctx context.Context, postalCode string) (*canadapost.PickupAvailabilityResponse, error) {
+		return &canadapost.PickupAvailabilityResponse{PostalCode: postalCode, OnDemandTour: true, OnDemandCutoff: "14:30"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{
+		Address: shipper.Address{PostalCode: "M5V1A1", CountryCode: "CA"},
+		Date:    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Available)
+	require.NotNil(t, resp.BookBy)
+	assert.Equal(t, time.Date(2025, 3, 10, 14, 30, 0, 0, time.UTC), *resp.BookBy)
+}
+
+func TestClient_SchedulePickup_Success(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured *canadapost.PickupRequest
+	mockAPI.OnCreatePickupRequest = func(ctx context.Context, req *canadapost.PickupRequest) (*canadapost.PickupRequestResponse, error) {
+		captured = req
+		return &canadapost.PickupRequestResponse{RequestID: "cp-pickup-1", Status: "Active"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	window := shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)}
+	resp, err := client.SchedulePickup(context.Background(), &shipper.SchedulePickupRequest{
+		Address:      shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Contact:      shipper.Contact{Name: "Jane Doe", Phone: "416-555-0100"},
+		Window:       window,
+		PackageCount: 3,
+		TotalWeight:  22,
+		WeightUnit:   shipper.WeightLB,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &shipper.SchedulePickupResponse{
+		PickupID: "cp-pickup-1",
+		Carrier:  "canadapost",
+		Status:   shipper.PickupScheduled,
+		Window:   window,
+	}, resp)
+
+	require.NotNil(t, captured)
+	assert.Equal(t, "Jane Doe", captured.Address.Name)
+	assert.Equal(t, "416-555-0100", captured.Address.Phone)
+	assert.Equal(t, 3, captured.ItemCount)
+	assert.Equal(t, 9.98, captured.Weight) // 9.9790321 kg, rounded up
+	assert.Equal(t, "2025-03-10", captured.Date)
+	assert.Equal(t, "13:00", captured.PreferredTime)
+	assert.Equal(t, "17:00", captured.ClosingTime)
+}
+
+func TestClient_CancelPickup_Success(t *testing.T) {
+	client := newTestClient(canadapost.NewMockAPIClient())
+
+	resp, err := client.CancelPickup(context.Background(), &shipper.CancelPickupRequest{PickupID: "cp-pickup-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cp-pickup-1", resp.PickupID)
+	assert.Equal(t, shipper.PickupCancelled, resp.Status)
+}
+
+func TestClient_CreateOrder_PickupUnsupported(t *testing.T) {
+	client := newTestClient(canadapost.NewMockAPIClient())
+
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "DOM.EP",
+		Pickup:    &shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrUnsupportedOption)
+}
+
+func TestClient_Manifest_Success(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured *canadapost.TransmitRequest
+	mockAPI.OnTransmitShipments = func(ctx context.Context, req *canadapost.TransmitRequest) (*canadapost.TransmitResponse, error) {
+		captured = req
+		return &canadapost.TransmitResponse{ManifestIDs: []string{"123456789012"}}, nil
+	}
+	mockAPI.OnGetManifest = func(ctx context.Context, manifestID string) (*canadapost.ManifestResponse, error) {
+		return &canadapost.ManifestResponse{ManifestID: manifestID, Data: []byte("%PDF")}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.Manifest(context.Background(), &shipper.ManifestRequest{
+		ShipperID: "shipper-123",
+		Origin:    shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V 1A1", CountryCode: "CA"},
+		ShipDate:  time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, []string{"delivro-05aa93da-20250310-M5V1A1"}, captured.GroupIDs)
+	assert.Equal(t, "Toronto", captured.ManifestAddress.City)
+
+	assert.Equal(t, "canadapost", resp.Carrier)
+	require.Len(t, resp.Manifests, 1)
+	assert.Equal(t, "123456789012", resp.Manifests[0].ManifestID)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("%PDF")), resp.Manifests[0].Document.Data)
+}
+
+func TestClient_CreateOrder_GroupsByShipperOriginAndShipDate(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var groupIDs []string
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *canadapost.ShipmentRequest) (*canadapost.ShipmentResponse, error) {
+		groupIDs = append(groupIDs, req.GroupID)
+		return &canadapost.ShipmentResponse{ShipmentID: "cp-ship-1", TrackingPIN: "PIN1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	shipDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	for _, shipperID := range []string{"shipper-123", "shipper-456"} {
+		_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+			ShipperID:     shipperID,
+			ServiceID:     "DOM.EP",
+			SenderAddress: shipper.Address{PostalCode: "m5v 1a1", CountryCode: "CA"},
+			Packages:      []shipper.Package{{Weight: 1}},
+			Options:       shipper.ShippingOptions{ShipDate: &shipDate},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, groupIDs, 2)
+	assert.Equal(t, "delivro-05aa93da-20250310-M5V1A1", groupIDs[0])
+	assert.NotEqual(t, groupIDs[0], groupIDs[1])
+	assert.LessOrEqual(t, len(groupIDs[0]), 32)
+}
+
+func TestClient_CreateReturn_Success(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured *canadapost.AuthorizedReturnRequest
+	mockAPI.OnCreateAuthorizedReturn = func(ctx context.Context, req *canadapost.AuthorizedReturnRequest) (*canadapost.AuthorizedReturnResponse, error) {
+		captured = req
+		return &canadapost.AuthorizedReturnResponse{TrackingPIN: "1234567890123", Data: []byte("%PDF")}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CreateReturn(context.Background(), &shipper.CreateReturnRequest{
+		ServiceID:       "DOM.EP",
+		Returner:        shipper.Contact{Name: "John Smith", Phone: "514-555-0100"},
+		ReturnerAddress: shipper.Address{Line1: "456 Rue Principale", City: "Montreal", ProvinceCode: "QC", PostalCode: "H2X1Y4", CountryCode: "CA"},
+		Receiver:        shipper.Contact{Company: "Acme Inc"},
+		ReceiverAddress: shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Packages:        []shipper.Package{{Weight: 2, Length: 30, Width: 20, Height: 10}},
+		RMANumber:       "RMA-1001",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890123", resp.OrderID)
+	assert.Equal(t, "1234567890123", resp.TrackingNumber)
+	assert.Equal(t, shipper.StatusConfirmed, resp.Status)
+	assert.Equal(t, shipper.LabelPDF, resp.Label.Format)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("%PDF")), resp.Label.Data)
+
+	require.NotNil(t, captured)
+	assert.Equal(t, "DOM.EP", captured.ServiceCode)
+	assert.Equal(t, "John Smith", captured.Returner.Name)
+	assert.Equal(t, "H2X1Y4", captured.Returner.PostalCode)
+	assert.Equal(t, "Acme Inc", captured.Receiver.Company)
+	assert.Equal(t, "M5V1A1", captured.Receiver.PostalCode)
+	assert.Equal(t, "PDF", captured.Encoding)
+	assert.Equal(t, "RMA-1001", captured.CustomerRef)
+}
+
+func TestClient_CreateReturn_SingleParcel(t *testing.T) {
+	client := newTestClient(canadapost.NewMockAPIClient())
+
+	_, err := client.CreateReturn(context.Background(), &shipper.CreateReturnRequest{
+		ServiceID:       "DOM.EP",
+		ReturnerAddress: shipper.Address{CountryCode: "CA"},
+		Packages:        []shipper.Package{{Weight: 1}, {Weight: 2}},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidPackage)
+}
+
+func TestClient_CreateReturn_FromOutsideCanada(t *testing.T) {
+	client := newTestClient(canadapost.NewMockAPIClient())
+
+	_, err := client.CreateReturn(context.Background(), &shipper.CreateReturnRequest{
+		ServiceID:       "DOM.EP",
+		ReturnerAddress: shipper.Address{CountryCode: "US"},
+		Packages:        []shipper.Package{{Weight: 1}},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrUnsupportedOption)
+}
+
+func internationalOrder() *shipper.CreateOrderRequest {
+	return &shipper.CreateOrderRequest{
+		ServiceID:        "USA.EP",
+		SenderAddress:    shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		RecipientAddress: shipper.Address{Line1: "456 Elm St", City: "Beverly Hills", ProvinceCode: "CA", PostalCode: "90210", CountryCode: "US"},
+		Packages:         []shipper.Package{{Length: 30, Width: 20, Height: 10, Weight: 2}},
+		Customs: &shipper.Customs{
+			ReasonForExport:         shipper.ExportSale,
+			Currency:                "CAD",
+			InvoiceNumber:           "INV-1001",
+			ExportDeclarationNumber: "B13A-123",
+			Items: []shipper.CustomsItem{{
+				Description:     "Cotton t-shirt",
+				Quantity:        3,
+				UnitValue:       shipper.Money{Minor: 2500, Currency: "CAD"},
+				UnitWeight:      0.5,
+				WeightUnit:      shipper.WeightLB,
+				CountryOfOrigin: "CA",
+				HSCode:          "6109100010",
+				SKU:             "TSHIRT-M",
+			}},
+		},
+	}
+}
+
+func TestClient_CreateOrder_Customs(t *testing.T) {
+	mockAPI := canadapost.NewMockAPIClient()
+	var captured *canadapost.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *canadapost.ShipmentRequest) (*canadapost.ShipmentResponse, error) {
+		captured = req
+		return &canadapost.ShipmentResponse{ShipmentID: "cp-ship-1", TrackingPIN: "PIN1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	_, err := client.CreateOrder(context.Background(), internationalOrder())
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	require.NotNil(t, captured.Customs)
+	assert.Equal(t, "CAD", captured.Customs.Currency)
+	assert.Equal(t, "SOG", captured.Customs.ReasonForExport)
+	assert.Equal(t, "INV-1001", captured.Customs.InvoiceNumber)
+	assert.Equal(t, "B13A-123", captured.Customs.AdditionalInfo)
+	require.Len(t, captured.Customs.Items, 1)
+	item := captured.Customs.Items[0]
+	assert.Equal(t, "6109.10.00.10", item.HSTariffCode)
+	assert.Equal(t, 3, item.Quantity)
+	assert.Equal(t, 25.0, item.UnitValue)
+	assert.InDelta(t, 0.227, item.UnitWeight, 0.001)
+	assert.Equal(t, "TSHIRT-M", item.SKU)
+}
+
+func TestClient_CreateOrder_CustomsUnsupported(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*shipper.CreateOrderRequest)
+		err    error
+	}{
+		{"duties paid", func(r *shipper.CreateOrderRequest) { r.Customs.Incoterm = shipper.IncotermDDP }, shipper.ErrUnsupportedOption},
+		{"values in USD", func(r *shipper.CreateOrderRequest) { r.Customs.Currency = "USD" }, shipper.ErrInvalidCustoms},
+		{"several parcels", func(r *shipper.CreateOrderRequest) { r.Packages = append(r.Packages, r.Packages[0]) }, shipper.ErrInvalidPackage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(canadapost.NewMockAPIClient())
+			req := internationalOrder()
+			tt.modify(req)
+
+			_, err := client.CreateOrder(context.Background(), req)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package canadapost
+
+import (
+	"crypto/subtle"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// trackingPush is the XML body of a tracking push, posted to the URL
+// registered for the customer's tracking notifications.
+type trackingPush struct {
+	XMLName     xml.Name             `xml:"tracking-update"`
+	PIN         string               `xml:"pin"`
+	ShipmentID  string               `xml:"shipment-id"`
+	Occurrences []trackingOccurrence `xml:"occurrence"`
+}
+
+type trackingOccurrence struct {
+	EventType        string `xml:"event-type"`
+	EventDateTime    string `xml:"event-date-time"`
+	EventDescription string `xml:"event-description"`
+	EventLocation    string `xml:"event-location"`
+}
+
+// ParseTrackingPush checks the basic auth credentials of a Canada Post
+// tracking push and returns its events.
+func (c *Client) ParseTrackingPush(header http.Header, body []byte) ([]shipper.TrackingUpdate, error) {
+	if !c.validPushCredentials(header) {
+		return nil, fmt.Errorf("%w: invalid basic auth credentials", shipper.ErrAuthenticationFailed)
+	}
+
+	var push trackingPush
+	if err := xml.Unmarshal(body, &push); err != nil {
+		return nil, fmt.Errorf("%w: %v", shipper.ErrInvalidPush, err)
+	}
+	if push.PIN == "" {
+		return nil, fmt.Errorf("%w: missing pin", shipper.ErrInvalidPush)
+	}
+
+	// Occurrences of unknown types say nothing about the shipment status,
+	// so they are left out rather than reported as pending
+	updates := make([]shipper.TrackingUpdate, 0, len(push.Occurrences))
+	for _, o := range push.Occurrences {
+		status, ok := statuses[o.EventType]
+		if !ok {
+			continue
+		}
+		updates = append(updates, shipper.TrackingUpdate{
+			OrderID:        push.ShipmentID,
+			TrackingNumber: push.PIN,
+			Event: shipper.TrackingEvent{
+				Timestamp:   parseTimestamp(o.EventDateTime),
+				Description: o.EventDescription,
+				Location:    o.EventLocation,
+				Status:      status,
+				CarrierCode: o.EventType,
+			},
+		})
+	}
+	return updates, nil
+}
+
+var _ shipper.PushTracker = (*Client)(nil)
+
+// validPushCredentials reports whether the request carries the configured
+// basic auth credentials. Without credentials no push is trusted.
+func (c *Client) validPushCredentials(header http.Header) bool {
+	if c.config.WebhookUsername == "" || c.config.WebhookPassword == "" {
+		return false
+	}
+	req := http.Request{Header: header}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return false
+	}
+	usernameOK := subtle.ConstantTimeCompare([]byte(username), []byte(c.config.WebhookUsername)) == 1
+	passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(c.config.WebhookPassword)) == 1
+	return usernameOK && passwordOK
+}
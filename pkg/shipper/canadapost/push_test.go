@@ -0,0 +1,101 @@
+package canadapost_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/canadapost"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+const trackingPush = `<tracking-update>
+	<pin>7023210039414604</pin>
+	<occurrence>
+		<event-type>in_transit</event-type>
+		<event-date-time>2026-10-14T08:12:00</event-date-time>
+		<event-description>Item processed</event-description>
+		<event-location>MISSISSAUGA, ON</event-location>
+	</occurrence>
+	<occurrence>
+		<event-type>attempted</event-type>
+		<event-date-time>2026-10-15T16:40:00</event-date-time>
+		<event-description>Delivery attempted, notice card left</event-description>
+		<event-location>VANCOUVER, BC</event-location>
+	</occurrence>
+</tracking-update>`
+
+func newPushClient(username, password string) *canadapost.Client {
+	cfg := canadapost.Config{WebhookUsername: username, WebhookPassword: password}
+	return canadapost.NewWithAPIClient(cfg, canadapost.NewMockAPIClient(), otelzap.New(zap.NewNop()), nil)
+}
+
+func basicAuth(username, password string) http.Header {
+	req, _ := http.NewRequest(http.MethodPost, "/", nil)
+	req.SetBasicAuth(username, password)
+	return req.Header
+}
+
+func TestClient_ParseTrackingPush(t *testing.T) {
+	client := newPushClient("canadapost", "s3cret")
+
+	updates, err := client.ParseTrackingPush(basicAuth("canadapost", "s3cret"), []byte(trackingPush))
+
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	assert.Empty(t, updates[0].OrderID)
+	assert.Equal(t, "7023210039414604", updates[0].TrackingNumber)
+	assert.Equal(t, shipper.StatusInTransit, updates[0].Event.Status)
+	assert.Equal(t, time.Date(2026, 10, 14, 8, 12, 0, 0, time.UTC), updates[0].Event.Timestamp)
+	assert.Equal(t, shipper.StatusException, updates[1].Event.Status)
+	assert.Equal(t, "attempted", updates[1].Event.CarrierCode)
+	assert.Equal(t, "VANCOUVER, BC", updates[1].Event.Location)
+}
+
+func TestClient_ParseTrackingPush_SkipsUnknownEvents(t *testing.T) {
+	client := newPushClient("canadapost", "s3cret")
+	body := `<tracking-update>
+	<pin>7023210039414604</pin>
+	<occurrence><event-type>delivered</event-type><event-date-time>2026-10-15T16:40:00</event-date-time></occurrence>
+	<occurrence><event-type>signature_image_available</event-type><event-date-time>2026-10-15T17:00:00</event-date-time></occurrence>
+</tracking-update>`
+
+	updates, err := client.ParseTrackingPush(basicAuth("canadapost", "s3cret"), []byte(body))
+
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Equal(t, shipper.StatusDelivered, updates[0].Event.Status)
+}
+
+func TestClient_ParseTrackingPush_InvalidCredentials(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *canadapost.Client
+		header http.Header
+	}{
+		{"wrong password", newPushClient("canadapost", "s3cret"), basicAuth("canadapost", "guess")},
+		{"missing credentials", newPushClient("canadapost", "s3cret"), http.Header{}},
+		{"no credentials configured", newPushClient("", ""), basicAuth("", "")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.client.ParseTrackingPush(tt.header, []byte(trackingPush))
+
+			assert.ErrorIs(t, err, shipper.ErrAuthenticationFailed)
+		})
+	}
+}
+
+func TestClient_ParseTrackingPush_InvalidBody(t *testing.T) {
+	client := newPushClient("canadapost", "s3cret")
+
+	for _, body := range []string{`<tracking-update><pin>`, `<tracking-update></tracking-update>`} {
+		_, err := client.ParseTrackingPush(basicAuth("canadapost", "s3cret"), []byte(body))
+
+		assert.ErrorIs(t, err, shipper.ErrInvalidPush)
+	}
+}
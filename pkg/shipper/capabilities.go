@@ -0,0 +1,124 @@
+package shipper
+
+import (
+	"slices"
+)
+
+// Capabilities describes what a carrier supports, so that callers can
+// avoid requests it would reject.
+type Capabilities struct {
+	LabelFormats      []LabelFormat
+	International     bool // Ships outside Canada
+	MultiPiece        bool // Ships several packages in one order
+	Pickups           bool
+	Manifests         bool // Shipments are transmitted in an end-of-day manifest
+	Returns           bool // Issues prepaid return labels
+	Insurance         bool
+	SignatureRequired bool
+	SaturdayDelivery  bool
+
+	// CreateOrder is deduplicated by the carrier on the request's
+	// IdempotencyKey, so that a request can be safely sent again after an
+	// ambiguous failure
+	DedupesCreateOrder bool
+
+	// A manifest transmits every open shipment of the carrier account at
+	// once, of all shippers, origins and ship dates, rather than those of
+	// one origin
+	ManifestsAccount bool
+
+	// Heaviest package accepted, zero if the carrier states no limit
+	MaxWeight     float64
+	MaxWeightUnit WeightUnit
+}
+
+// SupportsLabelFormat reports whether labels can be requested in format.
+func (c Capabilities) SupportsLabelFormat(format LabelFormat) bool {
+	return slices.Contains(c.LabelFormats, format)
+}
+
+// SupportsOptions reports the first of opts the carrier cannot honor, as
+// one of the Option* names, or "" if it can honor them all.
+func (c Capabilities) SupportsOptions(opts ShippingOptions) string {
+	switch {
+	case opts.SignatureRequired && !c.SignatureRequired:
+		return OptionSignatureRequired
+	case opts.InsuranceRequired && !c.Insurance:
+		return OptionInsuranceRequired
+	case opts.SaturdayDelivery && !c.SaturdayDelivery:
+		return OptionSaturdayDelivery
+	default:
+		return ""
+	}
+}
+
+// CapabilityReporter is implemented by carriers that describe their
+// capabilities.
+type CapabilityReporter interface {
+	Capabilities() Capabilities
+}
+
+// Unwrapper is implemented by decorators, such as middleware, that wrap
+// another Shipper.
+type Unwrapper interface {
+	Unwrap() Shipper
+}
+
+// As finds the first shipper in the chain of s and the shippers it wraps
+// that implements T, the way errors.As does for errors. Features beyond the
+// Shipper interface are discovered with it, e.g. As[CapabilityReporter](s).
+//
+// A shipper in the chain can also provide T with an As(target any) bool
+// method, where target is a *T. Decorators use it to apply their behaviour
+// to the features of the shipper they wrap rather than let callers reach
+// past them.
+func As[T any](s Shipper) (T, bool) {
+	var target T
+	for s != nil {
+		if t, ok := s.(T); ok {
+			return t, true
+		}
+		if x, ok := s.(interface{ As(any) bool }); ok && x.As(&target) {
+			return target, true
+		}
+		u, ok := s.(Unwrapper)
+		if !ok {
+			break
+		}
+		s = u.Unwrap()
+	}
+	return target, false
+}
+
+// CapabilitiesOf returns the capabilities of s. Carriers that do not report
+// any are assumed to support only the PDF labels every carrier provides.
+func CapabilitiesOf(s Shipper) Capabilities {
+	if r, ok := As[CapabilityReporter](s); ok {
+		return r.Capabilities()
+	}
+	return Capabilities{LabelFormats: []LabelFormat{LabelPDF}}
+}
+
+// Capabilities returns the capabilities of every registered carrier, keyed
+// by name.
+func (r *Registry) Capabilities() map[string]Capabilities {
+	shippers := r.All()
+	result := make(map[string]Capabilities, len(shippers))
+	for _, s := range shippers {
+		result[s.Name()] = CapabilitiesOf(s)
+	}
+	return result
+}
+
+// Supporting returns the names, in alphabetical order, of the registered
+// carriers whose capabilities satisfy match.
+func (r *Registry) Supporting(match func(Capabilities) bool) []string {
+	var names []string
+	for name, caps := range r.Capabilities() {
+		if match(caps) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,88 @@
+package shipper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+)
+
+// basicShipper implements only the Shipper interface.
+type basicShipper struct {
+	shipper.Shipper
+	name string
+}
+
+func (s *basicShipper) Name() string { return s.name }
+
+// wrapper decorates a Shipper the way middleware does.
+type wrapper struct {
+	shipper.Shipper
+}
+
+func (w *wrapper) Unwrap() shipper.Shipper { return w.Shipper }
+
+// limitedShipper reports PDF labels and signatures only.
+type limitedShipper struct {
+	basicShipper
+}
+
+func (s *limitedShipper) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{
+		LabelFormats:      []shipper.LabelFormat{shipper.LabelPDF},
+		SignatureRequired: true,
+		MaxWeight:         30,
+		MaxWeightUnit:     shipper.WeightKG,
+	}
+}
+
+func TestAs(t *testing.T) {
+	limited := &limitedShipper{basicShipper{name: "limited"}}
+
+	reporter, ok := shipper.As[shipper.CapabilityReporter](&wrapper{&wrapper{limited}})
+	assert.True(t, ok)
+	assert.Equal(t, limited, reporter)
+
+	_, ok = shipper.As[shipper.CapabilityReporter](&wrapper{&basicShipper{name: "basic"}})
+	assert.False(t, ok)
+
+	_, ok = shipper.As[shipper.CapabilityReporter](nil)
+	assert.False(t, ok)
+}
+
+func TestCapabilitiesOf_Default(t *testing.T) {
+	caps := shipper.CapabilitiesOf(&basicShipper{name: "basic"})
+
+	assert.Equal(t, []shipper.LabelFormat{shipper.LabelPDF}, caps.LabelFormats)
+	assert.False(t, caps.Insurance)
+}
+
+func TestCapabilities_Supports(t *testing.T) {
+	caps := (&limitedShipper{}).Capabilities()
+
+	assert.True(t, caps.SupportsLabelFormat(shipper.LabelPDF))
+	assert.False(t, caps.SupportsLabelFormat(shipper.LabelZPL))
+	assert.Equal(t, "", caps.SupportsOptions(shipper.ShippingOptions{SignatureRequired: true}))
+	assert.Equal(t, shipper.OptionInsuranceRequired, caps.SupportsOptions(shipper.ShippingOptions{SignatureRequired: true, InsuranceRequired: true}))
+	assert.Equal(t, shipper.OptionSaturdayDelivery, caps.SupportsOptions(shipper.ShippingOptions{SaturdayDelivery: true}))
+}
+
+func TestRegistry_Capabilities(t *testing.T) {
+	registry := shipper.NewRegistry()
+	registry.Register(mock.New("full"))
+	registry.Register(&limitedShipper{basicShipper{name: "limited"}})
+	registry.Register(&wrapper{&limitedShipper{basicShipper{name: "wrapped"}}})
+
+	caps := registry.Capabilities()
+
+	assert.Len(t, caps, 3)
+	assert.True(t, caps["full"].SaturdayDelivery)
+	assert.Equal(t, 30.0, caps["wrapped"].MaxWeight)
+
+	zpl := registry.Supporting(func(c shipper.Capabilities) bool { return c.SupportsLabelFormat(shipper.LabelZPL) })
+	assert.Equal(t, []string{"full"}, zpl)
+
+	signature := registry.Supporting(func(c shipper.Capabilities) bool { return c.SignatureRequired })
+	assert.Equal(t, []string{"full", "limited", "wrapped"}, signature)
+}
@@ -0,0 +1,60 @@
+package shipper
+
+import "strings"
+
+// ExportReason is the reason for export declared to customs.
+type ExportReason string
+
+const (
+	ExportSale   ExportReason = "sale"
+	ExportGift   ExportReason = "gift"
+	ExportSample ExportReason = "sample"
+	ExportRepair ExportReason = "repair"
+	ExportReturn ExportReason = "return"
+)
+
+// Incoterm states who pays the duties and taxes of an international
+// shipment.
+type Incoterm string
+
+const (
+	IncotermDDU Incoterm = "DDU" // Delivered duty unpaid, the recipient pays
+	IncotermDDP Incoterm = "DDP" // Delivered duty paid, the shipper pays
+)
+
+// Customs is the customs declaration and commercial invoice data of an
+// international shipment.
+type Customs struct {
+	Items                   []CustomsItem
+	ReasonForExport         ExportReason
+	Incoterm                Incoterm // Empty for DDU
+	Currency                string   // ISO 4217 currency of the item values
+	InvoiceNumber           string   // Commercial invoice number
+	ImporterTaxID           string
+	ExporterTaxID           string
+	ExportDeclarationNumber string // B13A or CERS proof of report number, for exports that require one
+}
+
+// CustomsItem is a line of a customs declaration.
+type CustomsItem struct {
+	Description     string
+	Quantity        int
+	UnitValue       Money // In the currency of the declaration
+	UnitWeight      float64
+	WeightUnit      WeightUnit // Empty for kg
+	CountryOfOrigin string     // ISO 3166-1 alpha-2
+	HSCode          string     // Harmonized System tariff code, 6 to 10 digits
+	SKU             string
+}
+
+// TotalValue returns the declared value of the item, its unit value times
+// its quantity.
+func (i CustomsItem) TotalValue() Money {
+	return Money{Minor: i.UnitValue.Minor * int64(i.Quantity), Currency: i.UnitValue.Currency}
+}
+
+// International reports whether a shipment between two addresses crosses a
+// border and needs a customs declaration.
+func International(from, to Address) bool {
+	return from.CountryCode != "" && to.CountryCode != "" && !strings.EqualFold(from.CountryCode, to.CountryCode)
+}
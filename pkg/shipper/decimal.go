@@ -0,0 +1,177 @@
+package shipper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalScale bounds the number of fractional digits of a Decimal.
+const maxDecimalScale = 18
+
+// Decimal is an exact base-10 number, as exchanged with API clients. It is
+// the Go type of the GraphQL Decimal scalar and keeps the scale it was
+// written with, so "12.50" stays "12.50". The zero value is 0.
+type Decimal struct {
+	coef  int64 // The value is coef / 10^scale
+	scale uint8
+}
+
+// ParseDecimal parses a plain decimal number such as "12", "-0.5" or
+// "1234.5678". Exponents, a leading plus sign, missing digits around the
+// decimal point, separators and surrounding spaces are rejected.
+func ParseDecimal(s string) (Decimal, error) {
+	digits, neg := strings.CutPrefix(s, "-")
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" || (hasPoint && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidAmount, s)
+	}
+	if len(fracPart) > maxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidAmount, s, maxDecimalScale)
+	}
+
+	coef, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, s)
+	}
+	if neg {
+		coef = -coef
+	}
+	return Decimal{coef: coef, scale: uint8(len(fracPart))}, nil
+}
+
+// DecimalFromFloat rounds f half away from zero to the given number of
+// decimal places. It is meant for values carriers report as floats.
+func DecimalFromFloat(f float64, scale int) Decimal {
+	scale = min(max(scale, 0), maxDecimalScale)
+	return Decimal{coef: int64(math.Round(f * math.Pow10(scale))), scale: uint8(scale)}
+}
+
+// String returns the decimal with its scale, e.g. "12.50".
+func (d Decimal) String() string {
+	if d.scale == 0 {
+		return strconv.FormatInt(d.coef, 10)
+	}
+
+	digits := strconv.FormatUint(absInt64(d.coef), 10)
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(d.scale)
+
+	sign := ""
+	if d.coef < 0 {
+		sign = "-"
+	}
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Float64 returns the float64 nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	switch {
+	case d.coef < 0:
+		return -1
+	case d.coef > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// rescale returns the coefficient of d at the given scale. It fails when d
+// has non-zero digits beyond that scale or the result overflows.
+func (d Decimal) rescale(scale int) (int64, error) {
+	diff := scale - int(d.scale)
+	if diff < 0 {
+		div := int64(math.Pow10(-diff))
+		if d.coef%div != 0 {
+			return 0, fmt.Errorf("%w: %s has more than %d decimal places", ErrInvalidAmount, d, scale)
+		}
+		return d.coef / div, nil
+	}
+
+	mul := int64(math.Pow10(diff))
+	if d.coef != 0 && absInt64(d.coef) > math.MaxInt64/uint64(mul) {
+		return 0, fmt.Errorf("%w: %s is out of range", ErrInvalidAmount, d)
+	}
+	return d.coef * mul, nil
+}
+
+// MarshalJSON encodes d as a JSON string so that no precision is lost.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a JSON string or number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalGQL implements the gqlgen Marshaler interface. Decimals are
+// written as strings.
+func (d Decimal) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(d.String()))
+}
+
+// UnmarshalGQL implements the gqlgen Unmarshaler interface. Strings and
+// numbers are accepted.
+func (d *Decimal) UnmarshalGQL(v any) error {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("%w: %T is not a decimal number", ErrInvalidAmount, v)
+	}
+
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-n)
+	}
+	return uint64(n)
+}
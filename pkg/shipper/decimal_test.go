@@ -0,0 +1,101 @@
+package shipper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"10", "10"},
+		{"10.5", "10.5"},
+		{"100.00", "100.00"},
+		{"0.99", "0.99"},
+		{"-0.05", "-0.05"},
+		{"007", "7"},
+		{"0.000000000000000001", "0.000000000000000001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := shipper.ParseDecimal(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
+
+func TestParseDecimal_Invalid(t *testing.T) {
+	inputs := []string{
+		"", "-", "abc", "12abc", "1e3", "+5", ".5", "5.", "1,000.00", " 1", "1 ", "--1", "1.2.3",
+		"0.0000000000000000001", "99999999999999999999",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			_, err := shipper.ParseDecimal(input)
+			assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+		})
+	}
+}
+
+func TestDecimalFromFloat(t *testing.T) {
+	assert.Equal(t, "4.536", shipper.DecimalFromFloat(4.5359237, 3).String())
+	assert.Equal(t, "0.30", shipper.DecimalFromFloat(0.1+0.2, 2).String())
+	assert.Equal(t, "-2", shipper.DecimalFromFloat(-1.5, 0).String())
+}
+
+func TestDecimal_Float64AndSign(t *testing.T) {
+	d, err := shipper.ParseDecimal("-12.50")
+	require.NoError(t, err)
+
+	assert.Equal(t, -12.5, d.Float64())
+	assert.Equal(t, -1, d.Sign())
+	assert.Equal(t, 0, shipper.Decimal{}.Sign())
+	assert.Equal(t, "0", shipper.Decimal{}.String())
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	d, err := shipper.ParseDecimal("12.50")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"12.50"`, string(data))
+
+	var fromString, fromNumber shipper.Decimal
+	require.NoError(t, json.Unmarshal([]byte(`"12.50"`), &fromString))
+	require.NoError(t, json.Unmarshal([]byte(`12.5`), &fromNumber))
+	assert.Equal(t, d, fromString)
+	assert.Equal(t, "12.5", fromNumber.String())
+
+	var invalid shipper.Decimal
+	assert.ErrorIs(t, json.Unmarshal([]byte(`"12abc"`), &invalid), shipper.ErrInvalidAmount)
+}
+
+func TestDecimal_GQL(t *testing.T) {
+	var d shipper.Decimal
+	require.NoError(t, d.UnmarshalGQL("0.10"))
+
+	var buf bytes.Buffer
+	d.MarshalGQL(&buf)
+	assert.Equal(t, `"0.10"`, buf.String())
+
+	require.NoError(t, d.UnmarshalGQL(json.Number("3")))
+	assert.Equal(t, "3", d.String())
+	require.NoError(t, d.UnmarshalGQL(int64(42)))
+	assert.Equal(t, "42", d.String())
+	require.NoError(t, d.UnmarshalGQL(2.25))
+	assert.Equal(t, "2.25", d.String())
+
+	assert.ErrorIs(t, d.UnmarshalGQL("1e3"), shipper.ErrInvalidAmount)
+	assert.ErrorIs(t, d.UnmarshalGQL(true), shipper.ErrInvalidAmount)
+}
@@ -3,6 +3,9 @@ package shipper
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 )
 
 // ShipperError represents an error from a shipping carrier.
@@ -12,6 +15,7 @@ type ShipperError struct {
 	Message    string
 	StatusCode int
 	Retryable  bool
+	RetryAfter time.Duration // Delay requested by the carrier before retrying, zero if unknown
 	Cause      error
 }
 
@@ -46,6 +50,43 @@ func NewShipperError(carrier, code, message string) *ShipperError {
 	}
 }
 
+// NewRateLimitError creates the error returned when a carrier rejects a
+// request for exceeding its rate limit. It wraps ErrRateLimitExceeded.
+func NewRateLimitError(carrier string, retryAfter time.Duration) *ShipperError {
+	return &ShipperError{
+		Carrier:    carrier,
+		Code:       "rate_limited",
+		Message:    "too many requests",
+		StatusCode: http.StatusTooManyRequests,
+		Retryable:  true,
+		RetryAfter: retryAfter,
+		Cause:      ErrRateLimitExceeded,
+	}
+}
+
+// NewUnsupportedOptionError creates the error returned when a carrier
+// cannot honor a requested shipping option. It wraps ErrUnsupportedOption.
+func NewUnsupportedOptionError(carrier, option string) *ShipperError {
+	return &ShipperError{
+		Carrier: carrier,
+		Code:    "unsupported_option",
+		Message: option + " is not supported by " + carrier,
+		Cause:   ErrUnsupportedOption,
+	}
+}
+
+// NewPackageLimitError creates the error returned when packages exceed a
+// carrier's weight or size limits. cause is the *ValidationError listing
+// the offending fields; it wraps ErrInvalidPackage.
+func NewPackageLimitError(carrier string, cause error) *ShipperError {
+	return &ShipperError{
+		Carrier: carrier,
+		Code:    "invalid_package",
+		Message: "package exceeds " + carrier + " limits",
+		Cause:   cause,
+	}
+}
+
 // WithCause adds a cause to the error.
 func (e *ShipperError) WithCause(err error) *ShipperError {
 	e.Cause = err
@@ -81,6 +122,9 @@ var (
 	// ErrOrderNotFound indicates the order ID was not found.
 	ErrOrderNotFound = errors.New("order not found")
 
+	// ErrInvalidCursor indicates a pagination cursor could not be decoded.
+	ErrInvalidCursor = errors.New("invalid cursor")
+
 	// ErrCancellationNotAllowed indicates the order cannot be cancelled.
 	ErrCancellationNotAllowed = errors.New("cancellation not allowed")
 
@@ -93,18 +137,92 @@ var (
 	// ErrRateLimitExceeded indicates the carrier rate limit was exceeded.
 	ErrRateLimitExceeded = errors.New("rate limit exceeded")
 
+	// ErrInvalidPush indicates a tracking push from a carrier could not be parsed.
+	ErrInvalidPush = errors.New("invalid tracking push")
+
 	// ErrInvalidPackage indicates package dimensions or weight are invalid.
 	ErrInvalidPackage = errors.New("invalid package")
 
+	// ErrInvalidPickup indicates a pickup window or pickup details are invalid.
+	ErrInvalidPickup = errors.New("invalid pickup")
+
+	// ErrInvalidCustoms indicates a customs declaration is missing or invalid.
+	ErrInvalidCustoms = errors.New("invalid customs declaration")
+
+	// ErrIdempotencyKeyReused indicates an idempotency key was replayed with
+	// a different request than the one it was first used with.
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+
+	// ErrIdempotencyKeyInUse indicates a request with the same idempotency
+	// key is still being processed.
+	ErrIdempotencyKeyInUse = errors.New("idempotency key in use")
+
 	// ErrCarrierNotFound indicates the requested carrier is not registered.
 	ErrCarrierNotFound = errors.New("carrier not found")
+
+	// ErrUnsupportedOption indicates the carrier cannot honor a requested shipping option.
+	ErrUnsupportedOption = errors.New("unsupported shipping option")
+
+	// ErrInvalidAmount indicates a decimal or monetary amount is malformed
+	// or more precise than its currency allows.
+	ErrInvalidAmount = errors.New("invalid amount")
+
+	// ErrCurrencyMismatch indicates amounts in different currencies were combined.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
 )
 
 // IsRetryable returns true if the error is retryable.
+// Carrier API errors can opt in by implementing Retryable() bool, and
+// network timeouts are always considered retryable.
 func IsRetryable(err error) bool {
 	var shipperErr *ShipperError
 	if errors.As(err, &shipperErr) {
 		return shipperErr.Retryable
 	}
+	var apiErr interface{ Retryable() bool }
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
 	return errors.Is(err, ErrServiceUnavailable) || errors.Is(err, ErrRateLimitExceeded)
 }
+
+// IsRejected reports whether err proves that the carrier refused a request
+// without acting on it: the request was invalid, unauthorized, rate limited
+// or asked for an option the carrier does not support. Timeouts, server
+// errors and cancelled contexts are not rejections, since the carrier may
+// have completed the request before failing to answer. Carrier API errors
+// can opt in by implementing Rejected() bool.
+func IsRejected(err error) bool {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return true
+	}
+	for _, rejection := range []error{
+		ErrInvalidAddress, ErrInvalidPackage, ErrInvalidPickup, ErrInvalidCustoms,
+		ErrUnsupportedOption, ErrAuthenticationFailed, ErrRateLimitExceeded,
+	} {
+		if errors.Is(err, rejection) {
+			return true
+		}
+	}
+	var shipperErr *ShipperError
+	if errors.As(err, &shipperErr) && rejectedStatus(shipperErr.StatusCode) {
+		return true
+	}
+	var apiErr interface{ Rejected() bool }
+	if errors.As(err, &apiErr) {
+		return apiErr.Rejected()
+	}
+	return false
+}
+
+// rejectedStatus reports whether an HTTP status means the request was
+// refused. A request timeout may have been processed anyway.
+func rejectedStatus(code int) bool {
+	return code >= http.StatusBadRequest && code < http.StatusInternalServerError &&
+		code != http.StatusRequestTimeout
+}
@@ -1,8 +1,12 @@
 package shipper_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tournevent/logistic/pkg/shipper"
@@ -62,6 +66,16 @@ func TestIsRetryable_ShipperErrorNotRetryable(t *testing.T) {
 	assert.False(t, shipper.IsRetryable(err))
 }
 
+func TestNewRateLimitError(t *testing.T) {
+	err := shipper.NewRateLimitError("freightcom", 30*time.Second)
+
+	assert.Equal(t, "rate_limited", err.Code)
+	assert.Equal(t, 429, err.StatusCode)
+	assert.Equal(t, 30*time.Second, err.RetryAfter)
+	assert.True(t, errors.Is(err, shipper.ErrRateLimitExceeded))
+	assert.True(t, shipper.IsRetryable(err))
+}
+
 func TestIsRetryable_ServiceUnavailable(t *testing.T) {
 	assert.True(t, shipper.IsRetryable(shipper.ErrServiceUnavailable))
 }
@@ -74,6 +88,50 @@ func TestIsRetryable_InvalidAddress(t *testing.T) {
 	assert.False(t, shipper.IsRetryable(shipper.ErrInvalidAddress))
 }
 
+type retryableError bool
+
+func (e retryableError) Error() string   { return "api error" }
+func (e retryableError) Retryable() bool { return bool(e) }
+
+func TestIsRetryable_RetryableInterface(t *testing.T) {
+	assert.True(t, shipper.IsRetryable(fmt.Errorf("calling api: %w", retryableError(true))))
+	assert.False(t, shipper.IsRetryable(fmt.Errorf("calling api: %w", retryableError(false))))
+}
+
+func TestIsRetryable_NetworkTimeout(t *testing.T) {
+	err := &url.Error{Op: "Post", URL: "https://api.example.com", Err: context.DeadlineExceeded}
+	assert.True(t, shipper.IsRetryable(err))
+}
+
+type rejectedError bool
+
+func (e rejectedError) Error() string  { return "api error" }
+func (e rejectedError) Rejected() bool { return bool(e) }
+
+func TestIsRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		rejected bool
+	}{
+		{"validation", &shipper.ValidationError{Fields: []*shipper.FieldError{{Field: "origin.postalCode"}}}, true},
+		{"unsupported option", shipper.NewUnsupportedOptionError("purolator", shipper.OptionSaturdayDelivery), true},
+		{"rate limited", shipper.NewRateLimitError("freightcom", 0), true},
+		{"client error", shipper.NewShipperError("freightcom", "HTTP_422", "Unprocessable").WithStatusCode(422), true},
+		{"request timeout", shipper.NewShipperError("freightcom", "HTTP_408", "Request Timeout").WithStatusCode(408), false},
+		{"server error", shipper.NewShipperError("freightcom", "HTTP_503", "Service Unavailable").WithStatusCode(503), false},
+		{"api rejection", fmt.Errorf("calling api: %w", rejectedError(true)), true},
+		{"api failure", fmt.Errorf("calling api: %w", rejectedError(false)), false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"network timeout", &url.Error{Op: "Post", URL: "https://api.example.com", Err: context.DeadlineExceeded}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.rejected, shipper.IsRejected(tt.err))
+		})
+	}
+}
+
 func TestSentinelErrors(t *testing.T) {
 	tests := []struct {
 		name string
@@ -2,6 +2,7 @@ package freightcom
 
 import (
 	"context"
+	"net/http"
 )
 
 // APIClient defines the interface for Freightcom API operations.
@@ -22,6 +23,12 @@ type APIClient interface {
 
 	// GetTracking retrieves tracking information
 	GetTracking(ctx context.Context, trackingNumber string) (*TrackingResponse, error)
+
+	// SchedulePickup books a pickup of an existing shipment
+	SchedulePickup(ctx context.Context, shipmentID string, req *PickupDetails) (*PickupResponse, error)
+
+	// CancelPickup cancels the pickup of a shipment
+	CancelPickup(ctx context.Context, shipmentID string) (*PickupResponse, error)
 }
 
 // ============================================================================
@@ -38,10 +45,32 @@ type RatesRequest struct {
 
 // ShippingDetails contains shipping information for rate requests.
 type ShippingDetails struct {
-	Origin      Location      `json:"origin"`
-	Destination Location      `json:"destination"`
-	Packaging   PackagingInfo `json:"packaging"`
-	CustomsData *CustomsData  `json:"customs_data,omitempty"` // For international
+	Origin               Location      `json:"origin"`
+	Destination          Location      `json:"destination"`
+	ExpectedShipDate     *Date         `json:"expected_ship_date,omitempty"`
+	Packaging            PackagingInfo `json:"packaging"`
+	SignatureRequirement string        `json:"signature_requirement,omitempty"` // "not-required", "required", "adult-required"
+	Insurance            *Insurance    `json:"insurance,omitempty"`
+	CustomsData          *CustomsData  `json:"customs_data,omitempty"` // For international
+}
+
+// Date is a calendar date as expected by the Freightcom API.
+type Date struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// Insurance requests coverage of a shipment's declared value.
+type Insurance struct {
+	Type      string `json:"type"` // "internal" or "carrier"
+	TotalCost Amount `json:"total_cost"`
+}
+
+// Amount is a monetary value.
+type Amount struct {
+	Currency string  `json:"currency"`
+	Value    float64 `json:"value"`
 }
 
 // Location represents origin or destination.
@@ -78,8 +107,9 @@ type Package struct {
 // CustomsData for international shipments.
 type CustomsData struct {
 	Description     string        `json:"description"`
-	ReasonForExport string        `json:"reason_for_export"` // "sale", "gift", "sample", etc.
-	Currency        string        `json:"currency"`          // CAD, USD
+	ReasonForExport string        `json:"reason_for_export"`   // "sale", "gift", "sample", etc.
+	Currency        string        `json:"currency"`            // CAD, USD
+	Incoterms       string        `json:"incoterms,omitempty"` // "DDU" or "DDP", empty for DDU
 	Items           []CustomsItem `json:"items"`
 }
 
@@ -87,8 +117,8 @@ type CustomsData struct {
 type CustomsItem struct {
 	Description   string  `json:"description"`
 	Quantity      int     `json:"quantity"`
-	Value         float64 `json:"value"`
-	Weight        float64 `json:"weight"`
+	Value         float64 `json:"value"`  // Per unit
+	Weight        float64 `json:"weight"` // Per unit, kg
 	CountryOrigin string  `json:"country_of_origin"`
 	HSCode        string  `json:"hs_code,omitempty"`
 }
@@ -159,6 +189,7 @@ type ShipmentRequest struct {
 	Instructions    string          `json:"instructions,omitempty"`
 	CustomsInvoice  *CustomsInvoice `json:"customs_invoice,omitempty"` // For international
 	PickupDetails   *PickupDetails  `json:"pickup_details,omitempty"`
+	IsReturn        bool            `json:"is_return,omitempty"` // Return shipment, billed to the recipient's account
 }
 
 // Contact represents sender/recipient contact info.
@@ -172,9 +203,13 @@ type Contact struct {
 
 // CustomsInvoice for international shipments.
 type CustomsInvoice struct {
-	InvoiceNumber string        `json:"invoice_number,omitempty"`
-	InvoiceDate   string        `json:"invoice_date,omitempty"`
-	Items         []CustomsItem `json:"items"`
+	InvoiceNumber           string        `json:"invoice_number,omitempty"`
+	InvoiceDate             string        `json:"invoice_date,omitempty"` // YYYY-MM-DD
+	Currency                string        `json:"currency"`
+	ImporterTaxID           string        `json:"importer_tax_id,omitempty"`
+	ExporterTaxID           string        `json:"exporter_tax_id,omitempty"`
+	ExportDeclarationNumber string        `json:"export_declaration_number,omitempty"` // B13A or CERS proof of report
+	Items                   []CustomsItem `json:"items"`
 }
 
 // PickupDetails for scheduling pickup.
@@ -185,6 +220,15 @@ type PickupDetails struct {
 	Instructions string `json:"instructions,omitempty"`
 }
 
+// PickupResponse represents the pickup booked for a shipment.
+// POST and DELETE /shipment/{shipment_id}/schedule
+type PickupResponse struct {
+	ShipmentID         string        `json:"shipment_id"`
+	Status             string        `json:"status"` // "scheduled", "cancelled"
+	ConfirmationNumber string        `json:"confirmation_number,omitempty"`
+	PickupDetails      PickupDetails `json:"pickup_details"`
+}
+
 // ShipmentResponse represents the Freightcom shipment creation response.
 type ShipmentResponse struct {
 	ID                string   `json:"id"`
@@ -245,11 +289,29 @@ type TrackingEvent struct {
 
 // APIError represents an error from the Freightcom API.
 type APIError struct {
-	Code    string            `json:"code"`
-	Message string            `json:"message"`
-	Errors  map[string]string `json:"errors,omitempty"` // Field-level errors
+	Code       string            `json:"code"`
+	Message    string            `json:"message"`
+	Errors     map[string]string `json:"errors,omitempty"` // Field-level errors
+	StatusCode int               `json:"-"`                // HTTP status, 0 if not from a response
 }
 
 func (e *APIError) Error() string {
 	return e.Code + ": " + e.Message
 }
+
+// Retryable reports whether the request may succeed if sent again.
+func (e *APIError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// ErrorCode returns the carrier error code.
+func (e *APIError) ErrorCode() string {
+	return e.Code
+}
+
+// Rejected reports whether the carrier refused the request without acting
+// on it.
+func (e *APIError) Rejected() bool {
+	return e.StatusCode >= http.StatusBadRequest && e.StatusCode < http.StatusInternalServerError &&
+		e.StatusCode != http.StatusRequestTimeout
+}
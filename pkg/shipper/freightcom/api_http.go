@@ -8,6 +8,10 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // HTTPAPIClient is the production implementation of APIClient using HTTP.
@@ -17,6 +21,7 @@ type HTTPAPIClient struct {
 	httpClient   *http.Client
 	pollInterval time.Duration
 	pollTimeout  time.Duration
+	limiter      *ratelimit.Limiter
 }
 
 // HTTPAPIClientConfig holds configuration for the HTTP client.
@@ -24,8 +29,9 @@ type HTTPAPIClientConfig struct {
 	BaseURL      string
 	APIKey       string
 	Timeout      time.Duration
-	PollInterval time.Duration // Interval between polling for async operations
-	PollTimeout  time.Duration // Max time to wait for async operations
+	PollInterval time.Duration      // Interval between polling for async operations
+	PollTimeout  time.Duration      // Max time to wait for async operations
+	RateLimiter  *ratelimit.Limiter // Optional client-side rate limiter
 }
 
 // NewHTTPAPIClient creates a new HTTP-based API client for production use.
@@ -49,10 +55,12 @@ func NewHTTPAPIClient(cfg HTTPAPIClientConfig) *HTTPAPIClient {
 		baseURL: cfg.BaseURL,
 		apiKey:  cfg.APIKey,
 		httpClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
 		pollInterval: pollInterval,
 		pollTimeout:  pollTimeout,
+		limiter:      cfg.RateLimiter,
 	}
 }
 
@@ -61,7 +69,7 @@ func NewHTTPAPIClient(cfg HTTPAPIClientConfig) *HTTPAPIClient {
 // then we poll GET /rate/{request_id} until complete.
 func (c *HTTPAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*RatesResponse, error) {
 	// Step 1: Submit rate request
-	resp, err := c.doRequest(ctx, http.MethodPost, "/rate", req)
+	resp, err := c.doRequest(ctx, "get_rates", http.MethodPost, "/rate", req)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +107,7 @@ func (c *HTTPAPIClient) pollRates(ctx context.Context, requestID string) (*Rates
 			}
 		}
 
-		resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+		resp, err := c.doRequest(ctx, "poll_rates", http.MethodGet, path, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -140,7 +148,7 @@ func (c *HTTPAPIClient) pollRates(ctx context.Context, requestID string) (*Rates
 // CreateShipment creates a new shipment via the Freightcom API.
 // POST /shipment - may return 202 Accepted for async processing.
 func (c *HTTPAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest) (*ShipmentResponse, error) {
-	resp, err := c.doRequest(ctx, http.MethodPost, "/shipment", req)
+	resp, err := c.doRequest(ctx, "create_shipment", http.MethodPost, "/shipment", req)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +190,7 @@ func (c *HTTPAPIClient) pollShipment(ctx context.Context, shipmentID string) (*S
 			}
 		}
 
-		resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+		resp, err := c.doRequest(ctx, "poll_shipment", http.MethodGet, path, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -222,7 +230,7 @@ func (c *HTTPAPIClient) pollShipment(ctx context.Context, shipmentID string) (*S
 func (c *HTTPAPIClient) GetLabel(ctx context.Context, shipmentID string, format string) (*LabelResponse, error) {
 	path := fmt.Sprintf("/shipment/%s", shipmentID)
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.doRequest(ctx, "get_label", http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +268,7 @@ func (c *HTTPAPIClient) GetLabel(ctx context.Context, shipmentID string, format
 func (c *HTTPAPIClient) CancelShipment(ctx context.Context, shipmentID string, reason string) (*CancelResponse, error) {
 	path := fmt.Sprintf("/shipment/%s", shipmentID)
 
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
+	resp, err := c.doRequest(ctx, "cancel_shipment", http.MethodDelete, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -290,12 +298,56 @@ func (c *HTTPAPIClient) CancelShipment(ctx context.Context, shipmentID string, r
 	return &result, nil
 }
 
+// SchedulePickup books the pickup of a shipment via the Freightcom API.
+// POST /shipment/{shipment_id}/schedule
+func (c *HTTPAPIClient) SchedulePickup(ctx context.Context, shipmentID string, req *PickupDetails) (*PickupResponse, error) {
+	path := fmt.Sprintf("/shipment/%s/schedule", shipmentID)
+
+	resp, err := c.doRequest(ctx, "schedule_pickup", http.MethodPost, path, map[string]any{"pickup_details": req})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, c.parseError(resp)
+	}
+
+	var result PickupResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode pickup response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CancelPickup cancels the pickup of a shipment via the Freightcom API.
+// DELETE /shipment/{shipment_id}/schedule
+func (c *HTTPAPIClient) CancelPickup(ctx context.Context, shipmentID string) (*PickupResponse, error) {
+	path := fmt.Sprintf("/shipment/%s/schedule", shipmentID)
+
+	resp, err := c.doRequest(ctx, "cancel_pickup", http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, c.parseError(resp)
+	}
+
+	return &PickupResponse{
+		ShipmentID: shipmentID,
+		Status:     "cancelled",
+	}, nil
+}
+
 // GetTracking retrieves tracking information from the Freightcom API.
 // GET /shipment/{shipment_id}/tracking-events
 func (c *HTTPAPIClient) GetTracking(ctx context.Context, shipmentID string) (*TrackingResponse, error) {
 	path := fmt.Sprintf("/shipment/%s/tracking-events", shipmentID)
 
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	resp, err := c.doRequest(ctx, "get_tracking", http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +367,12 @@ func (c *HTTPAPIClient) GetTracking(ctx context.Context, shipmentID string) (*Tr
 }
 
 // doRequest performs an HTTP request with proper headers and authentication.
-func (c *HTTPAPIClient) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+// It waits for the rate limiter and turns 429 responses into rate limit errors.
+func (c *HTTPAPIClient) doRequest(ctx context.Context, operation, method, path string, body interface{}) (*http.Response, error) {
+	if err := c.limiter.Wait(ctx, operation); err != nil {
+		return nil, err
+	}
+
 	url := c.baseURL + path
 
 	var bodyReader io.Reader
@@ -337,7 +394,17 @@ func (c *HTTPAPIClient) doRequest(ctx context.Context, method, path string, body
 	req.Header.Set("X-API-Key", c.apiKey) // Freightcom uses X-API-Key header
 	req.Header.Set("User-Agent", "delivro-logistic/1.0")
 
-	return c.httpClient.Do(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		retryAfter := ratelimit.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		c.limiter.Pause(retryAfter)
+		return nil, shipper.NewRateLimitError(carrierName, retryAfter)
+	}
+	return resp, nil
 }
 
 // parseError extracts error information from an HTTP response.
@@ -346,6 +413,7 @@ func (c *HTTPAPIClient) parseError(resp *http.Response) error {
 
 	var apiErr APIError
 	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Code != "" {
+		apiErr.StatusCode = resp.StatusCode
 		return &apiErr
 	}
 
@@ -361,15 +429,17 @@ func (c *HTTPAPIClient) parseError(resp *http.Response) error {
 		}
 		if msg != "" {
 			return &APIError{
-				Code:    fmt.Sprintf("HTTP_%d", resp.StatusCode),
-				Message: msg,
+				Code:       fmt.Sprintf("HTTP_%d", resp.StatusCode),
+				Message:    msg,
+				StatusCode: resp.StatusCode,
 			}
 		}
 	}
 
 	return &APIError{
-		Code:    fmt.Sprintf("HTTP_%d", resp.StatusCode),
-		Message: string(body),
+		Code:       fmt.Sprintf("HTTP_%d", resp.StatusCode),
+		Message:    string(body),
+		StatusCode: resp.StatusCode,
 	}
 }
 
@@ -0,0 +1,62 @@
+package freightcom_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/freightcom"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+)
+
+func TestHTTPAPIClient_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := freightcom.NewHTTPAPIClient(freightcom.HTTPAPIClientConfig{BaseURL: srv.URL})
+
+	_, err := client.GetTracking(context.Background(), "fc-order-123")
+
+	var shipperErr *shipper.ShipperError
+	require.True(t, errors.As(err, &shipperErr))
+	assert.Equal(t, "rate_limited", shipperErr.Code)
+	assert.Equal(t, 2*time.Second, shipperErr.RetryAfter)
+	assert.ErrorIs(t, err, shipper.ErrRateLimitExceeded)
+	assert.True(t, shipper.IsRetryable(err))
+}
+
+func TestHTTPAPIClient_RateLimiterWaits(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"events": []}`))
+	}))
+	defer srv.Close()
+
+	limiter := ratelimit.New("freightcom", ratelimit.Config{
+		Operations: map[string]ratelimit.Limit{"get_tracking": {Rate: 1, Burst: 1}},
+	}, nil)
+	client := freightcom.NewHTTPAPIClient(freightcom.HTTPAPIClientConfig{
+		BaseURL:     srv.URL,
+		RateLimiter: limiter,
+	})
+
+	_, err := client.GetTracking(context.Background(), "fc-order-123")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.GetTracking(ctx, "fc-order-123")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
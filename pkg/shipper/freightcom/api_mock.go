@@ -18,6 +18,8 @@ type MockAPIClient struct {
 	OnGetLabel       func(ctx context.Context, orderID string, format string) (*LabelResponse, error)
 	OnCancelShipment func(ctx context.Context, orderID string, reason string) (*CancelResponse, error)
 	OnGetTracking    func(ctx context.Context, trackingNumber string) (*TrackingResponse, error)
+	OnSchedulePickup func(ctx context.Context, shipmentID string, req *PickupDetails) (*PickupResponse, error)
+	OnCancelPickup   func(ctx context.Context, shipmentID string) (*PickupResponse, error)
 }
 
 // NewMockAPIClient creates a new mock API client with default behavior.
@@ -232,4 +234,46 @@ func (m *MockAPIClient) GetTracking(ctx context.Context, shipmentID string) (*Tr
 	}, nil
 }
 
+// SchedulePickup books a mock pickup.
+func (m *MockAPIClient) SchedulePickup(ctx context.Context, shipmentID string, req *PickupDetails) (*PickupResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Message: "Simulated API error"}
+	}
+
+	if m.OnSchedulePickup != nil {
+		return m.OnSchedulePickup(ctx, shipmentID, req)
+	}
+
+	return &PickupResponse{
+		ShipmentID:         shipmentID,
+		Status:             "scheduled",
+		ConfirmationNumber: fmt.Sprintf("PU-%d", time.Now().UnixNano()%1000000),
+		PickupDetails:      *req,
+	}, nil
+}
+
+// CancelPickup cancels a mock pickup.
+func (m *MockAPIClient) CancelPickup(ctx context.Context, shipmentID string) (*PickupResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Message: "Simulated API error"}
+	}
+
+	if m.OnCancelPickup != nil {
+		return m.OnCancelPickup(ctx, shipmentID)
+	}
+
+	return &PickupResponse{
+		ShipmentID: shipmentID,
+		Status:     "cancelled",
+	}, nil
+}
+
 var _ APIClient = (*MockAPIClient)(nil)
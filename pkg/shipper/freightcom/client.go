@@ -3,10 +3,16 @@ package freightcom
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"github.com/tournevent/logistic/pkg/shipper/units"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -14,12 +20,27 @@ import (
 
 const carrierName = "freightcom"
 
+// packageUnits is how Freightcom measures packages: kilograms and
+// centimeters, to two decimals. Its LTL carriers take pallets up to
+// 2,000 kg and 6 m long.
+var packageUnits = units.Spec{
+	WeightUnit:    shipper.WeightKG,
+	DimensionUnit: shipper.DimensionCM,
+	WeightStep:    0.01,
+	DimensionStep: 0.01,
+
+	MaxWeight: 2000,
+	MaxLength: 600,
+}
+
 // Config holds Freightcom configuration.
 type Config struct {
 	APIKey          string
 	BaseURL         string
-	PaymentMethodID int  // Required for creating shipments
-	UseMock         bool // When true, uses mock API client
+	PaymentMethodID int                // Required for creating shipments
+	UseMock         bool               // When true, uses mock API client
+	RateLimiter     *ratelimit.Limiter // Optional client-side rate limiter for the HTTP client
+	WebhookSecret   string             // Key of the signatures of tracking pushes; pushes are rejected when empty
 }
 
 // Client is the Freightcom shipper client.
@@ -42,9 +63,10 @@ func New(cfg Config, logger *otelzap.Logger, tracer trace.Tracer) *Client {
 		apiClient = NewMockAPIClient()
 	} else {
 		apiClient = NewHTTPAPIClient(HTTPAPIClientConfig{
-			BaseURL: cfg.BaseURL,
-			APIKey:  cfg.APIKey,
-			Timeout: 30 * time.Second,
+			BaseURL:     cfg.BaseURL,
+			APIKey:      cfg.APIKey,
+			Timeout:     30 * time.Second,
+			RateLimiter: cfg.RateLimiter,
 		})
 	}
 
@@ -72,14 +94,44 @@ func (c *Client) Name() string {
 	return carrierName
 }
 
+// Capabilities describes what Freightcom supports. There is no Saturday
+// delivery.
+func (c *Client) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{
+		LabelFormats:      []shipper.LabelFormat{shipper.LabelPDF, shipper.LabelPNG, shipper.LabelZPL},
+		International:     true,
+		MultiPiece:        true,
+		Pickups:           true,
+		Returns:           true,
+		Insurance:         true,
+		SignatureRequired: true,
+		MaxWeight:         packageUnits.MaxWeight,
+		MaxWeightUnit:     packageUnits.WeightUnit,
+
+		DedupesCreateOrder: true,
+	}
+}
+
 // GetQuote returns shipping quotes from Freightcom.
 func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_quote",
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Getting Freightcom quotes",
 		zap.String("origin_city", req.Origin.City),
 		zap.String("destination_city", req.Destination.City),
 		zap.Int("package_count", len(req.Packages)),
 	)
 
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
 	// Convert to API request
 	apiReq := &RatesRequest{
 		Details: ShippingDetails{
@@ -87,36 +139,68 @@ func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*ship
 			Destination: addressToLocation(req.Destination),
 			Packaging: PackagingInfo{
 				Type:     "package",
-				Packages: packagesToAPI(req.Packages),
+				Packages: packagesToAPI(pkgs),
 			},
 		},
 	}
+	if err := applyOptions(&apiReq.Details, req.Options, req.Packages); err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	if req.Customs != nil {
+		apiReq.Details.CustomsData = customsToAPI(req.Customs)
+	}
 
 	// Call API
 	apiResp, err := c.apiClient.GetRates(ctx, apiReq)
 	if err != nil {
 		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
 	// Convert to shipper response
-	return ratesResponseToShipper(apiResp), nil
+	resp := ratesResponseToShipper(apiResp)
+	resp.SetBillableWeight(packageUnits.TotalWeight(pkgs), packageUnits.WeightUnit)
+	return resp, nil
 }
 
 // CreateOrder creates a shipment with Freightcom.
 func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_order",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Creating Freightcom order",
 		zap.String("rate_id", req.RateID),
 		zap.String("recipient", req.Recipient.Name),
 	)
 
-	// Extract service ID from rate - in production this would come from the rate selection
-	serviceID := extractServiceID(req.RateID)
+	serviceID, err := strconv.Atoi(req.ServiceID)
+	if err != nil {
+		serviceErr := shipper.NewShipperError(carrierName, "invalid_service", "unknown service ID for rate "+req.RateID).
+			WithCause(err)
+		shipper.RecordError(span, serviceErr)
+		return nil, serviceErr
+	}
+
+	// Freightcom returns the existing shipment instead of booking a new one
+	// when the unique ID was already used
+	uniqueID := uuid.New().String()
+	switch {
+	case req.IdempotencyKey != "":
+		uniqueID = shipperUniqueID(req.ShipperID, req.IdempotencyKey)
+	case req.Reference != "":
+		uniqueID = shipperUniqueID(req.ShipperID, req.Reference)
+	}
 
-	// Generate unique ID for idempotency
-	uniqueID := req.Reference
-	if uniqueID == "" {
-		uniqueID = uuid.New().String()
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
 	}
 
 	// Convert to API request
@@ -129,7 +213,7 @@ func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderReques
 			Destination: addressToLocation(req.RecipientAddress),
 			Packaging: PackagingInfo{
 				Type:     "package",
-				Packages: packagesToAPI(req.Packages),
+				Packages: packagesToAPI(pkgs),
 			},
 		},
 		Sender:       contactToAPI(req.Sender),
@@ -138,11 +222,27 @@ func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderReques
 		PONumber:     req.PONumber,
 		Instructions: req.Instructions,
 	}
+	if req.Pickup != nil {
+		apiReq.PickupDetails = pickupDetailsToAPI(*req.Pickup, req.SenderAddress.Instructions)
+	}
+	if err := applyOptions(&apiReq.Details, req.Options, req.Packages); err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	if req.Customs != nil {
+		invoiceDate := time.Now()
+		if req.Options.ShipDate != nil {
+			invoiceDate = *req.Options.ShipDate
+		}
+		apiReq.Details.CustomsData = customsToAPI(req.Customs)
+		apiReq.CustomsInvoice = customsInvoiceToAPI(req.Customs, invoiceDate)
+	}
 
 	// Call API
 	apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
 	if err != nil {
 		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -152,6 +252,9 @@ func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderReques
 
 // GetLabel retrieves the shipping label from Freightcom.
 func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_label")
+	defer span.End()
+
 	c.logger.Info("Getting Freightcom label",
 		zap.String("order_id", req.OrderID),
 		zap.String("format", string(req.Format)),
@@ -166,6 +269,7 @@ func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*s
 	apiResp, err := c.apiClient.GetLabel(ctx, req.OrderID, format)
 	if err != nil {
 		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -175,6 +279,9 @@ func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*s
 
 // CancelOrder cancels a shipment with Freightcom.
 func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_order")
+	defer span.End()
+
 	c.logger.Info("Cancelling Freightcom order",
 		zap.String("order_id", req.OrderID),
 		zap.String("reason", req.Reason),
@@ -184,6 +291,7 @@ func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderReques
 	apiResp, err := c.apiClient.CancelShipment(ctx, req.OrderID, req.Reason)
 	if err != nil {
 		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -191,6 +299,189 @@ func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderReques
 	return cancelResponseToShipper(apiResp), nil
 }
 
+// Track retrieves the tracking history of a Freightcom shipment.
+// Freightcom tracks by shipment ID, so the order ID takes precedence.
+func (c *Client) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "track_shipment")
+	defer span.End()
+
+	c.logger.Info("Tracking Freightcom shipment",
+		zap.String("order_id", req.OrderID),
+		zap.String("tracking_number", req.TrackingNumber),
+	)
+
+	shipmentID := req.OrderID
+	if shipmentID == "" {
+		shipmentID = req.TrackingNumber
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.GetTracking(ctx, shipmentID)
+	if err != nil {
+		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Convert to shipper response
+	return trackingResponseToShipper(apiResp), nil
+}
+
+// GetPickupAvailability reports whether Freightcom can collect on the
+// requested day. Freightcom has no availability lookup: its carriers collect
+// on weekdays, at the times booked with each shipment.
+func (c *Client) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	_, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_pickup_availability")
+	defer span.End()
+
+	weekday := req.Date.Weekday()
+	return &shipper.PickupAvailabilityResponse{
+		Available: weekday != time.Saturday && weekday != time.Sunday,
+	}, nil
+}
+
+// SchedulePickup books the pickup of a Freightcom shipment. Each shipment
+// may travel with a different carrier, so pickups are booked per shipment
+// and the pickup ID is the shipment ID.
+func (c *Client) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "schedule_pickup")
+	defer span.End()
+
+	c.logger.Info("Scheduling Freightcom pickup",
+		zap.Strings("order_ids", req.OrderIDs),
+		zap.Time("ready_at", req.Window.ReadyAt),
+	)
+
+	if len(req.OrderIDs) != 1 {
+		err := shipper.NewShipperError(carrierName, "invalid_pickup", "pickups are booked per shipment, exactly one order ID is required").
+			WithCause(shipper.ErrInvalidPickup)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.SchedulePickup(ctx, req.OrderIDs[0], pickupDetailsToAPI(req.Window, req.Instructions))
+	if err != nil {
+		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.SchedulePickupResponse{
+		PickupID: apiResp.ShipmentID,
+		Carrier:  carrierName,
+		Status:   mapPickupStatus(apiResp.Status),
+		Window:   req.Window,
+	}, nil
+}
+
+// CancelPickup cancels the pickup of a Freightcom shipment, leaving the
+// shipment itself booked.
+func (c *Client) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_pickup")
+	defer span.End()
+
+	c.logger.Info("Cancelling Freightcom pickup",
+		zap.String("pickup_id", req.PickupID),
+	)
+
+	// Call API
+	apiResp, err := c.apiClient.CancelPickup(ctx, req.PickupID)
+	if err != nil {
+		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.CancelPickupResponse{
+		PickupID: apiResp.ShipmentID,
+		Status:   mapPickupStatus(apiResp.Status),
+	}, nil
+}
+
+var _ shipper.PickupScheduler = (*Client)(nil)
+
+// CreateReturn creates a Freightcom shipment flagged as a return and
+// retrieves its label. The RMA number is sent as the shipment reference.
+func (c *Client) CreateReturn(ctx context.Context, req *shipper.CreateReturnRequest) (*shipper.CreateReturnResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_return",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
+	c.logger.Info("Creating Freightcom return",
+		zap.String("original_order_id", req.OriginalOrderID),
+		zap.String("rma_number", req.RMANumber),
+	)
+
+	serviceID, err := strconv.Atoi(req.ServiceID)
+	if err != nil {
+		serviceErr := shipper.NewShipperError(carrierName, "invalid_service", "unknown service ID "+req.ServiceID).
+			WithCause(err)
+		shipper.RecordError(span, serviceErr)
+		return nil, serviceErr
+	}
+
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Convert to API request
+	apiReq := &ShipmentRequest{
+		UniqueID:        uuid.New().String(),
+		PaymentMethodID: c.config.PaymentMethodID,
+		ServiceID:       serviceID,
+		Details: ShippingDetails{
+			Origin:      addressToLocation(req.ReturnerAddress),
+			Destination: addressToLocation(req.ReceiverAddress),
+			Packaging: PackagingInfo{
+				Type:     "package",
+				Packages: packagesToAPI(pkgs),
+			},
+		},
+		Sender:    contactToAPI(req.Returner),
+		Recipient: contactToAPI(req.Receiver),
+		Reference: req.RMANumber,
+		IsReturn:  true,
+	}
+
+	format := string(req.LabelFormat)
+	if format == "" {
+		format = "pdf"
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
+	if err != nil {
+		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	labelResp, err := c.apiClient.GetLabel(ctx, apiResp.ID, format)
+	if err != nil {
+		c.logger.Error("Freightcom API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Convert to shipper response
+	order := shipmentResponseToShipper(apiResp)
+	return &shipper.CreateReturnResponse{
+		OrderID:        order.OrderID,
+		TrackingNumber: order.TrackingNumber,
+		Carrier:        carrierName,
+		Status:         order.Status,
+		TotalCharged:   order.TotalCharged,
+		Label:          labelResponseToShipper(labelResp).Label,
+	}, nil
+}
+
+var _ shipper.Returner = (*Client)(nil)
+
 // ============================================================================
 // Conversion helpers: Shipper models -> API models
 // ============================================================================
@@ -211,6 +502,80 @@ func addressToLocation(addr shipper.Address) Location {
 	}
 }
 
+// applyOptions sets the shipping options on the request details.
+// Freightcom has no Saturday delivery option.
+func applyOptions(details *ShippingDetails, opts shipper.ShippingOptions, pkgs []shipper.Package) error {
+	if opts.SaturdayDelivery {
+		return shipper.NewUnsupportedOptionError(carrierName, shipper.OptionSaturdayDelivery)
+	}
+	if opts.SignatureRequired {
+		details.SignatureRequirement = "required"
+	}
+	if opts.InsuranceRequired {
+		value, err := shipper.InsuredValue(carrierName, pkgs)
+		if err != nil {
+			return err
+		}
+		details.Insurance = &Insurance{
+			Type:      "internal",
+			TotalCost: Amount{Currency: value.Currency, Value: value.Float64()},
+		}
+	}
+	if opts.ShipDate != nil {
+		details.ExpectedShipDate = &Date{
+			Year:  opts.ShipDate.Year(),
+			Month: int(opts.ShipDate.Month()),
+			Day:   opts.ShipDate.Day(),
+		}
+	}
+	return nil
+}
+
+// customsToAPI converts a customs declaration to the customs data Freightcom
+// rates and ships with.
+func customsToAPI(c *shipper.Customs) *CustomsData {
+	descriptions := make([]string, len(c.Items))
+	for i, item := range c.Items {
+		descriptions[i] = item.Description
+	}
+	return &CustomsData{
+		Description:     strings.Join(descriptions, ", "),
+		ReasonForExport: string(c.ReasonForExport),
+		Currency:        c.Currency,
+		Incoterms:       string(c.Incoterm),
+		Items:           customsItemsToAPI(c.Items),
+	}
+}
+
+// customsInvoiceToAPI converts a customs declaration to the commercial
+// invoice of a shipment, dated on the ship date.
+func customsInvoiceToAPI(c *shipper.Customs, date time.Time) *CustomsInvoice {
+	return &CustomsInvoice{
+		InvoiceNumber:           c.InvoiceNumber,
+		InvoiceDate:             date.Format("2006-01-02"),
+		Currency:                c.Currency,
+		ImporterTaxID:           c.ImporterTaxID,
+		ExporterTaxID:           c.ExporterTaxID,
+		ExportDeclarationNumber: c.ExportDeclarationNumber,
+		Items:                   customsItemsToAPI(c.Items),
+	}
+}
+
+func customsItemsToAPI(items []shipper.CustomsItem) []CustomsItem {
+	result := make([]CustomsItem, len(items))
+	for i, item := range items {
+		result[i] = CustomsItem{
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+			Value:         item.UnitValue.Float64(),
+			Weight:        packageUnits.Weight(item.UnitWeight, item.WeightUnit),
+			CountryOrigin: item.CountryOfOrigin,
+			HSCode:        item.HSCode,
+		}
+	}
+	return result
+}
+
 func contactToAPI(c shipper.Contact) Contact {
 	return Contact{
 		Name:    c.Name,
@@ -220,6 +585,17 @@ func contactToAPI(c shipper.Contact) Contact {
 	}
 }
 
+// pickupDetailsToAPI converts a pickup window to the local date and times
+// Freightcom expects.
+func pickupDetailsToAPI(w shipper.PickupWindow, instructions string) *PickupDetails {
+	return &PickupDetails{
+		Date:         w.ReadyAt.Format("2006-01-02"),
+		ReadyTime:    w.ReadyAt.Format("15:04"),
+		ClosingTime:  w.CloseAt.In(w.ReadyAt.Location()).Format("15:04"),
+		Instructions: instructions,
+	}
+}
+
 func packagesToAPI(pkgs []shipper.Package) []Package {
 	result := make([]Package, len(pkgs))
 	for i, p := range pkgs {
@@ -252,13 +628,14 @@ func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 		rates[i] = shipper.RateOption{
 			RateID:            r.ID,
 			Carrier:           carrierName,
+			ServiceID:         strconv.Itoa(r.ServiceID),
 			ServiceCode:       r.ServiceCode,
 			ServiceName:       r.ServiceName,
 			ServiceType:       mapServiceType(r.ServiceCode),
-			BaseRate:          shipper.Money{Amount: r.BaseRate, Currency: r.Currency},
-			FuelSurcharge:     shipper.Money{Amount: r.FuelSurcharge, Currency: r.Currency},
-			Taxes:             shipper.Money{Amount: r.TotalTax, Currency: r.Currency},
-			TotalPrice:        shipper.Money{Amount: r.TotalPrice, Currency: r.Currency},
+			BaseRate:          shipper.MoneyFromFloat(r.BaseRate, r.Currency),
+			FuelSurcharge:     shipper.MoneyFromFloat(r.FuelSurcharge, r.Currency),
+			Taxes:             shipper.MoneyFromFloat(r.TotalTax, r.Currency),
+			TotalPrice:        shipper.MoneyFromFloat(r.TotalPrice, r.Currency),
 			TransitDays:       r.TransitDays,
 			EstimatedDelivery: estimatedDelivery,
 			ExpiresAt:         expiresAt,
@@ -305,9 +682,10 @@ func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderRespo
 		Status:            mapStatus(resp.Status),
 		Carrier:           carrierName,
 		ServiceName:       resp.ServiceName,
-		TotalCharged:      shipper.Money{Amount: resp.TotalCharged, Currency: resp.Currency},
+		TotalCharged:      shipper.MoneyFromFloat(resp.TotalCharged, resp.Currency),
 		EstimatedDelivery: estimatedDelivery,
 		LabelURL:          labelURL,
+		PreviouslyCreated: resp.PreviouslyCreated,
 	}
 }
 
@@ -331,7 +709,8 @@ func labelResponseToShipper(resp *LabelResponse) *shipper.GetLabelResponse {
 func cancelResponseToShipper(resp *CancelResponse) *shipper.CancelOrderResponse {
 	var refundAmount *shipper.Money
 	if resp.RefundAmount > 0 {
-		refundAmount = &shipper.Money{Amount: resp.RefundAmount, Currency: resp.Currency}
+		refund := shipper.MoneyFromFloat(resp.RefundAmount, resp.Currency)
+		refundAmount = &refund
 	}
 
 	return &shipper.CancelOrderResponse{
@@ -342,16 +721,32 @@ func cancelResponseToShipper(resp *CancelResponse) *shipper.CancelOrderResponse
 	}
 }
 
+func trackingResponseToShipper(resp *TrackingResponse) *shipper.TrackResponse {
+	events := make([]shipper.TrackingEvent, len(resp.Events))
+	for i, e := range resp.Events {
+		timestamp, _ := time.Parse(time.RFC3339, e.Timestamp)
+		events[i] = shipper.TrackingEvent{
+			Timestamp:   timestamp,
+			Description: e.Description,
+			Location:    e.Location,
+			Status:      mapStatus(e.Status),
+			CarrierCode: e.Code,
+		}
+	}
+	shipper.SortEventsNewestFirst(events)
+
+	return &shipper.TrackResponse{
+		OrderID:        resp.ShipmentID,
+		TrackingNumber: resp.TrackingNumber,
+		Status:         mapStatus(resp.Status),
+		Events:         events,
+	}
+}
+
 // ============================================================================
 // Mapping helpers
 // ============================================================================
 
-func extractServiceID(rateID string) int {
-	// In production, this would parse the rate ID to get the service ID
-	// For now, default to a common service ID
-	return 101
-}
-
 func mapServiceType(code string) shipper.ServiceType {
 	switch code {
 	case "GROUND", "STANDARD", "FEDEX_GROUND", "UPS_GROUND":
@@ -371,31 +766,39 @@ func mapServiceType(code string) shipper.ServiceType {
 	}
 }
 
+// statuses maps Freightcom shipment and event statuses to shipment statuses.
+var statuses = map[string]shipper.ShipmentStatus{
+	"pending":          shipper.StatusPending,
+	"processing":       shipper.StatusPending,
+	"quoted":           shipper.StatusQuoted,
+	"confirmed":        shipper.StatusConfirmed,
+	"booked":           shipper.StatusConfirmed,
+	"complete":         shipper.StatusConfirmed,
+	"assigned":         shipper.StatusAssigned,
+	"picked_up":        shipper.StatusPickedUp,
+	"in_transit":       shipper.StatusInTransit,
+	"out_for_delivery": shipper.StatusOutForDelivery,
+	"delivered":        shipper.StatusDelivered,
+	"cancelled":        shipper.StatusCancelled,
+	"exception":        shipper.StatusException,
+	"error":            shipper.StatusException,
+	"failed":           shipper.StatusException,
+}
+
+// mapStatus returns the shipment status of a Freightcom status, pending if
+// the status is unknown.
 func mapStatus(status string) shipper.ShipmentStatus {
-	switch status {
-	case "pending", "processing":
-		return shipper.StatusPending
-	case "quoted":
-		return shipper.StatusQuoted
-	case "confirmed", "booked", "complete":
-		return shipper.StatusConfirmed
-	case "assigned":
-		return shipper.StatusAssigned
-	case "picked_up":
-		return shipper.StatusPickedUp
-	case "in_transit":
-		return shipper.StatusInTransit
-	case "out_for_delivery":
-		return shipper.StatusOutForDelivery
-	case "delivered":
-		return shipper.StatusDelivered
-	case "cancelled":
-		return shipper.StatusCancelled
-	case "exception", "error", "failed":
-		return shipper.StatusException
-	default:
-		return shipper.StatusPending
+	if s, ok := statuses[status]; ok {
+		return s
 	}
+	return shipper.StatusPending
+}
+
+func mapPickupStatus(status string) shipper.PickupStatus {
+	if status == "cancelled" {
+		return shipper.PickupCancelled
+	}
+	return shipper.PickupScheduled
 }
 
 func mapLabelFormat(format string) shipper.LabelFormat {
@@ -410,3 +813,12 @@ func mapLabelFormat(format string) shipper.LabelFormat {
 		return shipper.LabelPDF
 	}
 }
+
+// shipperUniqueID returns the unique ID of a shipment keyed by a
+// client-supplied key. Keys are scoped to a shipper while unique IDs are
+// scoped to our Freightcom account, so the ID hashes both: two shippers
+// using the same key must not get each other's shipment back.
+func shipperUniqueID(shipperID, key string) string {
+	sum := sha256.Sum256([]byte(shipperID + "\x00" + key))
+	return hex.EncodeToString(sum[:16])
+}
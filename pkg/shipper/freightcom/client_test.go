@@ -3,13 +3,18 @@ package freightcom_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tournevent/logistic/pkg/shipper"
 	"github.com/tournevent/logistic/pkg/shipper/freightcom"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/zap"
 )
 
@@ -56,6 +61,7 @@ func TestClient_GetQuote_Success(t *testing.T) {
 	assert.NotEmpty(t, resp.QuoteID)
 	assert.Len(t, resp.Rates, 3) // Mock returns 3 rates
 	assert.Equal(t, "freightcom", resp.Rates[0].Carrier)
+	assert.Equal(t, "101", resp.Rates[0].ServiceID)
 }
 
 func TestClient_GetQuote_APIError(t *testing.T) {
@@ -118,8 +124,9 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 	client := newTestClient(mockAPI)
 
 	req := &shipper.CreateOrderRequest{
-		RateID: "fc-rate-ground-123",
-		Sender: shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
+		RateID:    "fc-rate-ground-123",
+		ServiceID: "101",
+		Sender:    shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
 		SenderAddress: shipper.Address{
 			Line1:        "123 Main St",
 			City:         "Toronto",
@@ -150,6 +157,81 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 	assert.Equal(t, "freightcom", resp.Carrier)
 }
 
+func TestClient_CreateOrder_UsesRateServiceID(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var serviceID int
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		serviceID = req.ServiceID
+		return &freightcom.ShipmentResponse{ID: "fc-ship-123", Status: "booked"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	req := &shipper.CreateOrderRequest{
+		RateID:    "rate-1a2b3c4d",
+		ServiceID: "201",
+	}
+
+	ctx := context.Background()
+	_, err := client.CreateOrder(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, 201, serviceID)
+}
+
+func TestClient_CreateOrder_IdempotencyKey(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var uniqueIDs []string
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		uniqueIDs = append(uniqueIDs, req.UniqueID)
+		return &freightcom.ShipmentResponse{ID: "fc-ship-123", Status: "booked", PreviouslyCreated: len(uniqueIDs) > 1}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	req := &shipper.CreateOrderRequest{
+		ShipperID:      "shipper-123",
+		ServiceID:      "101",
+		Reference:      "INV-1001",
+		IdempotencyKey: "order-attempt-1",
+	}
+
+	ctx := context.Background()
+	first, err := client.CreateOrder(ctx, req)
+	require.NoError(t, err)
+	second, err := client.CreateOrder(ctx, req)
+	require.NoError(t, err)
+
+	require.Len(t, uniqueIDs, 2)
+	assert.Equal(t, uniqueIDs[0], uniqueIDs[1])
+	assert.False(t, first.PreviouslyCreated)
+	assert.True(t, second.PreviouslyCreated)
+	assert.Equal(t, first.OrderID, second.OrderID)
+
+	// Keys are scoped to the shipper
+	other := *req
+	other.ShipperID = "shipper-456"
+	_, err = client.CreateOrder(ctx, &other)
+	require.NoError(t, err)
+	require.Len(t, uniqueIDs, 3)
+	assert.NotEqual(t, uniqueIDs[0], uniqueIDs[2])
+	assert.NotContains(t, uniqueIDs[2], "order-attempt-1")
+}
+
+func TestClient_CreateOrder_InvalidServiceID(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.CreateOrderRequest{
+		RateID: "rate-1a2b3c4d",
+	}
+
+	ctx := context.Background()
+	_, err := client.CreateOrder(ctx, req)
+
+	var shipperErr *shipper.ShipperError
+	require.ErrorAs(t, err, &shipperErr)
+	assert.Equal(t, "invalid_service", shipperErr.Code)
+}
+
 func TestClient_GetLabel_Success(t *testing.T) {
 	mockAPI := freightcom.NewMockAPIClient()
 	client := newTestClient(mockAPI)
@@ -207,9 +289,342 @@ func TestClient_CancelOrder_CustomError(t *testing.T) {
 	assert.Contains(t, err.Error(), "cannot cancel")
 }
 
+func TestClient_GetQuote_RetryableAPIError(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		retryable  bool
+	}{
+		{http.StatusTooManyRequests, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusBadRequest, false},
+		{0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			mockAPI := freightcom.NewMockAPIClient()
+			mockAPI.OnGetRates = func(ctx context.Context, req *freightcom.RatesRequest) (*freightcom.RatesResponse, error) {
+				return nil, &freightcom.APIError{Code: "ERR", Message: "failed", StatusCode: tt.statusCode}
+			}
+
+			client := newTestClient(mockAPI)
+			req := &shipper.QuoteRequest{
+				Origin:      shipper.Address{City: "Toronto"},
+				Destination: shipper.Address{City: "Vancouver"},
+			}
+			_, err := client.GetQuote(context.Background(), req)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.retryable, shipper.IsRetryable(err))
+		})
+	}
+}
+
+func TestClient_Track_Success(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.TrackRequest{
+		OrderID: "fc-ship-123",
+	}
+
+	ctx := context.Background()
+	resp, err := client.Track(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "fc-ship-123", resp.OrderID)
+	assert.Equal(t, shipper.StatusInTransit, resp.Status)
+	require.Len(t, resp.Events, 2)
+	// Events are returned newest first
+	assert.Equal(t, shipper.StatusInTransit, resp.Events[0].Status)
+	assert.Equal(t, shipper.StatusPickedUp, resp.Events[1].Status)
+	assert.Equal(t, "PU", resp.Events[1].CarrierCode)
+}
+
+func TestClient_Track_APIError(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	mockAPI.SimulateErrors = true
+
+	client := newTestClient(mockAPI)
+
+	ctx := context.Background()
+	_, err := client.Track(ctx, &shipper.TrackRequest{OrderID: "fc-ship-123"})
+
+	assert.Error(t, err)
+}
+
 func TestClient_Name(t *testing.T) {
 	mockAPI := freightcom.NewMockAPIClient()
 	client := newTestClient(mockAPI)
 
 	assert.Equal(t, "freightcom", client.Name())
 }
+
+func TestClient_GetQuote_RecordsSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	mockAPI := freightcom.NewMockAPIClient()
+	mockAPI.SimulateErrors = true
+	client := freightcom.NewWithAPIClient(freightcom.Config{}, mockAPI, otelzap.New(zap.NewNop()), tracer)
+
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{{Weight: 1}, {Weight: 2}},
+	})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "freightcom.get_quote", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Contains(t, span.Attributes(), shipper.AttrCarrier.String("freightcom"))
+	assert.Contains(t, span.Attributes(), shipper.AttrPackageCount.Int(2))
+	assert.Contains(t, span.Attributes(), shipper.AttrErrorCode.String("MOCK_ERROR"))
+}
+
+func TestClient_CreateOrder_Options(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var captured *freightcom.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		captured = req
+		return &freightcom.ShipmentResponse{ID: "fc-ship-1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	shipDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "101",
+		Packages:  []shipper.Package{{Weight: 1, DeclaredValue: shipper.Money{Minor: 8000, Currency: "USD"}}},
+		Options: shipper.ShippingOptions{
+			SignatureRequired: true,
+			InsuranceRequired: true,
+			ShipDate:          &shipDate,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, "required", captured.Details.SignatureRequirement)
+	assert.Equal(t, &freightcom.Insurance{
+		Type:      "internal",
+		TotalCost: freightcom.Amount{Currency: "USD", Value: 80},
+	}, captured.Details.Insurance)
+	assert.Equal(t, &freightcom.Date{Year: 2025, Month: 3, Day: 14}, captured.Details.ExpectedShipDate)
+}
+
+func TestClient_GetQuote_SaturdayDeliveryUnsupported(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Options: shipper.ShippingOptions{SaturdayDelivery: true},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrUnsupportedOption)
+	assert.Contains(t, err.Error(), shipper.OptionSaturdayDelivery)
+}
+
+func TestClient_GetQuote_ConvertsImperialPackages(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var captured *freightcom.RatesRequest
+	mockAPI.OnGetRates = func(ctx context.Context, req *freightcom.RatesRequest) (*freightcom.RatesResponse, error) {
+		captured = req
+		return &freightcom.RatesResponse{Status: "complete", Rates: []freightcom.Rate{{ID: "rate-1", ServiceID: 1}}}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 10, Length: 12, Width: 8, Height: 6, WeightUnit: shipper.WeightLB, DimensionUnit: shipper.DimensionIN},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, freightcom.Package{
+		Length:   30.48,
+		Width:    20.32,
+		Height:   15.24,
+		Weight:   4.54, // 4.5359 kg
+		Quantity: 1,
+	}, captured.Details.Packaging.Packages[0])
+
+	require.Len(t, resp.Rates, 1)
+	assert.Equal(t, 4.54, resp.Rates[0].BillableWeight)
+	assert.Equal(t, shipper.WeightKG, resp.Rates[0].BillableWeightUnit)
+}
+
+func TestClient_CreateOrder_Pickup(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var details *freightcom.PickupDetails
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		details = req.PickupDetails
+		return &freightcom.ShipmentResponse{ID: "fc-ship-123", Status: "booked"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	req := &shipper.CreateOrderRequest{
+		RateID:        "rate-1a2b3c4d",
+		ServiceID:     "101",
+		SenderAddress: shipper.Address{Instructions: "Loading dock at rear"},
+		Pickup:        &shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour).UTC()},
+	}
+
+	_, err := client.CreateOrder(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, &freightcom.PickupDetails{
+		Date:         "2025-03-10",
+		ReadyTime:    "13:00",
+		ClosingTime:  "17:00",
+		Instructions: "Loading dock at rear",
+	}, details)
+}
+
+func TestClient_GetPickupAvailability(t *testing.T) {
+	client := newTestClient(freightcom.NewMockAPIClient())
+
+	monday, err := client.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{
+		Date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.True(t, monday.Available)
+
+	saturday, err := client.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{
+		Date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.False(t, saturday.Available)
+}
+
+func TestClient_SchedulePickup_Success(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var shipmentID string
+	mockAPI.OnSchedulePickup = func(ctx context.Context, id string, req *freightcom.PickupDetails) (*freightcom.PickupResponse, error) {
+		shipmentID = id
+		return &freightcom.PickupResponse{ShipmentID: id, Status: "scheduled", PickupDetails: *req}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	window := shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)}
+	resp, err := client.SchedulePickup(context.Background(), &shipper.SchedulePickupRequest{
+		OrderIDs: []string{"fc-ship-123"},
+		Window:   window,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "fc-ship-123", shipmentID)
+	assert.Equal(t, &shipper.SchedulePickupResponse{
+		PickupID: "fc-ship-123",
+		Carrier:  "freightcom",
+		Status:   shipper.PickupScheduled,
+		Window:   window,
+	}, resp)
+}
+
+func TestClient_SchedulePickup_RequiresOneOrder(t *testing.T) {
+	client := newTestClient(freightcom.NewMockAPIClient())
+
+	_, err := client.SchedulePickup(context.Background(), &shipper.SchedulePickupRequest{
+		OrderIDs: []string{"fc-ship-123", "fc-ship-456"},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidPickup)
+}
+
+func TestClient_CancelPickup_Success(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CancelPickup(context.Background(), &shipper.CancelPickupRequest{PickupID: "fc-ship-123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "fc-ship-123", resp.PickupID)
+	assert.Equal(t, shipper.PickupCancelled, resp.Status)
+}
+
+func TestClient_CreateReturn_Success(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var captured *freightcom.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		captured = req
+		return &freightcom.ShipmentResponse{ID: "fc-ret-1", TrackingNumbers: []string{"FC-RET-1"}}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CreateReturn(context.Background(), &shipper.CreateReturnRequest{
+		ServiceID:       "123",
+		Returner:        shipper.Contact{Name: "John Smith"},
+		ReturnerAddress: shipper.Address{City: "Montreal", PostalCode: "H2X1Y4", CountryCode: "CA"},
+		Receiver:        shipper.Contact{Company: "Acme Inc"},
+		ReceiverAddress: shipper.Address{City: "Toronto", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Packages:        []shipper.Package{{Weight: 2, Length: 30, Width: 20, Height: 10}},
+		RMANumber:       "RMA-1001",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "fc-ret-1", resp.OrderID)
+	assert.Equal(t, "FC-RET-1", resp.TrackingNumber)
+
+	require.NotNil(t, captured)
+	assert.True(t, captured.IsReturn)
+	assert.Equal(t, 123, captured.ServiceID)
+	assert.Equal(t, "RMA-1001", captured.Reference)
+	assert.Equal(t, "H2X1Y4", captured.Details.Origin.PostalCode)
+	assert.Equal(t, "M5V1A1", captured.Details.Destination.PostalCode)
+}
+
+func TestClient_CreateOrder_Customs(t *testing.T) {
+	mockAPI := freightcom.NewMockAPIClient()
+	var captured *freightcom.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *freightcom.ShipmentRequest) (*freightcom.ShipmentResponse, error) {
+		captured = req
+		return &freightcom.ShipmentResponse{ID: "fc-ship-1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	shipDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "101",
+		Packages:  []shipper.Package{{Weight: 2}},
+		Options:   shipper.ShippingOptions{ShipDate: &shipDate},
+		Customs: &shipper.Customs{
+			ReasonForExport:         shipper.ExportGift,
+			Incoterm:                shipper.IncotermDDP,
+			Currency:                "USD",
+			InvoiceNumber:           "INV-1001",
+			ImporterTaxID:           "12-3456789",
+			ExportDeclarationNumber: "B13A-123",
+			Items: []shipper.CustomsItem{
+				{Description: "Scarf", Quantity: 2, UnitValue: shipper.Money{Minor: 1500, Currency: "USD"}, UnitWeight: 0.2, CountryOfOrigin: "CA"},
+				{Description: "Mug", Quantity: 1, UnitValue: shipper.Money{Minor: 1200, Currency: "USD"}, UnitWeight: 1, WeightUnit: shipper.WeightLB, CountryOfOrigin: "CN", HSCode: "6912.00"},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	items := []freightcom.CustomsItem{
+		{Description: "Scarf", Quantity: 2, Value: 15, Weight: 0.2, CountryOrigin: "CA"},
+		{Description: "Mug", Quantity: 1, Value: 12, Weight: 0.46, CountryOrigin: "CN", HSCode: "6912.00"},
+	}
+	assert.Equal(t, &freightcom.CustomsData{
+		Description:     "Scarf, Mug",
+		ReasonForExport: "gift",
+		Currency:        "USD",
+		Incoterms:       "DDP",
+		Items:           items,
+	}, captured.Details.CustomsData)
+	assert.Equal(t, &freightcom.CustomsInvoice{
+		InvoiceNumber:           "INV-1001",
+		InvoiceDate:             "2025-03-14",
+		Currency:                "USD",
+		ImporterTaxID:           "12-3456789",
+		ExportDeclarationNumber: "B13A-123",
+		Items:                   items,
+	}, captured.CustomsInvoice)
+}
@@ -0,0 +1,82 @@
+package freightcom
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of a tracking push body,
+// keyed with the webhook secret configured in the Freightcom portal.
+const SignatureHeader = "X-Freightcom-Signature"
+
+// TrackingPush is the body of a tracking push.
+// POST to the webhook URL configured in the Freightcom portal
+type TrackingPush struct {
+	Type           string          `json:"type"` // "shipment.tracking_updated"
+	ShipmentID     string          `json:"shipment_id"`
+	TrackingNumber string          `json:"tracking_number"`
+	Events         []TrackingEvent `json:"events"`
+}
+
+// ParseTrackingPush verifies the signature of a Freightcom tracking push
+// and returns its events.
+func (c *Client) ParseTrackingPush(header http.Header, body []byte) ([]shipper.TrackingUpdate, error) {
+	if !c.validPushSignature(header.Get(SignatureHeader), body) {
+		return nil, fmt.Errorf("%w: invalid %s", shipper.ErrAuthenticationFailed, SignatureHeader)
+	}
+
+	var push TrackingPush
+	if err := json.Unmarshal(body, &push); err != nil {
+		return nil, fmt.Errorf("%w: %v", shipper.ErrInvalidPush, err)
+	}
+	if push.ShipmentID == "" && push.TrackingNumber == "" {
+		return nil, fmt.Errorf("%w: missing shipment_id and tracking_number", shipper.ErrInvalidPush)
+	}
+
+	// Events of unknown types say nothing about the shipment status, so
+	// they are left out rather than reported as pending
+	updates := make([]shipper.TrackingUpdate, 0, len(push.Events))
+	for _, e := range push.Events {
+		status, ok := statuses[e.Status]
+		if !ok {
+			continue
+		}
+		timestamp, _ := time.Parse(time.RFC3339, e.Timestamp)
+		updates = append(updates, shipper.TrackingUpdate{
+			OrderID:        push.ShipmentID,
+			TrackingNumber: push.TrackingNumber,
+			Event: shipper.TrackingEvent{
+				Timestamp:   timestamp,
+				Description: e.Description,
+				Location:    e.Location,
+				Status:      status,
+				CarrierCode: e.Code,
+			},
+		})
+	}
+	return updates, nil
+}
+
+var _ shipper.PushTracker = (*Client)(nil)
+
+// validPushSignature reports whether signature is the HMAC of body under
+// the webhook secret. Without a secret no push is trusted.
+func (c *Client) validPushSignature(signature string, body []byte) bool {
+	if c.config.WebhookSecret == "" {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	h := hmac.New(sha256.New, []byte(c.config.WebhookSecret))
+	h.Write(body)
+	return hmac.Equal(got, h.Sum(nil))
+}
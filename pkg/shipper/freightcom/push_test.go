@@ -0,0 +1,103 @@
+package freightcom_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/freightcom"
+	"github.com/uptrace/opentelemetry-go-extra/otelzap"
+	"go.uber.org/zap"
+)
+
+const testWebhookSecret = "fc-webhook-secret"
+
+const trackingPush = `{
+	"type": "shipment.tracking_updated",
+	"shipment_id": "fc-ship-123",
+	"tracking_number": "1Z999AA10123456784",
+	"events": [
+		{"timestamp": "2026-10-14T09:30:00Z", "description": "Out for delivery", "location": "Vancouver, BC", "status": "out_for_delivery", "code": "OD"},
+		{"timestamp": "2026-10-14T14:05:00Z", "description": "Delivered", "location": "Vancouver, BC", "status": "delivered", "code": "DL"}
+	]
+}`
+
+func signPush(secret, body string) http.Header {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(body))
+	header := http.Header{}
+	header.Set(freightcom.SignatureHeader, hex.EncodeToString(h.Sum(nil)))
+	return header
+}
+
+func newPushClient(secret string) *freightcom.Client {
+	cfg := freightcom.Config{WebhookSecret: secret}
+	return freightcom.NewWithAPIClient(cfg, freightcom.NewMockAPIClient(), otelzap.New(zap.NewNop()), nil)
+}
+
+func TestClient_ParseTrackingPush(t *testing.T) {
+	client := newPushClient(testWebhookSecret)
+
+	updates, err := client.ParseTrackingPush(signPush(testWebhookSecret, trackingPush), []byte(trackingPush))
+
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	assert.Equal(t, "fc-ship-123", updates[0].OrderID)
+	assert.Equal(t, "1Z999AA10123456784", updates[0].TrackingNumber)
+	assert.Equal(t, shipper.StatusOutForDelivery, updates[0].Event.Status)
+	assert.Equal(t, shipper.StatusDelivered, updates[1].Event.Status)
+	assert.Equal(t, "DL", updates[1].Event.CarrierCode)
+	assert.Equal(t, "Vancouver, BC", updates[1].Event.Location)
+	assert.Equal(t, time.Date(2026, 10, 14, 14, 5, 0, 0, time.UTC), updates[1].Event.Timestamp)
+}
+
+func TestClient_ParseTrackingPush_SkipsUnknownEvents(t *testing.T) {
+	client := newPushClient(testWebhookSecret)
+	body := `{"shipment_id": "fc-ship-123", "events": [
+		{"timestamp": "2026-10-14T14:05:00Z", "status": "delivered"},
+		{"timestamp": "2026-10-14T15:00:00Z", "status": "survey_sent"}
+	]}`
+
+	updates, err := client.ParseTrackingPush(signPush(testWebhookSecret, body), []byte(body))
+
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Equal(t, shipper.StatusDelivered, updates[0].Event.Status)
+}
+
+func TestClient_ParseTrackingPush_InvalidSignature(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		header http.Header
+	}{
+		{"wrong secret", testWebhookSecret, signPush("other-secret", trackingPush)},
+		{"missing signature", testWebhookSecret, http.Header{}},
+		{"no secret configured", "", signPush("", trackingPush)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newPushClient(tt.secret)
+
+			_, err := client.ParseTrackingPush(tt.header, []byte(trackingPush))
+
+			assert.ErrorIs(t, err, shipper.ErrAuthenticationFailed)
+		})
+	}
+}
+
+func TestClient_ParseTrackingPush_InvalidBody(t *testing.T) {
+	client := newPushClient(testWebhookSecret)
+
+	for _, body := range []string{`{"events": [`, `{"events": []}`} {
+		_, err := client.ParseTrackingPush(signPush(testWebhookSecret, body), []byte(body))
+
+		assert.ErrorIs(t, err, shipper.ErrInvalidPush)
+	}
+}
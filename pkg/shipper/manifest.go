@@ -0,0 +1,35 @@
+package shipper
+
+import (
+	"context"
+	"time"
+)
+
+// Manifester is implemented by carriers that require the day's shipments
+// to be transmitted before they are collected. Find it with As[Manifester].
+type Manifester interface {
+	// Manifest transmits the open shipments sent from an origin on a ship
+	// date and returns the manifests to hand to the driver. Transmitted
+	// shipments can no longer be voided.
+	Manifest(ctx context.Context, req *ManifestRequest) (*ManifestResponse, error)
+}
+
+// ManifestRequest is the request for transmitting shipments.
+type ManifestRequest struct {
+	ShipperID string    // Shipper whose shipments are transmitted
+	Origin    Address   // Sender address of the shipments
+	ShipDate  time.Time // Only the date is used
+}
+
+// ManifestResponse is the response from transmitting shipments.
+type ManifestResponse struct {
+	Carrier   string
+	Manifests []Manifest // A transmit may produce several manifests
+}
+
+// Manifest is a carrier's record of the shipments handed over in one
+// transmit.
+type Manifest struct {
+	ManifestID string
+	Document   Label // Printable manifest, usually a PDF
+}
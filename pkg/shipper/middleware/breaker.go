@@ -0,0 +1,291 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// StateClosed lets all calls through.
+	StateClosed State = iota
+	// StateHalfOpen lets a single probe call through to test the carrier.
+	StateHalfOpen
+	// StateOpen rejects all calls until the open timeout elapses.
+	StateOpen
+)
+
+// String returns the state name.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig controls when a carrier's circuit opens and closes.
+type BreakerConfig struct {
+	FailureThreshold int           // Consecutive failures that open the circuit
+	OpenTimeout      time.Duration // Time the circuit stays open before a probe is let through
+}
+
+// DefaultBreakerConfig returns the circuit breaker settings used when none
+// are configured.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+}
+
+// CircuitBreaker returns a middleware that stops calling a carrier after
+// repeated failures. While the circuit is open, calls fail immediately with
+// an error wrapping shipper.ErrServiceUnavailable.
+//
+// Only retryable errors (see shipper.IsRetryable) count as failures, so
+// rejected requests such as invalid addresses do not open the circuit.
+// Each wrapped shipper gets its own breaker.
+func CircuitBreaker(cfg BreakerConfig, observer Observer) Middleware {
+	return func(next shipper.Shipper) shipper.Shipper {
+		b := &breaker{
+			next:     next,
+			cfg:      cfg,
+			observer: observerOrNop(observer),
+			now:      time.Now,
+		}
+		b.observer.RecordCircuitState(next.Name(), int(StateClosed))
+		return b
+	}
+}
+
+type breaker struct {
+	next     shipper.Shipper
+	cfg      BreakerConfig
+	observer Observer
+	now      func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *breaker) Name() string {
+	return b.next.Name()
+}
+
+func (b *breaker) Unwrap() shipper.Shipper {
+	return b.next
+}
+
+func (b *breaker) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	return guard(b, func() (*shipper.QuoteResponse, error) {
+		return b.next.GetQuote(ctx, req)
+	})
+}
+
+func (b *breaker) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	return guard(b, func() (*shipper.CreateOrderResponse, error) {
+		return b.next.CreateOrder(ctx, req)
+	})
+}
+
+func (b *breaker) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	return guard(b, func() (*shipper.GetLabelResponse, error) {
+		return b.next.GetLabel(ctx, req)
+	})
+}
+
+func (b *breaker) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	return guard(b, func() (*shipper.CancelOrderResponse, error) {
+		return b.next.CancelOrder(ctx, req)
+	})
+}
+
+func (b *breaker) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	return guard(b, func() (*shipper.TrackResponse, error) {
+		return b.next.Track(ctx, req)
+	})
+}
+
+// As provides the pickup scheduling, manifesting and returns of the wrapped
+// shipper behind the circuit breaker, for shipper.As. Tracking pushes are
+// parsed without calling the carrier and are found through Unwrap.
+func (b *breaker) As(target any) bool {
+	switch target := target.(type) {
+	case *shipper.PickupScheduler:
+		next, ok := shipper.As[shipper.PickupScheduler](b.next)
+		if ok {
+			*target = breakerPickups{b: b, next: next}
+		}
+		return ok
+	case *shipper.Manifester:
+		next, ok := shipper.As[shipper.Manifester](b.next)
+		if ok {
+			*target = breakerManifester{b: b, next: next}
+		}
+		return ok
+	case *shipper.Returner:
+		next, ok := shipper.As[shipper.Returner](b.next)
+		if ok {
+			*target = breakerReturner{b: b, next: next}
+		}
+		return ok
+	default:
+		return false
+	}
+}
+
+// breakerPickups is the pickup scheduling of a breaker's shipper.
+type breakerPickups struct {
+	b    *breaker
+	next shipper.PickupScheduler
+}
+
+func (p breakerPickups) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	return guard(p.b, func() (*shipper.PickupAvailabilityResponse, error) {
+		return p.next.GetPickupAvailability(ctx, req)
+	})
+}
+
+func (p breakerPickups) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	return guard(p.b, func() (*shipper.SchedulePickupResponse, error) {
+		return p.next.SchedulePickup(ctx, req)
+	})
+}
+
+func (p breakerPickups) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	return guard(p.b, func() (*shipper.CancelPickupResponse, error) {
+		return p.next.CancelPickup(ctx, req)
+	})
+}
+
+// breakerManifester is the manifesting of a breaker's shipper.
+type breakerManifester struct {
+	b    *breaker
+	next shipper.Manifester
+}
+
+func (m breakerManifester) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	return guard(m.b, func() (*shipper.ManifestResponse, error) {
+		return m.next.Manifest(ctx, req)
+	})
+}
+
+// breakerReturner is the returns of a breaker's shipper.
+type breakerReturner struct {
+	b    *breaker
+	next shipper.Returner
+}
+
+func (r breakerReturner) CreateReturn(ctx context.Context, req *shipper.CreateReturnRequest) (*shipper.CreateReturnResponse, error) {
+	return guard(r.b, func() (*shipper.CreateReturnResponse, error) {
+		return r.next.CreateReturn(ctx, req)
+	})
+}
+
+// guard runs fn if the circuit allows it and records the outcome.
+func guard[R any](b *breaker, fn func() (R, error)) (R, error) {
+	if err := b.allow(); err != nil {
+		var zero R
+		return zero, err
+	}
+	resp, err := fn()
+	b.record(err)
+	return resp, err
+}
+
+// allow reports whether a call may proceed, moving an open circuit to
+// half-open once the open timeout has elapsed.
+func (b *breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return b.openError()
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return b.openError()
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// record updates the circuit with the outcome of a call.
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if inconclusive(err) {
+		// No carrier answer was seen: the circuit stays as it is, and a
+		// half-open circuit lets the next call probe again
+		return
+	}
+	if !isFailure(err) {
+		b.failures = 0
+		if b.state != StateClosed {
+			b.setState(StateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.openedAt = b.now()
+		b.setState(StateOpen)
+	}
+}
+
+// setState changes the state and reports it. Callers must hold b.mu.
+func (b *breaker) setState(state State) {
+	b.state = state
+	b.observer.RecordCircuitState(b.next.Name(), int(state))
+}
+
+func (b *breaker) openError() error {
+	return shipper.NewShipperError(b.next.Name(), "circuit_open", "carrier temporarily unavailable after repeated failures").
+		WithCause(shipper.ErrServiceUnavailable)
+}
+
+// inconclusive reports whether a call ended without a carrier answer:
+// calls abandoned by the caller and rate limit rejections say nothing
+// about the carrier's health.
+func inconclusive(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, shipper.ErrRateLimitExceeded)
+}
+
+// isFailure reports whether err indicates the carrier is unhealthy.
+func isFailure(err error) bool {
+	return err != nil && shipper.IsRetryable(err)
+}
+
+// Ensure breaker implements Shipper interface
+var (
+	_ shipper.Shipper         = (*breaker)(nil)
+	_ shipper.PickupScheduler = breakerPickups{}
+	_ shipper.Manifester      = breakerManifester{}
+	_ shipper.Returner        = breakerReturner{}
+)
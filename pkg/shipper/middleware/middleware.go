@@ -0,0 +1,51 @@
+// Package middleware provides decorators that add cross-cutting behaviour,
+// such as retries and circuit breaking, to any shipper.Shipper.
+package middleware
+
+import "github.com/tournevent/logistic/pkg/shipper"
+
+// Middleware wraps a shipper with additional behaviour.
+type Middleware func(shipper.Shipper) shipper.Shipper
+
+// Chain wraps s with the given middlewares. The first middleware is the
+// outermost, so Chain(s, Retry(...), CircuitBreaker(...)) retries calls
+// that each pass through the circuit breaker.
+func Chain(s shipper.Shipper, middlewares ...Middleware) shipper.Shipper {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		s = middlewares[i](s)
+	}
+	return s
+}
+
+// Observer receives retry and circuit breaker events.
+// telemetry.Metrics implements it.
+type Observer interface {
+	// RecordRetry is called before an operation is attempted again.
+	RecordRetry(operation, carrier string)
+
+	// RecordCircuitState is called whenever a carrier's circuit changes state.
+	RecordCircuitState(carrier string, state int)
+}
+
+type nopObserver struct{}
+
+func (nopObserver) RecordRetry(operation, carrier string)        {}
+func (nopObserver) RecordCircuitState(carrier string, state int) {}
+
+func observerOrNop(o Observer) Observer {
+	if o == nil {
+		return nopObserver{}
+	}
+	return o
+}
+
+// Operation names reported to the Observer, matching the resolver metrics.
+const (
+	opGetQuote    = "get_quote"
+	opCreateOrder = "create_order"
+	opGetLabel    = "get_label"
+	opCancelOrder = "cancel_order"
+	opTrack       = "track_shipment"
+
+	opGetPickupAvailability = "get_pickup_availability"
+)
@@ -0,0 +1,444 @@
+package middleware_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/middleware"
+	"github.com/tournevent/logistic/pkg/shipper/mock"
+)
+
+var (
+	errTransient = shipper.NewShipperError("fake", "HTTP_503", "Service Unavailable").WithRetryable(true)
+	errRejected  = shipper.NewShipperError("fake", "INVALID_ADDRESS", "Bad address")
+)
+
+// fakeShipper fails each call with the next scripted error, then succeeds.
+type fakeShipper struct {
+	mu    sync.Mutex
+	errs  []error
+	calls int
+}
+
+func (f *fakeShipper) next() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeShipper) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *fakeShipper) Name() string { return "fake" }
+
+func (f *fakeShipper) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.QuoteResponse{QuoteID: "quote-1"}, nil
+}
+
+func (f *fakeShipper) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.CreateOrderResponse{OrderID: "order-1"}, nil
+}
+
+func (f *fakeShipper) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.GetLabelResponse{OrderID: req.OrderID}, nil
+}
+
+func (f *fakeShipper) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.CancelOrderResponse{OrderID: req.OrderID}, nil
+}
+
+func (f *fakeShipper) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.TrackResponse{OrderID: req.OrderID}, nil
+}
+
+// recordingObserver collects the events reported by the middlewares.
+type recordingObserver struct {
+	mu      sync.Mutex
+	retries []string
+	states  []middleware.State
+}
+
+func (o *recordingObserver) RecordRetry(operation, carrier string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.retries = append(o.retries, operation)
+}
+
+func (o *recordingObserver) RecordCircuitState(carrier string, state int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.states = append(o.states, middleware.State(state))
+}
+
+func fastRetry() middleware.RetryConfig {
+	return middleware.RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+	}
+}
+
+func TestRetry_RetriesTransientErrors(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient}}
+	observer := &recordingObserver{}
+	s := middleware.Retry(fastRetry(), observer)(fake)
+
+	resp, err := s.GetQuote(context.Background(), &shipper.QuoteRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "quote-1", resp.QuoteID)
+	assert.Equal(t, 3, fake.Calls())
+	assert.Equal(t, []string{"get_quote", "get_quote"}, observer.retries)
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient, errTransient, errTransient}}
+	s := middleware.Retry(fastRetry(), nil)(fake)
+
+	_, err := s.Track(context.Background(), &shipper.TrackRequest{OrderID: "order-1"})
+
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 3, fake.Calls())
+}
+
+func TestRetry_DoesNotRetryPermanentErrors(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errRejected}}
+	s := middleware.Retry(fastRetry(), nil)(fake)
+
+	_, err := s.GetLabel(context.Background(), &shipper.GetLabelRequest{OrderID: "order-1"})
+
+	assert.ErrorIs(t, err, errRejected)
+	assert.Equal(t, 1, fake.Calls())
+}
+
+// dedupingShipper is a fakeShipper whose carrier deduplicates CreateOrder
+// on the idempotency key.
+type dedupingShipper struct {
+	*fakeShipper
+}
+
+func (d dedupingShipper) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{DedupesCreateOrder: true}
+}
+
+func TestRetry_CreateOrderRequiresIdempotencyKey(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient}}
+	s := middleware.Retry(fastRetry(), nil)(dedupingShipper{fake})
+
+	_, err := s.CreateOrder(context.Background(), &shipper.CreateOrderRequest{})
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, fake.Calls())
+
+	fake = &fakeShipper{errs: []error{errTransient}}
+	s = middleware.Retry(fastRetry(), nil)(dedupingShipper{fake})
+
+	resp, err := s.CreateOrder(context.Background(), &shipper.CreateOrderRequest{IdempotencyKey: "key-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", resp.OrderID)
+	assert.Equal(t, 2, fake.Calls())
+}
+
+func TestRetry_CreateOrderRequiresDedupingCarrier(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient}}
+	s := middleware.Retry(fastRetry(), nil)(fake)
+
+	_, err := s.CreateOrder(context.Background(), &shipper.CreateOrderRequest{IdempotencyKey: "key-1"})
+
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, fake.Calls())
+}
+
+func TestRetry_CancelOrderNotRetried(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient}}
+	s := middleware.Retry(fastRetry(), nil)(fake)
+
+	_, err := s.CancelOrder(context.Background(), &shipper.CancelOrderRequest{OrderID: "order-1"})
+
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, fake.Calls())
+}
+
+func TestRetry_StopsAtContextDeadline(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient}}
+	cfg := middleware.RetryConfig{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Second}
+	s := middleware.Retry(cfg, nil)(fake)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := s.GetQuote(ctx, &shipper.QuoteRequest{})
+
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, fake.Calls())
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient, errTransient}}
+	observer := &recordingObserver{}
+	s := middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Hour}, observer)(fake)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		_, err := s.GetQuote(ctx, &shipper.QuoteRequest{})
+		assert.ErrorIs(t, err, errTransient)
+	}
+
+	_, err := s.GetQuote(ctx, &shipper.QuoteRequest{})
+
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+	assert.False(t, shipper.IsRetryable(err))
+	assert.Equal(t, 3, fake.Calls())
+	assert.Equal(t, []middleware.State{middleware.StateClosed, middleware.StateOpen}, observer.states)
+}
+
+func TestCircuitBreaker_IgnoresPermanentErrors(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errRejected, errRejected, errRejected}}
+	s := middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour}, nil)(fake)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		_, err := s.GetLabel(ctx, &shipper.GetLabelRequest{OrderID: "order-1"})
+		assert.ErrorIs(t, err, errRejected)
+	}
+
+	_, err := s.GetLabel(ctx, &shipper.GetLabelRequest{OrderID: "order-1"})
+	assert.NoError(t, err)
+}
+
+func TestCircuitBreaker_IgnoresRateLimits(t *testing.T) {
+	limited := shipper.NewRateLimitError("fake", time.Second)
+	fake := &fakeShipper{errs: []error{limited, limited}}
+	s := middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Hour}, nil)(fake)
+
+	ctx := context.Background()
+	_, err := s.GetQuote(ctx, &shipper.QuoteRequest{})
+	assert.ErrorIs(t, err, shipper.ErrRateLimitExceeded)
+
+	_, err = s.GetQuote(ctx, &shipper.QuoteRequest{})
+	assert.ErrorIs(t, err, shipper.ErrRateLimitExceeded)
+	assert.Equal(t, 2, fake.Calls())
+}
+
+func TestRetry_HonorsRetryAfter(t *testing.T) {
+	fake := &fakeShipper{errs: []error{shipper.NewRateLimitError("fake", 50*time.Millisecond)}}
+	s := middleware.Retry(fastRetry(), nil)(fake)
+
+	start := time.Now()
+	_, err := s.GetQuote(context.Background(), &shipper.QuoteRequest{})
+
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, 2, fake.Calls())
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient}}
+	observer := &recordingObserver{}
+	s := middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 1, OpenTimeout: 20 * time.Millisecond}, observer)(fake)
+
+	ctx := context.Background()
+	_, err := s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, errTransient)
+
+	// Failed probe reopens the circuit
+	time.Sleep(25 * time.Millisecond)
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, errTransient)
+
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+
+	// Successful probe closes it
+	time.Sleep(25 * time.Millisecond)
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	require.NoError(t, err)
+
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []middleware.State{
+		middleware.StateClosed,
+		middleware.StateOpen,
+		middleware.StateHalfOpen,
+		middleware.StateOpen,
+		middleware.StateHalfOpen,
+		middleware.StateClosed,
+	}, observer.states)
+}
+
+func TestCircuitBreaker_CancelledProbe(t *testing.T) {
+	limited := shipper.NewRateLimitError("fake", time.Second)
+	fake := &fakeShipper{errs: []error{errTransient, context.Canceled, limited, errTransient}}
+	observer := &recordingObserver{}
+	s := middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 1, OpenTimeout: 20 * time.Millisecond}, observer)(fake)
+
+	ctx := context.Background()
+	_, err := s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, errTransient)
+
+	// Probes without a carrier answer leave the circuit half-open, and the
+	// next call probes again
+	time.Sleep(25 * time.Millisecond)
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, shipper.ErrRateLimitExceeded)
+
+	// Until one fails
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, errTransient)
+	_, err = s.Track(ctx, &shipper.TrackRequest{})
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+
+	assert.Equal(t, 4, fake.Calls())
+	assert.Equal(t, []middleware.State{
+		middleware.StateClosed,
+		middleware.StateOpen,
+		middleware.StateHalfOpen,
+		middleware.StateOpen,
+	}, observer.states)
+}
+
+func TestChain_RetryStopsWhenCircuitOpens(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient, errTransient}}
+	s := middleware.Chain(fake,
+		middleware.Retry(fastRetry(), nil),
+		middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour}, nil),
+	)
+
+	_, err := s.GetQuote(context.Background(), &shipper.QuoteRequest{})
+
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+	assert.Equal(t, 2, fake.Calls())
+	assert.Equal(t, "fake", s.Name())
+}
+
+func TestState_String(t *testing.T) {
+	assert.Equal(t, "closed", middleware.StateClosed.String())
+	assert.Equal(t, "half_open", middleware.StateHalfOpen.String())
+	assert.Equal(t, "open", middleware.StateOpen.String())
+}
+
+func TestChain_ExposesWrappedCapabilities(t *testing.T) {
+	carrier := mock.New("mock")
+	wrapped := middleware.Chain(carrier,
+		middleware.Retry(middleware.DefaultRetryConfig(), nil),
+		middleware.CircuitBreaker(middleware.DefaultBreakerConfig(), nil),
+	)
+
+	reporter, ok := shipper.As[shipper.CapabilityReporter](wrapped)
+	require.True(t, ok)
+	assert.Equal(t, carrier.Capabilities(), reporter.Capabilities())
+	assert.Equal(t, carrier.Capabilities(), shipper.CapabilitiesOf(wrapped))
+}
+
+// featuredShipper is a fakeShipper that also schedules pickups and
+// manifests, failing the same way.
+type featuredShipper struct {
+	*fakeShipper
+}
+
+func (f featuredShipper) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.PickupAvailabilityResponse{Available: true}, nil
+}
+
+func (f featuredShipper) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.SchedulePickupResponse{PickupID: "pickup-1"}, nil
+}
+
+func (f featuredShipper) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.CancelPickupResponse{}, nil
+}
+
+func (f featuredShipper) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &shipper.ManifestResponse{Carrier: f.Name()}, nil
+}
+
+func TestChain_GuardsOptionalFeatures(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient, errTransient, errTransient}}
+	observer := &recordingObserver{}
+	s := middleware.Chain(featuredShipper{fake},
+		middleware.Retry(fastRetry(), observer),
+		middleware.CircuitBreaker(middleware.BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour}, nil),
+	)
+
+	pickups, ok := shipper.As[shipper.PickupScheduler](s)
+	require.True(t, ok)
+	_, err := pickups.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{})
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+	assert.Equal(t, 2, fake.Calls())
+	assert.Equal(t, []string{"get_pickup_availability", "get_pickup_availability"}, observer.retries)
+
+	manifester, ok := shipper.As[shipper.Manifester](s)
+	require.True(t, ok)
+	_, err = manifester.Manifest(context.Background(), &shipper.ManifestRequest{})
+	assert.ErrorIs(t, err, shipper.ErrServiceUnavailable)
+	assert.Equal(t, 2, fake.Calls())
+}
+
+func TestRetry_SchedulePickupNotRetried(t *testing.T) {
+	fake := &fakeShipper{errs: []error{errTransient}}
+	s := middleware.Retry(fastRetry(), nil)(featuredShipper{fake})
+
+	pickups, ok := shipper.As[shipper.PickupScheduler](s)
+	require.True(t, ok)
+	_, err := pickups.SchedulePickup(context.Background(), &shipper.SchedulePickupRequest{})
+
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, fake.Calls())
+}
+
+func TestChain_HidesMissingFeatures(t *testing.T) {
+	s := middleware.Chain(&fakeShipper{},
+		middleware.Retry(fastRetry(), nil),
+		middleware.CircuitBreaker(middleware.DefaultBreakerConfig(), nil),
+	)
+
+	_, ok := shipper.As[shipper.PickupScheduler](s)
+	assert.False(t, ok)
+	_, ok = shipper.As[shipper.Returner](s)
+	assert.False(t, ok)
+}
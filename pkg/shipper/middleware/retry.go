@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// RetryConfig controls how failed carrier calls are retried.
+type RetryConfig struct {
+	MaxAttempts int           // Total attempts, including the first
+	BaseDelay   time.Duration // Delay before the first retry, doubled on each attempt
+	MaxDelay    time.Duration // Upper bound on a single delay
+}
+
+// DefaultRetryConfig returns the retry settings used when none are configured.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+	}
+}
+
+// Retry returns a middleware that retries idempotent operations failing with
+// a retryable error (see shipper.IsRetryable). GetQuote, GetLabel and Track
+// are always retried; CreateOrder only when the request carries an
+// idempotency key that the carrier deduplicates on (see
+// shipper.Capabilities.DedupesCreateOrder), since a retry after an
+// ambiguous failure would otherwise book the shipment twice. CancelOrder is
+// never retried.
+//
+// Of the optional features found with shipper.As, GetPickupAvailability is
+// retried too. Booking or cancelling pickups, manifesting and creating
+// returns are not idempotent and are never retried.
+//
+// Delays grow exponentially with jitter, are extended to any Retry-After the
+// carrier asked for, and are never scheduled past the context deadline.
+func Retry(cfg RetryConfig, observer Observer) Middleware {
+	return func(next shipper.Shipper) shipper.Shipper {
+		return &retrier{
+			next:     next,
+			cfg:      cfg,
+			observer: observerOrNop(observer),
+		}
+	}
+}
+
+type retrier struct {
+	next     shipper.Shipper
+	cfg      RetryConfig
+	observer Observer
+}
+
+func (r *retrier) Name() string {
+	return r.next.Name()
+}
+
+func (r *retrier) Unwrap() shipper.Shipper {
+	return r.next
+}
+
+func (r *retrier) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	return retry(ctx, r, opGetQuote, func() (*shipper.QuoteResponse, error) {
+		return r.next.GetQuote(ctx, req)
+	})
+}
+
+func (r *retrier) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	if req.IdempotencyKey == "" || !shipper.CapabilitiesOf(r.next).DedupesCreateOrder {
+		return r.next.CreateOrder(ctx, req)
+	}
+	return retry(ctx, r, opCreateOrder, func() (*shipper.CreateOrderResponse, error) {
+		return r.next.CreateOrder(ctx, req)
+	})
+}
+
+func (r *retrier) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	return retry(ctx, r, opGetLabel, func() (*shipper.GetLabelResponse, error) {
+		return r.next.GetLabel(ctx, req)
+	})
+}
+
+func (r *retrier) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	return r.next.CancelOrder(ctx, req)
+}
+
+func (r *retrier) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	return retry(ctx, r, opTrack, func() (*shipper.TrackResponse, error) {
+		return r.next.Track(ctx, req)
+	})
+}
+
+// As provides the pickup scheduling of the wrapped shipper with retries,
+// for shipper.As. Its other optional features are never retried and are
+// found through Unwrap.
+func (r *retrier) As(target any) bool {
+	if target, ok := target.(*shipper.PickupScheduler); ok {
+		next, ok := shipper.As[shipper.PickupScheduler](r.next)
+		if ok {
+			*target = retrierPickups{r: r, next: next}
+		}
+		return ok
+	}
+	return false
+}
+
+// retrierPickups is the pickup scheduling of a retrier's shipper.
+type retrierPickups struct {
+	r    *retrier
+	next shipper.PickupScheduler
+}
+
+func (p retrierPickups) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	return retry(ctx, p.r, opGetPickupAvailability, func() (*shipper.PickupAvailabilityResponse, error) {
+		return p.next.GetPickupAvailability(ctx, req)
+	})
+}
+
+func (p retrierPickups) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	return p.next.SchedulePickup(ctx, req)
+}
+
+func (p retrierPickups) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	return p.next.CancelPickup(ctx, req)
+}
+
+// retry calls fn until it succeeds, fails with a non-retryable error, runs
+// out of attempts, or the next delay would outlive the context.
+func retry[R any](ctx context.Context, r *retrier, operation string, fn func() (R, error)) (R, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := fn()
+		if err == nil || attempt >= r.cfg.MaxAttempts || !shipper.IsRetryable(err) {
+			return resp, err
+		}
+
+		delay := r.backoff(attempt)
+		var shipperErr *shipper.ShipperError
+		if errors.As(err, &shipperErr) && shipperErr.RetryAfter > delay {
+			delay = shipperErr.RetryAfter
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+
+		r.observer.RecordRetry(operation, r.next.Name())
+	}
+}
+
+// backoff returns the delay before the given retry: half of the exponential
+// delay plus a random share of the other half.
+func (r *retrier) backoff(attempt int) time.Duration {
+	d := r.cfg.BaseDelay << (attempt - 1)
+	if d <= 0 || d > r.cfg.MaxDelay {
+		d = r.cfg.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// Ensure retrier implements Shipper interface
+var (
+	_ shipper.Shipper         = (*retrier)(nil)
+	_ shipper.PickupScheduler = retrierPickups{}
+)
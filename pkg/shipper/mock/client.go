@@ -24,6 +24,22 @@ func (c *Client) Name() string {
 	return c.name
 }
 
+// Capabilities reports every label format, shipping option, pickups,
+// manifests and returns, so that the mock can stand in for any carrier.
+func (c *Client) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{
+		LabelFormats:      []shipper.LabelFormat{shipper.LabelPDF, shipper.LabelPNG, shipper.LabelZPL},
+		International:     true,
+		MultiPiece:        true,
+		Pickups:           true,
+		Manifests:         true,
+		Returns:           true,
+		Insurance:         true,
+		SignatureRequired: true,
+		SaturdayDelivery:  true,
+	}
+}
+
 // GetQuote returns mock shipping quotes.
 func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
 	now := time.Now()
@@ -40,10 +56,10 @@ func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*ship
 				ServiceCode: "STANDARD",
 				ServiceName: fmt.Sprintf("%s Standard", c.name),
 				ServiceType: shipper.ServiceStandard,
-				BaseRate:    shipper.Money{Amount: 12.50, Currency: "CAD"},
-				FuelSurcharge: shipper.Money{Amount: 1.50, Currency: "CAD"},
-				Taxes:       shipper.Money{Amount: 1.82, Currency: "CAD"},
-				TotalPrice:  shipper.Money{Amount: 15.82, Currency: "CAD"},
+				BaseRate:    shipper.Money{Minor: 1250, Currency: "CAD"},
+				FuelSurcharge: shipper.Money{Minor: 150, Currency: "CAD"},
+				Taxes:       shipper.Money{Minor: 182, Currency: "CAD"},
+				TotalPrice:  shipper.Money{Minor: 1582, Currency: "CAD"},
 				TransitDays: 5,
 				EstimatedDelivery: &estimatedDelivery,
 				ExpiresAt:   expiresAt,
@@ -55,10 +71,10 @@ func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*ship
 				ServiceCode: "EXPRESS",
 				ServiceName: fmt.Sprintf("%s Express", c.name),
 				ServiceType: shipper.ServiceExpress,
-				BaseRate:    shipper.Money{Amount: 24.00, Currency: "CAD"},
-				FuelSurcharge: shipper.Money{Amount: 2.50, Currency: "CAD"},
-				Taxes:       shipper.Money{Amount: 3.45, Currency: "CAD"},
-				TotalPrice:  shipper.Money{Amount: 29.95, Currency: "CAD"},
+				BaseRate:    shipper.Money{Minor: 2400, Currency: "CAD"},
+				FuelSurcharge: shipper.Money{Minor: 250, Currency: "CAD"},
+				Taxes:       shipper.Money{Minor: 345, Currency: "CAD"},
+				TotalPrice:  shipper.Money{Minor: 2995, Currency: "CAD"},
 				TransitDays: 2,
 				EstimatedDelivery: func() *time.Time { t := now.Add(2 * 24 * time.Hour); return &t }(),
 				ExpiresAt:   expiresAt,
@@ -82,7 +98,7 @@ func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderReques
 		Status:         shipper.StatusConfirmed,
 		Carrier:        c.name,
 		ServiceName:    fmt.Sprintf("%s Standard", c.name),
-		TotalCharged:   shipper.Money{Amount: 15.82, Currency: "CAD"},
+		TotalCharged:   shipper.Money{Minor: 1582, Currency: "CAD"},
 		EstimatedDelivery: &estimatedDelivery,
 		LabelURL:       fmt.Sprintf("https://labels.%s.mock/%s.pdf", c.name, orderID),
 	}, nil
@@ -109,7 +125,117 @@ func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderReques
 	return &shipper.CancelOrderResponse{
 		OrderID:            req.OrderID,
 		Status:             shipper.StatusCancelled,
-		RefundAmount:       &shipper.Money{Amount: 15.82, Currency: "CAD"},
+		RefundAmount:       &shipper.Money{Minor: 1582, Currency: "CAD"},
 		ConfirmationNumber: fmt.Sprintf("CANCEL-%d", time.Now().UnixNano()),
 	}, nil
 }
+
+// Track returns a mock tracking history.
+func (c *Client) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	now := time.Now()
+	trackingNumber := req.TrackingNumber
+	if trackingNumber == "" {
+		trackingNumber = req.OrderID
+	}
+	estimatedDelivery := now.Add(3 * 24 * time.Hour)
+
+	return &shipper.TrackResponse{
+		OrderID:           req.OrderID,
+		TrackingNumber:    trackingNumber,
+		Status:            shipper.StatusInTransit,
+		EstimatedDelivery: &estimatedDelivery,
+		Events: []shipper.TrackingEvent{
+			{
+				Timestamp:   now.Add(-24 * time.Hour),
+				Description: "In transit to destination",
+				Location:    "Mississauga, ON",
+				Status:      shipper.StatusInTransit,
+				CarrierCode: "IT",
+			},
+			{
+				Timestamp:   now.Add(-48 * time.Hour),
+				Description: "Shipment picked up",
+				Location:    "Toronto, ON",
+				Status:      shipper.StatusPickedUp,
+				CarrierCode: "PU",
+			},
+		},
+	}, nil
+}
+
+// GetPickupAvailability reports a mock pickup window on weekdays.
+func (c *Client) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	if weekday := req.Date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return &shipper.PickupAvailabilityResponse{}, nil
+	}
+
+	y, m, d := req.Date.Date()
+	bookBy := time.Date(y, m, d, 12, 0, 0, 0, req.Date.Location())
+	return &shipper.PickupAvailabilityResponse{
+		Available: true,
+		BookBy:    &bookBy,
+		Windows: []shipper.PickupWindow{
+			{
+				ReadyAt: time.Date(y, m, d, 13, 0, 0, 0, req.Date.Location()),
+				CloseAt: time.Date(y, m, d, 17, 0, 0, 0, req.Date.Location()),
+			},
+		},
+	}, nil
+}
+
+// SchedulePickup books a mock pickup.
+func (c *Client) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	return &shipper.SchedulePickupResponse{
+		PickupID: fmt.Sprintf("%s-pickup-%d", c.name, time.Now().UnixNano()),
+		Carrier:  c.name,
+		Status:   shipper.PickupScheduled,
+		Window:   req.Window,
+	}, nil
+}
+
+// CancelPickup cancels a mock pickup.
+func (c *Client) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	return &shipper.CancelPickupResponse{
+		PickupID: req.PickupID,
+		Status:   shipper.PickupCancelled,
+	}, nil
+}
+
+// Manifest returns a single mock manifest.
+func (c *Client) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	manifestID := fmt.Sprintf("%s-manifest-%d", c.name, time.Now().UnixNano())
+	return &shipper.ManifestResponse{
+		Carrier: c.name,
+		Manifests: []shipper.Manifest{
+			{
+				ManifestID: manifestID,
+				Document: shipper.Label{
+					Format: shipper.LabelPDF,
+					URL:    fmt.Sprintf("https://manifests.%s.mock/%s.pdf", c.name, manifestID),
+				},
+			},
+		},
+	}, nil
+}
+
+// CreateReturn creates a mock return shipment.
+func (c *Client) CreateReturn(ctx context.Context, req *shipper.CreateReturnRequest) (*shipper.CreateReturnResponse, error) {
+	now := time.Now()
+	orderID := fmt.Sprintf("%s-return-%d", c.name, now.UnixNano())
+	format := req.LabelFormat
+	if format == "" {
+		format = shipper.LabelPDF
+	}
+
+	return &shipper.CreateReturnResponse{
+		OrderID:        orderID,
+		TrackingNumber: fmt.Sprintf("1Z%s%d", c.name[:3], now.UnixNano()%1000000000),
+		Carrier:        c.name,
+		Status:         shipper.StatusConfirmed,
+		TotalCharged:   shipper.Money{Minor: 1582, Currency: "CAD"},
+		Label: shipper.Label{
+			Format: format,
+			URL:    fmt.Sprintf("https://labels.%s.mock/%s.%s", c.name, orderID, format),
+		},
+	}, nil
+}
@@ -20,6 +20,24 @@ const (
 	StatusException      ShipmentStatus = "exception"
 )
 
+// Terminal reports whether a shipment in this status will not move again.
+func (s ShipmentStatus) Terminal() bool {
+	return s == StatusDelivered || s == StatusCancelled
+}
+
+// statusRank orders the statuses a shipment moves through on its way to
+// delivery. Cancelled and exception can be reached from any of them.
+var statusRank = map[ShipmentStatus]int{
+	StatusPending:        0,
+	StatusQuoted:         1,
+	StatusConfirmed:      2,
+	StatusAssigned:       3,
+	StatusPickedUp:       4,
+	StatusInTransit:      5,
+	StatusOutForDelivery: 6,
+	StatusDelivered:      7,
+}
+
 // ServiceType represents the shipping service type.
 type ServiceType string
 
@@ -104,20 +122,14 @@ type Package struct {
 	WeightUnit    WeightUnit
 	PackageType   PackageType
 	Description   string
-	DeclaredValue float64
-	Currency      string
-}
-
-// Money represents a monetary amount.
-type Money struct {
-	Amount   float64
-	Currency string
+	DeclaredValue Money
 }
 
 // RateOption represents a shipping rate option from a carrier.
 type RateOption struct {
 	RateID            string
 	Carrier           string
+	ServiceID         string // Carrier-native service identifier used when booking
 	ServiceCode       string
 	ServiceName       string
 	ServiceType       ServiceType
@@ -130,6 +142,11 @@ type RateOption struct {
 	ExpiresAt         time.Time
 	SignatureRequired bool
 	Guaranteed        bool
+
+	// Total package weight sent to the carrier, after unit conversion and
+	// the carrier's rounding
+	BillableWeight     float64
+	BillableWeightUnit WeightUnit
 }
 
 // TrackingEvent represents a tracking event.
@@ -159,6 +176,39 @@ type ShippingOptions struct {
 	ShipDate          *time.Time
 }
 
+// Shipping option names, as reported in unsupported option errors.
+const (
+	OptionSignatureRequired = "signature_required"
+	OptionInsuranceRequired = "insurance_required"
+	OptionSaturdayDelivery  = "saturday_delivery"
+	OptionShipDate          = "ship_date"
+	OptionLabelFormat       = "label_format"
+	OptionPickup            = "pickup"
+	OptionManifest          = "manifest"
+	OptionReturn            = "return"
+	OptionIncotermDDP       = "incoterm_ddp"
+)
+
+// InsuredValue returns the total declared value of the packages, which is
+// the amount to insure when insurance is required. All declared values must
+// be in the same currency.
+func InsuredValue(carrier string, pkgs []Package) (Money, error) {
+	var total Money
+	for _, p := range pkgs {
+		sum, err := total.Add(p.DeclaredValue)
+		if err != nil {
+			return Money{}, NewShipperError(carrier, "invalid_option", "declared values must share one currency").
+				WithCause(err)
+		}
+		total = sum
+	}
+	if total.Minor <= 0 {
+		return Money{}, NewShipperError(carrier, "invalid_option", "insurance requires a declared value on at least one package").
+			WithCause(ErrInvalidPackage)
+	}
+	return total, nil
+}
+
 // ============================================================================
 // Request/Response Types
 // ============================================================================
@@ -170,6 +220,7 @@ type QuoteRequest struct {
 	Destination Address
 	Packages    []Package
 	Options     ShippingOptions
+	Customs     *Customs // For international quotes, by carriers that rate with it
 }
 
 // QuoteResponse is the response from getting shipping quotes.
@@ -179,11 +230,20 @@ type QuoteResponse struct {
 	ExpiresAt time.Time
 }
 
+// SetBillableWeight records the weight the carrier rated on every rate.
+func (r *QuoteResponse) SetBillableWeight(weight float64, unit WeightUnit) {
+	for i := range r.Rates {
+		r.Rates[i].BillableWeight = weight
+		r.Rates[i].BillableWeightUnit = unit
+	}
+}
+
 // CreateOrderRequest is the request for creating a shipping order.
 type CreateOrderRequest struct {
 	ShipperID        string
 	QuoteID          string // From GetQuote response
 	RateID           string // Selected rate
+	ServiceID        string // Carrier-native service identifier of the selected rate
 	Sender           Contact
 	SenderAddress    Address
 	Recipient        Contact
@@ -192,6 +252,10 @@ type CreateOrderRequest struct {
 	Reference        string
 	PONumber         string
 	Instructions     string
+	IdempotencyKey   string // Client-supplied key making the request safe to retry
+	Options          ShippingOptions
+	Pickup           *PickupWindow // Books a pickup with the order, for carriers that support it
+	Customs          *Customs      // Required for international orders
 }
 
 // CreateOrderResponse is the response from creating a shipping order.
@@ -205,12 +269,34 @@ type CreateOrderResponse struct {
 	TotalCharged      Money
 	EstimatedDelivery *time.Time
 	LabelURL          string
+	Pieces            []Piece // One per package for carriers that track packages separately
+	PreviouslyCreated bool    // The order was created by an earlier request with the same idempotency key
+}
+
+// PieceIDs returns the IDs of the order's pieces.
+func (r *CreateOrderResponse) PieceIDs() []string {
+	if len(r.Pieces) == 0 {
+		return nil
+	}
+	ids := make([]string, len(r.Pieces))
+	for i, p := range r.Pieces {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+// Piece is a single package of a multi-package order.
+type Piece struct {
+	ID             string // Carrier identifier of the package, e.g. its shipment ID or PIN
+	TrackingNumber string
+	LabelURL       string
 }
 
 // GetLabelRequest is the request for getting a shipping label.
 type GetLabelRequest struct {
-	OrderID string
-	Format  LabelFormat
+	OrderID  string
+	Format   LabelFormat
+	PieceIDs []string // From CreateOrderResponse.Pieces, for carriers that label each package separately
 }
 
 // GetLabelResponse is the response from getting a shipping label.
@@ -222,8 +308,9 @@ type GetLabelResponse struct {
 
 // CancelOrderRequest is the request for cancelling an order.
 type CancelOrderRequest struct {
-	OrderID string
-	Reason  string
+	OrderID  string
+	Reason   string
+	PieceIDs []string // From CreateOrderResponse.Pieces, for carriers that cancel each package separately
 }
 
 // CancelOrderResponse is the response from cancelling an order.
@@ -233,3 +320,18 @@ type CancelOrderResponse struct {
 	RefundAmount       *Money
 	ConfirmationNumber string
 }
+
+// TrackRequest is the request for tracking a shipment.
+type TrackRequest struct {
+	OrderID        string
+	TrackingNumber string // Falls back to OrderID when empty
+}
+
+// TrackResponse is the response from tracking a shipment.
+type TrackResponse struct {
+	OrderID           string
+	TrackingNumber    string
+	Status            ShipmentStatus
+	EstimatedDelivery *time.Time
+	Events            []TrackingEvent // Most recent first
+}
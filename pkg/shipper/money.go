@@ -0,0 +1,126 @@
+package shipper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// DefaultCurrency is the currency of amounts that do not state one.
+const DefaultCurrency = "CAD"
+
+// minorUnits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit.
+var minorUnits = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0,
+	"XOF": 0, "XPF": 0,
+}
+
+// MinorUnits returns the number of decimal places of a currency's minor
+// unit, e.g. 2 for CAD and 0 for JPY. Unknown currencies have two.
+func MinorUnits(currency string) int {
+	if n, ok := minorUnits[strings.ToUpper(currency)]; ok {
+		return n
+	}
+	return 2
+}
+
+// Money is an exact amount of a currency, counted in the currency's minor
+// units (cents for CAD). Arithmetic on Money never goes through floats.
+type Money struct {
+	Minor    int64
+	Currency string
+}
+
+// NewMoney converts amount to Money. It fails if amount is more precise
+// than the currency's minor unit, e.g. 12.345 CAD.
+func NewMoney(amount Decimal, currency string) (Money, error) {
+	minor, err := amount.rescale(MinorUnits(currency))
+	if err != nil {
+		return Money{}, fmt.Errorf("%s amount: %w", currency, err)
+	}
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// ParseMoney parses a decimal amount of currency, such as "12.50".
+func ParseMoney(amount, currency string) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(d, currency)
+}
+
+// MoneyFromFloat rounds amount half away from zero to the currency's minor
+// unit. It is meant for amounts carriers report as floats.
+func MoneyFromFloat(amount float64, currency string) Money {
+	return Money{
+		Minor:    int64(math.Round(amount * math.Pow10(MinorUnits(currency)))),
+		Currency: currency,
+	}
+}
+
+// Amount returns the amount in major units, with the currency's scale.
+func (m Money) Amount() Decimal {
+	return Decimal{coef: m.Minor, scale: uint8(MinorUnits(m.Currency))}
+}
+
+// Float64 returns the amount in major units as the nearest float64, for
+// carrier APIs that take floats.
+func (m Money) Float64() float64 {
+	return m.Amount().Float64()
+}
+
+// String returns the amount followed by the currency, e.g. "12.50 CAD".
+func (m Money) String() string {
+	return strings.TrimSpace(m.Amount().String() + " " + m.Currency)
+}
+
+// IsZero reports whether m is the zero value.
+func (m Money) IsZero() bool {
+	return m == Money{}
+}
+
+// Add returns m + other. The zero Money adopts the currency of the other
+// operand, so a sum can start from Money{}. Adding different currencies
+// fails with ErrCurrencyMismatch.
+func (m Money) Add(other Money) (Money, error) {
+	switch {
+	case m.IsZero():
+		return other, nil
+	case other.IsZero():
+		return m, nil
+	case m.Currency != other.Currency:
+		return Money{}, fmt.Errorf("%w: cannot add %s to %s", ErrCurrencyMismatch, other.Currency, m.Currency)
+	}
+	return Money{Minor: m.Minor + other.Minor, Currency: m.Currency}, nil
+}
+
+// moneyJSON is the JSON form of Money. The amount is a decimal string.
+type moneyJSON struct {
+	Amount   Decimal
+	Currency string
+}
+
+// MarshalJSON encodes m as {"Amount": "12.50", "Currency": "CAD"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.Amount(), Currency: m.Currency})
+}
+
+// UnmarshalJSON decodes the form written by MarshalJSON. The amount may
+// also be a JSON number, e.g. {"Amount": 12.5, "Currency": "CAD"}.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	money, err := NewMoney(v.Amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
@@ -0,0 +1,85 @@
+package shipper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func TestMinorUnits(t *testing.T) {
+	assert.Equal(t, 2, shipper.MinorUnits("CAD"))
+	assert.Equal(t, 0, shipper.MinorUnits("JPY"))
+	assert.Equal(t, 3, shipper.MinorUnits("kwd"))
+	assert.Equal(t, 2, shipper.MinorUnits("XYZ"))
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount, currency string
+		want             shipper.Money
+		str              string
+	}{
+		{"12.5", "CAD", shipper.Money{Minor: 1250, Currency: "CAD"}, "12.50 CAD"},
+		{"12.500", "CAD", shipper.Money{Minor: 1250, Currency: "CAD"}, "12.50 CAD"},
+		{"1500", "JPY", shipper.Money{Minor: 1500, Currency: "JPY"}, "1500 JPY"},
+		{"1.234", "KWD", shipper.Money{Minor: 1234, Currency: "KWD"}, "1.234 KWD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			m, err := shipper.ParseMoney(tt.amount, tt.currency)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m)
+			assert.Equal(t, tt.str, m.String())
+		})
+	}
+}
+
+func TestParseMoney_TooPrecise(t *testing.T) {
+	_, err := shipper.ParseMoney("12.345", "CAD")
+	assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+
+	_, err = shipper.ParseMoney("1.5", "JPY")
+	assert.ErrorIs(t, err, shipper.ErrInvalidAmount)
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+	assert.Equal(t, shipper.Money{Minor: 30, Currency: "CAD"}, shipper.MoneyFromFloat(0.1+0.2, "CAD"))
+	assert.Equal(t, shipper.Money{Minor: 1582, Currency: "CAD"}, shipper.MoneyFromFloat(15.82, "CAD"))
+	assert.Equal(t, shipper.Money{Minor: 1000, Currency: "JPY"}, shipper.MoneyFromFloat(999.6, "JPY"))
+}
+
+func TestMoney_Add(t *testing.T) {
+	a := shipper.Money{Minor: 1050, Currency: "CAD"}
+	b := shipper.Money{Minor: 295, Currency: "CAD"}
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, shipper.Money{Minor: 1345, Currency: "CAD"}, sum)
+
+	sum, err = shipper.Money{}.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, b, sum)
+
+	_, err = a.Add(shipper.Money{Minor: 100, Currency: "USD"})
+	assert.ErrorIs(t, err, shipper.ErrCurrencyMismatch)
+}
+
+func TestMoney_JSON(t *testing.T) {
+	m := shipper.Money{Minor: 2599, Currency: "CAD"}
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Amount": "25.99", "Currency": "CAD"}`, string(data))
+
+	var decoded shipper.Money
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, m, decoded)
+
+	var legacy shipper.Money
+	require.NoError(t, json.Unmarshal([]byte(`{"Amount": 15.82, "Currency": "CAD"}`), &legacy))
+	assert.Equal(t, shipper.Money{Minor: 1582, Currency: "CAD"}, legacy)
+}
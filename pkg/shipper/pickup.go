@@ -0,0 +1,80 @@
+package shipper
+
+import (
+	"context"
+	"time"
+)
+
+// PickupScheduler is implemented by carriers that collect shipments from
+// the sender's premises on request. Find it with As[PickupScheduler].
+type PickupScheduler interface {
+	// GetPickupAvailability reports whether the carrier can collect at an
+	// address on a given day.
+	GetPickupAvailability(ctx context.Context, req *PickupAvailabilityRequest) (*PickupAvailabilityResponse, error)
+
+	// SchedulePickup books a pickup.
+	SchedulePickup(ctx context.Context, req *SchedulePickupRequest) (*SchedulePickupResponse, error)
+
+	// CancelPickup cancels a booked pickup.
+	CancelPickup(ctx context.Context, req *CancelPickupRequest) (*CancelPickupResponse, error)
+}
+
+// PickupStatus represents the status of a pickup.
+type PickupStatus string
+
+const (
+	PickupScheduled PickupStatus = "scheduled"
+	PickupCancelled PickupStatus = "cancelled"
+)
+
+// PickupWindow is when packages can be collected, in the local time of the
+// pickup address.
+type PickupWindow struct {
+	ReadyAt time.Time // Packages are ready from
+	CloseAt time.Time // Premises close at
+}
+
+// PickupAvailabilityRequest is the request for checking pickup availability.
+type PickupAvailabilityRequest struct {
+	Address Address
+	Date    time.Time // Only the date is used
+}
+
+// PickupAvailabilityResponse is the response from checking pickup availability.
+type PickupAvailabilityResponse struct {
+	Available bool
+	BookBy    *time.Time     // Latest time a pickup on the date can be booked, nil if unknown
+	Windows   []PickupWindow // Collection times offered, empty if the carrier states none
+}
+
+// SchedulePickupRequest is the request for scheduling a pickup.
+type SchedulePickupRequest struct {
+	ShipperID    string
+	Address      Address
+	Contact      Contact
+	Window       PickupWindow
+	OrderIDs     []string // Orders to collect
+	PackageCount int
+	TotalWeight  float64
+	WeightUnit   WeightUnit
+	Instructions string // e.g. "Loading dock at rear"
+}
+
+// SchedulePickupResponse is the response from scheduling a pickup.
+type SchedulePickupResponse struct {
+	PickupID string // Carrier identifier of the pickup, used to cancel
+	Carrier  string
+	Status   PickupStatus
+	Window   PickupWindow
+}
+
+// CancelPickupRequest is the request for cancelling a pickup.
+type CancelPickupRequest struct {
+	PickupID string
+}
+
+// CancelPickupResponse is the response from cancelling a pickup.
+type CancelPickupResponse struct {
+	PickupID string
+	Status   PickupStatus
+}
@@ -2,6 +2,9 @@ package purolator
 
 import (
 	"context"
+	"net/http"
+
+	"github.com/tournevent/logistic/pkg/shipper"
 )
 
 // APIClient defines the interface for Purolator API operations.
@@ -22,6 +25,21 @@ type APIClient interface {
 
 	// GetTracking retrieves tracking information via TrackingService
 	GetTracking(ctx context.Context, trackingPIN string) (*TrackingResponse, error)
+
+	// ValidatePickUp checks whether a pickup can be booked via PickUpService
+	ValidatePickUp(ctx context.Context, req *PickUpRequest) (*PickUpValidation, error)
+
+	// SchedulePickUp books a pickup via PickUpService
+	SchedulePickUp(ctx context.Context, req *PickUpRequest) (*PickUpResponse, error)
+
+	// VoidPickUp cancels a pickup via PickUpService
+	VoidPickUp(ctx context.Context, confirmationNumber string) (*PickUpResponse, error)
+
+	// Consolidate closes the day's shipments via ShippingService
+	Consolidate(ctx context.Context) error
+
+	// GetManifest retrieves the manifests of a day via ShippingDocumentsService
+	GetManifest(ctx context.Context, date string) (*ManifestResponse, error)
 }
 
 // ============================================================================
@@ -30,10 +48,12 @@ type APIClient interface {
 
 // RatesRequest represents a Purolator rate quote request.
 type RatesRequest struct {
-	BillingAccountNumber string
-	SenderPostalCode     string
-	ReceiverAddress      Address
-	PackageInformation   PackageInformation
+	BillingAccountNumber     string
+	SenderPostalCode         string
+	ReceiverAddress          Address
+	ShipmentDate             string // YYYY-MM-DD, empty for today
+	PackageInformation       PackageInformation
+	InternationalInformation *InternationalInformation // For shipments leaving Canada
 }
 
 // PackageInformation contains package details for rating.
@@ -41,6 +61,33 @@ type PackageInformation struct {
 	TotalWeight    Weight
 	TotalPieces    int
 	PiecesInFormat []Piece
+	Options        []OptionIDValuePair
+}
+
+// InternationalInformation is the customs declaration of a shipment
+// leaving Canada.
+type InternationalInformation struct {
+	ContentDetails    []ContentDetail
+	BillDutiesToParty string // "Sender" or "Receiver"
+	Currency          string // "CAD" or "USD"
+	ImportExportType  string // "Permanent", "Repair" or "Return"
+}
+
+// ContentDetail is a line of a customs declaration.
+type ContentDetail struct {
+	Description          string
+	HarmonizedCode       string
+	CountryOfManufacture string
+	ProductCode          string
+	UnitValue            float64
+	Quantity             int
+}
+
+// OptionIDValuePair is a Purolator service option, e.g.
+// ResidentialSignatureDomestic, DeclaredValue or SaturdayDelivery.
+type OptionIDValuePair struct {
+	ID    string
+	Value string
 }
 
 // Weight represents package weight.
@@ -95,10 +142,10 @@ type RatesResponse struct {
 type ShipmentRate struct {
 	ServiceCode          string
 	ServiceName          string
-	BasePrice            float64
-	FuelSurcharge        float64
-	Taxes                float64
-	TotalPrice           float64
+	BasePrice            shipper.Money
+	FuelSurcharge        shipper.Money
+	Taxes                shipper.Money
+	TotalPrice           shipper.Money
 	ExpectedDeliveryDate string
 	EstimatedTransitDays int
 	GuaranteedDelivery   bool
@@ -106,29 +153,35 @@ type ShipmentRate struct {
 
 // ShipmentRequest represents a Purolator shipment creation request.
 type ShipmentRequest struct {
-	BillingAccountNumber string
-	ServiceCode          string
-	Sender               Sender
-	Receiver             Receiver
-	PackageInformation   PackageInformation
-	PrinterType          string // "Thermal" or "Regular"
+	BillingAccountNumber     string
+	ServiceCode              string
+	Sender                   Sender
+	Receiver                 Receiver
+	ShipmentDate             string // YYYY-MM-DD, empty for today
+	PackageInformation       PackageInformation
+	PrinterType              string                    // "Thermal" or "Regular"
+	PaymentType              string                    // "Sender" or "Receiver", empty for Sender
+	Reference                string                    // Printed on the label
+	InternationalInformation *InternationalInformation // For shipments leaving Canada
 }
 
 // Sender represents shipment sender information.
 type Sender struct {
-	Address Address
+	Address   Address
+	TaxNumber string // Exporter tax ID, for customs
 }
 
 // Receiver represents shipment receiver information.
 type Receiver struct {
-	Address Address
+	Address   Address
+	TaxNumber string // Importer tax ID, for customs
 }
 
 // ShipmentResponse represents the Purolator shipment creation response.
 type ShipmentResponse struct {
 	ShipmentPIN          string
 	TrackingNumber       string
-	TotalPrice           float64
+	TotalPrice           shipper.Money
 	ExpectedDeliveryDate string
 	PiecePINs            []string
 	DocumentLinks        []DocumentLink
@@ -142,9 +195,10 @@ type DocumentLink struct {
 
 // LabelResponse represents the Purolator label response.
 type LabelResponse struct {
-	ShipmentPIN string
-	Format      string
-	Data        []byte // Raw label data (PDF, ZPL, etc.)
+	ShipmentPIN    string
+	Format         string
+	Data           []byte   // Raw label data (PDF, ZPL, etc.)
+	AdditionalData [][]byte // Labels of the other pieces of a multi-piece shipment
 }
 
 // VoidResponse represents the Purolator void shipment response.
@@ -170,12 +224,60 @@ type TrackingEvent struct {
 	Type        string
 }
 
+// PickUpRequest represents a Purolator pickup request.
+type PickUpRequest struct {
+	BillingAccountNumber string
+	Address              Address
+	Date                 string // YYYY-MM-DD
+	AnyTimeAfter         string // HHMM, packages ready from
+	UntilTime            string // HHMM, premises close at
+	TotalWeight          Weight
+	TotalPieces          int
+	Instructions         string
+}
+
+// PickUpValidation represents the result of validating a pickup.
+type PickUpValidation struct {
+	Valid  bool
+	Reason string // Why the pickup cannot be booked, empty if valid
+}
+
+// PickUpResponse represents a booked or voided pickup.
+type PickUpResponse struct {
+	ConfirmationNumber string
+	Status             string // "Scheduled", "Voided"
+}
+
+// ManifestResponse represents the manifests of a day.
+type ManifestResponse struct {
+	Date      string // YYYY-MM-DD
+	Documents []DocumentLink
+}
+
 // APIError represents an error from the Purolator API.
 type APIError struct {
 	Code        string
 	Description string
+	StatusCode  int // HTTP status, 0 if not from a response
 }
 
 func (e *APIError) Error() string {
 	return e.Code + ": " + e.Description
 }
+
+// Retryable reports whether the request may succeed if sent again.
+func (e *APIError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// ErrorCode returns the carrier error code.
+func (e *APIError) ErrorCode() string {
+	return e.Code
+}
+
+// Rejected reports whether the carrier refused the request without acting
+// on it.
+func (e *APIError) Rejected() bool {
+	return e.StatusCode >= http.StatusBadRequest && e.StatusCode < http.StatusInternalServerError &&
+		e.StatusCode != http.StatusRequestTimeout
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tournevent/logistic/pkg/shipper"
 )
 
 // MockAPIClient is a mock implementation of APIClient for testing.
@@ -18,6 +19,11 @@ type MockAPIClient struct {
 	OnGetLabel       func(ctx context.Context, shipmentPIN string, format string) (*LabelResponse, error)
 	OnVoidShipment   func(ctx context.Context, shipmentPIN string) (*VoidResponse, error)
 	OnGetTracking    func(ctx context.Context, trackingPIN string) (*TrackingResponse, error)
+	OnValidatePickUp func(ctx context.Context, req *PickUpRequest) (*PickUpValidation, error)
+	OnSchedulePickUp func(ctx context.Context, req *PickUpRequest) (*PickUpResponse, error)
+	OnVoidPickUp     func(ctx context.Context, confirmationNumber string) (*PickUpResponse, error)
+	OnConsolidate    func(ctx context.Context) error
+	OnGetManifest    func(ctx context.Context, date string) (*ManifestResponse, error)
 }
 
 // NewMockAPIClient creates a new mock API client with default behavior.
@@ -50,10 +56,10 @@ func (m *MockAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 			{
 				ServiceCode:          "PurolatorGround",
 				ServiceName:          "Purolator Ground",
-				BasePrice:            16.75,
-				FuelSurcharge:        2.01,
-				Taxes:                2.44,
-				TotalPrice:           21.20,
+				BasePrice:            shipper.Money{Minor: 1675, Currency: "CAD"},
+				FuelSurcharge:        shipper.Money{Minor: 201, Currency: "CAD"},
+				Taxes:                shipper.Money{Minor: 244, Currency: "CAD"},
+				TotalPrice:           shipper.Money{Minor: 2120, Currency: "CAD"},
 				ExpectedDeliveryDate: deliveryGround,
 				EstimatedTransitDays: 5,
 				GuaranteedDelivery:   false,
@@ -61,10 +67,10 @@ func (m *MockAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 			{
 				ServiceCode:          "PurolatorExpress",
 				ServiceName:          "Purolator Express",
-				BasePrice:            28.50,
-				FuelSurcharge:        3.42,
-				Taxes:                4.15,
-				TotalPrice:           36.07,
+				BasePrice:            shipper.Money{Minor: 2850, Currency: "CAD"},
+				FuelSurcharge:        shipper.Money{Minor: 342, Currency: "CAD"},
+				Taxes:                shipper.Money{Minor: 415, Currency: "CAD"},
+				TotalPrice:           shipper.Money{Minor: 3607, Currency: "CAD"},
 				ExpectedDeliveryDate: deliveryExpress,
 				EstimatedTransitDays: 2,
 				GuaranteedDelivery:   true,
@@ -72,10 +78,10 @@ func (m *MockAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 			{
 				ServiceCode:          "PurolatorExpress9AM",
 				ServiceName:          "Purolator Express 9AM",
-				BasePrice:            45.00,
-				FuelSurcharge:        5.40,
-				Taxes:                6.55,
-				TotalPrice:           56.95,
+				BasePrice:            shipper.Money{Minor: 4500, Currency: "CAD"},
+				FuelSurcharge:        shipper.Money{Minor: 540, Currency: "CAD"},
+				Taxes:                shipper.Money{Minor: 655, Currency: "CAD"},
+				TotalPrice:           shipper.Money{Minor: 5695, Currency: "CAD"},
 				ExpectedDeliveryDate: deliveryAM,
 				EstimatedTransitDays: 1,
 				GuaranteedDelivery:   true,
@@ -104,7 +110,7 @@ func (m *MockAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest
 	return &ShipmentResponse{
 		ShipmentPIN:          shipmentPIN,
 		TrackingNumber:       trackingNumber,
-		TotalPrice:           21.20,
+		TotalPrice:           shipper.Money{Minor: 2120, Currency: "CAD"},
 		ExpectedDeliveryDate: time.Now().AddDate(0, 0, 5).Format("2006-01-02"),
 		PiecePINs:            []string{trackingNumber},
 		DocumentLinks: []DocumentLink{
@@ -196,4 +202,100 @@ func (m *MockAPIClient) GetTracking(ctx context.Context, trackingPIN string) (*T
 	}, nil
 }
 
+// ValidatePickUp accepts any mock pickup.
+func (m *MockAPIClient) ValidatePickUp(ctx context.Context, req *PickUpRequest) (*PickUpValidation, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnValidatePickUp != nil {
+		return m.OnValidatePickUp(ctx, req)
+	}
+
+	return &PickUpValidation{Valid: true}, nil
+}
+
+// SchedulePickUp books a mock pickup.
+func (m *MockAPIClient) SchedulePickUp(ctx context.Context, req *PickUpRequest) (*PickUpResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnSchedulePickUp != nil {
+		return m.OnSchedulePickUp(ctx, req)
+	}
+
+	return &PickUpResponse{
+		ConfirmationNumber: fmt.Sprintf("%08d", time.Now().UnixNano()%100000000),
+		Status:             "Scheduled",
+	}, nil
+}
+
+// VoidPickUp voids a mock pickup.
+func (m *MockAPIClient) VoidPickUp(ctx context.Context, confirmationNumber string) (*PickUpResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnVoidPickUp != nil {
+		return m.OnVoidPickUp(ctx, confirmationNumber)
+	}
+
+	return &PickUpResponse{
+		ConfirmationNumber: confirmationNumber,
+		Status:             "Voided",
+	}, nil
+}
+
+// Consolidate closes the mock shipments of the day.
+func (m *MockAPIClient) Consolidate(ctx context.Context) error {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnConsolidate != nil {
+		return m.OnConsolidate(ctx)
+	}
+
+	return nil
+}
+
+// GetManifest returns a mock manifest of the day.
+func (m *MockAPIClient) GetManifest(ctx context.Context, date string) (*ManifestResponse, error) {
+	if m.SimulateLatency > 0 {
+		time.Sleep(m.SimulateLatency)
+	}
+
+	if m.SimulateErrors {
+		return nil, &APIError{Code: "MOCK_ERROR", Description: "Simulated API error"}
+	}
+
+	if m.OnGetManifest != nil {
+		return m.OnGetManifest(ctx, date)
+	}
+
+	return &ManifestResponse{
+		Date: date,
+		Documents: []DocumentLink{
+			{Type: "DomesticBillOfLading", URL: "https://eshiponline.purolator.com/manifests/" + date + ".pdf"},
+		},
+	}, nil
+}
+
 var _ APIClient = (*MockAPIClient)(nil)
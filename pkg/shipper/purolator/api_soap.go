@@ -8,8 +8,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"text/template"
 	"time"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // SOAPAPIClient is the production implementation of APIClient using SOAP/WSDL.
@@ -18,14 +23,16 @@ type SOAPAPIClient struct {
 	username   string
 	password   string
 	httpClient *http.Client
+	limiter    *ratelimit.Limiter
 }
 
 // SOAPAPIClientConfig holds configuration for the SOAP client.
 type SOAPAPIClientConfig struct {
-	WSDLURL  string
-	Username string
-	Password string
-	Timeout  time.Duration
+	WSDLURL     string
+	Username    string
+	Password    string
+	Timeout     time.Duration
+	RateLimiter *ratelimit.Limiter // Optional client-side rate limiter
 }
 
 // NewSOAPAPIClient creates a new SOAP-based API client for production use.
@@ -40,8 +47,10 @@ func NewSOAPAPIClient(cfg SOAPAPIClientConfig) *SOAPAPIClient {
 		username: cfg.Username,
 		password: cfg.Password,
 		httpClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
+		limiter: cfg.RateLimiter,
 	}
 }
 
@@ -54,7 +63,7 @@ func (c *SOAPAPIClient) GetRates(ctx context.Context, req *RatesRequest) (*Rates
 	}
 
 	endpoint := c.getEstimatingServiceEndpoint()
-	resp, err := c.doSOAPRequest(ctx, endpoint, "GetFullEstimate", soapBody)
+	resp, err := c.doSOAPRequest(ctx, "get_rates", endpoint, "GetFullEstimate", soapBody)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +84,7 @@ func (c *SOAPAPIClient) CreateShipment(ctx context.Context, req *ShipmentRequest
 	}
 
 	endpoint := c.getShippingServiceEndpoint()
-	resp, err := c.doSOAPRequest(ctx, endpoint, "CreateShipment", soapBody)
+	resp, err := c.doSOAPRequest(ctx, "create_shipment", endpoint, "CreateShipment", soapBody)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +105,7 @@ func (c *SOAPAPIClient) GetLabel(ctx context.Context, shipmentPIN string, format
 	}
 
 	endpoint := c.getDocumentsServiceEndpoint()
-	resp, err := c.doSOAPRequest(ctx, endpoint, "GetDocuments", soapBody)
+	resp, err := c.doSOAPRequest(ctx, "get_label", endpoint, "GetDocuments", soapBody)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +126,7 @@ func (c *SOAPAPIClient) VoidShipment(ctx context.Context, shipmentPIN string) (*
 	}
 
 	endpoint := c.getShippingServiceEndpoint()
-	resp, err := c.doSOAPRequest(ctx, endpoint, "VoidShipment", soapBody)
+	resp, err := c.doSOAPRequest(ctx, "void_shipment", endpoint, "VoidShipment", soapBody)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +147,7 @@ func (c *SOAPAPIClient) GetTracking(ctx context.Context, trackingPIN string) (*T
 	}
 
 	endpoint := c.getTrackingServiceEndpoint()
-	resp, err := c.doSOAPRequest(ctx, endpoint, "TrackPackagesByPin", soapBody)
+	resp, err := c.doSOAPRequest(ctx, "get_tracking", endpoint, "TrackPackagesByPin", soapBody)
 	if err != nil {
 		return nil, err
 	}
@@ -151,11 +160,233 @@ func (c *SOAPAPIClient) GetTracking(ctx context.Context, trackingPIN string) (*T
 	return c.parseTrackingResponse(resp.Body, trackingPIN)
 }
 
+// ValidatePickUp checks a pickup via the Purolator PickUpService.
+func (c *SOAPAPIClient) ValidatePickUp(ctx context.Context, req *PickUpRequest) (*PickUpValidation, error) {
+	env, err := c.doPickUpRequest(ctx, "validate_pickup", "ValidatePickUp", req)
+	if err != nil {
+		return nil, err
+	}
+	if env.Body.ValidatePickUpResponse == nil {
+		return nil, &APIError{
+			Code:        "PARSE_ERROR",
+			Description: "No pickup validation in response",
+		}
+	}
+
+	// Validation errors mean the pickup cannot be booked, not that the
+	// request failed
+	if errs := env.Body.ValidatePickUpResponse.ResponseInformation.Errors; len(errs) > 0 {
+		return &PickUpValidation{Reason: errs[0].Description}, nil
+	}
+	return &PickUpValidation{Valid: true}, nil
+}
+
+// SchedulePickUp books a pickup via the Purolator PickUpService.
+func (c *SOAPAPIClient) SchedulePickUp(ctx context.Context, req *PickUpRequest) (*PickUpResponse, error) {
+	env, err := c.doPickUpRequest(ctx, "schedule_pickup", "SchedulePickUp", req)
+	if err != nil {
+		return nil, err
+	}
+	resp := env.Body.SchedulePickUpResponse
+	if resp == nil {
+		return nil, &APIError{
+			Code:        "PARSE_ERROR",
+			Description: "No pickup data in response",
+		}
+	}
+	if err := firstResponseError(resp.ResponseInformation); err != nil {
+		return nil, err
+	}
+
+	return &PickUpResponse{
+		ConfirmationNumber: resp.PickUpConfirmationNumber,
+		Status:             "Scheduled",
+	}, nil
+}
+
+// VoidPickUp cancels a pickup via the Purolator PickUpService.
+func (c *SOAPAPIClient) VoidPickUp(ctx context.Context, confirmationNumber string) (*PickUpResponse, error) {
+	bodyTmpl := `<v1:VoidPickUpRequest xmlns:v1="http://purolator.com/pws/datatypes/v1">
+      <v1:PickUpConfirmationNumber>{{.}}</v1:PickUpConfirmationNumber>
+    </v1:VoidPickUpRequest>`
+
+	soapBody, err := c.buildEnvelope(bodyTmpl, confirmationNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	env, err := c.doEnvelopeRequest(ctx, "void_pickup", c.getPickUpServiceEndpoint(), "VoidPickUp", soapBody)
+	if err != nil {
+		return nil, err
+	}
+	resp := env.Body.VoidPickUpResponse
+	if resp == nil {
+		return nil, &APIError{
+			Code:        "PARSE_ERROR",
+			Description: "No void pickup data in response",
+		}
+	}
+	if err := firstResponseError(resp.ResponseInformation); err != nil {
+		return nil, err
+	}
+	if !resp.PickUpVoided {
+		return nil, &APIError{
+			Code:        "VOID_FAILED",
+			Description: "Pickup " + confirmationNumber + " could not be voided",
+		}
+	}
+
+	return &PickUpResponse{
+		ConfirmationNumber: confirmationNumber,
+		Status:             "Voided",
+	}, nil
+}
+
+// doPickUpRequest sends a ValidatePickUp or SchedulePickUp request, which
+// share their body.
+func (c *SOAPAPIClient) doPickUpRequest(ctx context.Context, operation, action string, req *PickUpRequest) (*soapEnvelope, error) {
+	bodyTmpl := `<v1:{{.Action}}Request xmlns:v1="http://purolator.com/pws/datatypes/v1">
+      <v1:BillingAccountNumber>{{.Req.BillingAccountNumber}}</v1:BillingAccountNumber>
+      <v1:PickupInstruction>
+        <v1:Date>{{.Req.Date}}</v1:Date>
+        <v1:AnyTimeAfter>{{.Req.AnyTimeAfter}}</v1:AnyTimeAfter>
+        <v1:UntilTime>{{.Req.UntilTime}}</v1:UntilTime>
+        <v1:TotalWeight>
+          <v1:Value>{{.Req.TotalWeight.Value}}</v1:Value>
+          <v1:WeightUnit>{{.Req.TotalWeight.Unit}}</v1:WeightUnit>
+        </v1:TotalWeight>
+        <v1:TotalPieces>{{.Req.TotalPieces}}</v1:TotalPieces>
+        {{- with .Req.Instructions}}
+        <v1:AdditionalInstructions>{{html .}}</v1:AdditionalInstructions>
+        {{- end}}
+      </v1:PickupInstruction>
+      <v1:Address>
+        <v1:Name>{{html .Req.Address.Name}}</v1:Name>
+        <v1:Company>{{html .Req.Address.Company}}</v1:Company>
+        <v1:StreetAddress>{{html .Req.Address.StreetAddress}}</v1:StreetAddress>
+        <v1:City>{{.Req.Address.City}}</v1:City>
+        <v1:Province>{{.Req.Address.Province}}</v1:Province>
+        <v1:PostalCode>{{.Req.Address.PostalCode}}</v1:PostalCode>
+        <v1:Country>{{.Req.Address.Country}}</v1:Country>
+        <v1:PhoneNumber>
+          <v1:CountryCode>{{.Req.Address.PhoneNumber.CountryCode}}</v1:CountryCode>
+          <v1:AreaCode>{{.Req.Address.PhoneNumber.AreaCode}}</v1:AreaCode>
+          <v1:Phone>{{.Req.Address.PhoneNumber.Phone}}</v1:Phone>
+        </v1:PhoneNumber>
+      </v1:Address>
+    </v1:{{.Action}}Request>`
+
+	data := struct {
+		Action string
+		Req    *PickUpRequest
+	}{Action: action, Req: req}
+
+	soapBody, err := c.buildEnvelope(bodyTmpl, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	return c.doEnvelopeRequest(ctx, operation, c.getPickUpServiceEndpoint(), action, soapBody)
+}
+
+// Consolidate closes the day's shipments via the Purolator ShippingService.
+func (c *SOAPAPIClient) Consolidate(ctx context.Context) error {
+	soapBody, err := c.buildEnvelope(`<v2:ConsolidateRequest/>`, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	env, err := c.doEnvelopeRequest(ctx, "consolidate", c.getShippingServiceEndpoint(), "Consolidate", soapBody)
+	if err != nil {
+		return err
+	}
+	if env.Body.ConsolidateResponse == nil {
+		return &APIError{
+			Code:        "PARSE_ERROR",
+			Description: "No consolidate data in response",
+		}
+	}
+	return firstResponseError(env.Body.ConsolidateResponse.ResponseInformation)
+}
+
+// GetManifest retrieves the manifests of a day via the Purolator
+// ShippingDocumentsService.
+func (c *SOAPAPIClient) GetManifest(ctx context.Context, date string) (*ManifestResponse, error) {
+	bodyTmpl := `<v2:GetShipmentManifestDocumentRequest>
+      <v2:ShipmentManifestDocumentCriterium>
+        <v2:ShipmentManifestDocumentCriteria>
+          <v2:ManifestDate>{{.}}</v2:ManifestDate>
+        </v2:ShipmentManifestDocumentCriteria>
+      </v2:ShipmentManifestDocumentCriterium>
+    </v2:GetShipmentManifestDocumentRequest>`
+
+	soapBody, err := c.buildEnvelope(bodyTmpl, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	env, err := c.doEnvelopeRequest(ctx, "get_manifest", c.getDocumentsServiceEndpoint(), "GetShipmentManifestDocument", soapBody)
+	if err != nil {
+		return nil, err
+	}
+	resp := env.Body.GetShipmentManifestDocumentResponse
+	if resp == nil {
+		return nil, &APIError{
+			Code:        "PARSE_ERROR",
+			Description: "No manifest data in response",
+		}
+	}
+	if err := firstResponseError(resp.ResponseInformation); err != nil {
+		return nil, err
+	}
+
+	result := &ManifestResponse{Date: date}
+	for _, batch := range resp.ManifestBatches {
+		for _, detail := range batch.Details {
+			if detail.DocumentStatus == "Completed" {
+				result.Documents = append(result.Documents, DocumentLink{Type: detail.DocumentType, URL: detail.URL})
+			}
+		}
+	}
+	return result, nil
+}
+
+// doEnvelopeRequest sends a SOAP request and decodes the response envelope.
+func (c *SOAPAPIClient) doEnvelopeRequest(ctx context.Context, operation, endpoint, action string, soapBody []byte) (*soapEnvelope, error) {
+	resp, err := c.doSOAPRequest(ctx, operation, endpoint, action, soapBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseSOAPError(resp)
+	}
+
+	var env soapEnvelope
+	if err := xml.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if env.Body.Fault != nil {
+		return nil, &APIError{
+			Code:        env.Body.Fault.Code,
+			Description: env.Body.Fault.String,
+		}
+	}
+	return &env, nil
+}
+
 // ============================================================================
 // SOAP Request Helpers
 // ============================================================================
 
-func (c *SOAPAPIClient) doSOAPRequest(ctx context.Context, endpoint, action string, body []byte) (*http.Response, error) {
+// doSOAPRequest waits for the rate limiter, sends the SOAP request and turns
+// 429 responses into rate limit errors.
+func (c *SOAPAPIClient) doSOAPRequest(ctx context.Context, operation, endpoint, action string, body []byte) (*http.Response, error) {
+	if err := c.limiter.Wait(ctx, operation); err != nil {
+		return nil, err
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -167,7 +398,17 @@ func (c *SOAPAPIClient) doSOAPRequest(ctx context.Context, endpoint, action stri
 	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
 	req.Header.Set("SOAPAction", fmt.Sprintf("http://purolator.com/pws/service/v2/%s", action))
 
-	return c.httpClient.Do(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		retryAfter := ratelimit.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		c.limiter.Pause(retryAfter)
+		return nil, shipper.NewRateLimitError(carrierName, retryAfter)
+	}
+	return resp, nil
 }
 
 func (c *SOAPAPIClient) getEstimatingServiceEndpoint() string {
@@ -186,6 +427,10 @@ func (c *SOAPAPIClient) getTrackingServiceEndpoint() string {
 	return c.wsdlURL + "/PWS/V1/Tracking/TrackingService.asmx"
 }
 
+func (c *SOAPAPIClient) getPickUpServiceEndpoint() string {
+	return c.wsdlURL + "/EWS/V1/PickUp/PickUpService.asmx"
+}
+
 // ============================================================================
 // SOAP Request Builders
 // ============================================================================
@@ -205,6 +450,34 @@ const soapEnvelopeTemplate = `<?xml version="1.0" encoding="utf-8"?>
   </soap:Body>
 </soap:Envelope>`
 
+// internationalInformationTemplate renders the customs declaration of rate
+// and shipment requests, which share its layout.
+const internationalInformationTemplate = `
+        {{- with .InternationalInformation}}
+        <v2:InternationalInformation>
+          <v2:DocumentsOnlyIndicator>false</v2:DocumentsOnlyIndicator>
+          <v2:ContentDetails>
+            {{- range .ContentDetails}}
+            <v2:ContentDetail>
+              <v2:Description>{{.Description}}</v2:Description>
+              <v2:HarmonizedCode>{{.HarmonizedCode}}</v2:HarmonizedCode>
+              <v2:CountryOfManufacture>{{.CountryOfManufacture}}</v2:CountryOfManufacture>
+              <v2:ProductCode>{{.ProductCode}}</v2:ProductCode>
+              <v2:UnitValue>{{.UnitValue}}</v2:UnitValue>
+              <v2:Quantity>{{.Quantity}}</v2:Quantity>
+            </v2:ContentDetail>
+            {{- end}}
+          </v2:ContentDetails>
+          <v2:DutyInformation>
+            <v2:BillDutiesToParty>{{.BillDutiesToParty}}</v2:BillDutiesToParty>
+            <v2:BusinessRelationship>NotRelated</v2:BusinessRelationship>
+            <v2:Currency>{{.Currency}}</v2:Currency>
+          </v2:DutyInformation>
+          <v2:ImportExportType>{{.ImportExportType}}</v2:ImportExportType>
+          <v2:CustomsInvoiceDocumentIndicator>true</v2:CustomsInvoiceDocumentIndicator>
+        </v2:InternationalInformation>
+        {{- end}}`
+
 func (c *SOAPAPIClient) buildRatesRequest(req *RatesRequest) ([]byte, error) {
 	bodyTmpl := `<v2:GetFullEstimateRequest>
       <v2:Shipment>
@@ -222,13 +495,52 @@ func (c *SOAPAPIClient) buildRatesRequest(req *RatesRequest) ([]byte, error) {
             <v2:Country>{{.ReceiverAddress.Country}}</v2:Country>
           </v2:Address>
         </v2:ReceiverInformation>
+        {{- with .ShipmentDate}}
+        <v2:ShipmentDate>{{.}}</v2:ShipmentDate>
+        {{- end}}
         <v2:PackageInformation>
           <v2:TotalWeight>
             <v2:Value>{{.PackageInformation.TotalWeight.Value}}</v2:Value>
             <v2:WeightUnit>{{.PackageInformation.TotalWeight.Unit}}</v2:WeightUnit>
           </v2:TotalWeight>
           <v2:TotalPieces>{{.PackageInformation.TotalPieces}}</v2:TotalPieces>
-        </v2:PackageInformation>
+          {{- with .PackageInformation.PiecesInFormat}}
+          <v2:PiecesInformation>
+            {{- range .}}
+            <v2:Piece>
+              <v2:Weight>
+                <v2:Value>{{.Weight.Value}}</v2:Value>
+                <v2:WeightUnit>{{.Weight.Unit}}</v2:WeightUnit>
+              </v2:Weight>
+              <v2:Length>
+                <v2:Value>{{.Length.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Length.Unit}}</v2:DimensionUnit>
+              </v2:Length>
+              <v2:Width>
+                <v2:Value>{{.Width.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Width.Unit}}</v2:DimensionUnit>
+              </v2:Width>
+              <v2:Height>
+                <v2:Value>{{.Height.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Height.Unit}}</v2:DimensionUnit>
+              </v2:Height>
+            </v2:Piece>
+            {{- end}}
+          </v2:PiecesInformation>
+          {{- end}}
+          {{- with .PackageInformation.Options}}
+          <v2:OptionsInformation>
+            <v2:Options>
+              {{- range .}}
+              <v2:OptionIDValuePair>
+                <v2:ID>{{.ID}}</v2:ID>
+                <v2:Value>{{.Value}}</v2:Value>
+              </v2:OptionIDValuePair>
+              {{- end}}
+            </v2:Options>
+          </v2:OptionsInformation>
+          {{- end}}
+        </v2:PackageInformation>` + internationalInformationTemplate + `
         <v2:PaymentInformation>
           <v2:PaymentType>Sender</v2:PaymentType>
           <v2:RegisteredAccountNumber>{{.BillingAccountNumber}}</v2:RegisteredAccountNumber>
@@ -259,6 +571,9 @@ func (c *SOAPAPIClient) buildShipmentRequest(req *ShipmentRequest) ([]byte, erro
               <v2:Phone>{{.Sender.Address.PhoneNumber.Phone}}</v2:Phone>
             </v2:PhoneNumber>
           </v2:Address>
+          {{- with .Sender.TaxNumber}}
+          <v2:TaxNumber>{{.}}</v2:TaxNumber>
+          {{- end}}
         </v2:SenderInformation>
         <v2:ReceiverInformation>
           <v2:Address>
@@ -276,7 +591,13 @@ func (c *SOAPAPIClient) buildShipmentRequest(req *ShipmentRequest) ([]byte, erro
               <v2:Phone>{{.Receiver.Address.PhoneNumber.Phone}}</v2:Phone>
             </v2:PhoneNumber>
           </v2:Address>
+          {{- with .Receiver.TaxNumber}}
+          <v2:TaxNumber>{{.}}</v2:TaxNumber>
+          {{- end}}
         </v2:ReceiverInformation>
+        {{- with .ShipmentDate}}
+        <v2:ShipmentDate>{{.}}</v2:ShipmentDate>
+        {{- end}}
         <v2:PackageInformation>
           <v2:ServiceID>{{.ServiceCode}}</v2:ServiceID>
           <v2:TotalWeight>
@@ -284,11 +605,52 @@ func (c *SOAPAPIClient) buildShipmentRequest(req *ShipmentRequest) ([]byte, erro
             <v2:WeightUnit>{{.PackageInformation.TotalWeight.Unit}}</v2:WeightUnit>
           </v2:TotalWeight>
           <v2:TotalPieces>{{.PackageInformation.TotalPieces}}</v2:TotalPieces>
-        </v2:PackageInformation>
+          {{- with .PackageInformation.PiecesInFormat}}
+          <v2:PiecesInformation>
+            {{- range .}}
+            <v2:Piece>
+              <v2:Weight>
+                <v2:Value>{{.Weight.Value}}</v2:Value>
+                <v2:WeightUnit>{{.Weight.Unit}}</v2:WeightUnit>
+              </v2:Weight>
+              <v2:Length>
+                <v2:Value>{{.Length.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Length.Unit}}</v2:DimensionUnit>
+              </v2:Length>
+              <v2:Width>
+                <v2:Value>{{.Width.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Width.Unit}}</v2:DimensionUnit>
+              </v2:Width>
+              <v2:Height>
+                <v2:Value>{{.Height.Value}}</v2:Value>
+                <v2:DimensionUnit>{{.Height.Unit}}</v2:DimensionUnit>
+              </v2:Height>
+            </v2:Piece>
+            {{- end}}
+          </v2:PiecesInformation>
+          {{- end}}
+          {{- with .PackageInformation.Options}}
+          <v2:OptionsInformation>
+            <v2:Options>
+              {{- range .}}
+              <v2:OptionIDValuePair>
+                <v2:ID>{{.ID}}</v2:ID>
+                <v2:Value>{{.Value}}</v2:Value>
+              </v2:OptionIDValuePair>
+              {{- end}}
+            </v2:Options>
+          </v2:OptionsInformation>
+          {{- end}}
+        </v2:PackageInformation>` + internationalInformationTemplate + `
         <v2:PaymentInformation>
-          <v2:PaymentType>Sender</v2:PaymentType>
+          <v2:PaymentType>{{or .PaymentType "Sender"}}</v2:PaymentType>
           <v2:RegisteredAccountNumber>{{.BillingAccountNumber}}</v2:RegisteredAccountNumber>
         </v2:PaymentInformation>
+        {{- with .Reference}}
+        <v2:TrackingReferenceInformation>
+          <v2:Reference1>{{.}}</v2:Reference1>
+        </v2:TrackingReferenceInformation>
+        {{- end}}
       </v2:Shipment>
       <v2:PrinterType>{{.PrinterType}}</v2:PrinterType>
     </v2:CreateShipmentRequest>`
@@ -393,6 +755,12 @@ type soapBody struct {
 	GetDocumentsResponse    *getDocumentsResponse        `xml:"GetDocumentsResponse,omitempty"`
 	VoidShipmentResponse    *voidShipmentResponse        `xml:"VoidShipmentResponse,omitempty"`
 	TrackPackagesByPinResp  *trackPackagesByPinResponse  `xml:"TrackPackagesByPinResponse,omitempty"`
+	ValidatePickUpResponse  *validatePickUpResponse      `xml:"ValidatePickUpResponse,omitempty"`
+	SchedulePickUpResponse  *schedulePickUpResponse      `xml:"SchedulePickUpResponse,omitempty"`
+	VoidPickUpResponse      *voidPickUpResponse          `xml:"VoidPickUpResponse,omitempty"`
+	ConsolidateResponse     *consolidateResponse         `xml:"ConsolidateResponse,omitempty"`
+
+	GetShipmentManifestDocumentResponse *getManifestResponse `xml:"GetShipmentManifestDocumentResponse,omitempty"`
 }
 
 type soapFault struct {
@@ -501,6 +869,37 @@ type voidShipmentResponse struct {
 	ShipmentVoided      bool         `xml:"ShipmentVoided"`
 }
 
+// PickUpService response types
+type validatePickUpResponse struct {
+	ResponseInformation responseInfo `xml:"ResponseInformation"`
+}
+
+type schedulePickUpResponse struct {
+	ResponseInformation      responseInfo `xml:"ResponseInformation"`
+	PickUpConfirmationNumber string       `xml:"PickUpConfirmationNumber"`
+}
+
+type voidPickUpResponse struct {
+	ResponseInformation responseInfo `xml:"ResponseInformation"`
+	PickUpVoided        bool         `xml:"PickUpVoided"`
+}
+
+// Consolidate response types
+type consolidateResponse struct {
+	ResponseInformation responseInfo `xml:"ResponseInformation"`
+}
+
+// GetShipmentManifestDocument response types
+type getManifestResponse struct {
+	ResponseInformation responseInfo    `xml:"ResponseInformation"`
+	ManifestBatches     []manifestBatch `xml:"ManifestBatches>ManifestBatch"`
+}
+
+type manifestBatch struct {
+	ShipmentManifestDate string           `xml:"ShipmentManifestDate"`
+	Details              []documentDetail `xml:"ManifestBatchDetails>ManifestBatchDetail"`
+}
+
 // TrackPackagesByPin response types
 type trackPackagesByPinResponse struct {
 	ResponseInformation     responseInfo     `xml:"ResponseInformation"`
@@ -552,12 +951,26 @@ func (c *SOAPAPIClient) parseSOAPError(resp *http.Response) error {
 		return &APIError{
 			Code:        env.Body.Fault.Code,
 			Description: env.Body.Fault.String,
+			StatusCode:  resp.StatusCode,
 		}
 	}
 
 	return &APIError{
 		Code:        fmt.Sprintf("HTTP_%d", resp.StatusCode),
 		Description: string(body),
+		StatusCode:  resp.StatusCode,
+	}
+}
+
+// firstResponseError returns the first error reported in a response, or nil.
+func firstResponseError(info responseInfo) error {
+	if len(info.Errors) == 0 {
+		return nil
+	}
+	e := info.Errors[0]
+	return &APIError{
+		Code:        e.Code,
+		Description: e.Description,
 	}
 }
 
@@ -599,27 +1012,42 @@ func (c *SOAPAPIClient) parseRatesResponse(body io.Reader) (*RatesResponse, erro
 
 	rates := make([]ShipmentRate, len(resp.ShipmentEstimates.ShipmentEstimate))
 	for i, est := range resp.ShipmentEstimates.ShipmentEstimate {
+		basePrice, err := parseAmount(est.BasePrice)
+		if err != nil {
+			return nil, err
+		}
+		totalPrice, err := parseAmount(est.TotalPrice)
+		if err != nil {
+			return nil, err
+		}
+
 		// Calculate fuel surcharge from surcharges
-		var fuelSurcharge float64
+		fuelSurcharge := shipper.Money{Currency: currency}
 		for _, sc := range est.Surcharges.Surcharge {
 			if sc.Type == "Fuel" || sc.Type == "FuelSurcharge" {
-				fuelSurcharge = parseFloat(sc.Amount)
+				if fuelSurcharge, err = parseAmount(sc.Amount); err != nil {
+					return nil, err
+				}
 			}
 		}
 
 		// Calculate total taxes
-		var taxes float64
+		taxes := shipper.Money{Currency: currency}
 		for _, tax := range est.Taxes.Tax {
-			taxes += parseFloat(tax.Amount)
+			amount, err := parseAmount(tax.Amount)
+			if err != nil {
+				return nil, err
+			}
+			taxes.Minor += amount.Minor
 		}
 
 		rates[i] = ShipmentRate{
 			ServiceCode:          est.ServiceID,
 			ServiceName:          mapServiceName(est.ServiceID),
-			BasePrice:            parseFloat(est.BasePrice),
+			BasePrice:            basePrice,
 			FuelSurcharge:        fuelSurcharge,
 			Taxes:                taxes,
-			TotalPrice:           parseFloat(est.TotalPrice),
+			TotalPrice:           totalPrice,
 			ExpectedDeliveryDate: est.ExpectedDeliveryDate,
 			EstimatedTransitDays: est.EstimatedTransitDays,
 			GuaranteedDelivery:   isGuaranteedService(est.ServiceID),
@@ -674,10 +1102,15 @@ func (c *SOAPAPIClient) parseShipmentResponse(body io.Reader) (*ShipmentResponse
 		piecePINs[i] = pin.Value
 	}
 
+	totalPrice, err := parseAmount(resp.TotalPrice)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ShipmentResponse{
 		ShipmentPIN:          resp.ShipmentPIN.Value,
 		TrackingNumber:       resp.ShipmentPIN.Value,
-		TotalPrice:           parseFloat(resp.TotalPrice),
+		TotalPrice:           totalPrice,
 		ExpectedDeliveryDate: resp.ExpectedDeliveryDate,
 		PiecePINs:            piecePINs,
 	}, nil
@@ -719,7 +1152,8 @@ func (c *SOAPAPIClient) parseLabelResponse(body io.Reader, shipmentPIN, format s
 		}
 	}
 
-	// Find the label document
+	// Collect the label documents, one per piece
+	var labels [][]byte
 	for _, doc := range resp.Documents.Document {
 		for _, detail := range doc.DocumentDetails {
 			if detail.DocumentStatus == "Completed" {
@@ -728,20 +1162,24 @@ func (c *SOAPAPIClient) parseLabelResponse(body io.Reader, shipmentPIN, format s
 				if err != nil {
 					return nil, fmt.Errorf("failed to decode label data: %w", err)
 				}
-
-				return &LabelResponse{
-					ShipmentPIN: shipmentPIN,
-					Format:      format,
-					Data:        labelData,
-				}, nil
+				labels = append(labels, labelData)
 			}
 		}
 	}
 
-	return nil, &APIError{
-		Code:        "LABEL_NOT_FOUND",
-		Description: "No completed label found in response",
+	if len(labels) == 0 {
+		return nil, &APIError{
+			Code:        "LABEL_NOT_FOUND",
+			Description: "No completed label found in response",
+		}
 	}
+
+	return &LabelResponse{
+		ShipmentPIN:    shipmentPIN,
+		Format:         format,
+		Data:           labels[0],
+		AdditionalData: labels[1:],
+	}, nil
 }
 
 func (c *SOAPAPIClient) parseVoidResponse(body io.Reader, shipmentPIN string) (*VoidResponse, error) {
@@ -872,10 +1310,21 @@ func (c *SOAPAPIClient) parseTrackingResponse(body io.Reader, trackingPIN string
 // Helper Functions
 // ============================================================================
 
-func parseFloat(s string) float64 {
-	var f float64
-	fmt.Sscanf(s, "%f", &f)
-	return f
+// currency is the currency of all Purolator amounts.
+const currency = "CAD"
+
+// parseAmount parses an amount from a Purolator response. An empty amount
+// is zero.
+func parseAmount(s string) (shipper.Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return shipper.Money{Currency: currency}, nil
+	}
+	m, err := shipper.ParseMoney(s, currency)
+	if err != nil {
+		return shipper.Money{}, &APIError{Code: "PARSE_ERROR", Description: err.Error()}
+	}
+	return m, nil
 }
 
 func mapServiceName(serviceID string) string {
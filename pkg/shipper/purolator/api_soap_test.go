@@ -0,0 +1,235 @@
+package purolator_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper/purolator"
+)
+
+func TestSOAPAPIClient_GetRates_Options(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.GetRates(context.Background(), &purolator.RatesRequest{
+		ShipmentDate: "2025-03-14",
+		PackageInformation: purolator.PackageInformation{
+			TotalPieces: 1,
+			Options: []purolator.OptionIDValuePair{
+				{ID: "SaturdayDelivery", Value: "true"},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	assert.Contains(t, body, "<v2:ShipmentDate>2025-03-14</v2:ShipmentDate>")
+	assert.Contains(t, body, "<v2:ID>SaturdayDelivery</v2:ID>")
+	assert.Contains(t, body, "<v2:Value>true</v2:Value>")
+}
+
+func TestSOAPAPIClient_GetRates_NoOptions(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.GetRates(context.Background(), &purolator.RatesRequest{})
+	require.Error(t, err)
+
+	assert.NotContains(t, body, "OptionsInformation")
+	assert.NotContains(t, body, "ShipmentDate")
+}
+
+func TestSOAPAPIClient_CreateShipment_Pieces(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.CreateShipment(context.Background(), &purolator.ShipmentRequest{
+		PackageInformation: purolator.PackageInformation{
+			TotalPieces: 2,
+			PiecesInFormat: []purolator.Piece{
+				{Weight: purolator.Weight{Value: 1.5, Unit: "kg"}, Length: purolator.Dimension{Value: 30, Unit: "cm"}},
+				{Weight: purolator.Weight{Value: 4, Unit: "kg"}, Length: purolator.Dimension{Value: 55, Unit: "cm"}},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, 2, strings.Count(body, "<v2:Piece>"))
+	assert.Contains(t, body, "<v2:Value>1.5</v2:Value>")
+	assert.Contains(t, body, "<v2:Value>55</v2:Value>")
+	assert.Contains(t, body, "<v2:DimensionUnit>cm</v2:DimensionUnit>")
+}
+
+func TestSOAPAPIClient_GetLabel_AllPieces(t *testing.T) {
+	label := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<Envelope><Body><GetDocumentsResponse><Documents>
+			<Document><DocumentDetails>
+				<DocumentDetail><DocumentStatus>Completed</DocumentStatus><Data>` + label("piece-1") + `</Data></DocumentDetail>
+			</DocumentDetails></Document>
+			<Document><DocumentDetails>
+				<DocumentDetail><DocumentStatus>Pending</DocumentStatus></DocumentDetail>
+				<DocumentDetail><DocumentStatus>Completed</DocumentStatus><Data>` + label("piece-2") + `</Data></DocumentDetail>
+			</DocumentDetails></Document>
+		</Documents></GetDocumentsResponse></Body></Envelope>`))
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	resp, err := client.GetLabel(context.Background(), "329014521622", "application/pdf")
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("piece-1"), resp.Data)
+	assert.Equal(t, [][]byte{[]byte("piece-2")}, resp.AdditionalData)
+}
+
+func TestSOAPAPIClient_ValidatePickUp_Invalid(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		io.WriteString(w, `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <ValidatePickUpResponse xmlns="http://purolator.com/pws/datatypes/v1">
+      <ResponseInformation>
+        <Errors>
+          <Error><Code>1100604</Code><Description>Pickup date is a holiday</Description></Error>
+        </Errors>
+      </ResponseInformation>
+    </ValidatePickUpResponse>
+  </soap:Body>
+</soap:Envelope>`)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	resp, err := client.ValidatePickUp(context.Background(), &purolator.PickUpRequest{Date: "2025-07-01"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "/EWS/V1/PickUp/PickUpService.asmx", path)
+	assert.False(t, resp.Valid)
+	assert.Equal(t, "Pickup date is a holiday", resp.Reason)
+}
+
+func TestSOAPAPIClient_GetManifest_CompletedDocuments(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		io.WriteString(w, `<Envelope><Body><GetShipmentManifestDocumentResponse>
+			<ManifestBatches><ManifestBatch>
+				<ShipmentManifestDate>2025-03-10</ShipmentManifestDate>
+				<ManifestBatchDetails>
+					<ManifestBatchDetail><DocumentType>DomesticBillOfLading</DocumentType><DocumentStatus>Completed</DocumentStatus><URL>https://manifests/1.pdf</URL></ManifestBatchDetail>
+					<ManifestBatchDetail><DocumentType>TransBorderBillOfLading</DocumentType><DocumentStatus>Pending</DocumentStatus></ManifestBatchDetail>
+				</ManifestBatchDetails>
+			</ManifestBatch></ManifestBatches>
+		</GetShipmentManifestDocumentResponse></Body></Envelope>`)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	resp, err := client.GetManifest(context.Background(), "2025-03-10")
+
+	require.NoError(t, err)
+	assert.Contains(t, body, "<v2:ManifestDate>2025-03-10</v2:ManifestDate>")
+	assert.Equal(t, []purolator.DocumentLink{{Type: "DomesticBillOfLading", URL: "https://manifests/1.pdf"}}, resp.Documents)
+}
+
+func TestSOAPAPIClient_CreateShipment_Return(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.CreateShipment(context.Background(), &purolator.ShipmentRequest{
+		PaymentType: "Receiver",
+		Reference:   "RMA-1001",
+	})
+	require.Error(t, err)
+
+	assert.Contains(t, body, "<v2:PaymentType>Receiver</v2:PaymentType>")
+	assert.Contains(t, body, "<v2:Reference1>RMA-1001</v2:Reference1>")
+}
+
+func TestSOAPAPIClient_CreateShipment_InternationalInformation(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.CreateShipment(context.Background(), &purolator.ShipmentRequest{
+		Receiver: purolator.Receiver{TaxNumber: "12-3456789"},
+		InternationalInformation: &purolator.InternationalInformation{
+			ContentDetails: []purolator.ContentDetail{
+				{Description: "Cotton t-shirt", HarmonizedCode: "6109.10", CountryOfManufacture: "CA", UnitValue: 25, Quantity: 3},
+			},
+			BillDutiesToParty: "Sender",
+			Currency:          "USD",
+			ImportExportType:  "Permanent",
+		},
+	})
+	require.Error(t, err)
+
+	assert.Contains(t, body, "<v2:TaxNumber>12-3456789</v2:TaxNumber>")
+	assert.Contains(t, body, "<v2:HarmonizedCode>6109.10</v2:HarmonizedCode>")
+	assert.Contains(t, body, "<v2:Quantity>3</v2:Quantity>")
+	assert.Contains(t, body, "<v2:BillDutiesToParty>Sender</v2:BillDutiesToParty>")
+	assert.Contains(t, body, "<v2:Currency>USD</v2:Currency>")
+}
+
+func TestSOAPAPIClient_GetRates_Domestic(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := purolator.NewSOAPAPIClient(purolator.SOAPAPIClientConfig{WSDLURL: srv.URL})
+
+	_, err := client.GetRates(context.Background(), &purolator.RatesRequest{})
+	require.Error(t, err)
+
+	assert.NotContains(t, body, "InternationalInformation")
+}
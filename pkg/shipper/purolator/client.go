@@ -4,9 +4,14 @@ package purolator
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+	"github.com/tournevent/logistic/pkg/shipper/units"
 	"github.com/uptrace/opentelemetry-go-extra/otelzap"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -14,12 +19,34 @@ import (
 
 const carrierName = "purolator"
 
+// packageUnits is how Purolator measures pieces: pounds and inches, to one
+// decimal. Shipment totals are billed in whole pounds. Pieces are limited to
+// 150 lb, 108 in on the longest side and 165 in in length plus girth.
+var packageUnits = units.Spec{
+	WeightUnit:    shipper.WeightLB,
+	DimensionUnit: shipper.DimensionIN,
+	WeightStep:    0.1,
+	DimensionStep: 0.1,
+
+	MaxWeight:          150,
+	MaxLength:          108,
+	MaxLengthPlusGirth: 165,
+}
+
+// Purolator validates a given pickup window rather than listing the times it
+// can collect, so availability is checked for a pickup over business hours.
+const (
+	businessHoursStart = 9
+	businessHoursEnd   = 17
+)
+
 // Config holds Purolator configuration.
 type Config struct {
-	Username string
-	Password string
-	WSDLURL  string
-	UseMock  bool
+	Username    string
+	Password    string
+	WSDLURL     string
+	UseMock     bool
+	RateLimiter *ratelimit.Limiter // Optional client-side rate limiter for the SOAP client
 }
 
 // Client is the Purolator API client.
@@ -38,10 +65,11 @@ func New(cfg Config, logger *otelzap.Logger, tracer trace.Tracer) *Client {
 		apiClient = NewMockAPIClient()
 	} else {
 		apiClient = NewSOAPAPIClient(SOAPAPIClientConfig{
-			WSDLURL:  cfg.WSDLURL,
-			Username: cfg.Username,
-			Password: cfg.Password,
-			Timeout:  30 * time.Second,
+			WSDLURL:     cfg.WSDLURL,
+			Username:    cfg.Username,
+			Password:    cfg.Password,
+			Timeout:     30 * time.Second,
+			RateLimiter: cfg.RateLimiter,
 		})
 	}
 
@@ -68,14 +96,55 @@ func (c *Client) Name() string {
 	return carrierName
 }
 
+// Capabilities describes what Purolator supports. Labels come as PDF or
+// ZPL.
+func (c *Client) Capabilities() shipper.Capabilities {
+	return shipper.Capabilities{
+		LabelFormats:      []shipper.LabelFormat{shipper.LabelPDF, shipper.LabelZPL},
+		International:     true,
+		MultiPiece:        true,
+		Pickups:           true,
+		Manifests:         true,
+		ManifestsAccount:  true,
+		Returns:           true,
+		Insurance:         true,
+		SignatureRequired: true,
+		SaturdayDelivery:  true,
+		MaxWeight:         packageUnits.MaxWeight,
+		MaxWeightUnit:     packageUnits.WeightUnit,
+	}
+}
+
 // GetQuote returns shipping quotes from Purolator.
 func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_quote",
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Getting Purolator quotes",
 		zap.String("origin_postal", req.Origin.PostalCode),
 		zap.String("destination_postal", req.Destination.PostalCode),
 		zap.Int("package_count", len(req.Packages)),
 	)
 
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	options, err := optionsToAPI(req.Options, pkgs)
+	if err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	international, err := customsToAPI(req.Customs)
+	if err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
 	// Convert to API request
 	apiReq := &RatesRequest{
 		SenderPostalCode: req.Origin.PostalCode,
@@ -85,69 +154,98 @@ func (c *Client) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*ship
 			PostalCode: req.Destination.PostalCode,
 			Country:    req.Destination.CountryCode,
 		},
+		ShipmentDate:             formatShipmentDate(req.Options.ShipDate),
+		InternationalInformation: international,
 	}
 
 	// Set package information
-	if len(req.Packages) > 0 {
-		var totalWeight float64
-		for _, pkg := range req.Packages {
-			totalWeight += pkg.Weight
-		}
-		apiReq.PackageInformation = PackageInformation{
-			TotalWeight: Weight{Value: totalWeight, Unit: "kg"},
-			TotalPieces: len(req.Packages),
-		}
-	}
+	apiReq.PackageInformation = packageInformation(pkgs)
+	apiReq.PackageInformation.Options = options
 
 	// Call API
 	apiResp, err := c.apiClient.GetRates(ctx, apiReq)
 	if err != nil {
 		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
 	// Convert to shipper response
-	return ratesResponseToShipper(apiResp), nil
+	resp := ratesResponseToShipper(apiResp)
+	resp.SetBillableWeight(apiReq.PackageInformation.TotalWeight.Value, packageUnits.WeightUnit)
+	return resp, nil
 }
 
 // CreateOrder creates a shipment with Purolator.
 func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderRequest) (*shipper.CreateOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_order",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
 	c.logger.Info("Creating Purolator order",
 		zap.String("rate_id", req.RateID),
 		zap.String("recipient", req.Recipient.Name),
 	)
 
-	// Extract service code from rate ID
-	serviceCode := extractServiceCode(req.RateID)
+	if req.ServiceID == "" {
+		err := shipper.NewShipperError(carrierName, "invalid_service", "unknown service code for rate "+req.RateID)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	if req.Pickup != nil {
+		// Pickups are booked separately, with SchedulePickup
+		err := shipper.NewUnsupportedOptionError(carrierName, shipper.OptionPickup)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	options, err := optionsToAPI(req.Options, pkgs)
+	if err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	international, err := customsToAPI(req.Customs)
+	if err != nil {
+		shipper.RecordError(span, err)
+		return nil, err
+	}
 
 	// Convert to API request
 	apiReq := &ShipmentRequest{
-		ServiceCode: serviceCode,
+		ServiceCode: req.ServiceID,
 		Sender: Sender{
 			Address: addressToAPI(req.SenderAddress),
 		},
 		Receiver: Receiver{
 			Address: addressToAPI(req.RecipientAddress),
 		},
-		PrinterType: "Regular",
+		ShipmentDate:             formatShipmentDate(req.Options.ShipDate),
+		PrinterType:              "Regular",
+		InternationalInformation: international,
+	}
+	if req.Customs != nil {
+		apiReq.Sender.TaxNumber = req.Customs.ExporterTaxID
+		apiReq.Receiver.TaxNumber = req.Customs.ImporterTaxID
 	}
 
 	// Set package information
-	if len(req.Packages) > 0 {
-		var totalWeight float64
-		for _, pkg := range req.Packages {
-			totalWeight += pkg.Weight
-		}
-		apiReq.PackageInformation = PackageInformation{
-			TotalWeight: Weight{Value: totalWeight, Unit: "kg"},
-			TotalPieces: len(req.Packages),
-		}
-	}
+	apiReq.PackageInformation = packageInformation(pkgs)
+	apiReq.PackageInformation.Options = options
 
 	// Call API
 	apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
 	if err != nil {
 		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -157,6 +255,9 @@ func (c *Client) CreateOrder(ctx context.Context, req *shipper.CreateOrderReques
 
 // GetLabel retrieves the shipping label from Purolator.
 func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*shipper.GetLabelResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_label")
+	defer span.End()
+
 	c.logger.Info("Getting Purolator label",
 		zap.String("order_id", req.OrderID),
 		zap.String("format", string(req.Format)),
@@ -171,6 +272,7 @@ func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*s
 	apiResp, err := c.apiClient.GetLabel(ctx, req.OrderID, format)
 	if err != nil {
 		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -180,6 +282,9 @@ func (c *Client) GetLabel(ctx context.Context, req *shipper.GetLabelRequest) (*s
 
 // CancelOrder cancels a shipment with Purolator.
 func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderRequest) (*shipper.CancelOrderResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_order")
+	defer span.End()
+
 	c.logger.Info("Cancelling Purolator order",
 		zap.String("order_id", req.OrderID),
 		zap.String("reason", req.Reason),
@@ -189,6 +294,7 @@ func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderReques
 	apiResp, err := c.apiClient.VoidShipment(ctx, req.OrderID)
 	if err != nil {
 		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
 		return nil, err
 	}
 
@@ -196,10 +302,340 @@ func (c *Client) CancelOrder(ctx context.Context, req *shipper.CancelOrderReques
 	return voidResponseToShipper(apiResp), nil
 }
 
+// Track retrieves the tracking history of a Purolator shipment by PIN.
+func (c *Client) Track(ctx context.Context, req *shipper.TrackRequest) (*shipper.TrackResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "track_shipment")
+	defer span.End()
+
+	c.logger.Info("Tracking Purolator shipment",
+		zap.String("order_id", req.OrderID),
+		zap.String("tracking_number", req.TrackingNumber),
+	)
+
+	pin := req.TrackingNumber
+	if pin == "" {
+		pin = req.OrderID
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.GetTracking(ctx, pin)
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Convert to shipper response
+	resp := trackingResponseToShipper(apiResp)
+	resp.OrderID = req.OrderID
+	return resp, nil
+}
+
+// GetPickupAvailability reports whether Purolator can collect at the address
+// during business hours on the requested day.
+func (c *Client) GetPickupAvailability(ctx context.Context, req *shipper.PickupAvailabilityRequest) (*shipper.PickupAvailabilityResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "get_pickup_availability")
+	defer span.End()
+
+	c.logger.Info("Getting Purolator pickup availability",
+		zap.String("postal_code", req.Address.PostalCode),
+	)
+
+	y, m, d := req.Date.Date()
+	window := shipper.PickupWindow{
+		ReadyAt: time.Date(y, m, d, businessHoursStart, 0, 0, 0, req.Date.Location()),
+		CloseAt: time.Date(y, m, d, businessHoursEnd, 0, 0, 0, req.Date.Location()),
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.ValidatePickUp(ctx, pickUpRequest(req.Address, window, 1, Weight{Value: 1, Unit: "lb"}, ""))
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	resp := &shipper.PickupAvailabilityResponse{Available: apiResp.Valid}
+	if apiResp.Valid {
+		resp.Windows = []shipper.PickupWindow{window}
+	}
+	return resp, nil
+}
+
+// SchedulePickup books a Purolator pickup. The pickup ID is the pickup
+// confirmation number.
+func (c *Client) SchedulePickup(ctx context.Context, req *shipper.SchedulePickupRequest) (*shipper.SchedulePickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "schedule_pickup",
+		shipper.AttrPackageCount.Int(req.PackageCount),
+	)
+	defer span.End()
+
+	c.logger.Info("Scheduling Purolator pickup",
+		zap.String("postal_code", req.Address.PostalCode),
+		zap.Time("ready_at", req.Window.ReadyAt),
+	)
+
+	address := req.Address
+	address.Name = req.Contact.Name
+	address.Phone = req.Contact.Phone
+	weight := Weight{
+		Value: units.RoundUp(packageUnits.Weight(req.TotalWeight, req.WeightUnit), 1),
+		Unit:  string(packageUnits.WeightUnit),
+	}
+
+	// Call API
+	apiResp, err := c.apiClient.SchedulePickUp(ctx, pickUpRequest(address, req.Window, req.PackageCount, weight, req.Instructions))
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.SchedulePickupResponse{
+		PickupID: apiResp.ConfirmationNumber,
+		Carrier:  carrierName,
+		Status:   mapPickupStatus(apiResp.Status),
+		Window:   req.Window,
+	}, nil
+}
+
+// CancelPickup voids a Purolator pickup.
+func (c *Client) CancelPickup(ctx context.Context, req *shipper.CancelPickupRequest) (*shipper.CancelPickupResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "cancel_pickup")
+	defer span.End()
+
+	c.logger.Info("Cancelling Purolator pickup",
+		zap.String("pickup_id", req.PickupID),
+	)
+
+	// Call API
+	apiResp, err := c.apiClient.VoidPickUp(ctx, req.PickupID)
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	return &shipper.CancelPickupResponse{
+		PickupID: apiResp.ConfirmationNumber,
+		Status:   mapPickupStatus(apiResp.Status),
+	}, nil
+}
+
+var _ shipper.PickupScheduler = (*Client)(nil)
+
+// Manifest consolidates the day's shipments and retrieves the manifests of
+// the ship date. Purolator consolidates every open shipment of the account
+// at once, so the shipper and origin are not used (see
+// Capabilities.ManifestsAccount).
+func (c *Client) Manifest(ctx context.Context, req *shipper.ManifestRequest) (*shipper.ManifestResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "manifest")
+	defer span.End()
+
+	date := req.ShipDate.Format("2006-01-02")
+	c.logger.Info("Consolidating Purolator shipments",
+		zap.String("date", date),
+	)
+
+	// Call API
+	if err := c.apiClient.Consolidate(ctx); err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	apiResp, err := c.apiClient.GetManifest(ctx, date)
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Purolator manifests have no ID of their own. The date may be
+	// consolidated several times, so IDs also name the consolidation.
+	run := uuid.New().String()[:8]
+	resp := &shipper.ManifestResponse{
+		Carrier:   carrierName,
+		Manifests: make([]shipper.Manifest, len(apiResp.Documents)),
+	}
+	for i, doc := range apiResp.Documents {
+		resp.Manifests[i] = shipper.Manifest{
+			ManifestID: fmt.Sprintf("%s-%s-%d", apiResp.Date, run, i+1),
+			Document:   shipper.Label{Format: shipper.LabelPDF, URL: doc.URL},
+		}
+	}
+	return resp, nil
+}
+
+var _ shipper.Manifester = (*Client)(nil)
+
+// CreateReturn creates a Purolator return shipment with the ReturnServices
+// option, billed to the receiver's account, and retrieves its labels. The
+// RMA number is printed as the first tracking reference.
+func (c *Client) CreateReturn(ctx context.Context, req *shipper.CreateReturnRequest) (*shipper.CreateReturnResponse, error) {
+	ctx, span := shipper.StartSpan(ctx, c.tracer, carrierName, "create_return",
+		shipper.AttrServiceCode.String(req.ServiceID),
+		shipper.AttrPackageCount.Int(len(req.Packages)),
+	)
+	defer span.End()
+
+	c.logger.Info("Creating Purolator return",
+		zap.String("original_order_id", req.OriginalOrderID),
+		zap.String("rma_number", req.RMANumber),
+	)
+
+	pkgs := packageUnits.NormalizeAll(req.Packages)
+	if err := packageUnits.Check(pkgs); err != nil {
+		err = shipper.NewPackageLimitError(carrierName, err)
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	printerType, format := "Regular", "application/pdf"
+	if req.LabelFormat == shipper.LabelZPL {
+		printerType, format = "Thermal", "application/zpl"
+	}
+
+	returner := addressToAPI(req.ReturnerAddress)
+	returner.PhoneNumber = phoneToAPI(req.Returner.Phone)
+	apiReq := &ShipmentRequest{
+		ServiceCode: req.ServiceID,
+		Sender: Sender{
+			Address: returner,
+		},
+		Receiver: Receiver{
+			Address: addressToAPI(req.ReceiverAddress),
+		},
+		PackageInformation: packageInformation(pkgs),
+		PrinterType:        printerType,
+		PaymentType:        "Receiver",
+		Reference:          req.RMANumber,
+	}
+	apiReq.PackageInformation.Options = []OptionIDValuePair{{ID: "ReturnServices", Value: "true"}}
+
+	// Call API
+	apiResp, err := c.apiClient.CreateShipment(ctx, apiReq)
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+	labelResp, err := c.apiClient.GetLabel(ctx, apiResp.ShipmentPIN, format)
+	if err != nil {
+		c.logger.Error("Purolator API error", zap.Error(err))
+		shipper.RecordError(span, err)
+		return nil, err
+	}
+
+	// Convert to shipper response
+	labels := labelResponseToShipper(labelResp)
+	return &shipper.CreateReturnResponse{
+		OrderID:          apiResp.ShipmentPIN,
+		TrackingNumber:   apiResp.TrackingNumber,
+		Carrier:          carrierName,
+		Status:           shipper.StatusConfirmed,
+		TotalCharged:     apiResp.TotalPrice,
+		Label:            labels.Label,
+		AdditionalLabels: labels.AdditionalLabels,
+	}, nil
+}
+
+var _ shipper.Returner = (*Client)(nil)
+
 // ============================================================================
 // Conversion helpers
 // ============================================================================
 
+// optionsToAPI maps shipping options to Purolator OptionIDValuePair entries.
+func optionsToAPI(opts shipper.ShippingOptions, pkgs []shipper.Package) ([]OptionIDValuePair, error) {
+	var options []OptionIDValuePair
+	if opts.SignatureRequired {
+		options = append(options, OptionIDValuePair{ID: "ResidentialSignatureDomestic", Value: "true"})
+	}
+	if opts.InsuranceRequired {
+		value, err := shipper.InsuredValue(carrierName, pkgs)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, OptionIDValuePair{ID: "DeclaredValue", Value: value.Amount().String()})
+	}
+	if opts.SaturdayDelivery {
+		options = append(options, OptionIDValuePair{ID: "SaturdayDelivery", Value: "true"})
+	}
+	return options, nil
+}
+
+// customsToAPI converts a customs declaration, or returns nil without one.
+// Purolator declares values in CAD or USD only. It has no field for the
+// export declaration number, which is reported to CBSA before tendering.
+func customsToAPI(c *shipper.Customs) (*InternationalInformation, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Currency != "CAD" && c.Currency != "USD" {
+		return nil, shipper.NewShipperError(carrierName, "invalid_customs", "customs values must be in CAD or USD").
+			WithCause(shipper.ErrInvalidCustoms)
+	}
+
+	info := &InternationalInformation{
+		ContentDetails:    make([]ContentDetail, len(c.Items)),
+		BillDutiesToParty: "Receiver",
+		Currency:          c.Currency,
+		ImportExportType:  "Permanent",
+	}
+	if c.Incoterm == shipper.IncotermDDP {
+		info.BillDutiesToParty = "Sender"
+	}
+	switch c.ReasonForExport {
+	case shipper.ExportRepair:
+		info.ImportExportType = "Repair"
+	case shipper.ExportReturn:
+		info.ImportExportType = "Return"
+	}
+	for i, item := range c.Items {
+		info.ContentDetails[i] = ContentDetail{
+			Description:          item.Description,
+			HarmonizedCode:       item.HSCode,
+			CountryOfManufacture: item.CountryOfOrigin,
+			ProductCode:          item.SKU,
+			UnitValue:            item.UnitValue.Float64(),
+			Quantity:             item.Quantity,
+		}
+	}
+	return info, nil
+}
+
+// packageInformation describes packages normalized to packageUnits as
+// Purolator pieces, keeping each package's weight and dimensions alongside
+// the totals.
+func packageInformation(pkgs []shipper.Package) PackageInformation {
+	var info PackageInformation
+	if len(pkgs) == 0 {
+		return info
+	}
+
+	weightUnit, dimensionUnit := string(packageUnits.WeightUnit), string(packageUnits.DimensionUnit)
+	info.PiecesInFormat = make([]Piece, len(pkgs))
+	for i, pkg := range pkgs {
+		info.PiecesInFormat[i] = Piece{
+			Weight:   Weight{Value: pkg.Weight, Unit: weightUnit},
+			Length:   Dimension{Value: pkg.Length, Unit: dimensionUnit},
+			Width:    Dimension{Value: pkg.Width, Unit: dimensionUnit},
+			Height:   Dimension{Value: pkg.Height, Unit: dimensionUnit},
+			Quantity: 1,
+		}
+	}
+	info.TotalWeight = Weight{Value: units.RoundUp(packageUnits.TotalWeight(pkgs), 1), Unit: weightUnit}
+	info.TotalPieces = len(pkgs)
+	return info
+}
+
+func formatShipmentDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
 func addressToAPI(addr shipper.Address) Address {
 	return Address{
 		Name:          addr.Name,
@@ -212,6 +648,39 @@ func addressToAPI(addr shipper.Address) Address {
 	}
 }
 
+// phoneToAPI splits a North American phone number into its country code,
+// area code and number, for the driver to call. Other numbers are sent
+// whole.
+func phoneToAPI(phone string) PhoneNumber {
+	digits := strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, phone)
+	digits = strings.TrimPrefix(digits, "1")
+	if len(digits) != 10 {
+		return PhoneNumber{Phone: phone}
+	}
+	return PhoneNumber{CountryCode: "1", AreaCode: digits[:3], Phone: digits[3:]}
+}
+
+// pickUpRequest builds a pickup request for the window, in the local time
+// of its start.
+func pickUpRequest(addr shipper.Address, window shipper.PickupWindow, pieces int, weight Weight, instructions string) *PickUpRequest {
+	address := addressToAPI(addr)
+	address.PhoneNumber = phoneToAPI(addr.Phone)
+	return &PickUpRequest{
+		Address:      address,
+		Date:         window.ReadyAt.Format("2006-01-02"),
+		AnyTimeAfter: window.ReadyAt.Format("1504"),
+		UntilTime:    window.CloseAt.In(window.ReadyAt.Location()).Format("1504"),
+		TotalWeight:  weight,
+		TotalPieces:  pieces,
+		Instructions: instructions,
+	}
+}
+
 func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 	rates := make([]shipper.RateOption, len(resp.ShipmentRates))
 	expiresAt := time.Now().Add(30 * time.Minute)
@@ -227,13 +696,14 @@ func ratesResponseToShipper(resp *RatesResponse) *shipper.QuoteResponse {
 		rates[i] = shipper.RateOption{
 			RateID:            generateRateID(r.ServiceCode),
 			Carrier:           carrierName,
+			ServiceID:         r.ServiceCode,
 			ServiceCode:       r.ServiceCode,
 			ServiceName:       r.ServiceName,
 			ServiceType:       mapServiceType(r.ServiceCode),
-			BaseRate:          shipper.Money{Amount: r.BasePrice, Currency: "CAD"},
-			FuelSurcharge:     shipper.Money{Amount: r.FuelSurcharge, Currency: "CAD"},
-			Taxes:             shipper.Money{Amount: r.Taxes, Currency: "CAD"},
-			TotalPrice:        shipper.Money{Amount: r.TotalPrice, Currency: "CAD"},
+			BaseRate:          r.BasePrice,
+			FuelSurcharge:     r.FuelSurcharge,
+			Taxes:             r.Taxes,
+			TotalPrice:        r.TotalPrice,
 			TransitDays:       r.EstimatedTransitDays,
 			EstimatedDelivery: estimatedDelivery,
 			ExpiresAt:         expiresAt,
@@ -265,6 +735,12 @@ func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderRespo
 		}
 	}
 
+	// Each piece is tracked by its own PIN
+	pieces := make([]shipper.Piece, len(resp.PiecePINs))
+	for i, pin := range resp.PiecePINs {
+		pieces[i] = shipper.Piece{ID: pin, TrackingNumber: pin}
+	}
+
 	return &shipper.CreateOrderResponse{
 		OrderID:           resp.ShipmentPIN,
 		TrackingNumber:    resp.TrackingNumber,
@@ -272,9 +748,10 @@ func shipmentResponseToShipper(resp *ShipmentResponse) *shipper.CreateOrderRespo
 		Status:            shipper.StatusConfirmed,
 		Carrier:           carrierName,
 		ServiceName:       "Purolator",
-		TotalCharged:      shipper.Money{Amount: resp.TotalPrice, Currency: "CAD"},
+		TotalCharged:      resp.TotalPrice,
 		EstimatedDelivery: estimatedDelivery,
 		LabelURL:          labelURL,
+		Pieces:            pieces,
 	}
 }
 
@@ -284,21 +761,30 @@ func labelResponseToShipper(resp *LabelResponse) *shipper.GetLabelResponse {
 		format = shipper.LabelZPL
 	}
 
-	// Encode binary data as base64 for the shipper response
-	data := ""
-	if len(resp.Data) > 0 {
-		data = base64.StdEncoding.EncodeToString(resp.Data)
+	// Purolator returns one document per piece; the first is the main label
+	var additional []shipper.Label
+	for _, data := range resp.AdditionalData {
+		additional = append(additional, shipper.Label{Format: format, Data: encodeLabel(data)})
 	}
 
 	return &shipper.GetLabelResponse{
 		OrderID: resp.ShipmentPIN,
 		Label: shipper.Label{
 			Format: format,
-			Data:   data,
+			Data:   encodeLabel(resp.Data),
 		},
+		AdditionalLabels: additional,
 	}
 }
 
+// encodeLabel encodes binary label data as base64 for the shipper response.
+func encodeLabel(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
 func voidResponseToShipper(resp *VoidResponse) *shipper.CancelOrderResponse {
 	return &shipper.CancelOrderResponse{
 		OrderID:            resp.ShipmentPIN,
@@ -307,28 +793,51 @@ func voidResponseToShipper(resp *VoidResponse) *shipper.CancelOrderResponse {
 	}
 }
 
-func generateRateID(serviceCode string) string {
-	return "puro-" + serviceCode + "-" + time.Now().Format("20060102150405")
-}
-
-func extractServiceCode(rateID string) string {
-	// Parse rate ID like "puro-PurolatorGround-20231215120000" -> "PurolatorGround"
-	// For simplicity, default to Ground
-	for _, code := range []string{"PurolatorGround", "PurolatorExpress", "PurolatorExpress9AM", "PurolatorExpress10:30AM"} {
-		if contains(rateID, code) {
-			return code
+func trackingResponseToShipper(resp *TrackingResponse) *shipper.TrackResponse {
+	events := make([]shipper.TrackingEvent, len(resp.Events))
+	for i, e := range resp.Events {
+		events[i] = shipper.TrackingEvent{
+			Timestamp:   parseTimestamp(e.Timestamp),
+			Description: e.Description,
+			Location:    e.Location,
+			CarrierCode: e.Type,
+		}
+	}
+	shipper.SortEventsNewestFirst(events)
+
+	// Informational scans, such as a package held at a depot, do not
+	// change the shipment status: they carry the status of the scan
+	// before them
+	status := mapStatus(resp.Status)
+	if len(events) > 0 {
+		status = shipper.StatusPending
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if s, ok := statuses[events[i].CarrierCode]; ok {
+			status = s
 		}
+		events[i].Status = status
+	}
+
+	return &shipper.TrackResponse{
+		TrackingNumber: resp.TrackingPIN,
+		Status:         status,
+		Events:         events,
 	}
-	return "PurolatorGround"
 }
 
-func contains(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+// parseTimestamp accepts the scan timestamp layouts used by TrackingService.
+func parseTimestamp(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
 		}
 	}
-	return false
+	return time.Time{}
+}
+
+func generateRateID(serviceCode string) string {
+	return "puro-" + serviceCode + "-" + uuid.New().String()
 }
 
 func mapServiceType(code string) shipper.ServiceType {
@@ -343,3 +852,35 @@ func mapServiceType(code string) shipper.ServiceType {
 		return shipper.ServiceStandard
 	}
 }
+
+// statuses maps the Purolator scan types that change the status of a
+// shipment. Other scans are informational.
+var statuses = map[string]shipper.ShipmentStatus{
+	"Undeliverable":   shipper.StatusException,
+	"Exception":       shipper.StatusException,
+	"ReturnToSender":  shipper.StatusException,
+	"PickedUp":        shipper.StatusPickedUp,
+	"ProofOfPickUp":   shipper.StatusPickedUp,
+	"InTransit":       shipper.StatusInTransit,
+	"OutForDelivery":  shipper.StatusOutForDelivery,
+	"OnDelivery":      shipper.StatusOutForDelivery,
+	"Delivered":       shipper.StatusDelivered,
+	"ProofOfDelivery": shipper.StatusDelivered,
+	"Voided":          shipper.StatusCancelled,
+}
+
+// mapStatus returns the shipment status of a Purolator scan type, pending
+// if the scan is informational.
+func mapStatus(scanType string) shipper.ShipmentStatus {
+	if s, ok := statuses[scanType]; ok {
+		return s
+	}
+	return shipper.StatusPending
+}
+
+func mapPickupStatus(status string) shipper.PickupStatus {
+	if status == "Voided" {
+		return shipper.PickupCancelled
+	}
+	return shipper.PickupScheduled
+}
@@ -2,8 +2,10 @@ package purolator_test
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -84,7 +86,7 @@ func TestClient_GetQuote_CustomMock(t *testing.T) {
 				{
 					ServiceCode:          "PurolatorExpress9AM",
 					ServiceName:          "Purolator Express 9AM",
-					TotalPrice:           56.95,
+					TotalPrice:           shipper.Money{Minor: 5695, Currency: "CAD"},
 					EstimatedTransitDays: 1,
 					ExpectedDeliveryDate: "2024-01-15",
 					GuaranteedDelivery:   true,
@@ -136,8 +138,9 @@ func TestClient_CreateOrder_Success(t *testing.T) {
 	client := newTestClient(mockAPI)
 
 	req := &shipper.CreateOrderRequest{
-		RateID: "puro-PurolatorGround-20231215120000",
-		Sender: shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
+		RateID:    "puro-PurolatorGround-20231215120000",
+		ServiceID: "PurolatorGround",
+		Sender:    shipper.Contact{Name: "John Doe", Phone: "416-555-1234"},
 		SenderAddress: shipper.Address{
 			Line1:        "123 Main St",
 			City:         "Toronto",
@@ -177,6 +180,7 @@ func TestClient_CreateOrder_APIError(t *testing.T) {
 
 	req := &shipper.CreateOrderRequest{
 		RateID:           "puro-PurolatorGround-123",
+		ServiceID:        "PurolatorGround",
 		SenderAddress:    shipper.Address{City: "Toronto"},
 		RecipientAddress: shipper.Address{City: "Vancouver"},
 	}
@@ -189,10 +193,16 @@ func TestClient_CreateOrder_APIError(t *testing.T) {
 
 func TestClient_CreateOrder_ExpressService(t *testing.T) {
 	mockAPI := purolator.NewMockAPIClient()
+	var serviceCode string
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *purolator.ShipmentRequest) (*purolator.ShipmentResponse, error) {
+		serviceCode = req.ServiceCode
+		return &purolator.ShipmentResponse{ShipmentPIN: "329012345678901"}, nil
+	}
 	client := newTestClient(mockAPI)
 
 	req := &shipper.CreateOrderRequest{
-		RateID: "puro-PurolatorExpress-20231215120000",
+		RateID:    "puro-PurolatorExpress-20231215120000",
+		ServiceID: "PurolatorExpress",
 		SenderAddress: shipper.Address{
 			City:         "Toronto",
 			ProvinceCode: "ON",
@@ -211,6 +221,25 @@ func TestClient_CreateOrder_ExpressService(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, resp.OrderID)
+	assert.Equal(t, "PurolatorExpress", serviceCode)
+}
+
+func TestClient_CreateOrder_MissingService(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.CreateOrderRequest{
+		RateID:           "puro-PurolatorGround-123",
+		SenderAddress:    shipper.Address{City: "Toronto"},
+		RecipientAddress: shipper.Address{City: "Vancouver"},
+	}
+
+	ctx := context.Background()
+	_, err := client.CreateOrder(ctx, req)
+
+	var shipperErr *shipper.ShipperError
+	require.ErrorAs(t, err, &shipperErr)
+	assert.Equal(t, "invalid_service", shipperErr.Code)
 }
 
 func TestClient_GetLabel_Success(t *testing.T) {
@@ -326,6 +355,64 @@ func TestClient_CancelOrder_CustomError(t *testing.T) {
 	assert.Contains(t, err.Error(), "cannot cancel")
 }
 
+func TestClient_Track_Success(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	client := newTestClient(mockAPI)
+
+	req := &shipper.TrackRequest{
+		OrderID:        "puro-ship-12345678",
+		TrackingNumber: "329012345678901",
+	}
+
+	ctx := context.Background()
+	resp, err := client.Track(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "329012345678901", resp.TrackingNumber)
+	assert.Equal(t, shipper.StatusInTransit, resp.Status)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, shipper.StatusInTransit, resp.Events[0].Status)
+	assert.Equal(t, shipper.StatusPickedUp, resp.Events[1].Status)
+	assert.Equal(t, "PickedUp", resp.Events[1].CarrierCode)
+}
+
+func TestClient_Track_InformationalScans(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.OnGetTracking = func(ctx context.Context, trackingPIN string) (*purolator.TrackingResponse, error) {
+		return &purolator.TrackingResponse{
+			TrackingPIN: trackingPIN,
+			Status:      "Other",
+			Events: []purolator.TrackingEvent{
+				{Timestamp: "2026-10-15T09:00:00", Description: "Shipment held at depot", Type: "Other"},
+				{Timestamp: "2026-10-14T16:00:00", Description: "In transit to destination", Type: "InTransit"},
+				{Timestamp: "2026-10-14T08:00:00", Description: "Shipment information received", Type: "Undefined"},
+			},
+		}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.Track(context.Background(), &shipper.TrackRequest{TrackingNumber: "329012345678901"})
+
+	require.NoError(t, err)
+	assert.Equal(t, shipper.StatusInTransit, resp.Status)
+	require.Len(t, resp.Events, 3)
+	assert.Equal(t, shipper.StatusInTransit, resp.Events[0].Status)
+	assert.Equal(t, shipper.StatusInTransit, resp.Events[1].Status)
+	assert.Equal(t, shipper.StatusPending, resp.Events[2].Status)
+}
+
+func TestClient_Track_APIError(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.SimulateErrors = true
+
+	client := newTestClient(mockAPI)
+
+	ctx := context.Background()
+	_, err := client.Track(ctx, &shipper.TrackRequest{TrackingNumber: "329012345678901"})
+
+	assert.Error(t, err)
+}
+
 func TestClient_Name(t *testing.T) {
 	mockAPI := purolator.NewMockAPIClient()
 	client := newTestClient(mockAPI)
@@ -380,3 +467,384 @@ func TestClient_ServiceTypeMapping(t *testing.T) {
 	assert.Equal(t, shipper.ServiceExpress, serviceTypes["PurolatorExpress"])
 	assert.Equal(t, shipper.ServiceOvernight, serviceTypes["PurolatorExpress9AM"])
 }
+
+func TestClient_CreateOrder_Options(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *purolator.ShipmentRequest) (*purolator.ShipmentResponse, error) {
+		captured = req
+		return &purolator.ShipmentResponse{ShipmentPIN: "329014521622"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	shipDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "PurolatorExpress",
+		Packages:  []shipper.Package{{Weight: 1, DeclaredValue: shipper.Money{Minor: 25000, Currency: "CAD"}}},
+		Options: shipper.ShippingOptions{
+			SignatureRequired: true,
+			InsuranceRequired: true,
+			SaturdayDelivery:  true,
+			ShipDate:          &shipDate,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, []purolator.OptionIDValuePair{
+		{ID: "ResidentialSignatureDomestic", Value: "true"},
+		{ID: "DeclaredValue", Value: "250.00"},
+		{ID: "SaturdayDelivery", Value: "true"},
+	}, captured.PackageInformation.Options)
+	assert.Equal(t, "2025-03-14", captured.ShipmentDate)
+}
+
+func TestClient_CreateOrder_MultiplePieces(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *purolator.ShipmentRequest) (*purolator.ShipmentResponse, error) {
+		captured = req
+		return &purolator.ShipmentResponse{
+			ShipmentPIN:    "329014521622",
+			TrackingNumber: "329014521622",
+			PiecePINs:      []string{"329014521622", "329014521633"},
+		}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID: "PurolatorExpress",
+		Packages: []shipper.Package{
+			{Weight: 1.5, Length: 12, Width: 8, Height: 4, WeightUnit: shipper.WeightLB, DimensionUnit: shipper.DimensionIN},
+			{Weight: 4, Length: 22, Width: 16, Height: 10, WeightUnit: shipper.WeightLB, DimensionUnit: shipper.DimensionIN},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	info := captured.PackageInformation
+	assert.Equal(t, purolator.Weight{Value: 6, Unit: "lb"}, info.TotalWeight)
+	assert.Equal(t, 2, info.TotalPieces)
+	require.Len(t, info.PiecesInFormat, 2)
+	assert.Equal(t, purolator.Piece{
+		Weight:   purolator.Weight{Value: 4, Unit: "lb"},
+		Length:   purolator.Dimension{Value: 22, Unit: "in"},
+		Width:    purolator.Dimension{Value: 16, Unit: "in"},
+		Height:   purolator.Dimension{Value: 10, Unit: "in"},
+		Quantity: 1,
+	}, info.PiecesInFormat[1])
+
+	assert.Equal(t, []shipper.Piece{
+		{ID: "329014521622", TrackingNumber: "329014521622"},
+		{ID: "329014521633", TrackingNumber: "329014521633"},
+	}, resp.Pieces)
+}
+
+func TestClient_GetLabel_MultiplePieces(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.OnGetLabel = func(ctx context.Context, shipmentPIN string, format string) (*purolator.LabelResponse, error) {
+		return &purolator.LabelResponse{
+			ShipmentPIN:    shipmentPIN,
+			Format:         format,
+			Data:           []byte("piece-1"),
+			AdditionalData: [][]byte{[]byte("piece-2"), []byte("piece-3")},
+		}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetLabel(context.Background(), &shipper.GetLabelRequest{
+		OrderID: "329014521622",
+		Format:  shipper.LabelZPL,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("piece-1")), resp.Label.Data)
+	require.Len(t, resp.AdditionalLabels, 2)
+	assert.Equal(t, shipper.Label{
+		Format: shipper.LabelZPL,
+		Data:   base64.StdEncoding.EncodeToString([]byte("piece-3")),
+	}, resp.AdditionalLabels[1])
+}
+
+func TestClient_GetQuote_ConvertsMetricPackages(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.RatesRequest
+	mockAPI.OnGetRates = func(ctx context.Context, req *purolator.RatesRequest) (*purolator.RatesResponse, error) {
+		captured = req
+		return &purolator.RatesResponse{ShipmentRates: []purolator.ShipmentRate{{ServiceCode: "PurolatorExpress"}}}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 10, Length: 30, Width: 20, Height: 10, WeightUnit: shipper.WeightKG, DimensionUnit: shipper.DimensionCM},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	piece := captured.PackageInformation.PiecesInFormat[0]
+	assert.Equal(t, purolator.Weight{Value: 22.1, Unit: "lb"}, piece.Weight)    // 22.046 lb
+	assert.Equal(t, purolator.Dimension{Value: 11.9, Unit: "in"}, piece.Length) // 11.811 in
+	assert.Equal(t, purolator.Weight{Value: 23, Unit: "lb"}, captured.PackageInformation.TotalWeight)
+
+	require.Len(t, resp.Rates, 1)
+	assert.Equal(t, 23.0, resp.Rates[0].BillableWeight)
+	assert.Equal(t, shipper.WeightLB, resp.Rates[0].BillableWeightUnit)
+}
+
+func TestClient_GetQuote_RejectsOversizedPieces(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.OnGetRates = func(ctx context.Context, req *purolator.RatesRequest) (*purolator.RatesResponse, error) {
+		t.Fatal("oversized pieces must not be sent to Purolator")
+		return nil, nil
+	}
+	client := newTestClient(mockAPI)
+
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{
+			{Weight: 5, Length: 30, Width: 20, Height: 10},
+			{Weight: 70, Length: 30, Width: 20, Height: 10, WeightUnit: shipper.WeightKG}, // 154.3 lb
+		},
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, shipper.ErrInvalidPackage)
+	var shipperErr *shipper.ShipperError
+	require.ErrorAs(t, err, &shipperErr)
+	assert.Equal(t, "invalid_package", shipperErr.Code)
+	fields := shipper.ValidationFields(err)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "packages[1].weight", fields[0].Field)
+	assert.Equal(t, "must not exceed 150 lb for this carrier", fields[0].Message)
+}
+
+func TestClient_GetPickupAvailability(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.PickUpRequest
+	mockAPI.OnValidatePickUp = func(ctx context.Context, req *purolator.PickUpRequest) (*purolator.PickUpValidation, error) {
+		captured = req
+		return &purolator.PickUpValidation{Valid: true}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{
+		Address: shipper.Address{PostalCode: "M5V1A1", CountryCode: "CA"},
+		Date:    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Available)
+	assert.Equal(t, []shipper.PickupWindow{{
+		ReadyAt: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+		CloseAt: time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC),
+	}}, resp.Windows)
+
+	require.NotNil(t, captured)
+	assert.Equal(t, "0900", captured.AnyTimeAfter)
+	assert.Equal(t, "1700", captured.UntilTime)
+}
+
+func TestClient_GetPickupAvailability_Unavailable(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.OnValidatePickUp = func(ctx context.Context, req *purolator.PickUpRequest) (*purolator.PickUpValidation, error) {
+		return &purolator.PickUpValidation{Reason: "No pickups on statutory holidays"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.GetPickupAvailability(context.Background(), &shipper.PickupAvailabilityRequest{
+		Date: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Available)
+	assert.Empty(t, resp.Windows)
+}
+
+func TestClient_SchedulePickup_Success(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.PickUpRequest
+	mockAPI.OnSchedulePickUp = func(ctx context.Context, req *purolator.PickUpRequest) (*purolator.PickUpResponse, error) {
+		captured = req
+		return &purolator.PickUpResponse{ConfirmationNumber: "PUR-PU-1", Status: "Scheduled"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	window := shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)}
+	resp, err := client.SchedulePickup(context.Background(), &shipper.SchedulePickupRequest{
+		Address:      shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Contact:      shipper.Contact{Name: "Jane Doe", Phone: "+1 (416) 555-0100"},
+		Window:       window,
+		PackageCount: 2,
+		TotalWeight:  5,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &shipper.SchedulePickupResponse{
+		PickupID: "PUR-PU-1",
+		Carrier:  "purolator",
+		Status:   shipper.PickupScheduled,
+		Window:   window,
+	}, resp)
+
+	require.NotNil(t, captured)
+	assert.Equal(t, purolator.Weight{Value: 12, Unit: "lb"}, captured.TotalWeight) // 11.02 lb
+	assert.Equal(t, 2, captured.TotalPieces)
+	assert.Equal(t, "Jane Doe", captured.Address.Name)
+	assert.Equal(t, purolator.PhoneNumber{CountryCode: "1", AreaCode: "416", Phone: "5550100"}, captured.Address.PhoneNumber)
+	assert.Equal(t, "2025-03-10", captured.Date)
+	assert.Equal(t, "1300", captured.AnyTimeAfter)
+	assert.Equal(t, "1700", captured.UntilTime)
+}
+
+func TestClient_CancelPickup_Success(t *testing.T) {
+	client := newTestClient(purolator.NewMockAPIClient())
+
+	resp, err := client.CancelPickup(context.Background(), &shipper.CancelPickupRequest{PickupID: "PUR-PU-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "PUR-PU-1", resp.PickupID)
+	assert.Equal(t, shipper.PickupCancelled, resp.Status)
+}
+
+func TestClient_Manifest_Success(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var consolidated bool
+	mockAPI.OnConsolidate = func(ctx context.Context) error {
+		consolidated = true
+		return nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.Manifest(context.Background(), &shipper.ManifestRequest{
+		Origin:   shipper.Address{PostalCode: "M5V1A1", CountryCode: "CA"},
+		ShipDate: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, consolidated)
+	assert.Equal(t, "purolator", resp.Carrier)
+	require.Len(t, resp.Manifests, 1)
+	assert.Regexp(t, `^2025-03-10-[0-9a-f]{8}-1$`, resp.Manifests[0].ManifestID)
+	assert.Equal(t, shipper.LabelPDF, resp.Manifests[0].Document.Format)
+	assert.Equal(t, "https://eshiponline.purolator.com/manifests/2025-03-10.pdf", resp.Manifests[0].Document.URL)
+
+	// Each consolidation has its own IDs
+	again, err := client.Manifest(context.Background(), &shipper.ManifestRequest{ShipDate: time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	require.Len(t, again.Manifests, 1)
+	assert.NotEqual(t, resp.Manifests[0].ManifestID, again.Manifests[0].ManifestID)
+}
+
+func TestClient_Manifest_ConsolidateError(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	mockAPI.OnConsolidate = func(ctx context.Context) error {
+		return &purolator.APIError{Code: "1100001", Description: "No shipments to consolidate"}
+	}
+	var fetched bool
+	mockAPI.OnGetManifest = func(ctx context.Context, date string) (*purolator.ManifestResponse, error) {
+		fetched = true
+		return &purolator.ManifestResponse{Date: date}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	_, err := client.Manifest(context.Background(), &shipper.ManifestRequest{ShipDate: time.Now()})
+
+	require.Error(t, err)
+	assert.False(t, fetched)
+}
+
+func TestClient_CreateReturn_Success(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *purolator.ShipmentRequest) (*purolator.ShipmentResponse, error) {
+		captured = req
+		return &purolator.ShipmentResponse{ShipmentPIN: "PUR-RET-1", TrackingNumber: "PUR-RET-1"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	resp, err := client.CreateReturn(context.Background(), &shipper.CreateReturnRequest{
+		ServiceID:       "PurolatorExpress",
+		Returner:        shipper.Contact{Name: "John Smith", Phone: "+1 (514) 555-0100"},
+		ReturnerAddress: shipper.Address{Line1: "456 Rue Principale", City: "Montreal", ProvinceCode: "QC", PostalCode: "H2X1Y4", CountryCode: "CA"},
+		ReceiverAddress: shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Packages:        []shipper.Package{{Weight: 2, Length: 30, Width: 20, Height: 10}},
+		RMANumber:       "RMA-1001",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "PUR-RET-1", resp.OrderID)
+	assert.Equal(t, "purolator", resp.Carrier)
+	assert.Equal(t, shipper.StatusConfirmed, resp.Status)
+
+	require.NotNil(t, captured)
+	assert.Equal(t, "H2X1Y4", captured.Sender.Address.PostalCode)
+	assert.Equal(t, purolator.PhoneNumber{CountryCode: "1", AreaCode: "514", Phone: "5550100"}, captured.Sender.Address.PhoneNumber)
+	assert.Equal(t, "M5V1A1", captured.Receiver.Address.PostalCode)
+	assert.Equal(t, "Receiver", captured.PaymentType)
+	assert.Equal(t, "RMA-1001", captured.Reference)
+	assert.Contains(t, captured.PackageInformation.Options, purolator.OptionIDValuePair{ID: "ReturnServices", Value: "true"})
+}
+
+func TestClient_CreateOrder_Customs(t *testing.T) {
+	mockAPI := purolator.NewMockAPIClient()
+	var captured *purolator.ShipmentRequest
+	mockAPI.OnCreateShipment = func(ctx context.Context, req *purolator.ShipmentRequest) (*purolator.ShipmentResponse, error) {
+		captured = req
+		return &purolator.ShipmentResponse{ShipmentPIN: "329014521622"}, nil
+	}
+	client := newTestClient(mockAPI)
+
+	_, err := client.CreateOrder(context.Background(), &shipper.CreateOrderRequest{
+		ServiceID:        "PurolatorExpressU.S.",
+		RecipientAddress: shipper.Address{City: "Beverly Hills", ProvinceCode: "CA", PostalCode: "90210", CountryCode: "US"},
+		Packages:         []shipper.Package{{Weight: 1}},
+		Customs: &shipper.Customs{
+			ReasonForExport: shipper.ExportRepair,
+			Incoterm:        shipper.IncotermDDP,
+			Currency:        "USD",
+			ImporterTaxID:   "12-3456789",
+			ExporterTaxID:   "123456789RM0001",
+			Items: []shipper.CustomsItem{{
+				Description:     "Camera",
+				Quantity:        1,
+				UnitValue:       shipper.Money{Minor: 45000, Currency: "USD"},
+				UnitWeight:      1,
+				CountryOfOrigin: "JP",
+				HSCode:          "8525.80",
+				SKU:             "CAM-1",
+			}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, "123456789RM0001", captured.Sender.TaxNumber)
+	assert.Equal(t, "12-3456789", captured.Receiver.TaxNumber)
+	require.NotNil(t, captured.InternationalInformation)
+	assert.Equal(t, &purolator.InternationalInformation{
+		ContentDetails: []purolator.ContentDetail{
+			{Description: "Camera", HarmonizedCode: "8525.80", CountryOfManufacture: "JP", ProductCode: "CAM-1", UnitValue: 450, Quantity: 1},
+		},
+		BillDutiesToParty: "Sender",
+		Currency:          "USD",
+		ImportExportType:  "Repair",
+	}, captured.InternationalInformation)
+}
+
+func TestClient_GetQuote_CustomsCurrency(t *testing.T) {
+	client := newTestClient(purolator.NewMockAPIClient())
+
+	_, err := client.GetQuote(context.Background(), &shipper.QuoteRequest{
+		Destination: shipper.Address{PostalCode: "90210", CountryCode: "US"},
+		Packages:    []shipper.Package{{Weight: 1}},
+		Customs: &shipper.Customs{
+			ReasonForExport: shipper.ExportSale,
+			Currency:        "EUR",
+			Items:           []shipper.CustomsItem{{Description: "Book", Quantity: 1, UnitValue: shipper.Money{Minor: 2000, Currency: "EUR"}}},
+		},
+	})
+
+	assert.ErrorIs(t, err, shipper.ErrInvalidCustoms)
+}
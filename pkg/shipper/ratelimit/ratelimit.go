@@ -0,0 +1,226 @@
+// Package ratelimit provides client-side token-bucket rate limiting for
+// carrier API clients.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultOperation is the configuration key of the bucket shared by all
+// operations without a limit of their own.
+const DefaultOperation = "default"
+
+// Limit is the sustained rate and burst size of a token bucket.
+type Limit struct {
+	Rate  float64 // Requests per second; zero means unlimited
+	Burst int     // Requests that may be sent back to back
+}
+
+// Config holds the limits of one carrier.
+type Config struct {
+	Default    Limit            // Shared by operations without their own limit
+	Operations map[string]Limit // Keyed by API operation, e.g. "get_rates"
+}
+
+// ParseConfig parses limits given as operation to "rate[/burst]", where rate
+// is in requests per second. The "default" entry sets Config.Default.
+// When burst is omitted it is the rate rounded up, and at least 1.
+func ParseConfig(spec map[string]string) (Config, error) {
+	cfg := Config{Operations: make(map[string]Limit)}
+	for op, value := range spec {
+		limit, err := parseLimit(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("rate limit for %s: %w", op, err)
+		}
+		if op == DefaultOperation {
+			cfg.Default = limit
+		} else {
+			cfg.Operations[op] = limit
+		}
+	}
+	return cfg, nil
+}
+
+func parseLimit(value string) (Limit, error) {
+	rateStr, burstStr, hasBurst := strings.Cut(strings.TrimSpace(value), "/")
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate < 0 {
+		return Limit{}, fmt.Errorf("invalid rate %q", rateStr)
+	}
+
+	burst := max(1, int(math.Ceil(rate)))
+	if hasBurst {
+		burst, err = strconv.Atoi(burstStr)
+		if err != nil || burst < 1 {
+			return Limit{}, fmt.Errorf("invalid burst %q", burstStr)
+		}
+	}
+	return Limit{Rate: rate, Burst: burst}, nil
+}
+
+// ParseRetryAfter returns the delay requested by a Retry-After header value,
+// given either as seconds or as an HTTP date. It returns zero if the value
+// is missing or invalid.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	return 0
+}
+
+// Observer receives the time callers spent waiting for a token.
+// telemetry.Metrics implements it.
+type Observer interface {
+	RecordRateLimitWait(carrier, operation string, seconds float64)
+}
+
+// Limiter rate limits the API calls of one carrier.
+// A nil Limiter never blocks.
+type Limiter struct {
+	carrier  string
+	observer Observer
+	def      *bucket
+	ops      map[string]*bucket
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// New creates a limiter for the given carrier. The observer may be nil.
+func New(carrier string, cfg Config, observer Observer) *Limiter {
+	l := &Limiter{
+		carrier:  carrier,
+		observer: observer,
+		def:      newBucket(cfg.Default),
+		ops:      make(map[string]*bucket, len(cfg.Operations)),
+	}
+	for op, limit := range cfg.Operations {
+		l.ops[op] = newBucket(limit)
+	}
+	return l
+}
+
+// Wait blocks until a request for the operation may be sent.
+// It returns the context error if ctx is done first.
+func (l *Limiter) Wait(ctx context.Context, operation string) error {
+	if l == nil {
+		return nil
+	}
+
+	start := time.Now()
+	err := l.wait(ctx, operation)
+	if l.observer != nil {
+		l.observer.RecordRateLimitWait(l.carrier, operation, time.Since(start).Seconds())
+	}
+	return err
+}
+
+func (l *Limiter) wait(ctx context.Context, operation string) error {
+	l.mu.Lock()
+	paused := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+	if err := sleep(ctx, paused); err != nil {
+		return err
+	}
+
+	b := l.def
+	if opBucket, ok := l.ops[operation]; ok {
+		b = opBucket
+	}
+	delay := b.reserve(time.Now())
+	if err := sleep(ctx, delay); err != nil {
+		b.cancel()
+		return err
+	}
+	return nil
+}
+
+// Pause blocks all operations for d, typically because the carrier answered
+// with a Retry-After header.
+func (l *Limiter) Pause(d time.Duration) {
+	if l == nil || d <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// bucket is a token bucket. Tokens may go negative: each caller reserves a
+// token immediately and waits until the bucket has refilled past it.
+type bucket struct {
+	mu     sync.Mutex
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(limit Limit) *bucket {
+	return &bucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait for it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	if b.limit.Rate <= 0 {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() {
+		elapsed := now.Sub(b.last).Seconds()
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+}
+
+// cancel returns a token reserved by a caller that gave up waiting.
+func (b *bucket) cancel() {
+	if b.limit.Rate <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+1)
+}
@@ -0,0 +1,135 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper/ratelimit"
+)
+
+type recordingObserver struct {
+	mu    sync.Mutex
+	waits map[string]int
+}
+
+func (o *recordingObserver) RecordRateLimitWait(carrier, operation string, seconds float64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.waits == nil {
+		o.waits = make(map[string]int)
+	}
+	o.waits[carrier+"/"+operation]++
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ratelimit.ParseConfig(map[string]string{
+		"default":    "10/20",
+		"poll_rates": "2",
+		"get_label":  "0.5",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Limit{Rate: 10, Burst: 20}, cfg.Default)
+	assert.Equal(t, ratelimit.Limit{Rate: 2, Burst: 2}, cfg.Operations["poll_rates"])
+	assert.Equal(t, ratelimit.Limit{Rate: 0.5, Burst: 1}, cfg.Operations["get_label"])
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	tests := []string{"fast", "-1", "10/0", "10/many"}
+
+	for _, value := range tests {
+		t.Run(value, func(t *testing.T) {
+			_, err := ratelimit.ParseConfig(map[string]string{"default": value})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, ratelimit.ParseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, ratelimit.ParseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), ratelimit.ParseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), ratelimit.ParseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), ratelimit.ParseRetryAfter("soon", now))
+}
+
+func TestLimiter_BurstThenThrottle(t *testing.T) {
+	observer := &recordingObserver{}
+	l := ratelimit.New("freightcom", ratelimit.Config{
+		Default: ratelimit.Limit{Rate: 20, Burst: 2},
+	}, observer)
+	ctx := context.Background()
+
+	start := time.Now()
+	require.NoError(t, l.Wait(ctx, "get_rates"))
+	require.NoError(t, l.Wait(ctx, "get_rates"))
+	assert.Less(t, time.Since(start), 20*time.Millisecond)
+
+	require.NoError(t, l.Wait(ctx, "get_rates"))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	assert.Equal(t, 3, observer.waits["freightcom/get_rates"])
+}
+
+func TestLimiter_PerOperationBuckets(t *testing.T) {
+	l := ratelimit.New("freightcom", ratelimit.Config{
+		Operations: map[string]ratelimit.Limit{
+			"poll_rates": {Rate: 1, Burst: 1},
+		},
+	}, nil)
+	ctx := context.Background()
+
+	require.NoError(t, l.Wait(ctx, "poll_rates"))
+
+	// The default bucket is unlimited and unaffected by poll_rates
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.Wait(ctx, "get_label"))
+	}
+	assert.Less(t, time.Since(start), 20*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx, "poll_rates"), context.DeadlineExceeded)
+}
+
+func TestLimiter_CancelledWaitReturnsToken(t *testing.T) {
+	l := ratelimit.New("freightcom", ratelimit.Config{
+		Default: ratelimit.Limit{Rate: 10, Burst: 1},
+	}, nil)
+
+	require.NoError(t, l.Wait(context.Background(), "get_rates"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, l.Wait(ctx, "get_rates"), context.Canceled)
+
+	// Only the first token is spent, so the next caller waits ~100ms, not ~200ms
+	start := time.Now()
+	require.NoError(t, l.Wait(context.Background(), "get_rates"))
+	assert.Less(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestLimiter_Pause(t *testing.T) {
+	l := ratelimit.New("purolator", ratelimit.Config{}, nil)
+
+	l.Pause(50 * time.Millisecond)
+
+	start := time.Now()
+	require.NoError(t, l.Wait(context.Background(), "get_rates"))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestLimiter_Nil(t *testing.T) {
+	var l *ratelimit.Limiter
+
+	l.Pause(time.Hour)
+	assert.NoError(t, l.Wait(context.Background(), "get_rates"))
+}
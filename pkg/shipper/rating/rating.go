@@ -0,0 +1,238 @@
+// Package rating filters, ranks and tags the rates aggregated from several
+// carriers so that they can be presented as one stable list.
+package rating
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// Sort is the order in which ranked rates are listed.
+type Sort string
+
+const (
+	SortCheapest  Sort = "cheapest"   // Lowest total price first
+	SortFastest   Sort = "fastest"    // Fewest transit days first
+	SortBestValue Sort = "best_value" // Lowest weighted score first
+)
+
+// Tag marks a notable rate of a ranked list.
+type Tag string
+
+const (
+	TagCheapest    Tag = "cheapest"
+	TagFastest     Tag = "fastest"
+	TagRecommended Tag = "recommended" // Best value
+)
+
+// Filter selects the rates to rank. The zero Filter keeps every rate.
+type Filter struct {
+	ServiceTypes   []shipper.ServiceType // Empty = all
+	GuaranteedOnly bool
+	MaxTransitDays int           // Zero = no limit; rates without transit days are dropped when set
+	MaxPrice       shipper.Money // Zero = no limit; rates in another currency are dropped when set
+}
+
+// Weights balances price against speed in the best value score. Only their
+// ratio matters.
+type Weights struct {
+	Price float64
+	Speed float64
+}
+
+// DefaultWeights favours price over speed.
+var DefaultWeights = Weights{Price: 0.7, Speed: 0.3}
+
+// Options configures Rank.
+type Options struct {
+	Filter  Filter
+	Sort    Sort    // Empty for SortCheapest
+	Weights Weights // Zero for DefaultWeights
+}
+
+// Rate is a rate of a ranked list.
+type Rate struct {
+	shipper.RateOption
+	Score float64 // Best value score, from 0 for the best value to 1
+	Tags  []Tag
+}
+
+// Rank filters rates, keeps the cheapest rate of each carrier service, sorts
+// them and tags the cheapest, fastest and recommended ones. Ties are broken
+// by price, transit days, carrier and service code so that the same rates
+// always rank in the same order, whatever order the carriers answered in.
+// Rates without transit days rank as the slowest. Prices are compared by
+// amount, as carriers quote in the shipper's currency.
+func Rank(rates []shipper.RateOption, opts Options) []Rate {
+	weights := opts.Weights
+	if weights.Price <= 0 && weights.Speed <= 0 {
+		weights = DefaultWeights
+	}
+
+	ranked := dedupe(filter(rates, opts.Filter))
+	if len(ranked) == 0 {
+		return nil
+	}
+	score(ranked, weights)
+
+	tag(ranked, TagCheapest, compareCheapest)
+	if slices.ContainsFunc(ranked, func(r Rate) bool { return r.TransitDays > 0 }) {
+		tag(ranked, TagFastest, compareFastest)
+	}
+	tag(ranked, TagRecommended, compareBestValue)
+
+	switch opts.Sort {
+	case SortFastest:
+		slices.SortFunc(ranked, compareFastest)
+	case SortBestValue:
+		slices.SortFunc(ranked, compareBestValue)
+	default:
+		slices.SortFunc(ranked, compareCheapest)
+	}
+	return ranked
+}
+
+func filter(rates []shipper.RateOption, f Filter) []shipper.RateOption {
+	kept := make([]shipper.RateOption, 0, len(rates))
+	for _, rate := range rates {
+		if len(f.ServiceTypes) > 0 && !slices.Contains(f.ServiceTypes, rate.ServiceType) {
+			continue
+		}
+		if f.GuaranteedOnly && !rate.Guaranteed {
+			continue
+		}
+		if f.MaxTransitDays > 0 && (rate.TransitDays <= 0 || rate.TransitDays > f.MaxTransitDays) {
+			continue
+		}
+		if !f.MaxPrice.IsZero() && (rate.TotalPrice.Currency != f.MaxPrice.Currency || rate.TotalPrice.Minor > f.MaxPrice.Minor) {
+			continue
+		}
+		kept = append(kept, rate)
+	}
+	return kept
+}
+
+// dedupe keeps the cheapest rate of each carrier service, since quoting the
+// same packages twice returns identical services.
+func dedupe(rates []shipper.RateOption) []Rate {
+	index := make(map[string]int, len(rates))
+	result := make([]Rate, 0, len(rates))
+	for _, rate := range rates {
+		key := rate.Carrier + "/" + rate.ServiceCode
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(result)
+			result = append(result, Rate{RateOption: rate})
+			continue
+		}
+		if compareCheapest(Rate{RateOption: rate}, result[i]) < 0 {
+			result[i] = Rate{RateOption: rate}
+		}
+	}
+	return result
+}
+
+// score sets the best value score of each rate: its price and transit days
+// scaled between the cheapest and dearest, fastest and slowest rates, and
+// weighted.
+func score(rates []Rate, w Weights) {
+	minPrice, maxPrice := rates[0].TotalPrice.Minor, rates[0].TotalPrice.Minor
+	minDays, maxDays := 0, 0
+	for _, r := range rates {
+		minPrice = min(minPrice, r.TotalPrice.Minor)
+		maxPrice = max(maxPrice, r.TotalPrice.Minor)
+		if r.TransitDays > 0 {
+			if minDays == 0 {
+				minDays = r.TransitDays
+			}
+			minDays = min(minDays, r.TransitDays)
+			maxDays = max(maxDays, r.TransitDays)
+		}
+	}
+
+	total := max(w.Price, 0) + max(w.Speed, 0)
+	for i, r := range rates {
+		price := scale(float64(r.TotalPrice.Minor), float64(minPrice), float64(maxPrice))
+		speed := 1.0
+		if r.TransitDays > 0 {
+			speed = scale(float64(r.TransitDays), float64(minDays), float64(maxDays))
+		}
+		rates[i].Score = (max(w.Price, 0)*price + max(w.Speed, 0)*speed) / total
+	}
+}
+
+// scale maps v from [lo, hi] to [0, 1].
+func scale(v, lo, hi float64) float64 {
+	if hi <= lo {
+		return 0
+	}
+	return (v - lo) / (hi - lo)
+}
+
+// tag adds t to the first rate in the order of compare.
+func tag(rates []Rate, t Tag, compare func(a, b Rate) int) {
+	best := 0
+	for i := range rates {
+		if compare(rates[i], rates[best]) < 0 {
+			best = i
+		}
+	}
+	rates[best].Tags = append(rates[best].Tags, t)
+}
+
+func compareCheapest(a, b Rate) int {
+	if c := compareMinor(a, b); c != 0 {
+		return c
+	}
+	if c := compareTransitDays(a, b); c != 0 {
+		return c
+	}
+	return compareService(a, b)
+}
+
+func compareFastest(a, b Rate) int {
+	if c := compareTransitDays(a, b); c != 0 {
+		return c
+	}
+	if c := compareMinor(a, b); c != 0 {
+		return c
+	}
+	return compareService(a, b)
+}
+
+func compareBestValue(a, b Rate) int {
+	if c := cmp.Compare(a.Score, b.Score); c != 0 {
+		return c
+	}
+	return compareCheapest(a, b)
+}
+
+func compareMinor(a, b Rate) int {
+	return cmp.Compare(a.TotalPrice.Minor, b.TotalPrice.Minor)
+}
+
+// compareTransitDays orders rates by transit days, unknown last.
+func compareTransitDays(a, b Rate) int {
+	switch {
+	case a.TransitDays == b.TransitDays:
+		return 0
+	case a.TransitDays <= 0:
+		return 1
+	case b.TransitDays <= 0:
+		return -1
+	}
+	return cmp.Compare(a.TransitDays, b.TransitDays)
+}
+
+func compareService(a, b Rate) int {
+	if c := strings.Compare(a.Carrier, b.Carrier); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.ServiceCode, b.ServiceCode); c != 0 {
+		return c
+	}
+	return strings.Compare(a.RateID, b.RateID)
+}
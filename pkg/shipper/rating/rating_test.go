@@ -0,0 +1,159 @@
+package rating_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
+)
+
+func rate(carrier, service string, price int64, days int) shipper.RateOption {
+	return shipper.RateOption{
+		RateID:      carrier + "-" + service,
+		Carrier:     carrier,
+		ServiceCode: service,
+		ServiceType: shipper.ServiceStandard,
+		TotalPrice:  shipper.Money{Minor: price, Currency: "CAD"},
+		TransitDays: days,
+	}
+}
+
+func rateIDs(rates []rating.Rate) []string {
+	ids := make([]string, len(rates))
+	for i, r := range rates {
+		ids[i] = r.RateID
+	}
+	return ids
+}
+
+func tagged(rates []rating.Rate) map[rating.Tag]string {
+	tags := make(map[rating.Tag]string)
+	for _, r := range rates {
+		for _, t := range r.Tags {
+			tags[t] = r.RateID
+		}
+	}
+	return tags
+}
+
+var quotes = []shipper.RateOption{
+	rate("purolator", "PurolatorExpress", 3500, 1),
+	rate("canadapost", "DOM.RP", 1500, 5),
+	rate("freightcom", "101", 2000, 2),
+	rate("canadapost", "DOM.EP", 2200, 3),
+}
+
+func TestRank_Sort(t *testing.T) {
+	tests := []struct {
+		sort     rating.Sort
+		expected []string
+	}{
+		{"", []string{"canadapost-DOM.RP", "freightcom-101", "canadapost-DOM.EP", "purolator-PurolatorExpress"}},
+		{rating.SortFastest, []string{"purolator-PurolatorExpress", "freightcom-101", "canadapost-DOM.EP", "canadapost-DOM.RP"}},
+		{rating.SortBestValue, []string{"freightcom-101", "canadapost-DOM.RP", "canadapost-DOM.EP", "purolator-PurolatorExpress"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			ranked := rating.Rank(quotes, rating.Options{Sort: tt.sort})
+
+			assert.Equal(t, tt.expected, rateIDs(ranked))
+		})
+	}
+}
+
+func TestRank_Tags(t *testing.T) {
+	ranked := rating.Rank(quotes, rating.Options{})
+
+	assert.Equal(t, map[rating.Tag]string{
+		rating.TagCheapest:    "canadapost-DOM.RP",
+		rating.TagFastest:     "purolator-PurolatorExpress",
+		rating.TagRecommended: "freightcom-101",
+	}, tagged(ranked))
+	assert.InDelta(t, 0.3, ranked[0].Score, 1e-9)
+	assert.InDelta(t, 0.25, ranked[1].Score, 1e-9)
+}
+
+func TestRank_Weights(t *testing.T) {
+	ranked := rating.Rank(quotes, rating.Options{
+		Sort:    rating.SortBestValue,
+		Weights: rating.Weights{Price: 0, Speed: 1},
+	})
+
+	assert.Equal(t, "purolator-PurolatorExpress", ranked[0].RateID)
+	assert.Equal(t, []rating.Tag{rating.TagFastest, rating.TagRecommended}, ranked[0].Tags)
+}
+
+func TestRank_StableAcrossArrivalOrder(t *testing.T) {
+	reversed := []shipper.RateOption{quotes[3], quotes[2], quotes[1], quotes[0]}
+	tied := append(reversed, rate("canadapost", "DOM.XP", 2000, 2))
+
+	first := rating.Rank(tied, rating.Options{})
+	second := rating.Rank(append([]shipper.RateOption{tied[4]}, tied[:4]...), rating.Options{})
+
+	assert.Equal(t, rateIDs(first), rateIDs(second))
+	assert.Equal(t, []string{"canadapost-DOM.RP", "canadapost-DOM.XP", "freightcom-101"}, rateIDs(first)[:3])
+}
+
+func TestRank_Filter(t *testing.T) {
+	express := rate("purolator", "PurolatorExpress9AM", 6000, 1)
+	express.ServiceType = shipper.ServiceExpress
+	express.Guaranteed = true
+	rates := append([]shipper.RateOption{express}, quotes...)
+
+	tests := []struct {
+		name     string
+		filter   rating.Filter
+		expected []string
+	}{
+		{"service type", rating.Filter{ServiceTypes: []shipper.ServiceType{shipper.ServiceExpress}}, []string{"purolator-PurolatorExpress9AM"}},
+		{"guaranteed", rating.Filter{GuaranteedOnly: true}, []string{"purolator-PurolatorExpress9AM"}},
+		{"max transit days", rating.Filter{MaxTransitDays: 2}, []string{"freightcom-101", "purolator-PurolatorExpress", "purolator-PurolatorExpress9AM"}},
+		{"max price", rating.Filter{MaxPrice: shipper.Money{Minor: 2000, Currency: "CAD"}}, []string{"canadapost-DOM.RP", "freightcom-101"}},
+		{"max price in other currency", rating.Filter{MaxPrice: shipper.Money{Minor: 100000, Currency: "USD"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := rating.Rank(rates, rating.Options{Filter: tt.filter})
+
+			if tt.expected == nil {
+				assert.Empty(t, ranked)
+				return
+			}
+			assert.Equal(t, tt.expected, rateIDs(ranked))
+		})
+	}
+}
+
+func TestRank_Dedupe(t *testing.T) {
+	dearer := rate("canadapost", "DOM.RP", 1800, 5)
+	dearer.RateID = "canadapost-DOM.RP-2"
+
+	ranked := rating.Rank([]shipper.RateOption{dearer, quotes[1]}, rating.Options{})
+
+	require.Len(t, ranked, 1)
+	assert.Equal(t, "canadapost-DOM.RP", ranked[0].RateID)
+}
+
+func TestRank_UnknownTransitDays(t *testing.T) {
+	ranked := rating.Rank([]shipper.RateOption{
+		rate("freightcom", "201", 1000, 0),
+		rate("freightcom", "101", 2000, 2),
+	}, rating.Options{Sort: rating.SortFastest})
+
+	assert.Equal(t, []string{"freightcom-101", "freightcom-201"}, rateIDs(ranked))
+	assert.InDelta(t, 0.3, ranked[1].Score, 1e-9)
+	assert.Equal(t, map[rating.Tag]string{
+		rating.TagCheapest:    "freightcom-201",
+		rating.TagFastest:     "freightcom-101",
+		rating.TagRecommended: "freightcom-201",
+	}, tagged(ranked))
+}
+
+func TestRank_Empty(t *testing.T) {
+	assert.Nil(t, rating.Rank(nil, rating.Options{}))
+	assert.Nil(t, rating.Rank(quotes, rating.Options{Filter: rating.Filter{GuaranteedOnly: true}}))
+}
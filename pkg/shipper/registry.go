@@ -2,15 +2,22 @@ package shipper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"slices"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
 // Registry manages registered shipping carriers.
 type Registry struct {
 	shippers map[string]Shipper
+	tracer   trace.Tracer
 	mu       sync.RWMutex
 }
 
@@ -28,6 +35,14 @@ func (r *Registry) Register(s Shipper) {
 	r.shippers[s.Name()] = s
 }
 
+// SetTracer sets the tracer used for quote fan-out spans. Without one, the
+// global tracer provider is used.
+func (r *Registry) SetTracer(tracer trace.Tracer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tracer = tracer
+}
+
 // Get returns a shipper by name.
 func (r *Registry) Get(name string) (Shipper, error) {
 	r.mu.RLock()
@@ -67,37 +82,96 @@ func (r *Registry) Count() int {
 	return len(r.shippers)
 }
 
-// GetAllQuotes fetches quotes from all registered carriers in parallel.
-// Errors from individual carriers are logged but don't fail the entire request.
-func (r *Registry) GetAllQuotes(ctx context.Context, req *QuoteRequest) ([]*QuoteResponse, []error) {
-	shippers := r.All()
-	if len(shippers) == 0 {
-		return nil, []error{ErrCarrierNotFound}
-	}
+// QuoteStatus is the outcome of asking one carrier for rates.
+type QuoteStatus string
 
-	results := make([]*QuoteResponse, 0, len(shippers))
-	errs := make([]error, 0)
-	mu := &sync.Mutex{}
+const (
+	QuoteOK      QuoteStatus = "ok"
+	QuoteError   QuoteStatus = "error"
+	QuoteTimeout QuoteStatus = "timeout"
+	QuoteSkipped QuoteStatus = "skipped" // Carrier not registered or unable to quote the request
+)
+
+// CarrierQuote is the outcome of asking one carrier for rates.
+type CarrierQuote struct {
+	Carrier  string
+	Status   QuoteStatus
+	Response *QuoteResponse // Set when Status is QuoteOK
+	Err      error          // Set otherwise
+	Latency  time.Duration
+}
 
+// ErrorCode returns the code the carrier reported for the failure, or an
+// empty string if the quote succeeded or the failure has no carrier code.
+// See ErrorCode.
+func (q *CarrierQuote) ErrorCode() string {
+	return ErrorCode(q.Err)
+}
+
+// Retryable reports whether the quote failed with an error worth retrying.
+func (q *CarrierQuote) Retryable() bool {
+	return q.Err != nil && IsRetryable(q.Err)
+}
+
+// QuoteCarriers fetches quotes from the named carriers in parallel, or from
+// all registered carriers if none are named, and returns the outcome of
+// each in carrier name order. A carrier failing does not affect the others.
+func (r *Registry) QuoteCarriers(ctx context.Context, req *QuoteRequest, carriers []string) []CarrierQuote {
+	if len(carriers) == 0 {
+		carriers = r.Names()
+	}
+	carriers = slices.Clone(carriers)
+	slices.Sort(carriers)
+	carriers = slices.Compact(carriers)
+
+	results := make([]CarrierQuote, len(carriers))
 	g, ctx := errgroup.WithContext(ctx)
 
-	for _, s := range shippers {
-		s := s // capture loop variable
+	for i, name := range carriers {
 		g.Go(func() error {
-			resp, err := s.GetQuote(ctx, req)
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				errs = append(errs, fmt.Errorf("%s: %w", s.Name(), err))
-				return nil // Don't fail the group, continue with other carriers
+			start := time.Now()
+			s, err := r.Get(name)
+			var resp *QuoteResponse
+			if err == nil {
+				resp, err = r.getQuote(ctx, s, req)
+			}
+			results[i] = CarrierQuote{
+				Carrier:  name,
+				Status:   quoteStatus(err),
+				Response: resp,
+				Err:      err,
+				Latency:  time.Since(start),
 			}
-			results = append(results, resp)
-			return nil
+			return nil // Don't fail the group, continue with other carriers
 		})
 	}
 
 	g.Wait()
-	return results, errs
+	return results
+}
+
+// quoteStatus classifies the error of a quote.
+func quoteStatus(err error) QuoteStatus {
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return QuoteOK
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return QuoteTimeout
+	case errors.Is(err, ErrCarrierNotFound), errors.Is(err, ErrUnsupportedOption):
+		return QuoteSkipped
+	default:
+		return QuoteError
+	}
+}
+
+// GetAllQuotes fetches quotes from all registered carriers in parallel.
+// Errors from individual carriers are logged but don't fail the entire request.
+func (r *Registry) GetAllQuotes(ctx context.Context, req *QuoteRequest) ([]*QuoteResponse, []error) {
+	if r.Count() == 0 {
+		return nil, []error{ErrCarrierNotFound}
+	}
+	return splitQuotes(r.QuoteCarriers(ctx, req, nil))
 }
 
 // GetQuotesFromCarriers fetches quotes from specific carriers.
@@ -105,36 +179,43 @@ func (r *Registry) GetQuotesFromCarriers(ctx context.Context, req *QuoteRequest,
 	if len(carriers) == 0 {
 		return r.GetAllQuotes(ctx, req)
 	}
+	return splitQuotes(r.QuoteCarriers(ctx, req, carriers))
+}
 
-	results := make([]*QuoteResponse, 0, len(carriers))
+// splitQuotes separates the responses of quotes from their errors, which
+// are prefixed with the carrier name.
+func splitQuotes(quotes []CarrierQuote) ([]*QuoteResponse, []error) {
+	results := make([]*QuoteResponse, 0, len(quotes))
 	errs := make([]error, 0)
-	mu := &sync.Mutex{}
-
-	g, ctx := errgroup.WithContext(ctx)
-
-	for _, name := range carriers {
-		name := name // capture loop variable
-		g.Go(func() error {
-			s, err := r.Get(name)
-			if err != nil {
-				mu.Lock()
-				errs = append(errs, err)
-				mu.Unlock()
-				return nil
-			}
+	for _, q := range quotes {
+		switch {
+		case q.Err == nil:
+			results = append(results, q.Response)
+		case errors.Is(q.Err, ErrCarrierNotFound):
+			errs = append(errs, q.Err)
+		default:
+			errs = append(errs, fmt.Errorf("%s: %w", q.Carrier, q.Err))
+		}
+	}
+	return results, errs
+}
 
-			resp, err := s.GetQuote(ctx, req)
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				errs = append(errs, fmt.Errorf("%s: %w", name, err))
-				return nil
-			}
-			results = append(results, resp)
-			return nil
-		})
+// getQuote runs one branch of a quote fan-out in its own span.
+func (r *Registry) getQuote(ctx context.Context, s Shipper, req *QuoteRequest) (*QuoteResponse, error) {
+	r.mu.RLock()
+	tracer := r.tracer
+	r.mu.RUnlock()
+	if tracer == nil {
+		tracer = otel.Tracer(TracerName)
 	}
 
-	g.Wait()
-	return results, errs
+	ctx, span := tracer.Start(ctx, "registry.get_quote", trace.WithAttributes(
+		AttrCarrier.String(s.Name()),
+		AttrPackageCount.Int(len(req.Packages)),
+	))
+	defer span.End()
+
+	resp, err := s.GetQuote(ctx, req)
+	RecordError(span, err)
+	return resp, err
 }
@@ -3,12 +3,16 @@ package shipper_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tournevent/logistic/pkg/shipper"
 	"github.com/tournevent/logistic/pkg/shipper/mock"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestRegistry_Register(t *testing.T) {
@@ -198,3 +202,121 @@ func TestRegistry_GetQuotesFromCarriers_NotFound(t *testing.T) {
 	assert.Len(t, errs, 1)
 	assert.True(t, errors.Is(errs[0], shipper.ErrCarrierNotFound))
 }
+
+func TestRegistry_GetAllQuotes_SpanPerCarrier(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	registry := shipper.NewRegistry()
+	registry.SetTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"))
+
+	registry.Register(mock.New("carrier-a"))
+	registry.Register(mock.New("carrier-b"))
+
+	_, errs := registry.GetAllQuotes(context.Background(), &shipper.QuoteRequest{
+		Packages: []shipper.Package{{Weight: 1}},
+	})
+	require.Empty(t, errs)
+
+	carriers := make([]string, 0, 2)
+	for _, span := range recorder.Ended() {
+		assert.Equal(t, "registry.get_quote", span.Name())
+		for _, attr := range span.Attributes() {
+			if attr.Key == shipper.AttrCarrier {
+				carriers = append(carriers, attr.Value.AsString())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"carrier-a", "carrier-b"}, carriers)
+}
+
+// failingShipper fails every quote with err.
+type failingShipper struct {
+	shipper.Shipper
+	err error
+}
+
+func (s failingShipper) GetQuote(ctx context.Context, req *shipper.QuoteRequest) (*shipper.QuoteResponse, error) {
+	return nil, s.err
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRegistry_QuoteCarriers(t *testing.T) {
+	registry := shipper.NewRegistry()
+	registry.Register(mock.New("freightcom"))
+	registry.Register(failingShipper{mock.New("purolator"), shipper.NewShipperError("purolator", "HTTP_503", "Service Unavailable").WithRetryable(true)})
+	registry.Register(failingShipper{mock.New("canadapost"), &url.Error{Op: "Post", URL: "https://soa-gw.canadapost.ca", Err: timeoutError{}}})
+	registry.Register(failingShipper{mock.New("other"), shipper.NewUnsupportedOptionError("other", shipper.OptionSaturdayDelivery)})
+
+	quotes := registry.QuoteCarriers(context.Background(), &shipper.QuoteRequest{}, []string{"purolator", "freightcom", "canadapost", "other", "unknown", "freightcom"})
+
+	require.Len(t, quotes, 5)
+	carriers := make([]string, len(quotes))
+	statuses := make([]shipper.QuoteStatus, len(quotes))
+	for i, q := range quotes {
+		carriers[i] = q.Carrier
+		statuses[i] = q.Status
+	}
+	assert.Equal(t, []string{"canadapost", "freightcom", "other", "purolator", "unknown"}, carriers)
+	assert.Equal(t, []shipper.QuoteStatus{shipper.QuoteTimeout, shipper.QuoteOK, shipper.QuoteSkipped, shipper.QuoteError, shipper.QuoteSkipped}, statuses)
+
+	assert.True(t, quotes[0].Retryable())
+	assert.Empty(t, quotes[0].ErrorCode())
+	require.NotNil(t, quotes[1].Response)
+	assert.NoError(t, quotes[1].Err)
+	assert.False(t, quotes[1].Retryable())
+	assert.Equal(t, "unsupported_option", quotes[2].ErrorCode())
+	assert.Equal(t, "HTTP_503", quotes[3].ErrorCode())
+	assert.True(t, quotes[3].Retryable())
+	assert.ErrorIs(t, quotes[4].Err, shipper.ErrCarrierNotFound)
+}
+
+func TestRegistry_QuoteCarriers_All(t *testing.T) {
+	registry := shipper.NewRegistry()
+	registry.Register(mock.New("purolator"))
+	registry.Register(mock.New("canadapost"))
+
+	quotes := registry.QuoteCarriers(context.Background(), &shipper.QuoteRequest{}, nil)
+
+	require.Len(t, quotes, 2)
+	assert.Equal(t, "canadapost", quotes[0].Carrier)
+	assert.Equal(t, "purolator", quotes[1].Carrier)
+}
+
+func TestRegistry_QuoteCarriers_Deadline(t *testing.T) {
+	registry := shipper.NewRegistry()
+	registry.Register(failingShipper{mock.New("freightcom"), fmt.Errorf("polling rates: %w", context.DeadlineExceeded)})
+
+	quotes := registry.QuoteCarriers(context.Background(), &shipper.QuoteRequest{}, nil)
+
+	require.Len(t, quotes, 1)
+	assert.Equal(t, shipper.QuoteTimeout, quotes[0].Status)
+}
+
+// codedError is a carrier API error reporting its own code.
+type codedError struct{ code string }
+
+func (e codedError) Error() string     { return "carrier error " + e.code }
+func (e codedError) ErrorCode() string { return e.code }
+
+func TestCarrierQuote_ErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"success", nil, ""},
+		{"shipper error", shipper.NewShipperError("fake", "INVALID_POSTAL_CODE", "bad postal code"), "INVALID_POSTAL_CODE"},
+		{"carrier API error", fmt.Errorf("quoting: %w", codedError{code: "E1002"}), "E1002"},
+		{"plain error", errors.New("boom"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &shipper.CarrierQuote{Carrier: "fake", Err: tt.err}
+			assert.Equal(t, tt.want, q.ErrorCode())
+		})
+	}
+}
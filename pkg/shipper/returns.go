@@ -0,0 +1,71 @@
+package shipper
+
+import (
+	"context"
+)
+
+// Returner is implemented by carriers that issue prepaid labels for
+// authorized returns. Find it with As[Returner].
+type Returner interface {
+	// CreateReturn books a shipment from a customer back to the shipper and
+	// returns its label, billed to the shipper's account.
+	CreateReturn(ctx context.Context, req *CreateReturnRequest) (*CreateReturnResponse, error)
+}
+
+// CreateReturnRequest is the request for creating a return shipment.
+type CreateReturnRequest struct {
+	ShipperID       string
+	OriginalOrderID string // Order being returned
+	ServiceID       string // Carrier-native service of the return
+	Returner        Contact
+	ReturnerAddress Address // Where the packages are sent back from
+	Receiver        Contact
+	ReceiverAddress Address // Where the packages are returned to
+	Packages        []Package
+	RMANumber       string      // Return merchandise authorization, printed on the label
+	LabelFormat     LabelFormat // Empty for PDF
+}
+
+// CreateReturnResponse is the response from creating a return shipment.
+type CreateReturnResponse struct {
+	OrderID          string
+	TrackingNumber   string
+	Carrier          string
+	Status           ShipmentStatus
+	TotalCharged     Money
+	Label            Label
+	AdditionalLabels []Label // For multi-package returns
+}
+
+// OrderRequest returns the return as the request of an order, for
+// recording it in the order store.
+func (r *CreateReturnRequest) OrderRequest() CreateOrderRequest {
+	return CreateOrderRequest{
+		ShipperID:        r.ShipperID,
+		ServiceID:        r.ServiceID,
+		Sender:           r.Returner,
+		SenderAddress:    r.ReturnerAddress,
+		Recipient:        r.Receiver,
+		RecipientAddress: r.ReceiverAddress,
+		Packages:         r.Packages,
+		Reference:        r.RMANumber,
+	}
+}
+
+// ReturnRequest returns the request for returning the order: the same
+// packages sent back from its recipient to its sender with the same
+// service.
+func (o *Order) ReturnRequest(rmaNumber string, format LabelFormat) *CreateReturnRequest {
+	return &CreateReturnRequest{
+		ShipperID:       o.ShipperID,
+		OriginalOrderID: o.OrderID,
+		ServiceID:       o.Request.ServiceID,
+		Returner:        o.Request.Recipient,
+		ReturnerAddress: o.Request.RecipientAddress,
+		Receiver:        o.Request.Sender,
+		ReceiverAddress: o.Request.SenderAddress,
+		Packages:        o.Request.Packages,
+		RMANumber:       rmaNumber,
+		LabelFormat:     format,
+	}
+}
@@ -21,4 +21,7 @@ type Shipper interface {
 
 	// CancelOrder cancels an existing shipment.
 	CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error)
+
+	// Track returns the tracking history of a shipment.
+	Track(ctx context.Context, req *TrackRequest) (*TrackResponse, error)
 }
@@ -0,0 +1,194 @@
+package shipper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// StoredRate is a rate option recorded when quotes are returned, so that a
+// later CreateOrder can be routed to the exact carrier and service that
+// produced the price.
+type StoredRate struct {
+	QuoteID   string
+	ShipperID string
+	Rate      RateOption
+	CreatedAt time.Time
+}
+
+// Expired reports whether the rate can no longer be booked at the given time.
+func (r *StoredRate) Expired(now time.Time) bool {
+	return !r.Rate.ExpiresAt.IsZero() && now.After(r.Rate.ExpiresAt)
+}
+
+// QuoteStore persists rate options returned by carriers.
+type QuoteStore interface {
+	// SaveQuote records all rates of a quote response for the given shipper.
+	SaveQuote(ctx context.Context, shipperID string, quote *QuoteResponse) error
+
+	// GetRate returns the stored rate with the given ID.
+	// Returns ErrQuoteNotFound if the rate is unknown and ErrQuoteExpired
+	// if it is past its expiry.
+	GetRate(ctx context.Context, rateID string) (*StoredRate, error)
+}
+
+// StatusChange is a single entry in an order's status history.
+type StatusChange struct {
+	Status    ShipmentStatus
+	Timestamp time.Time
+	Source    string // What reported the change, e.g. "create_order", "track"
+
+	// Timestamp is the carrier's time of the tracking event, rather than
+	// the time the change was recorded
+	CarrierTime bool
+}
+
+// Order is an order created through a carrier, kept together with the
+// request that produced it so later calls can be routed without relying
+// on the carrier's ID format.
+type Order struct {
+	OrderID   string
+	ShipperID string
+	Carrier   string
+	Reference string
+	Request   CreateOrderRequest
+	Response  CreateOrderResponse
+	Status    ShipmentStatus
+	History   []StatusChange // Oldest first
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Carrier manifests the order was transmitted in, empty until the
+	// order is manifested
+	ManifestIDs  []string
+	ManifestedAt *time.Time
+
+	ReturnOf string // Order this order returns, empty for outbound orders
+}
+
+// ShipDate returns the day the order is handed to the carrier: the
+// requested ship date, or the day it was created.
+func (o *Order) ShipDate() time.Time {
+	if o.Request.Options.ShipDate != nil {
+		return *o.Request.Options.ShipDate
+	}
+	return o.CreatedAt
+}
+
+// AcceptsStatus reports whether the order can move to status next. Orders
+// only move forward towards delivery, and delivered or cancelled orders do
+// not move at all. An exception can be reported at any point, after which
+// the order resumes from the status it had before the exception.
+func (o *Order) AcceptsStatus(next ShipmentStatus) bool {
+	switch {
+	case o.Status.Terminal() || next == o.Status:
+		return false
+	case next == StatusCancelled || next == StatusException:
+		return true
+	}
+	rank, ok := statusRank[next]
+	if !ok {
+		return false
+	}
+	if o.Status != StatusException {
+		return rank > statusRank[o.Status]
+	}
+	for i := len(o.History) - 1; i >= 0; i-- {
+		if status := o.History[i].Status; status != StatusException {
+			return rank >= statusRank[status]
+		}
+	}
+	return true
+}
+
+// OrderPage is one page of a shipper's orders, newest first.
+type OrderPage struct {
+	Orders     []*Order
+	NextCursor string // Empty when there are no more orders
+}
+
+// OrderStore persists orders and their status history.
+type OrderStore interface {
+	// SaveOrder creates or replaces an order.
+	SaveOrder(ctx context.Context, order *Order) error
+
+	// GetOrder returns the order with the given ID.
+	// Returns ErrOrderNotFound if the order is unknown.
+	GetOrder(ctx context.Context, orderID string) (*Order, error)
+
+	// GetOrderByReference returns the most recent order of a shipper with
+	// the given reference. Returns ErrOrderNotFound if none matches.
+	GetOrderByReference(ctx context.Context, shipperID, reference string) (*Order, error)
+
+	// GetOrderByTrackingNumber returns the most recent order shipped with a
+	// carrier under the given tracking number. Returns ErrOrderNotFound if
+	// none matches.
+	GetOrderByTrackingNumber(ctx context.Context, carrier, trackingNumber string) (*Order, error)
+
+	// ListOrders returns up to limit orders of a shipper, starting after cursor.
+	// Returns ErrInvalidCursor if the cursor was not produced by this store.
+	ListOrders(ctx context.Context, shipperID, cursor string, limit int) (*OrderPage, error)
+
+	// ListActiveOrders returns the orders of all shippers whose status is
+	// not terminal, oldest first.
+	ListActiveOrders(ctx context.Context) ([]*Order, error)
+
+	// UpdateStatus records a status change in the order's history.
+	// Repeated reports of the current status and changes the order does
+	// not accept (see Order.AcceptsStatus) are ignored; the result reports
+	// whether the status changed.
+	UpdateStatus(ctx context.Context, orderID string, change StatusChange) (bool, error)
+
+	// MarkManifested records the carrier manifests an order was
+	// transmitted in, leaving the rest of the order as stored.
+	// Returns ErrOrderNotFound if the order is unknown.
+	MarkManifested(ctx context.Context, orderID string, manifestIDs []string, at time.Time) error
+}
+
+// IdempotencyRecord ties a client-supplied idempotency key to the order it
+// created.
+type IdempotencyRecord struct {
+	ShipperID   string
+	Key         string
+	Fingerprint string // Of the request the key was first used with
+	OrderID     string // Empty while the order is being created
+	CreatedAt   time.Time
+}
+
+// Pending reports whether the order of the key is still being created.
+func (r *IdempotencyRecord) Pending() bool {
+	return r.OrderID == ""
+}
+
+// IdempotencyStore remembers the orders created with client-supplied
+// idempotency keys, so that a replayed CreateOrder returns the original
+// order instead of shipping twice. Keys are scoped to a shipper.
+type IdempotencyStore interface {
+	// Claim reserves key for a request with the given fingerprint. If the
+	// key is already claimed, the existing record is returned and claimed
+	// is false.
+	Claim(ctx context.Context, shipperID, key, fingerprint string) (record *IdempotencyRecord, claimed bool, err error)
+
+	// Complete records the order created for a claimed key.
+	Complete(ctx context.Context, shipperID, key, orderID string) error
+
+	// Release forgets a claimed key whose order could not be created, so
+	// that the request can be retried with the same key.
+	Release(ctx context.Context, shipperID, key string) error
+}
+
+// Fingerprint returns a hash of the request, ignoring its idempotency key,
+// for detecting a key replayed with a different payload. The quote and
+// service IDs are ignored too: they are resolved from the rate ID, which
+// may have expired by the time a key is replayed.
+func (r *CreateOrderRequest) Fingerprint() string {
+	req := *r
+	req.IdempotencyKey = ""
+	req.QuoteID = ""
+	req.ServiceID = ""
+	data, _ := json.Marshal(&req) // Cannot fail, the request is plain data
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,59 @@
+package shipper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func TestCreateOrderRequest_Fingerprint(t *testing.T) {
+	req := &shipper.CreateOrderRequest{
+		ShipperID:      "shipper-123",
+		RateID:         "rate-1",
+		Packages:       []shipper.Package{{Weight: 5}},
+		IdempotencyKey: "key-1",
+	}
+
+	retry := *req
+	retry.IdempotencyKey = "key-2"
+	assert.Equal(t, req.Fingerprint(), retry.Fingerprint())
+
+	changed := *req
+	changed.Packages = []shipper.Package{{Weight: 6}}
+	assert.NotEqual(t, req.Fingerprint(), changed.Fingerprint())
+}
+
+func TestOrder_AcceptsStatus(t *testing.T) {
+	history := func(statuses ...shipper.ShipmentStatus) []shipper.StatusChange {
+		changes := make([]shipper.StatusChange, len(statuses))
+		for i, s := range statuses {
+			changes[i] = shipper.StatusChange{Status: s}
+		}
+		return changes
+	}
+
+	tests := []struct {
+		name    string
+		history []shipper.StatusChange
+		next    shipper.ShipmentStatus
+		want    bool
+	}{
+		{"forward", history(shipper.StatusConfirmed), shipper.StatusInTransit, true},
+		{"backward", history(shipper.StatusConfirmed, shipper.StatusInTransit), shipper.StatusPending, false},
+		{"same", history(shipper.StatusConfirmed), shipper.StatusConfirmed, false},
+		{"unknown", history(shipper.StatusConfirmed), "", false},
+		{"exception", history(shipper.StatusConfirmed, shipper.StatusInTransit), shipper.StatusException, true},
+		{"cancelled", history(shipper.StatusConfirmed), shipper.StatusCancelled, true},
+		{"resume after exception", history(shipper.StatusConfirmed, shipper.StatusInTransit, shipper.StatusException), shipper.StatusInTransit, true},
+		{"backward after exception", history(shipper.StatusConfirmed, shipper.StatusInTransit, shipper.StatusException), shipper.StatusPickedUp, false},
+		{"delivered", history(shipper.StatusConfirmed, shipper.StatusDelivered), shipper.StatusException, false},
+		{"cancelled order", history(shipper.StatusConfirmed, shipper.StatusCancelled), shipper.StatusInTransit, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &shipper.Order{Status: tt.history[len(tt.history)-1].Status, History: tt.history}
+			assert.Equal(t, tt.want, order.AcceptsStatus(tt.next))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package shipper
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of spans created when no tracer
+// is supplied.
+const TracerName = "github.com/tournevent/logistic/pkg/shipper"
+
+// Span attributes set on carrier operations.
+const (
+	AttrCarrier      = attribute.Key("shipper.carrier")
+	AttrServiceCode  = attribute.Key("shipper.service_code")
+	AttrPackageCount = attribute.Key("shipper.package_count")
+	AttrErrorCode    = attribute.Key("shipper.error_code")
+)
+
+// StartSpan starts a span named "<carrier>.<operation>" tagged with the
+// carrier. A nil tracer falls back to the global tracer provider.
+func StartSpan(ctx context.Context, tracer trace.Tracer, carrier, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if tracer == nil {
+		tracer = otel.Tracer(TracerName)
+	}
+	attrs = append([]attribute.KeyValue{AttrCarrier.String(carrier)}, attrs...)
+	return tracer.Start(ctx, carrier+"."+operation, trace.WithAttributes(attrs...))
+}
+
+// RecordError marks the span as failed and tags it with the error code.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	if code := ErrorCode(err); code != "" {
+		span.SetAttributes(AttrErrorCode.String(code))
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// ErrorCode returns the carrier error code carried by err, or an empty
+// string if there is none. Carrier API errors can expose their code by
+// implementing ErrorCode() string.
+func ErrorCode(err error) string {
+	var shipperErr *ShipperError
+	if errors.As(err, &shipperErr) {
+		return shipperErr.Code
+	}
+	var apiErr interface{ ErrorCode() string }
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return ""
+}
@@ -0,0 +1,34 @@
+package shipper
+
+import (
+	"net/http"
+	"sort"
+)
+
+// SortEventsNewestFirst orders tracking events from most recent to oldest.
+// Carriers disagree on the order they report scans in, so adapters normalize
+// before returning a TrackResponse.
+func SortEventsNewestFirst(events []TrackingEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.After(events[j].Timestamp)
+	})
+}
+
+// PushTracker is implemented by carriers that push tracking events to a
+// webhook as shipments move, instead of waiting to be polled with Track.
+// Find it with As[PushTracker].
+type PushTracker interface {
+	// ParseTrackingPush authenticates a request pushed by the carrier and
+	// returns the tracking updates it carries. It fails with
+	// ErrAuthenticationFailed if the request is not from the carrier, and
+	// with ErrInvalidPush if the body cannot be parsed.
+	ParseTrackingPush(header http.Header, body []byte) ([]TrackingUpdate, error)
+}
+
+// TrackingUpdate is a tracking event pushed by a carrier for one of its
+// shipments.
+type TrackingUpdate struct {
+	OrderID        string // Carrier order ID, empty if the carrier only reports the tracking number
+	TrackingNumber string
+	Event          TrackingEvent
+}
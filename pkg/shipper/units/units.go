@@ -0,0 +1,158 @@
+// Package units converts package weights and dimensions to the units and
+// precision each carrier expects.
+package units
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+// Exact conversion factors, as defined by the international yard and pound
+// agreement.
+const (
+	KilogramsPerPound  = 0.45359237
+	CentimetersPerInch = 2.54
+)
+
+// Spec describes the units a carrier expects, how it rounds them and the
+// largest package it accepts. Values are rounded up to the next multiple of
+// the step so that a package is never declared lighter or smaller than it
+// is; a zero step disables rounding. Limits are in the spec's units and a
+// zero limit is not enforced.
+type Spec struct {
+	WeightUnit    shipper.WeightUnit
+	DimensionUnit shipper.DimensionUnit
+	WeightStep    float64
+	DimensionStep float64
+
+	MaxWeight          float64
+	MaxLength          float64 // Longest side
+	MaxLengthPlusGirth float64 // Longest side plus twice the two others
+}
+
+// Normalize returns pkg with its weight and dimensions converted to the
+// spec's units and rounded to its precision.
+func (s Spec) Normalize(pkg shipper.Package) shipper.Package {
+	pkg.Weight = s.Weight(pkg.Weight, pkg.WeightUnit)
+	pkg.WeightUnit = s.WeightUnit
+	pkg.Length = RoundUp(ConvertDimension(pkg.Length, pkg.DimensionUnit, s.DimensionUnit), s.DimensionStep)
+	pkg.Width = RoundUp(ConvertDimension(pkg.Width, pkg.DimensionUnit, s.DimensionUnit), s.DimensionStep)
+	pkg.Height = RoundUp(ConvertDimension(pkg.Height, pkg.DimensionUnit, s.DimensionUnit), s.DimensionStep)
+	pkg.DimensionUnit = s.DimensionUnit
+	return pkg
+}
+
+// Weight converts a weight to the spec's unit, rounded to its precision.
+func (s Spec) Weight(value float64, unit shipper.WeightUnit) float64 {
+	return RoundUp(ConvertWeight(value, unit, s.WeightUnit), s.WeightStep)
+}
+
+// NormalizeAll normalizes each package. The input slice is not modified.
+func (s Spec) NormalizeAll(pkgs []shipper.Package) []shipper.Package {
+	if pkgs == nil {
+		return nil
+	}
+	result := make([]shipper.Package, len(pkgs))
+	for i, pkg := range pkgs {
+		result[i] = s.Normalize(pkg)
+	}
+	return result
+}
+
+// TotalWeight returns the summed weight of packages already normalized to
+// the spec, rounded to its precision.
+func (s Spec) TotalWeight(pkgs []shipper.Package) float64 {
+	var total float64
+	for _, pkg := range pkgs {
+		total += pkg.Weight
+	}
+	return RoundUp(total, s.WeightStep)
+}
+
+// Check reports the packages exceeding the spec's limits. Packages must
+// already be normalized to the spec. It returns a *shipper.ValidationError
+// whose fields wrap shipper.ErrInvalidPackage, or nil.
+func (s Spec) Check(pkgs []shipper.Package) error {
+	var fields []*shipper.FieldError
+	add := func(field, format string, args ...any) {
+		fields = append(fields, &shipper.FieldError{
+			Field:   field,
+			Message: fmt.Sprintf(format, args...),
+			Err:     shipper.ErrInvalidPackage,
+		})
+	}
+
+	for i, pkg := range pkgs {
+		path := fmt.Sprintf("packages[%d]", i)
+		if s.MaxWeight > 0 && pkg.Weight > s.MaxWeight {
+			add(path+".weight", "must not exceed %g %s for this carrier", s.MaxWeight, s.WeightUnit)
+		}
+
+		longest, side := pkg.Length, "length"
+		if pkg.Width > longest {
+			longest, side = pkg.Width, "width"
+		}
+		if pkg.Height > longest {
+			longest, side = pkg.Height, "height"
+		}
+		if s.MaxLength > 0 && longest > s.MaxLength {
+			add(path+"."+side, "must not exceed %g %s for this carrier", s.MaxLength, s.DimensionUnit)
+		}
+		girth := 2 * (pkg.Length + pkg.Width + pkg.Height - longest)
+		if s.MaxLengthPlusGirth > 0 && longest+girth > s.MaxLengthPlusGirth {
+			add(path, "length plus girth must not exceed %g %s for this carrier", s.MaxLengthPlusGirth, s.DimensionUnit)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return &shipper.ValidationError{Fields: fields}
+}
+
+// ConvertWeight converts value from one weight unit to another. An empty
+// unit is taken as kilograms.
+func ConvertWeight(value float64, from, to shipper.WeightUnit) float64 {
+	if isPounds(from) == isPounds(to) {
+		return value
+	}
+	if isPounds(from) {
+		return value * KilogramsPerPound
+	}
+	return value / KilogramsPerPound
+}
+
+// ConvertDimension converts value from one dimension unit to another. An
+// empty unit is taken as centimeters.
+func ConvertDimension(value float64, from, to shipper.DimensionUnit) float64 {
+	if isInches(from) == isInches(to) {
+		return value
+	}
+	if isInches(from) {
+		return value * CentimetersPerInch
+	}
+	return value / CentimetersPerInch
+}
+
+// RoundUp rounds value up to the next multiple of step. Values within a
+// millionth of a step of a multiple are treated as exact, so conversion
+// noise such as 2.0000000001 does not round up to the next step.
+func RoundUp(value, step float64) float64 {
+	if step <= 0 {
+		return value
+	}
+	n := math.Ceil(math.Round(value/step*1e6) / 1e6)
+
+	// Drop float noise from the product, e.g. 3*0.1 = 0.30000000000000004
+	return math.Round(n*step*1e9) / 1e9
+}
+
+func isPounds(u shipper.WeightUnit) bool {
+	return u == shipper.WeightLB
+}
+
+func isInches(u shipper.DimensionUnit) bool {
+	return u == shipper.DimensionIN
+}
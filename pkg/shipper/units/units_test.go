@@ -0,0 +1,116 @@
+package units_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/units"
+)
+
+func TestConvertWeight(t *testing.T) {
+	assert.InDelta(t, 4.5359237, units.ConvertWeight(10, shipper.WeightLB, shipper.WeightKG), 1e-12)
+	assert.InDelta(t, 10, units.ConvertWeight(4.5359237, shipper.WeightKG, shipper.WeightLB), 1e-12)
+	assert.Equal(t, 10.0, units.ConvertWeight(10, shipper.WeightLB, shipper.WeightLB))
+	assert.Equal(t, 10.0, units.ConvertWeight(10, "", shipper.WeightKG), "empty unit is kilograms")
+}
+
+func TestConvertDimension(t *testing.T) {
+	assert.Equal(t, 25.4, units.ConvertDimension(10, shipper.DimensionIN, shipper.DimensionCM))
+	assert.InDelta(t, 10, units.ConvertDimension(25.4, shipper.DimensionCM, shipper.DimensionIN), 1e-12)
+	assert.Equal(t, 10.0, units.ConvertDimension(10, "", shipper.DimensionCM), "empty unit is centimeters")
+}
+
+func TestRoundUp(t *testing.T) {
+	tests := []struct {
+		value, step, want float64
+	}{
+		{4.5359237, 0.001, 4.536},
+		{4.536, 0.001, 4.536},
+		{0.25, 0.1, 0.3},
+		{2.0000000001, 0.1, 2},
+		{12.01, 1, 13},
+		{3.14159, 0, 3.14159},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, units.RoundUp(tt.value, tt.step), "RoundUp(%v, %v)", tt.value, tt.step)
+	}
+}
+
+func TestSpec_Normalize(t *testing.T) {
+	spec := units.Spec{
+		WeightUnit:    shipper.WeightKG,
+		DimensionUnit: shipper.DimensionCM,
+		WeightStep:    0.001,
+		DimensionStep: 0.1,
+	}
+
+	pkg := spec.Normalize(shipper.Package{
+		ID:            "box-1",
+		Weight:        10,
+		WeightUnit:    shipper.WeightLB,
+		Length:        12,
+		Width:         8.5,
+		Height:        3.25,
+		DimensionUnit: shipper.DimensionIN,
+		DeclaredValue: shipper.Money{Minor: 10000, Currency: "CAD"},
+	})
+
+	assert.Equal(t, shipper.Package{
+		ID:            "box-1",
+		Weight:        4.536,
+		WeightUnit:    shipper.WeightKG,
+		Length:        30.5,
+		Width:         21.6,
+		Height:        8.3,
+		DimensionUnit: shipper.DimensionCM,
+		DeclaredValue: shipper.Money{Minor: 10000, Currency: "CAD"},
+	}, pkg)
+}
+
+func TestSpec_NormalizeAll(t *testing.T) {
+	spec := units.Spec{WeightUnit: shipper.WeightLB, DimensionUnit: shipper.DimensionIN, WeightStep: 0.1}
+	pkgs := []shipper.Package{
+		{Weight: 1, WeightUnit: shipper.WeightKG},
+		{Weight: 2, WeightUnit: shipper.WeightLB},
+	}
+
+	normalized := spec.NormalizeAll(pkgs)
+
+	assert.Equal(t, 2.3, normalized[0].Weight)
+	assert.Equal(t, 2.0, normalized[1].Weight)
+	assert.Equal(t, 4.3, spec.TotalWeight(normalized))
+	assert.Equal(t, shipper.WeightKG, pkgs[0].WeightUnit, "input must not be modified")
+	assert.Nil(t, spec.NormalizeAll(nil))
+}
+
+func TestSpec_Check(t *testing.T) {
+	spec := units.Spec{
+		WeightUnit:         shipper.WeightKG,
+		DimensionUnit:      shipper.DimensionCM,
+		MaxWeight:          30,
+		MaxLength:          200,
+		MaxLengthPlusGirth: 300,
+	}
+
+	assert.NoError(t, spec.Check([]shipper.Package{{Weight: 30, Length: 200, Width: 10, Height: 10}}))
+
+	err := spec.Check([]shipper.Package{
+		{Weight: 1, Length: 10, Width: 10, Height: 10},
+		{Weight: 31, Length: 10, Width: 210, Height: 50},
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, shipper.ErrInvalidPackage)
+	fields := shipper.ValidationFields(err)
+	require.Len(t, fields, 3)
+	assert.Equal(t, "packages[1].weight", fields[0].Field)
+	assert.Equal(t, "packages[1].width", fields[1].Field)
+	assert.Equal(t, "packages[1]", fields[2].Field)
+	assert.Equal(t, "length plus girth must not exceed 300 cm for this carrier", fields[2].Message)
+}
+
+func TestSpec_Check_NoLimits(t *testing.T) {
+	assert.NoError(t, units.Spec{}.Check([]shipper.Package{{Weight: 1e6, Length: 1e6}}))
+}
@@ -0,0 +1,384 @@
+package shipper
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FieldError reports an invalid request field. It wraps ErrInvalidAddress,
+// ErrInvalidPackage, ErrInvalidPickup or ErrInvalidCustoms.
+type FieldError struct {
+	Field   string // JSON path of the field, e.g. "packages[1].weight"
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Unwrap returns the sentinel error of the failure.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError lists every invalid field of a request.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the field errors, so that errors.Is matches their
+// sentinel errors.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+// validator collects field errors while checking a request.
+type validator struct {
+	fields []*FieldError
+}
+
+func (v *validator) add(field string, sentinel error, format string, args ...any) {
+	v.fields = append(v.fields, &FieldError{Field: field, Message: fmt.Sprintf(format, args...), Err: sentinel})
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// Request is a request that Validate can check.
+type Request interface {
+	*QuoteRequest | *CreateOrderRequest | *SchedulePickupRequest | *CreateReturnRequest
+}
+
+// Validate checks a quote, order, pickup or return request before it is sent to
+// carriers: ISO country codes, postal code formats, CA and US province
+// codes, package weight, dimension and declared value bounds, pickup
+// windows and customs declarations, which international orders require.
+// Carrier-specific limits are checked by each carrier. It returns a
+// *ValidationError, or nil if the request is valid.
+func Validate[R Request](req R) error {
+	var v validator
+	switch req := any(req).(type) {
+	case *QuoteRequest:
+		validateAddress(&v, "origin", req.Origin, false)
+		validateAddress(&v, "destination", req.Destination, false)
+		validatePackages(&v, req.Packages)
+		if req.Customs != nil {
+			validateCustoms(&v, "customs", req.Customs)
+		}
+	case *CreateOrderRequest:
+		validateAddress(&v, "senderAddress", req.SenderAddress, true)
+		validateAddress(&v, "recipientAddress", req.RecipientAddress, true)
+		validatePackages(&v, req.Packages)
+		if req.Pickup != nil {
+			validatePickupWindow(&v, "pickup", *req.Pickup)
+		}
+		switch {
+		case req.Customs != nil:
+			validateCustoms(&v, "customs", req.Customs)
+		case International(req.SenderAddress, req.RecipientAddress):
+			v.add("customs", ErrInvalidCustoms, "is required for international shipments")
+		}
+	case *SchedulePickupRequest:
+		validateAddress(&v, "address", req.Address, true)
+		validatePickupWindow(&v, "window", req.Window)
+		if strings.TrimSpace(req.Contact.Phone) == "" {
+			v.add("contact.phone", ErrInvalidPickup, "is required")
+		}
+		if req.PackageCount <= 0 {
+			v.add("packageCount", ErrInvalidPickup, "must be greater than 0")
+		}
+		if _, ok := maxPackageWeight[req.WeightUnit]; !ok && req.WeightUnit != "" {
+			v.add("weightUnit", ErrInvalidPickup, "unknown weight unit %q", req.WeightUnit)
+		}
+		if req.TotalWeight <= 0 {
+			v.add("totalWeight", ErrInvalidPickup, "must be greater than 0")
+		}
+	case *CreateReturnRequest:
+		validateAddress(&v, "returnerAddress", req.ReturnerAddress, true)
+		validateAddress(&v, "receiverAddress", req.ReceiverAddress, true)
+		validatePackages(&v, req.Packages)
+	}
+	return v.err()
+}
+
+// Package bounds per unit, about 10 tonnes and 10 meters. No carrier
+// accepts more.
+var (
+	maxPackageWeight    = map[WeightUnit]float64{WeightKG: 10000, WeightLB: 22000}
+	maxPackageDimension = map[DimensionUnit]float64{DimensionCM: 1000, DimensionIN: 400}
+)
+
+// postalCodeFormats lists the postal code formats of the countries we
+// ship to most. Postal codes are required in these countries and free-form
+// elsewhere.
+var postalCodeFormats = map[string]*regexp.Regexp{
+	"CA": regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	"MX": regexp.MustCompile(`^\d{5}$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+}
+
+// provinceCodes lists the province, territory and state codes of the
+// countries where carriers require one.
+var provinceCodes = map[string]map[string]bool{
+	"CA": set("AB BC MB NB NL NS NT NU ON PE QC SK YT"),
+	"US": set("AL AK AZ AR CA CO CT DE DC FL GA HI ID IL IN IA KS KY LA ME MD MA MI MN MS MO MT NE NV NH NJ NM NY NC ND OH OK OR PA RI SC SD TN TX UT VT VA WA WV WI WY " +
+		"AS GU MP PR VI AA AE AP"),
+}
+
+// countryCodes lists the ISO 3166-1 alpha-2 country codes.
+var countryCodes = set("AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ " +
+	"BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ " +
+	"CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ " +
+	"DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR " +
+	"GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY " +
+	"HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP " +
+	"KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY " +
+	"MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ " +
+	"NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA " +
+	"RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ " +
+	"TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ " +
+	"VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW")
+
+// exportReasons lists the reasons for export carriers accept.
+var exportReasons = map[ExportReason]bool{
+	ExportSale:   true,
+	ExportGift:   true,
+	ExportSample: true,
+	ExportRepair: true,
+	ExportReturn: true,
+}
+
+// hsCodeFormat matches Harmonized System codes of 6, 8 or 10 digits,
+// optionally grouped with dots, e.g. "6109.10" or "6109100010".
+var hsCodeFormat = regexp.MustCompile(`^\d{4}(\.?\d{2}){1,3}$`)
+
+func set(codes string) map[string]bool {
+	m := make(map[string]bool)
+	for _, c := range strings.Fields(codes) {
+		m[c] = true
+	}
+	return m
+}
+
+// validateAddress checks addr. Street-level fields are only required when
+// shipping, not when quoting.
+func validateAddress(v *validator, path string, addr Address, shipping bool) {
+	country := strings.ToUpper(addr.CountryCode)
+	if !countryCodes[country] {
+		v.add(path+".countryCode", ErrInvalidAddress, "%q is not an ISO 3166-1 alpha-2 country code", addr.CountryCode)
+		return
+	}
+
+	if format, ok := postalCodeFormats[country]; ok {
+		postalCode := strings.ToUpper(strings.TrimSpace(addr.PostalCode))
+		switch {
+		case postalCode == "":
+			v.add(path+".postalCode", ErrInvalidAddress, "is required")
+		case !format.MatchString(postalCode):
+			v.add(path+".postalCode", ErrInvalidAddress, "%q is not a valid %s postal code", addr.PostalCode, country)
+		}
+	}
+
+	if codes, ok := provinceCodes[country]; ok {
+		province := strings.ToUpper(strings.TrimSpace(addr.ProvinceCode))
+		switch {
+		case province == "":
+			if shipping {
+				v.add(path+".provinceCode", ErrInvalidAddress, "is required")
+			}
+		case !codes[province]:
+			v.add(path+".provinceCode", ErrInvalidAddress, "%q is not a valid %s province code", addr.ProvinceCode, country)
+		}
+	}
+
+	if shipping {
+		if strings.TrimSpace(addr.Line1) == "" {
+			v.add(path+".line1", ErrInvalidAddress, "is required")
+		}
+		if strings.TrimSpace(addr.City) == "" {
+			v.add(path+".city", ErrInvalidAddress, "is required")
+		}
+	}
+}
+
+func validatePackages(v *validator, pkgs []Package) {
+	if len(pkgs) == 0 {
+		v.add("packages", ErrInvalidPackage, "at least one package is required")
+		return
+	}
+
+	for i, pkg := range pkgs {
+		path := fmt.Sprintf("packages[%d]", i)
+
+		weightUnit := pkg.WeightUnit
+		if weightUnit == "" {
+			weightUnit = WeightKG
+		}
+		maxWeight, ok := maxPackageWeight[weightUnit]
+		switch {
+		case !ok:
+			v.add(path+".weightUnit", ErrInvalidPackage, "unknown weight unit %q", pkg.WeightUnit)
+		case pkg.Weight <= 0:
+			v.add(path+".weight", ErrInvalidPackage, "must be greater than 0")
+		case pkg.Weight > maxWeight:
+			v.add(path+".weight", ErrInvalidPackage, "must not exceed %g %s", maxWeight, weightUnit)
+		}
+
+		dimensionUnit := pkg.DimensionUnit
+		if dimensionUnit == "" {
+			dimensionUnit = DimensionCM
+		}
+		if maxDimension, ok := maxPackageDimension[dimensionUnit]; ok {
+			validateDimension(v, path+".length", pkg.Length, maxDimension, dimensionUnit)
+			validateDimension(v, path+".width", pkg.Width, maxDimension, dimensionUnit)
+			validateDimension(v, path+".height", pkg.Height, maxDimension, dimensionUnit)
+		} else {
+			v.add(path+".dimensionUnit", ErrInvalidPackage, "unknown dimension unit %q", pkg.DimensionUnit)
+		}
+
+		if pkg.DeclaredValue.Minor < 0 {
+			v.add(path+".declaredValue", ErrInvalidPackage, "must not be negative")
+		}
+		if pkg.DeclaredValue.Minor != 0 && !isCurrencyCode(pkg.DeclaredValue.Currency) {
+			v.add(path+".currency", ErrInvalidPackage, "%q is not an ISO 4217 currency code", pkg.DeclaredValue.Currency)
+		}
+	}
+}
+
+func validateDimension(v *validator, field string, value, maxValue float64, unit DimensionUnit) {
+	switch {
+	case value <= 0:
+		v.add(field, ErrInvalidPackage, "must be greater than 0")
+	case value > maxValue:
+		v.add(field, ErrInvalidPackage, "must not exceed %g %s", maxValue, unit)
+	}
+}
+
+// validateCustoms checks the reason for export, incoterm and currency of a
+// customs declaration and each of its items.
+func validateCustoms(v *validator, path string, c *Customs) {
+	if !exportReasons[c.ReasonForExport] {
+		v.add(path+".reasonForExport", ErrInvalidCustoms, "unknown reason for export %q", c.ReasonForExport)
+	}
+	if c.Incoterm != "" && c.Incoterm != IncotermDDU && c.Incoterm != IncotermDDP {
+		v.add(path+".incoterm", ErrInvalidCustoms, "unknown incoterm %q", c.Incoterm)
+	}
+	validCurrency := isCurrencyCode(c.Currency)
+	if !validCurrency {
+		v.add(path+".currency", ErrInvalidCustoms, "%q is not an ISO 4217 currency code", c.Currency)
+	}
+
+	if len(c.Items) == 0 {
+		v.add(path+".items", ErrInvalidCustoms, "at least one item is required")
+		return
+	}
+	for i, item := range c.Items {
+		itemPath := fmt.Sprintf("%s.items[%d]", path, i)
+
+		if strings.TrimSpace(item.Description) == "" {
+			v.add(itemPath+".description", ErrInvalidCustoms, "is required")
+		}
+		if item.Quantity <= 0 {
+			v.add(itemPath+".quantity", ErrInvalidCustoms, "must be greater than 0")
+		}
+		switch {
+		case item.UnitValue.Minor <= 0:
+			v.add(itemPath+".unitValue", ErrInvalidCustoms, "must be greater than 0")
+		case validCurrency && item.UnitValue.Currency != c.Currency:
+			v.add(itemPath+".unitValue", ErrInvalidCustoms, "must be in %s, the currency of the declaration", c.Currency)
+		}
+
+		weightUnit := item.WeightUnit
+		if weightUnit == "" {
+			weightUnit = WeightKG
+		}
+		switch _, ok := maxPackageWeight[weightUnit]; {
+		case !ok:
+			v.add(itemPath+".weightUnit", ErrInvalidCustoms, "unknown weight unit %q", item.WeightUnit)
+		case item.UnitWeight <= 0:
+			v.add(itemPath+".unitWeight", ErrInvalidCustoms, "must be greater than 0")
+		}
+
+		if !countryCodes[strings.ToUpper(item.CountryOfOrigin)] {
+			v.add(itemPath+".countryOfOrigin", ErrInvalidCustoms, "%q is not an ISO 3166-1 alpha-2 country code", item.CountryOfOrigin)
+		}
+		if item.HSCode != "" && !hsCodeFormat.MatchString(item.HSCode) {
+			v.add(itemPath+".hsCode", ErrInvalidCustoms, "%q is not a Harmonized System code of 6 to 10 digits", item.HSCode)
+		}
+	}
+}
+
+// validatePickupWindow checks that a pickup window is set, ends after it
+// starts and falls within one day, since carriers book pickups by date.
+func validatePickupWindow(v *validator, path string, w PickupWindow) {
+	switch {
+	case w.ReadyAt.IsZero():
+		v.add(path+".readyAt", ErrInvalidPickup, "is required")
+	case w.CloseAt.IsZero():
+		v.add(path+".closeAt", ErrInvalidPickup, "is required")
+	case !w.CloseAt.After(w.ReadyAt):
+		v.add(path+".closeAt", ErrInvalidPickup, "must be after readyAt")
+	case !sameDay(w.ReadyAt, w.CloseAt):
+		v.add(path+".closeAt", ErrInvalidPickup, "must be on the same day as readyAt")
+	}
+}
+
+func sameDay(a, b time.Time) bool {
+	y1, m1, d1 := a.Date()
+	y2, m2, d2 := b.In(a.Location()).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidationFields returns the field errors of a failed validation, or nil
+// if err is not one.
+func ValidationFields(err error) []*FieldError {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr.Fields
+	}
+	var ferr *FieldError
+	if errors.As(err, &ferr) {
+		return []*FieldError{ferr}
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package shipper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tournevent/logistic/pkg/shipper"
+)
+
+func validQuoteRequest() *shipper.QuoteRequest {
+	return &shipper.QuoteRequest{
+		Origin:      shipper.Address{PostalCode: "M5V 1A1", ProvinceCode: "ON", CountryCode: "CA"},
+		Destination: shipper.Address{PostalCode: "90210-1234", ProvinceCode: "CA", CountryCode: "US"},
+		Packages: []shipper.Package{
+			{Length: 10, Width: 10, Height: 10, Weight: 5},
+			{Length: 12, Width: 8, Height: 4, DimensionUnit: shipper.DimensionIN, Weight: 2, WeightUnit: shipper.WeightLB},
+		},
+	}
+}
+
+// fieldErrors returns the field paths of a validation error, mapped to the
+// sentinel error each one wraps.
+func fieldErrors(t *testing.T, err error) map[string]error {
+	t.Helper()
+	var verr *shipper.ValidationError
+	require.True(t, errors.As(err, &verr), "expected a validation error, got %v", err)
+
+	fields := make(map[string]error)
+	for _, f := range verr.Fields {
+		fields[f.Field] = f.Err
+	}
+	return fields
+}
+
+func TestValidate_QuoteRequest(t *testing.T) {
+	assert.NoError(t, shipper.Validate(validQuoteRequest()))
+}
+
+func TestValidate_QuoteRequest_Invalid(t *testing.T) {
+	req := validQuoteRequest()
+	req.Origin.PostalCode = "12345"
+	req.Destination.CountryCode = "XX"
+	req.Packages[0].Weight = 0
+	req.Packages[1].Length = -1
+	req.Packages[1].DeclaredValue = shipper.Money{Minor: -100, Currency: "CAD"}
+
+	err := shipper.Validate(req)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, shipper.ErrInvalidAddress)
+	assert.ErrorIs(t, err, shipper.ErrInvalidPackage)
+	assert.Equal(t, map[string]error{
+		"origin.postalCode":         shipper.ErrInvalidAddress,
+		"destination.countryCode":   shipper.ErrInvalidAddress,
+		"packages[0].weight":        shipper.ErrInvalidPackage,
+		"packages[1].length":        shipper.ErrInvalidPackage,
+		"packages[1].declaredValue": shipper.ErrInvalidPackage,
+	}, fieldErrors(t, err))
+	assert.Contains(t, err.Error(), `origin.postalCode: "12345" is not a valid CA postal code`)
+}
+
+func TestValidate_Address(t *testing.T) {
+	tests := []struct {
+		name  string
+		addr  shipper.Address
+		field string
+	}{
+		{"lowercase country", shipper.Address{CountryCode: "ca", PostalCode: "h2x 1y4"}, ""},
+		{"missing country", shipper.Address{PostalCode: "M5V1A1"}, "origin.countryCode"},
+		{"missing CA postal code", shipper.Address{CountryCode: "CA"}, "origin.postalCode"},
+		{"invalid CA postal code letter", shipper.Address{CountryCode: "CA", PostalCode: "D5V1A1"}, "origin.postalCode"},
+		{"invalid US ZIP code", shipper.Address{CountryCode: "US", PostalCode: "9021"}, "origin.postalCode"},
+		{"unknown province", shipper.Address{CountryCode: "CA", PostalCode: "M5V1A1", ProvinceCode: "ZZ"}, "origin.provinceCode"},
+		{"US state as CA province", shipper.Address{CountryCode: "CA", PostalCode: "M5V1A1", ProvinceCode: "NY"}, "origin.provinceCode"},
+		{"free-form postal code", shipper.Address{CountryCode: "IE", PostalCode: "D02 X285"}, ""},
+		{"no postal code required", shipper.Address{CountryCode: "HK"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validQuoteRequest()
+			req.Origin = tt.addr
+
+			err := shipper.Validate(req)
+
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, map[string]error{tt.field: shipper.ErrInvalidAddress}, fieldErrors(t, err))
+		})
+	}
+}
+
+func TestValidate_Package(t *testing.T) {
+	tests := []struct {
+		name  string
+		pkg   shipper.Package
+		field string
+	}{
+		{"too heavy", shipper.Package{Length: 1, Width: 1, Height: 1, Weight: 10001}, "packages[0].weight"},
+		{"too heavy in pounds", shipper.Package{Length: 1, Width: 1, Height: 1, Weight: 22001, WeightUnit: shipper.WeightLB}, "packages[0].weight"},
+		{"too long", shipper.Package{Length: 401, Width: 1, Height: 1, DimensionUnit: shipper.DimensionIN, Weight: 1}, "packages[0].length"},
+		{"zero height", shipper.Package{Length: 1, Width: 1, Weight: 1}, "packages[0].height"},
+		{"unknown weight unit", shipper.Package{Length: 1, Width: 1, Height: 1, Weight: 1, WeightUnit: "oz"}, "packages[0].weightUnit"},
+		{"unknown dimension unit", shipper.Package{Length: 1, Width: 1, Height: 1, Weight: 1, DimensionUnit: "mm"}, "packages[0].dimensionUnit"},
+		{"invalid currency", shipper.Package{Length: 1, Width: 1, Height: 1, Weight: 1, DeclaredValue: shipper.Money{Minor: 100, Currency: "dollars"}}, "packages[0].currency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validQuoteRequest()
+			req.Packages = []shipper.Package{tt.pkg}
+
+			err := shipper.Validate(req)
+
+			assert.Equal(t, map[string]error{tt.field: shipper.ErrInvalidPackage}, fieldErrors(t, err))
+		})
+	}
+}
+
+func TestValidate_NoPackages(t *testing.T) {
+	req := validQuoteRequest()
+	req.Packages = nil
+
+	err := shipper.Validate(req)
+
+	assert.Equal(t, map[string]error{"packages": shipper.ErrInvalidPackage}, fieldErrors(t, err))
+}
+
+func TestValidate_CreateOrderRequest(t *testing.T) {
+	req := &shipper.CreateOrderRequest{
+		SenderAddress:    shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		RecipientAddress: shipper.Address{PostalCode: "V6B2W2", CountryCode: "CA"},
+		Packages:         []shipper.Package{{Length: 10, Width: 10, Height: 10, Weight: 5}},
+	}
+
+	err := shipper.Validate(req)
+
+	assert.Equal(t, map[string]error{
+		"recipientAddress.line1":        shipper.ErrInvalidAddress,
+		"recipientAddress.city":         shipper.ErrInvalidAddress,
+		"recipientAddress.provinceCode": shipper.ErrInvalidAddress,
+	}, fieldErrors(t, err))
+}
+
+func TestValidate_CreateOrderRequest_Pickup(t *testing.T) {
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	req := &shipper.CreateOrderRequest{
+		SenderAddress:    shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		RecipientAddress: shipper.Address{Line1: "456 Oak Ave", City: "Vancouver", ProvinceCode: "BC", PostalCode: "V6B2W2", CountryCode: "CA"},
+		Packages:         []shipper.Package{{Length: 10, Width: 10, Height: 10, Weight: 5}},
+		Pickup:           &shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(-time.Hour)},
+	}
+
+	err := shipper.Validate(req)
+
+	assert.Equal(t, map[string]error{"pickup.closeAt": shipper.ErrInvalidPickup}, fieldErrors(t, err))
+}
+
+func validCustoms() *shipper.Customs {
+	return &shipper.Customs{
+		ReasonForExport: shipper.ExportSale,
+		Currency:        "CAD",
+		Items: []shipper.CustomsItem{{
+			Description:     "Cotton t-shirt",
+			Quantity:        3,
+			UnitValue:       shipper.Money{Minor: 2500, Currency: "CAD"},
+			UnitWeight:      0.2,
+			CountryOfOrigin: "CA",
+			HSCode:          "6109.10",
+		}},
+	}
+}
+
+func TestValidate_CreateOrderRequest_International(t *testing.T) {
+	req := &shipper.CreateOrderRequest{
+		SenderAddress:    shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		RecipientAddress: shipper.Address{Line1: "456 Elm St", City: "Beverly Hills", ProvinceCode: "CA", PostalCode: "90210", CountryCode: "US"},
+		Packages:         []shipper.Package{{Length: 10, Width: 10, Height: 10, Weight: 5}},
+	}
+
+	err := shipper.Validate(req)
+	assert.Equal(t, map[string]error{"customs": shipper.ErrInvalidCustoms}, fieldErrors(t, err))
+
+	req.Customs = validCustoms()
+	assert.NoError(t, shipper.Validate(req))
+}
+
+func TestValidate_Customs(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*shipper.Customs)
+		field  string
+	}{
+		{"unknown reason", func(c *shipper.Customs) { c.ReasonForExport = "smuggling" }, "customs.reasonForExport"},
+		{"unknown incoterm", func(c *shipper.Customs) { c.Incoterm = "EXW" }, "customs.incoterm"},
+		{"invalid currency", func(c *shipper.Customs) { c.Currency = "dollars" }, "customs.currency"},
+		{"no items", func(c *shipper.Customs) { c.Items = nil }, "customs.items"},
+		{"missing description", func(c *shipper.Customs) { c.Items[0].Description = " " }, "customs.items[0].description"},
+		{"zero quantity", func(c *shipper.Customs) { c.Items[0].Quantity = 0 }, "customs.items[0].quantity"},
+		{"zero value", func(c *shipper.Customs) { c.Items[0].UnitValue.Minor = 0 }, "customs.items[0].unitValue"},
+		{"value in other currency", func(c *shipper.Customs) { c.Items[0].UnitValue.Currency = "USD" }, "customs.items[0].unitValue"},
+		{"zero weight", func(c *shipper.Customs) { c.Items[0].UnitWeight = 0 }, "customs.items[0].unitWeight"},
+		{"unknown weight unit", func(c *shipper.Customs) { c.Items[0].WeightUnit = "oz" }, "customs.items[0].weightUnit"},
+		{"unknown country of origin", func(c *shipper.Customs) { c.Items[0].CountryOfOrigin = "XX" }, "customs.items[0].countryOfOrigin"},
+		{"short HS code", func(c *shipper.Customs) { c.Items[0].HSCode = "6109" }, "customs.items[0].hsCode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validQuoteRequest()
+			req.Customs = validCustoms()
+			tt.modify(req.Customs)
+
+			err := shipper.Validate(req)
+
+			assert.Equal(t, map[string]error{tt.field: shipper.ErrInvalidCustoms}, fieldErrors(t, err))
+		})
+	}
+}
+
+func validSchedulePickupRequest() *shipper.SchedulePickupRequest {
+	ready := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+	return &shipper.SchedulePickupRequest{
+		Address:      shipper.Address{Line1: "123 Main St", City: "Toronto", ProvinceCode: "ON", PostalCode: "M5V1A1", CountryCode: "CA"},
+		Contact:      shipper.Contact{Name: "Jane Doe", Phone: "416-555-0100"},
+		Window:       shipper.PickupWindow{ReadyAt: ready, CloseAt: ready.Add(4 * time.Hour)},
+		PackageCount: 2,
+		TotalWeight:  12.5,
+	}
+}
+
+func TestValidate_SchedulePickupRequest(t *testing.T) {
+	assert.NoError(t, shipper.Validate(validSchedulePickupRequest()))
+}
+
+func TestValidate_SchedulePickupRequest_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*shipper.SchedulePickupRequest)
+		field  string
+	}{
+		{"missing ready time", func(r *shipper.SchedulePickupRequest) { r.Window.ReadyAt = time.Time{} }, "window.readyAt"},
+		{"closes before ready", func(r *shipper.SchedulePickupRequest) { r.Window.CloseAt = r.Window.ReadyAt }, "window.closeAt"},
+		{"spans two days", func(r *shipper.SchedulePickupRequest) { r.Window.CloseAt = r.Window.ReadyAt.Add(24 * time.Hour) }, "window.closeAt"},
+		{"missing phone", func(r *shipper.SchedulePickupRequest) { r.Contact.Phone = "" }, "contact.phone"},
+		{"no packages", func(r *shipper.SchedulePickupRequest) { r.PackageCount = 0 }, "packageCount"},
+		{"zero weight", func(r *shipper.SchedulePickupRequest) { r.TotalWeight = 0 }, "totalWeight"},
+		{"unknown weight unit", func(r *shipper.SchedulePickupRequest) { r.WeightUnit = "oz" }, "weightUnit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validSchedulePickupRequest()
+			tt.modify(req)
+
+			err := shipper.Validate(req)
+
+			assert.Equal(t, map[string]error{tt.field: shipper.ErrInvalidPickup}, fieldErrors(t, err))
+		})
+	}
+}
+
+func TestValidate_SchedulePickupRequest_Address(t *testing.T) {
+	req := validSchedulePickupRequest()
+	req.Address.Line1 = ""
+
+	err := shipper.Validate(req)
+
+	assert.Equal(t, map[string]error{"address.line1": shipper.ErrInvalidAddress}, fieldErrors(t, err))
+}
+
+func TestValidate_CreateReturnRequest(t *testing.T) {
+	req := &shipper.CreateReturnRequest{
+		ReturnerAddress: shipper.Address{Line1: "456 Oak Ave", City: "Vancouver", ProvinceCode: "BC", PostalCode: "V6B2W2", CountryCode: "CA"},
+		ReceiverAddress: shipper.Address{PostalCode: "M5V1A1", CountryCode: "CA"},
+		Packages:        []shipper.Package{{Length: 10, Width: 10, Height: 10, Weight: 0}},
+	}
+
+	err := shipper.Validate(req)
+
+	assert.Equal(t, map[string]error{
+		"receiverAddress.line1":        shipper.ErrInvalidAddress,
+		"receiverAddress.city":         shipper.ErrInvalidAddress,
+		"receiverAddress.provinceCode": shipper.ErrInvalidAddress,
+		"packages[0].weight":           shipper.ErrInvalidPackage,
+	}, fieldErrors(t, err))
+}
+
+func TestValidationFields(t *testing.T) {
+	field := &shipper.FieldError{Field: "packages[0].weight", Message: "must be greater than 0", Err: shipper.ErrInvalidPackage}
+
+	assert.Equal(t, []*shipper.FieldError{field}, shipper.ValidationFields(&shipper.ValidationError{Fields: []*shipper.FieldError{field}}))
+	assert.Equal(t, []*shipper.FieldError{field}, shipper.ValidationFields(field))
+	assert.Nil(t, shipper.ValidationFields(shipper.ErrInvalidPackage))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tournevent/logistic/pkg/shipper"
+	"github.com/tournevent/logistic/pkg/shipper/rating"
+)
+
+var quoteFlags struct {
+	requestFlags
+	shipperID string
+	carriers  []string
+	sort      string
+}
+
+var quoteCmd = &cobra.Command{
+	Use:   "quote",
+	Short: "Get rates from carriers",
+	Long: `Get rates for a shipment from the enabled carriers, or those named with
+--carrier, ranked as returned by the GraphQL API.
+
+The request is a shipper.QuoteRequest in JSON or YAML, e.g.
+
+  origin: {postalCode: M5V3L9, provinceCode: ON, countryCode: CA}
+  destination: {postalCode: H2X1Y4, provinceCode: QC, countryCode: CA}
+  packages:
+    - {length: 30, width: 20, height: 10, dimensionUnit: cm, weight: 2, weightUnit: kg}`,
+	SilenceUsage: true,
+	RunE:         runQuote,
+}
+
+func init() {
+	quoteFlags.register(quoteCmd)
+	quoteCmd.Flags().StringVar(&quoteFlags.shipperID, "shipper-id", "", "shipper to quote for, overrides the request")
+	quoteCmd.Flags().StringSliceVar(&quoteFlags.carriers, "carrier", nil, "carriers to quote, overrides the request (default all)")
+	quoteCmd.Flags().StringVar(&quoteFlags.sort, "sort", string(rating.SortCheapest), "rate order: cheapest, fastest or best_value")
+	rootCmd.AddCommand(quoteCmd)
+}
+
+// quoteOutput is the JSON output of quote.
+type quoteOutput struct {
+	Rates    []rating.Rate
+	Carriers []carrierOutcome
+}
+
+// carrierOutcome is the outcome of asking one carrier for rates.
+type carrierOutcome struct {
+	Carrier string
+	Status  shipper.QuoteStatus
+	Error   string `json:",omitempty"`
+	Latency string
+}
+
+func runQuote(cmd *cobra.Command, args []string) error {
+	if err := validateOutput(quoteFlags.output); err != nil {
+		return err
+	}
+	sort := rating.Sort(quoteFlags.sort)
+	switch sort {
+	case rating.SortCheapest, rating.SortFastest, rating.SortBestValue:
+	default:
+		return fmt.Errorf("unsupported sort %q, use cheapest, fastest or best_value", quoteFlags.sort)
+	}
+
+	req, err := quoteRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, registry, err := cliRegistry()
+	if err != nil {
+		return err
+	}
+
+	quotes := registry.QuoteCarriers(cmd.Context(), req, req.Options.Carriers)
+	var rates []shipper.RateOption
+	out := quoteOutput{Carriers: make([]carrierOutcome, len(quotes))}
+	for i, q := range quotes {
+		out.Carriers[i] = carrierOutcome{
+			Carrier: q.Carrier,
+			Status:  q.Status,
+			Latency: q.Latency.Round(time.Millisecond).String(),
+		}
+		if q.Err != nil {
+			out.Carriers[i].Error = q.Err.Error()
+			continue
+		}
+		rates = append(rates, q.Response.Rates...)
+	}
+	out.Rates = rating.Rank(rates, rating.Options{
+		Filter: rating.Filter{ServiceTypes: req.Options.ServiceTypes},
+		Sort:   sort,
+		Weights: rating.Weights{
+			Price: cfg.RatingPriceWeight,
+			Speed: cfg.RatingSpeedWeight,
+		},
+	})
+
+	if quoteFlags.output == outputJSON {
+		if err := printJSON(cmd.OutOrStdout(), out); err != nil {
+			return err
+		}
+	} else if err := printQuote(cmd, out); err != nil {
+		return err
+	}
+
+	if len(out.Rates) == 0 {
+		return errors.New("no carrier returned rates")
+	}
+	return nil
+}
+
+// quoteRequest returns the validated request of quote, with the flags
+// applied over the request read.
+func quoteRequest(cmd *cobra.Command) (*shipper.QuoteRequest, error) {
+	var req shipper.QuoteRequest
+	if err := quoteFlags.read(cmd, &req); err != nil {
+		return nil, err
+	}
+	if quoteFlags.shipperID != "" {
+		req.ShipperID = quoteFlags.shipperID
+	}
+	if len(quoteFlags.carriers) > 0 {
+		req.Options.Carriers = quoteFlags.carriers
+	}
+	if err := shipper.Validate(&req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// printQuote writes the ranked rates as a table, and carrier failures to
+// stderr.
+func printQuote(cmd *cobra.Command, out quoteOutput) error {
+	tw := newTable(cmd.OutOrStdout())
+	fmt.Fprintln(tw, "RATE ID\tCARRIER\tSERVICE\tTYPE\tPRICE\tDAYS\tTAGS")
+	for _, r := range out.Rates {
+		days := "-"
+		if r.TransitDays > 0 {
+			days = strconv.Itoa(r.TransitDays)
+		}
+		tags := make([]string, len(r.Tags))
+		for i, t := range r.Tags {
+			tags[i] = string(t)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.RateID, r.Carrier, r.ServiceName, r.ServiceType, r.TotalPrice, days, strings.Join(tags, ","))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, c := range out.Carriers {
+		if c.Error != "" {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s: %s: %s\n", c.Carrier, c.Status, c.Error)
+		}
+	}
+	return nil
+}